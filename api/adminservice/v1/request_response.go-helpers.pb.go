@@ -2914,3 +2914,1594 @@ func (this *ListQueuesResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ListBacklogTasksRequest to the protobuf v3 wire format
+func (val *ListBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBacklogTasksRequest from the protobuf v3 wire format
+func (val *ListBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBacklogTasksRequest
+	switch t := that.(type) {
+	case *ListBacklogTasksRequest:
+		that1 = t
+	case ListBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListBacklogTasksResponse to the protobuf v3 wire format
+func (val *ListBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBacklogTasksResponse from the protobuf v3 wire format
+func (val *ListBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBacklogTasksResponse
+	switch t := that.(type) {
+	case *ListBacklogTasksResponse:
+		that1 = t
+	case ListBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteBacklogTasksRequest to the protobuf v3 wire format
+func (val *DeleteBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteBacklogTasksRequest from the protobuf v3 wire format
+func (val *DeleteBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteBacklogTasksRequest
+	switch t := that.(type) {
+	case *DeleteBacklogTasksRequest:
+		that1 = t
+	case DeleteBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteBacklogTasksResponse to the protobuf v3 wire format
+func (val *DeleteBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteBacklogTasksResponse from the protobuf v3 wire format
+func (val *DeleteBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteBacklogTasksResponse
+	switch t := that.(type) {
+	case *DeleteBacklogTasksResponse:
+		that1 = t
+	case DeleteBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordWorkerHeartbeatRequest to the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordWorkerHeartbeatRequest from the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordWorkerHeartbeatRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordWorkerHeartbeatRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordWorkerHeartbeatRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordWorkerHeartbeatRequest
+	switch t := that.(type) {
+	case *RecordWorkerHeartbeatRequest:
+		that1 = t
+	case RecordWorkerHeartbeatRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordWorkerHeartbeatResponse to the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordWorkerHeartbeatResponse from the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordWorkerHeartbeatResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordWorkerHeartbeatResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordWorkerHeartbeatResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordWorkerHeartbeatResponse
+	switch t := that.(type) {
+	case *RecordWorkerHeartbeatResponse:
+		that1 = t
+	case RecordWorkerHeartbeatResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListWorkersRequest to the protobuf v3 wire format
+func (val *ListWorkersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListWorkersRequest from the protobuf v3 wire format
+func (val *ListWorkersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListWorkersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListWorkersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListWorkersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListWorkersRequest
+	switch t := that.(type) {
+	case *ListWorkersRequest:
+		that1 = t
+	case ListWorkersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListWorkersResponse to the protobuf v3 wire format
+func (val *ListWorkersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListWorkersResponse from the protobuf v3 wire format
+func (val *ListWorkersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListWorkersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListWorkersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListWorkersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListWorkersResponse
+	switch t := that.(type) {
+	case *ListWorkersResponse:
+		that1 = t
+	case ListWorkersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerRequest to the protobuf v3 wire format
+func (val *DescribeWorkerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerRequest from the protobuf v3 wire format
+func (val *DescribeWorkerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerRequest
+	switch t := that.(type) {
+	case *DescribeWorkerRequest:
+		that1 = t
+	case DescribeWorkerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeWorkerResponse to the protobuf v3 wire format
+func (val *DescribeWorkerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeWorkerResponse from the protobuf v3 wire format
+func (val *DescribeWorkerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeWorkerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeWorkerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeWorkerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeWorkerResponse
+	switch t := that.(type) {
+	case *DescribeWorkerResponse:
+		that1 = t
+	case DescribeWorkerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseActivityRequest to the protobuf v3 wire format
+func (val *PauseActivityRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseActivityRequest from the protobuf v3 wire format
+func (val *PauseActivityRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseActivityRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseActivityRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseActivityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseActivityRequest
+	switch t := that.(type) {
+	case *PauseActivityRequest:
+		that1 = t
+	case PauseActivityRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PauseActivityResponse to the protobuf v3 wire format
+func (val *PauseActivityResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PauseActivityResponse from the protobuf v3 wire format
+func (val *PauseActivityResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PauseActivityResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PauseActivityResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PauseActivityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PauseActivityResponse
+	switch t := that.(type) {
+	case *PauseActivityResponse:
+		that1 = t
+	case PauseActivityResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseActivityRequest to the protobuf v3 wire format
+func (val *UnpauseActivityRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseActivityRequest from the protobuf v3 wire format
+func (val *UnpauseActivityRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseActivityRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseActivityRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseActivityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseActivityRequest
+	switch t := that.(type) {
+	case *UnpauseActivityRequest:
+		that1 = t
+	case UnpauseActivityRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UnpauseActivityResponse to the protobuf v3 wire format
+func (val *UnpauseActivityResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UnpauseActivityResponse from the protobuf v3 wire format
+func (val *UnpauseActivityResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UnpauseActivityResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UnpauseActivityResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UnpauseActivityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UnpauseActivityResponse
+	switch t := that.(type) {
+	case *UnpauseActivityResponse:
+		that1 = t
+	case UnpauseActivityResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SuspendWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *SuspendWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SuspendWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *SuspendWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SuspendWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SuspendWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SuspendWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SuspendWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *SuspendWorkflowExecutionRequest:
+		that1 = t
+	case SuspendWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SuspendWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *SuspendWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SuspendWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *SuspendWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SuspendWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SuspendWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SuspendWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SuspendWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *SuspendWorkflowExecutionResponse:
+		that1 = t
+	case SuspendWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeWorkflowExecutionRequest to the protobuf v3 wire format
+func (val *ResumeWorkflowExecutionRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeWorkflowExecutionRequest from the protobuf v3 wire format
+func (val *ResumeWorkflowExecutionRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeWorkflowExecutionRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeWorkflowExecutionRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeWorkflowExecutionRequest
+	switch t := that.(type) {
+	case *ResumeWorkflowExecutionRequest:
+		that1 = t
+	case ResumeWorkflowExecutionRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ResumeWorkflowExecutionResponse to the protobuf v3 wire format
+func (val *ResumeWorkflowExecutionResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ResumeWorkflowExecutionResponse from the protobuf v3 wire format
+func (val *ResumeWorkflowExecutionResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ResumeWorkflowExecutionResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ResumeWorkflowExecutionResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ResumeWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ResumeWorkflowExecutionResponse
+	switch t := that.(type) {
+	case *ResumeWorkflowExecutionResponse:
+		that1 = t
+	case ResumeWorkflowExecutionResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *ExportWorkflowExecutionsRequest:
+		that1 = t
+	case ExportWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExportWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExportWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *ExportWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExportWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExportWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExportWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExportWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *ExportWorkflowExecutionsResponse:
+		that1 = t
+	case ExportWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkflowExecutionExport to the protobuf v3 wire format
+func (val *WorkflowExecutionExport) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowExecutionExport from the protobuf v3 wire format
+func (val *WorkflowExecutionExport) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowExecutionExport) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowExecutionExport values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowExecutionExport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowExecutionExport
+	switch t := that.(type) {
+	case *WorkflowExecutionExport:
+		that1 = t
+	case WorkflowExecutionExport:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type HistoryBranchExport to the protobuf v3 wire format
+func (val *HistoryBranchExport) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryBranchExport from the protobuf v3 wire format
+func (val *HistoryBranchExport) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryBranchExport) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryBranchExport values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryBranchExport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryBranchExport
+	switch t := that.(type) {
+	case *HistoryBranchExport:
+		that1 = t
+	case HistoryBranchExport:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardLoad to the protobuf v3 wire format
+func (val *ShardLoad) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardLoad from the protobuf v3 wire format
+func (val *ShardLoad) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardLoad) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardLoad values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardLoad) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardLoad
+	switch t := that.(type) {
+	case *ShardLoad:
+		that1 = t
+	case ShardLoad:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeShardPlacementRequest to the protobuf v3 wire format
+func (val *DescribeShardPlacementRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeShardPlacementRequest from the protobuf v3 wire format
+func (val *DescribeShardPlacementRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeShardPlacementRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeShardPlacementRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeShardPlacementRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeShardPlacementRequest
+	switch t := that.(type) {
+	case *DescribeShardPlacementRequest:
+		that1 = t
+	case DescribeShardPlacementRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeShardPlacementResponse to the protobuf v3 wire format
+func (val *DescribeShardPlacementResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeShardPlacementResponse from the protobuf v3 wire format
+func (val *DescribeShardPlacementResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeShardPlacementResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeShardPlacementResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeShardPlacementResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeShardPlacementResponse
+	switch t := that.(type) {
+	case *DescribeShardPlacementResponse:
+		that1 = t
+	case DescribeShardPlacementResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardPlacementInfo to the protobuf v3 wire format
+func (val *ShardPlacementInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardPlacementInfo from the protobuf v3 wire format
+func (val *ShardPlacementInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardPlacementInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardPlacementInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardPlacementInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardPlacementInfo
+	switch t := that.(type) {
+	case *ShardPlacementInfo:
+		that1 = t
+	case ShardPlacementInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateShardPlacementRequest to the protobuf v3 wire format
+func (val *UpdateShardPlacementRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateShardPlacementRequest from the protobuf v3 wire format
+func (val *UpdateShardPlacementRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateShardPlacementRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateShardPlacementRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateShardPlacementRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateShardPlacementRequest
+	switch t := that.(type) {
+	case *UpdateShardPlacementRequest:
+		that1 = t
+	case UpdateShardPlacementRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateShardPlacementResponse to the protobuf v3 wire format
+func (val *UpdateShardPlacementResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateShardPlacementResponse from the protobuf v3 wire format
+func (val *UpdateShardPlacementResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateShardPlacementResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateShardPlacementResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateShardPlacementResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateShardPlacementResponse
+	switch t := that.(type) {
+	case *UpdateShardPlacementResponse:
+		that1 = t
+	case UpdateShardPlacementResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNamespaceReplicationFiltersRequest to the protobuf v3 wire format
+func (val *GetNamespaceReplicationFiltersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNamespaceReplicationFiltersRequest from the protobuf v3 wire format
+func (val *GetNamespaceReplicationFiltersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNamespaceReplicationFiltersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNamespaceReplicationFiltersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNamespaceReplicationFiltersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNamespaceReplicationFiltersRequest
+	switch t := that.(type) {
+	case *GetNamespaceReplicationFiltersRequest:
+		that1 = t
+	case GetNamespaceReplicationFiltersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNamespaceReplicationFiltersResponse to the protobuf v3 wire format
+func (val *GetNamespaceReplicationFiltersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNamespaceReplicationFiltersResponse from the protobuf v3 wire format
+func (val *GetNamespaceReplicationFiltersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNamespaceReplicationFiltersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNamespaceReplicationFiltersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNamespaceReplicationFiltersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNamespaceReplicationFiltersResponse
+	switch t := that.(type) {
+	case *GetNamespaceReplicationFiltersResponse:
+		that1 = t
+	case GetNamespaceReplicationFiltersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNamespaceReplicationFiltersRequest to the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFiltersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNamespaceReplicationFiltersRequest from the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFiltersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNamespaceReplicationFiltersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNamespaceReplicationFiltersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNamespaceReplicationFiltersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNamespaceReplicationFiltersRequest
+	switch t := that.(type) {
+	case *UpdateNamespaceReplicationFiltersRequest:
+		that1 = t
+	case UpdateNamespaceReplicationFiltersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateNamespaceReplicationFiltersResponse to the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFiltersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateNamespaceReplicationFiltersResponse from the protobuf v3 wire format
+func (val *UpdateNamespaceReplicationFiltersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateNamespaceReplicationFiltersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateNamespaceReplicationFiltersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateNamespaceReplicationFiltersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateNamespaceReplicationFiltersResponse
+	switch t := that.(type) {
+	case *UpdateNamespaceReplicationFiltersResponse:
+		that1 = t
+	case UpdateNamespaceReplicationFiltersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetReplicationStatusRequest to the protobuf v3 wire format
+func (val *GetReplicationStatusRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetReplicationStatusRequest from the protobuf v3 wire format
+func (val *GetReplicationStatusRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetReplicationStatusRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetReplicationStatusRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetReplicationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetReplicationStatusRequest
+	switch t := that.(type) {
+	case *GetReplicationStatusRequest:
+		that1 = t
+	case GetReplicationStatusRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetReplicationStatusResponse to the protobuf v3 wire format
+func (val *GetReplicationStatusResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetReplicationStatusResponse from the protobuf v3 wire format
+func (val *GetReplicationStatusResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetReplicationStatusResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetReplicationStatusResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetReplicationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetReplicationStatusResponse
+	switch t := that.(type) {
+	case *GetReplicationStatusResponse:
+		that1 = t
+	case GetReplicationStatusResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardReplicationStatus to the protobuf v3 wire format
+func (val *ShardReplicationStatus) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardReplicationStatus from the protobuf v3 wire format
+func (val *ShardReplicationStatus) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardReplicationStatus) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardReplicationStatus values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardReplicationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardReplicationStatus
+	switch t := that.(type) {
+	case *ShardReplicationStatus:
+		that1 = t
+	case ShardReplicationStatus:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ShardReplicationStatusPerCluster to the protobuf v3 wire format
+func (val *ShardReplicationStatusPerCluster) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardReplicationStatusPerCluster from the protobuf v3 wire format
+func (val *ShardReplicationStatusPerCluster) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardReplicationStatusPerCluster) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardReplicationStatusPerCluster values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardReplicationStatusPerCluster) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardReplicationStatusPerCluster
+	switch t := that.(type) {
+	case *ShardReplicationStatusPerCluster:
+		that1 = t
+	case ShardReplicationStatusPerCluster:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ReplicationDivergence to the protobuf v3 wire format
+func (val *ReplicationDivergence) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ReplicationDivergence from the protobuf v3 wire format
+func (val *ReplicationDivergence) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ReplicationDivergence) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ReplicationDivergence values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ReplicationDivergence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ReplicationDivergence
+	switch t := that.(type) {
+	case *ReplicationDivergence:
+		that1 = t
+	case ReplicationDivergence:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListQueueMitigationsRequest to the protobuf v3 wire format
+func (val *ListQueueMitigationsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListQueueMitigationsRequest from the protobuf v3 wire format
+func (val *ListQueueMitigationsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListQueueMitigationsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListQueueMitigationsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListQueueMitigationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListQueueMitigationsRequest
+	switch t := that.(type) {
+	case *ListQueueMitigationsRequest:
+		that1 = t
+	case ListQueueMitigationsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListQueueMitigationsResponse to the protobuf v3 wire format
+func (val *ListQueueMitigationsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListQueueMitigationsResponse from the protobuf v3 wire format
+func (val *ListQueueMitigationsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListQueueMitigationsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListQueueMitigationsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListQueueMitigationsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListQueueMitigationsResponse
+	switch t := that.(type) {
+	case *ListQueueMitigationsResponse:
+		that1 = t
+	case ListQueueMitigationsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RevertQueueMitigationRequest to the protobuf v3 wire format
+func (val *RevertQueueMitigationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RevertQueueMitigationRequest from the protobuf v3 wire format
+func (val *RevertQueueMitigationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RevertQueueMitigationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RevertQueueMitigationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RevertQueueMitigationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RevertQueueMitigationRequest
+	switch t := that.(type) {
+	case *RevertQueueMitigationRequest:
+		that1 = t
+	case RevertQueueMitigationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RevertQueueMitigationResponse to the protobuf v3 wire format
+func (val *RevertQueueMitigationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RevertQueueMitigationResponse from the protobuf v3 wire format
+func (val *RevertQueueMitigationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RevertQueueMitigationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RevertQueueMitigationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RevertQueueMitigationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RevertQueueMitigationResponse
+	switch t := that.(type) {
+	case *RevertQueueMitigationResponse:
+		that1 = t
+	case RevertQueueMitigationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetArchivedMutableStateRequest to the protobuf v3 wire format
+func (val *GetArchivedMutableStateRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetArchivedMutableStateRequest from the protobuf v3 wire format
+func (val *GetArchivedMutableStateRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetArchivedMutableStateRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetArchivedMutableStateRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetArchivedMutableStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetArchivedMutableStateRequest
+	switch t := that.(type) {
+	case *GetArchivedMutableStateRequest:
+		that1 = t
+	case GetArchivedMutableStateRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetArchivedMutableStateResponse to the protobuf v3 wire format
+func (val *GetArchivedMutableStateResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetArchivedMutableStateResponse from the protobuf v3 wire format
+func (val *GetArchivedMutableStateResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetArchivedMutableStateResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetArchivedMutableStateResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetArchivedMutableStateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetArchivedMutableStateResponse
+	switch t := that.(type) {
+	case *GetArchivedMutableStateResponse:
+		that1 = t
+	case GetArchivedMutableStateResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
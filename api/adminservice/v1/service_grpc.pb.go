@@ -80,6 +80,24 @@ const (
 	AdminService_CancelDLQJob_FullMethodName                      = "/temporal.server.api.adminservice.v1.AdminService/CancelDLQJob"
 	AdminService_AddTasks_FullMethodName                          = "/temporal.server.api.adminservice.v1.AdminService/AddTasks"
 	AdminService_ListQueues_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/ListQueues"
+	AdminService_ListBacklogTasks_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListBacklogTasks"
+	AdminService_DeleteBacklogTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DeleteBacklogTasks"
+	AdminService_RecordWorkerHeartbeat_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/RecordWorkerHeartbeat"
+	AdminService_ListWorkers_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ListWorkers"
+	AdminService_DescribeWorker_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/DescribeWorker"
+	AdminService_PauseActivity_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/PauseActivity"
+	AdminService_UnpauseActivity_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/UnpauseActivity"
+	AdminService_SuspendWorkflowExecution_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/SuspendWorkflowExecution"
+	AdminService_ResumeWorkflowExecution_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ResumeWorkflowExecution"
+	AdminService_ExportWorkflowExecutions_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/ExportWorkflowExecutions"
+	AdminService_DescribeShardPlacement_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/DescribeShardPlacement"
+	AdminService_UpdateShardPlacement_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/UpdateShardPlacement"
+	AdminService_GetNamespaceReplicationFilters_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/GetNamespaceReplicationFilters"
+	AdminService_UpdateNamespaceReplicationFilters_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/UpdateNamespaceReplicationFilters"
+	AdminService_GetReplicationStatus_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/GetReplicationStatus"
+	AdminService_ListQueueMitigations_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ListQueueMitigations"
+	AdminService_RevertQueueMitigation_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/RevertQueueMitigation"
+	AdminService_GetArchivedMutableState_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/GetArchivedMutableState"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -165,6 +183,60 @@ type AdminServiceClient interface {
 	CancelDLQJob(ctx context.Context, in *CancelDLQJobRequest, opts ...grpc.CallOption) (*CancelDLQJobResponse, error)
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error)
+	// ListBacklogTasks lists the persisted backlog of a task queue partition, starting at its ack level.
+	ListBacklogTasks(ctx context.Context, in *ListBacklogTasksRequest, opts ...grpc.CallOption) (*ListBacklogTasksResponse, error)
+	// DeleteBacklogTasks deletes specific tasks from the backlog of a task queue partition, optionally re-adding
+	// them to another task queue first.
+	// (-- api-linter: core::0135::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0135::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	DeleteBacklogTasks(ctx context.Context, in *DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteBacklogTasksResponse, error)
+	// RecordWorkerHeartbeat records the state of a worker in the worker registry of the root partition of a task
+	// queue. Workers report their state on polls instead, see the worker-heartbeat-bin header.
+	RecordWorkerHeartbeat(ctx context.Context, in *RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkerHeartbeatResponse, error)
+	// ListWorkers lists the workers that recently polled or heartbeated on any partition of a task queue.
+	ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error)
+	// DescribeWorker returns the registry state of a single worker of a task queue.
+	DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error)
+	// PauseActivity stops dispatching and retrying a pending activity until it is unpaused.
+	PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error)
+	// UnpauseActivity resumes a paused activity, optionally restarting its retry policy from the first attempt.
+	UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error)
+	// SuspendWorkflowExecution freezes a running workflow execution until it is resumed, without terminating it.
+	SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*SuspendWorkflowExecutionResponse, error)
+	// ResumeWorkflowExecution resumes a suspended workflow execution.
+	ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResumeWorkflowExecutionResponse, error)
+	// ExportWorkflowExecutions returns one page of the executions matching a visibility query with their mutable
+	// state, in a format which can be imported into another namespace or cluster. The history of each execution is
+	// read separately, one page at a time, with GetWorkflowExecutionRawHistoryV2.
+	ExportWorkflowExecutions(ctx context.Context, in *ExportWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExportWorkflowExecutionsResponse, error)
+	// DescribeShardPlacement returns the owner, placement and load of each history shard.
+	DescribeShardPlacement(ctx context.Context, in *DescribeShardPlacementRequest, opts ...grpc.CallOption) (*DescribeShardPlacementResponse, error)
+	// UpdateShardPlacement pins a history shard to a host, or removes its placement. Placements are only used in the
+	// load-aware shard placement mode.
+	UpdateShardPlacement(ctx context.Context, in *UpdateShardPlacementRequest, opts ...grpc.CallOption) (*UpdateShardPlacementResponse, error)
+	// GetNamespaceReplicationFilters returns the filters excluding workflow executions of a namespace from replication.
+	GetNamespaceReplicationFilters(ctx context.Context, in *GetNamespaceReplicationFiltersRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationFiltersResponse, error)
+	// UpdateNamespaceReplicationFilters replaces the filters excluding workflow executions of a namespace from
+	// replication. Filters are evaluated when executions generate replication tasks, so they apply to the tasks
+	// generated after the update. Filters can only be updated on the active cluster of the namespace, and are
+	// replicated to the standby clusters with the namespace.
+	UpdateNamespaceReplicationFilters(ctx context.Context, in *UpdateNamespaceReplicationFiltersRequest, opts ...grpc.CallOption) (*UpdateNamespaceReplicationFiltersResponse, error)
+	// GetReplicationStatus returns, for each history shard and remote cluster, the replication ack level, the tasks
+	// not yet acked, the age of the oldest of them and the size of the replication DLQ. It can also sample executions
+	// of a namespace and compare their version histories with the remote clusters to detect divergence.
+	GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error)
+	// ListQueueMitigations returns the mitigations a history task queue has applied since the shard was loaded.
+	ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error)
+	// RevertQueueMitigation moves the tasks moved by a mitigation back to the default reader of a history task queue.
+	RevertQueueMitigation(ctx context.Context, in *RevertQueueMitigationRequest, opts ...grpc.CallOption) (*RevertQueueMitigationResponse, error)
+	// GetArchivedMutableState returns the final mutable state of a workflow execution, with its search attributes and
+	// memo, archived with its history. The archiver of the namespace must support archiving mutable state snapshots.
+	GetArchivedMutableState(ctx context.Context, in *GetArchivedMutableStateRequest, opts ...grpc.CallOption) (*GetArchivedMutableStateResponse, error)
 }
 
 type adminServiceClient struct {
@@ -539,6 +611,168 @@ func (c *adminServiceClient) ListQueues(ctx context.Context, in *ListQueuesReque
 	return out, nil
 }
 
+func (c *adminServiceClient) ListBacklogTasks(ctx context.Context, in *ListBacklogTasksRequest, opts ...grpc.CallOption) (*ListBacklogTasksResponse, error) {
+	out := new(ListBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteBacklogTasks(ctx context.Context, in *DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteBacklogTasksResponse, error) {
+	out := new(DeleteBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RecordWorkerHeartbeat(ctx context.Context, in *RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkerHeartbeatResponse, error) {
+	out := new(RecordWorkerHeartbeatResponse)
+	err := c.cc.Invoke(ctx, AdminService_RecordWorkerHeartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error) {
+	out := new(ListWorkersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error) {
+	out := new(DescribeWorkerResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeWorker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error) {
+	out := new(PauseActivityResponse)
+	err := c.cc.Invoke(ctx, AdminService_PauseActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error) {
+	out := new(UnpauseActivityResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnpauseActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*SuspendWorkflowExecutionResponse, error) {
+	out := new(SuspendWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_SuspendWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResumeWorkflowExecutionResponse, error) {
+	out := new(ResumeWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResumeWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportWorkflowExecutions(ctx context.Context, in *ExportWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExportWorkflowExecutionsResponse, error) {
+	out := new(ExportWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExportWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeShardPlacement(ctx context.Context, in *DescribeShardPlacementRequest, opts ...grpc.CallOption) (*DescribeShardPlacementResponse, error) {
+	out := new(DescribeShardPlacementResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeShardPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateShardPlacement(ctx context.Context, in *UpdateShardPlacementRequest, opts ...grpc.CallOption) (*UpdateShardPlacementResponse, error) {
+	out := new(UpdateShardPlacementResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateShardPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNamespaceReplicationFilters(ctx context.Context, in *GetNamespaceReplicationFiltersRequest, opts ...grpc.CallOption) (*GetNamespaceReplicationFiltersResponse, error) {
+	out := new(GetNamespaceReplicationFiltersResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetNamespaceReplicationFilters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateNamespaceReplicationFilters(ctx context.Context, in *UpdateNamespaceReplicationFiltersRequest, opts ...grpc.CallOption) (*UpdateNamespaceReplicationFiltersResponse, error) {
+	out := new(UpdateNamespaceReplicationFiltersResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateNamespaceReplicationFilters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReplicationStatus(ctx context.Context, in *GetReplicationStatusRequest, opts ...grpc.CallOption) (*GetReplicationStatusResponse, error) {
+	out := new(GetReplicationStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetReplicationStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error) {
+	out := new(ListQueueMitigationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListQueueMitigations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevertQueueMitigation(ctx context.Context, in *RevertQueueMitigationRequest, opts ...grpc.CallOption) (*RevertQueueMitigationResponse, error) {
+	out := new(RevertQueueMitigationResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevertQueueMitigation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetArchivedMutableState(ctx context.Context, in *GetArchivedMutableStateRequest, opts ...grpc.CallOption) (*GetArchivedMutableStateResponse, error) {
+	out := new(GetArchivedMutableStateResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetArchivedMutableState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -622,6 +856,60 @@ type AdminServiceServer interface {
 	CancelDLQJob(context.Context, *CancelDLQJobRequest) (*CancelDLQJobResponse, error)
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error)
+	// ListBacklogTasks lists the persisted backlog of a task queue partition, starting at its ack level.
+	ListBacklogTasks(context.Context, *ListBacklogTasksRequest) (*ListBacklogTasksResponse, error)
+	// DeleteBacklogTasks deletes specific tasks from the backlog of a task queue partition, optionally re-adding
+	// them to another task queue first.
+	// (-- api-linter: core::0135::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0135::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	DeleteBacklogTasks(context.Context, *DeleteBacklogTasksRequest) (*DeleteBacklogTasksResponse, error)
+	// RecordWorkerHeartbeat records the state of a worker in the worker registry of the root partition of a task
+	// queue. Workers report their state on polls instead, see the worker-heartbeat-bin header.
+	RecordWorkerHeartbeat(context.Context, *RecordWorkerHeartbeatRequest) (*RecordWorkerHeartbeatResponse, error)
+	// ListWorkers lists the workers that recently polled or heartbeated on any partition of a task queue.
+	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
+	// DescribeWorker returns the registry state of a single worker of a task queue.
+	DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error)
+	// PauseActivity stops dispatching and retrying a pending activity until it is unpaused.
+	PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error)
+	// UnpauseActivity resumes a paused activity, optionally restarting its retry policy from the first attempt.
+	UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error)
+	// SuspendWorkflowExecution freezes a running workflow execution until it is resumed, without terminating it.
+	SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*SuspendWorkflowExecutionResponse, error)
+	// ResumeWorkflowExecution resumes a suspended workflow execution.
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*ResumeWorkflowExecutionResponse, error)
+	// ExportWorkflowExecutions returns one page of the executions matching a visibility query with their mutable
+	// state, in a format which can be imported into another namespace or cluster. The history of each execution is
+	// read separately, one page at a time, with GetWorkflowExecutionRawHistoryV2.
+	ExportWorkflowExecutions(context.Context, *ExportWorkflowExecutionsRequest) (*ExportWorkflowExecutionsResponse, error)
+	// DescribeShardPlacement returns the owner, placement and load of each history shard.
+	DescribeShardPlacement(context.Context, *DescribeShardPlacementRequest) (*DescribeShardPlacementResponse, error)
+	// UpdateShardPlacement pins a history shard to a host, or removes its placement. Placements are only used in the
+	// load-aware shard placement mode.
+	UpdateShardPlacement(context.Context, *UpdateShardPlacementRequest) (*UpdateShardPlacementResponse, error)
+	// GetNamespaceReplicationFilters returns the filters excluding workflow executions of a namespace from replication.
+	GetNamespaceReplicationFilters(context.Context, *GetNamespaceReplicationFiltersRequest) (*GetNamespaceReplicationFiltersResponse, error)
+	// UpdateNamespaceReplicationFilters replaces the filters excluding workflow executions of a namespace from
+	// replication. Filters are evaluated when executions generate replication tasks, so they apply to the tasks
+	// generated after the update. Filters can only be updated on the active cluster of the namespace, and are
+	// replicated to the standby clusters with the namespace.
+	UpdateNamespaceReplicationFilters(context.Context, *UpdateNamespaceReplicationFiltersRequest) (*UpdateNamespaceReplicationFiltersResponse, error)
+	// GetReplicationStatus returns, for each history shard and remote cluster, the replication ack level, the tasks
+	// not yet acked, the age of the oldest of them and the size of the replication DLQ. It can also sample executions
+	// of a namespace and compare their version histories with the remote clusters to detect divergence.
+	GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error)
+	// ListQueueMitigations returns the mitigations a history task queue has applied since the shard was loaded.
+	ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error)
+	// RevertQueueMitigation moves the tasks moved by a mitigation back to the default reader of a history task queue.
+	RevertQueueMitigation(context.Context, *RevertQueueMitigationRequest) (*RevertQueueMitigationResponse, error)
+	// GetArchivedMutableState returns the final mutable state of a workflow execution, with its search attributes and
+	// memo, archived with its history. The archiver of the namespace must support archiving mutable state snapshots.
+	GetArchivedMutableState(context.Context, *GetArchivedMutableStateRequest) (*GetArchivedMutableStateResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -743,6 +1031,60 @@ func (UnimplementedAdminServiceServer) AddTasks(context.Context, *AddTasksReques
 func (UnimplementedAdminServiceServer) ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQueues not implemented")
 }
+func (UnimplementedAdminServiceServer) ListBacklogTasks(context.Context, *ListBacklogTasksRequest) (*ListBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBacklogTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteBacklogTasks(context.Context, *DeleteBacklogTasksRequest) (*DeleteBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBacklogTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) RecordWorkerHeartbeat(context.Context, *RecordWorkerHeartbeatRequest) (*RecordWorkerHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordWorkerHeartbeat not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorker not implemented")
+}
+func (UnimplementedAdminServiceServer) PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivity not implemented")
+}
+func (UnimplementedAdminServiceServer) UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivity not implemented")
+}
+func (UnimplementedAdminServiceServer) SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*SuspendWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*ResumeWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflowExecution not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportWorkflowExecutions(context.Context, *ExportWorkflowExecutionsRequest) (*ExportWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeShardPlacement(context.Context, *DescribeShardPlacementRequest) (*DescribeShardPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeShardPlacement not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateShardPlacement(context.Context, *UpdateShardPlacementRequest) (*UpdateShardPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShardPlacement not implemented")
+}
+func (UnimplementedAdminServiceServer) GetNamespaceReplicationFilters(context.Context, *GetNamespaceReplicationFiltersRequest) (*GetNamespaceReplicationFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceReplicationFilters not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateNamespaceReplicationFilters(context.Context, *UpdateNamespaceReplicationFiltersRequest) (*UpdateNamespaceReplicationFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespaceReplicationFilters not implemented")
+}
+func (UnimplementedAdminServiceServer) GetReplicationStatus(context.Context, *GetReplicationStatusRequest) (*GetReplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationStatus not implemented")
+}
+func (UnimplementedAdminServiceServer) ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueMitigations not implemented")
+}
+func (UnimplementedAdminServiceServer) RevertQueueMitigation(context.Context, *RevertQueueMitigationRequest) (*RevertQueueMitigationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertQueueMitigation not implemented")
+}
+func (UnimplementedAdminServiceServer) GetArchivedMutableState(context.Context, *GetArchivedMutableStateRequest) (*GetArchivedMutableStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedMutableState not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1448,6 +1790,330 @@ func _AdminService_ListQueues_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBacklogTasks(ctx, req.(*ListBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteBacklogTasks(ctx, req.(*DeleteBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RecordWorkerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordWorkerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RecordWorkerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RecordWorkerHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RecordWorkerHeartbeat(ctx, req.(*RecordWorkerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorkers(ctx, req.(*ListWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeWorker(ctx, req.(*DescribeWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PauseActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseActivity(ctx, req.(*PauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnpauseActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseActivity(ctx, req.(*UnpauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SuspendWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SuspendWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SuspendWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SuspendWorkflowExecution(ctx, req.(*SuspendWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResumeWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResumeWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResumeWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResumeWorkflowExecution(ctx, req.(*ResumeWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportWorkflowExecutions(ctx, req.(*ExportWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeShardPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeShardPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeShardPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeShardPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeShardPlacement(ctx, req.(*DescribeShardPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateShardPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShardPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateShardPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateShardPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateShardPlacement(ctx, req.(*UpdateShardPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNamespaceReplicationFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceReplicationFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNamespaceReplicationFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetNamespaceReplicationFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNamespaceReplicationFilters(ctx, req.(*GetNamespaceReplicationFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateNamespaceReplicationFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceReplicationFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateNamespaceReplicationFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateNamespaceReplicationFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateNamespaceReplicationFilters(ctx, req.(*UpdateNamespaceReplicationFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetReplicationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetReplicationStatus(ctx, req.(*GetReplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListQueueMitigations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueMitigationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListQueueMitigations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListQueueMitigations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListQueueMitigations(ctx, req.(*ListQueueMitigationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevertQueueMitigation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertQueueMitigationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevertQueueMitigation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevertQueueMitigation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevertQueueMitigation(ctx, req.(*RevertQueueMitigationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetArchivedMutableState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedMutableStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetArchivedMutableState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetArchivedMutableState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetArchivedMutableState(ctx, req.(*GetArchivedMutableStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1603,6 +2269,78 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListQueues",
 			Handler:    _AdminService_ListQueues_Handler,
 		},
+		{
+			MethodName: "ListBacklogTasks",
+			Handler:    _AdminService_ListBacklogTasks_Handler,
+		},
+		{
+			MethodName: "DeleteBacklogTasks",
+			Handler:    _AdminService_DeleteBacklogTasks_Handler,
+		},
+		{
+			MethodName: "RecordWorkerHeartbeat",
+			Handler:    _AdminService_RecordWorkerHeartbeat_Handler,
+		},
+		{
+			MethodName: "ListWorkers",
+			Handler:    _AdminService_ListWorkers_Handler,
+		},
+		{
+			MethodName: "DescribeWorker",
+			Handler:    _AdminService_DescribeWorker_Handler,
+		},
+		{
+			MethodName: "PauseActivity",
+			Handler:    _AdminService_PauseActivity_Handler,
+		},
+		{
+			MethodName: "UnpauseActivity",
+			Handler:    _AdminService_UnpauseActivity_Handler,
+		},
+		{
+			MethodName: "SuspendWorkflowExecution",
+			Handler:    _AdminService_SuspendWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflowExecution",
+			Handler:    _AdminService_ResumeWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ExportWorkflowExecutions",
+			Handler:    _AdminService_ExportWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "DescribeShardPlacement",
+			Handler:    _AdminService_DescribeShardPlacement_Handler,
+		},
+		{
+			MethodName: "UpdateShardPlacement",
+			Handler:    _AdminService_UpdateShardPlacement_Handler,
+		},
+		{
+			MethodName: "GetNamespaceReplicationFilters",
+			Handler:    _AdminService_GetNamespaceReplicationFilters_Handler,
+		},
+		{
+			MethodName: "UpdateNamespaceReplicationFilters",
+			Handler:    _AdminService_UpdateNamespaceReplicationFilters_Handler,
+		},
+		{
+			MethodName: "GetReplicationStatus",
+			Handler:    _AdminService_GetReplicationStatus_Handler,
+		},
+		{
+			MethodName: "ListQueueMitigations",
+			Handler:    _AdminService_ListQueueMitigations_Handler,
+		},
+		{
+			MethodName: "RevertQueueMitigation",
+			Handler:    _AdminService_RevertQueueMitigation_Handler,
+		},
+		{
+			MethodName: "GetArchivedMutableState",
+			Handler:    _AdminService_GetArchivedMutableState_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -161,6 +161,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockAdminServiceClient) DeleteBacklogTasks(ctx context.Context, in *adminservice.DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.DeleteBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockAdminServiceClientMockRecorder) DeleteBacklogTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteBacklogTasks), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +281,86 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeShardPlacement mocks base method.
+func (m *MockAdminServiceClient) DescribeShardPlacement(ctx context.Context, in *adminservice.DescribeShardPlacementRequest, opts ...grpc.CallOption) (*adminservice.DescribeShardPlacementResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeShardPlacement", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeShardPlacementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardPlacement indicates an expected call of DescribeShardPlacement.
+func (mr *MockAdminServiceClientMockRecorder) DescribeShardPlacement(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardPlacement", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeShardPlacement), varargs...)
+}
+
+// DescribeWorker mocks base method.
+func (m *MockAdminServiceClient) DescribeWorker(ctx context.Context, in *adminservice.DescribeWorkerRequest, opts ...grpc.CallOption) (*adminservice.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWorker", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockAdminServiceClientMockRecorder) DescribeWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeWorker), varargs...)
+}
+
+// ExportWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ExportWorkflowExecutions(ctx context.Context, in *adminservice.ExportWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExportWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowExecutions indicates an expected call of ExportWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ExportWorkflowExecutions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ExportWorkflowExecutions), varargs...)
+}
+
+// GetArchivedMutableState mocks base method.
+func (m *MockAdminServiceClient) GetArchivedMutableState(ctx context.Context, in *adminservice.GetArchivedMutableStateRequest, opts ...grpc.CallOption) (*adminservice.GetArchivedMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetArchivedMutableState", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetArchivedMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedMutableState indicates an expected call of GetArchivedMutableState.
+func (mr *MockAdminServiceClientMockRecorder) GetArchivedMutableState(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).GetArchivedMutableState), varargs...)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceClient) GetDLQMessages(ctx context.Context, in *adminservice.GetDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +441,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespace(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespace), varargs...)
 }
 
+// GetNamespaceReplicationFilters mocks base method.
+func (m *MockAdminServiceClient) GetNamespaceReplicationFilters(ctx context.Context, in *adminservice.GetNamespaceReplicationFiltersRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationFiltersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNamespaceReplicationFilters", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationFiltersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceReplicationFilters indicates an expected call of GetNamespaceReplicationFilters.
+func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationFilters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationFilters", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationFilters), varargs...)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetNamespaceReplicationMessages(ctx context.Context, in *adminservice.GetNamespaceReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +501,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetReplicationMessages(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationMessages), varargs...)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceClient) GetReplicationStatus(ctx context.Context, in *adminservice.GetReplicationStatusRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationStatus", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceClientMockRecorder) GetReplicationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceClient)(nil).GetReplicationStatus), varargs...)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceClient) GetSearchAttributes(ctx context.Context, in *adminservice.GetSearchAttributesRequest, opts ...grpc.CallOption) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -501,6 +641,26 @@ func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockAdminServiceClient) ListBacklogTasks(ctx context.Context, in *adminservice.ListBacklogTasksRequest, opts ...grpc.CallOption) (*adminservice.ListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockAdminServiceClientMockRecorder) ListBacklogTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListBacklogTasks), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -561,6 +721,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockAdminServiceClient) ListQueueMitigations(ctx context.Context, in *adminservice.ListQueueMitigationsRequest, opts ...grpc.CallOption) (*adminservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQueueMitigations", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockAdminServiceClientMockRecorder) ListQueueMitigations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueueMitigations), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -581,6 +761,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListWorkers mocks base method.
+func (m *MockAdminServiceClient) ListWorkers(ctx context.Context, in *adminservice.ListWorkersRequest, opts ...grpc.CallOption) (*adminservice.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkers", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockAdminServiceClientMockRecorder) ListWorkers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockAdminServiceClient)(nil).ListWorkers), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -621,6 +821,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// PauseActivity mocks base method.
+func (m *MockAdminServiceClient) PauseActivity(ctx context.Context, in *adminservice.PauseActivityRequest, opts ...grpc.CallOption) (*adminservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockAdminServiceClientMockRecorder) PauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseActivity), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -701,6 +921,26 @@ func (mr *MockAdminServiceClientMockRecorder) RebuildMutableState(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).RebuildMutableState), varargs...)
 }
 
+// RecordWorkerHeartbeat mocks base method.
+func (m *MockAdminServiceClient) RecordWorkerHeartbeat(ctx context.Context, in *adminservice.RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*adminservice.RecordWorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordWorkerHeartbeat", varargs...)
+	ret0, _ := ret[0].(*adminservice.RecordWorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkerHeartbeat indicates an expected call of RecordWorkerHeartbeat.
+func (mr *MockAdminServiceClientMockRecorder) RecordWorkerHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockAdminServiceClient)(nil).RecordWorkerHeartbeat), varargs...)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceClient) RefreshWorkflowTasks(ctx context.Context, in *adminservice.RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -801,6 +1041,46 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// ResumeWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) ResumeWorkflowExecution(ctx context.Context, in *adminservice.ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.ResumeWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResumeWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) ResumeWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ResumeWorkflowExecution), varargs...)
+}
+
+// RevertQueueMitigation mocks base method.
+func (m *MockAdminServiceClient) RevertQueueMitigation(ctx context.Context, in *adminservice.RevertQueueMitigationRequest, opts ...grpc.CallOption) (*adminservice.RevertQueueMitigationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevertQueueMitigation", varargs...)
+	ret0, _ := ret[0].(*adminservice.RevertQueueMitigationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertQueueMitigation indicates an expected call of RevertQueueMitigation.
+func (mr *MockAdminServiceClientMockRecorder) RevertQueueMitigation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertQueueMitigation", reflect.TypeOf((*MockAdminServiceClient)(nil).RevertQueueMitigation), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -821,6 +1101,86 @@ func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
 }
 
+// SuspendWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) SuspendWorkflowExecution(ctx context.Context, in *adminservice.SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.SuspendWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.SuspendWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) SuspendWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).SuspendWorkflowExecution), varargs...)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockAdminServiceClient) UnpauseActivity(ctx context.Context, in *adminservice.UnpauseActivityRequest, opts ...grpc.CallOption) (*adminservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseActivity), varargs...)
+}
+
+// UpdateNamespaceReplicationFilters mocks base method.
+func (m *MockAdminServiceClient) UpdateNamespaceReplicationFilters(ctx context.Context, in *adminservice.UpdateNamespaceReplicationFiltersRequest, opts ...grpc.CallOption) (*adminservice.UpdateNamespaceReplicationFiltersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateNamespaceReplicationFilters", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceReplicationFiltersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceReplicationFilters indicates an expected call of UpdateNamespaceReplicationFilters.
+func (mr *MockAdminServiceClientMockRecorder) UpdateNamespaceReplicationFilters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceReplicationFilters", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateNamespaceReplicationFilters), varargs...)
+}
+
+// UpdateShardPlacement mocks base method.
+func (m *MockAdminServiceClient) UpdateShardPlacement(ctx context.Context, in *adminservice.UpdateShardPlacementRequest, opts ...grpc.CallOption) (*adminservice.UpdateShardPlacementResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateShardPlacement", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateShardPlacementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardPlacement indicates an expected call of UpdateShardPlacement.
+func (mr *MockAdminServiceClientMockRecorder) UpdateShardPlacement(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardPlacement", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateShardPlacement), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1056,6 +1416,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockAdminServiceServer) DeleteBacklogTasks(arg0 context.Context, arg1 *adminservice.DeleteBacklogTasksRequest) (*adminservice.DeleteBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockAdminServiceServerMockRecorder) DeleteBacklogTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteBacklogTasks), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1131,6 +1506,66 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeShardPlacement mocks base method.
+func (m *MockAdminServiceServer) DescribeShardPlacement(arg0 context.Context, arg1 *adminservice.DescribeShardPlacementRequest) (*adminservice.DescribeShardPlacementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeShardPlacement", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeShardPlacementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeShardPlacement indicates an expected call of DescribeShardPlacement.
+func (mr *MockAdminServiceServerMockRecorder) DescribeShardPlacement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeShardPlacement", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeShardPlacement), arg0, arg1)
+}
+
+// DescribeWorker mocks base method.
+func (m *MockAdminServiceServer) DescribeWorker(arg0 context.Context, arg1 *adminservice.DescribeWorkerRequest) (*adminservice.DescribeWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWorker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWorker indicates an expected call of DescribeWorker.
+func (mr *MockAdminServiceServerMockRecorder) DescribeWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorker", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeWorker), arg0, arg1)
+}
+
+// ExportWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ExportWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ExportWorkflowExecutionsRequest) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExportWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportWorkflowExecutions indicates an expected call of ExportWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ExportWorkflowExecutions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ExportWorkflowExecutions), arg0, arg1)
+}
+
+// GetArchivedMutableState mocks base method.
+func (m *MockAdminServiceServer) GetArchivedMutableState(arg0 context.Context, arg1 *adminservice.GetArchivedMutableStateRequest) (*adminservice.GetArchivedMutableStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedMutableState", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetArchivedMutableStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedMutableState indicates an expected call of GetArchivedMutableState.
+func (mr *MockAdminServiceServerMockRecorder) GetArchivedMutableState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).GetArchivedMutableState), arg0, arg1)
+}
+
 // GetDLQMessages mocks base method.
 func (m *MockAdminServiceServer) GetDLQMessages(arg0 context.Context, arg1 *adminservice.GetDLQMessagesRequest) (*adminservice.GetDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1191,6 +1626,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetNamespace(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespace", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespace), arg0, arg1)
 }
 
+// GetNamespaceReplicationFilters mocks base method.
+func (m *MockAdminServiceServer) GetNamespaceReplicationFilters(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationFiltersRequest) (*adminservice.GetNamespaceReplicationFiltersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamespaceReplicationFilters", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNamespaceReplicationFiltersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamespaceReplicationFilters indicates an expected call of GetNamespaceReplicationFilters.
+func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationFilters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationFilters", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationFilters), arg0, arg1)
+}
+
 // GetNamespaceReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetNamespaceReplicationMessages(arg0 context.Context, arg1 *adminservice.GetNamespaceReplicationMessagesRequest) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1221,6 +1671,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetReplicationMessages(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationMessages), arg0, arg1)
 }
 
+// GetReplicationStatus mocks base method.
+func (m *MockAdminServiceServer) GetReplicationStatus(arg0 context.Context, arg1 *adminservice.GetReplicationStatusRequest) (*adminservice.GetReplicationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetReplicationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationStatus indicates an expected call of GetReplicationStatus.
+func (mr *MockAdminServiceServerMockRecorder) GetReplicationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationStatus", reflect.TypeOf((*MockAdminServiceServer)(nil).GetReplicationStatus), arg0, arg1)
+}
+
 // GetSearchAttributes mocks base method.
 func (m *MockAdminServiceServer) GetSearchAttributes(arg0 context.Context, arg1 *adminservice.GetSearchAttributesRequest) (*adminservice.GetSearchAttributesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1311,6 +1776,21 @@ func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockAdminServiceServer) ListBacklogTasks(arg0 context.Context, arg1 *adminservice.ListBacklogTasksRequest) (*adminservice.ListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockAdminServiceServerMockRecorder) ListBacklogTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListBacklogTasks), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1356,6 +1836,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockAdminServiceServer) ListQueueMitigations(arg0 context.Context, arg1 *adminservice.ListQueueMitigationsRequest) (*adminservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueueMitigations", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockAdminServiceServerMockRecorder) ListQueueMitigations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueueMitigations), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1371,6 +1866,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListWorkers mocks base method.
+func (m *MockAdminServiceServer) ListWorkers(arg0 context.Context, arg1 *adminservice.ListWorkersRequest) (*adminservice.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockAdminServiceServerMockRecorder) ListWorkers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockAdminServiceServer)(nil).ListWorkers), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1401,6 +1911,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockAdminServiceServer) PauseActivity(arg0 context.Context, arg1 *adminservice.PauseActivityRequest) (*adminservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockAdminServiceServerMockRecorder) PauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseActivity), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1461,6 +1986,21 @@ func (mr *MockAdminServiceServerMockRecorder) RebuildMutableState(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).RebuildMutableState), arg0, arg1)
 }
 
+// RecordWorkerHeartbeat mocks base method.
+func (m *MockAdminServiceServer) RecordWorkerHeartbeat(arg0 context.Context, arg1 *adminservice.RecordWorkerHeartbeatRequest) (*adminservice.RecordWorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkerHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RecordWorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkerHeartbeat indicates an expected call of RecordWorkerHeartbeat.
+func (mr *MockAdminServiceServerMockRecorder) RecordWorkerHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockAdminServiceServer)(nil).RecordWorkerHeartbeat), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminServiceServer) RefreshWorkflowTasks(arg0 context.Context, arg1 *adminservice.RefreshWorkflowTasksRequest) (*adminservice.RefreshWorkflowTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1536,6 +2076,36 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// ResumeWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) ResumeWorkflowExecution(arg0 context.Context, arg1 *adminservice.ResumeWorkflowExecutionRequest) (*adminservice.ResumeWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResumeWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ResumeWorkflowExecution), arg0, arg1)
+}
+
+// RevertQueueMitigation mocks base method.
+func (m *MockAdminServiceServer) RevertQueueMitigation(arg0 context.Context, arg1 *adminservice.RevertQueueMitigationRequest) (*adminservice.RevertQueueMitigationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertQueueMitigation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RevertQueueMitigationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertQueueMitigation indicates an expected call of RevertQueueMitigation.
+func (mr *MockAdminServiceServerMockRecorder) RevertQueueMitigation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertQueueMitigation", reflect.TypeOf((*MockAdminServiceServer)(nil).RevertQueueMitigation), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1550,6 +2120,66 @@ func (mr *MockAdminServiceServerMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).StreamWorkflowReplicationMessages), arg0)
 }
 
+// SuspendWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) SuspendWorkflowExecution(arg0 context.Context, arg1 *adminservice.SuspendWorkflowExecutionRequest) (*adminservice.SuspendWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SuspendWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) SuspendWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).SuspendWorkflowExecution), arg0, arg1)
+}
+
+// UnpauseActivity mocks base method.
+func (m *MockAdminServiceServer) UnpauseActivity(arg0 context.Context, arg1 *adminservice.UnpauseActivityRequest) (*adminservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseActivity), arg0, arg1)
+}
+
+// UpdateNamespaceReplicationFilters mocks base method.
+func (m *MockAdminServiceServer) UpdateNamespaceReplicationFilters(arg0 context.Context, arg1 *adminservice.UpdateNamespaceReplicationFiltersRequest) (*adminservice.UpdateNamespaceReplicationFiltersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNamespaceReplicationFilters", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateNamespaceReplicationFiltersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNamespaceReplicationFilters indicates an expected call of UpdateNamespaceReplicationFilters.
+func (mr *MockAdminServiceServerMockRecorder) UpdateNamespaceReplicationFilters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespaceReplicationFilters", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateNamespaceReplicationFilters), arg0, arg1)
+}
+
+// UpdateShardPlacement mocks base method.
+func (m *MockAdminServiceServer) UpdateShardPlacement(arg0 context.Context, arg1 *adminservice.UpdateShardPlacementRequest) (*adminservice.UpdateShardPlacementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShardPlacement", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateShardPlacementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShardPlacement indicates an expected call of UpdateShardPlacement.
+func (mr *MockAdminServiceServerMockRecorder) UpdateShardPlacement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShardPlacement", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateShardPlacement), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
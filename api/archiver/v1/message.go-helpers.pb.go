@@ -139,3 +139,40 @@ func (this *VisibilityRecord) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type MutableStateSnapshot to the protobuf v3 wire format
+func (val *MutableStateSnapshot) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MutableStateSnapshot from the protobuf v3 wire format
+func (val *MutableStateSnapshot) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MutableStateSnapshot) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MutableStateSnapshot values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MutableStateSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MutableStateSnapshot
+	switch t := that.(type) {
+	case *MutableStateSnapshot:
+		that1 = t
+	case MutableStateSnapshot:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
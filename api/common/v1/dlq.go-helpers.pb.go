@@ -140,6 +140,43 @@ func (this *HistoryDLQTask) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type HistoryDLQTaskFailure to the protobuf v3 wire format
+func (val *HistoryDLQTaskFailure) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type HistoryDLQTaskFailure from the protobuf v3 wire format
+func (val *HistoryDLQTaskFailure) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *HistoryDLQTaskFailure) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two HistoryDLQTaskFailure values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *HistoryDLQTaskFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *HistoryDLQTaskFailure
+	switch t := that.(type) {
+	case *HistoryDLQTaskFailure:
+		that1 = t
+	case HistoryDLQTaskFailure:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type HistoryDLQKey to the protobuf v3 wire format
 func (val *HistoryDLQKey) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -67,3 +67,22 @@ func DLQOperationStateFromString(s string) (DLQOperationState, error) {
 	}
 	return DLQOperationState(0), fmt.Errorf("%s is not a valid DLQOperationState", s)
 }
+
+var (
+	DLQTaskFailureType_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Transient":   1,
+		"Terminal":    2,
+	}
+)
+
+// DLQTaskFailureTypeFromString parses a DLQTaskFailureType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to DLQTaskFailureType
+func DLQTaskFailureTypeFromString(s string) (DLQTaskFailureType, error) {
+	if v, ok := DLQTaskFailureType_value[s]; ok {
+		return DLQTaskFailureType(v), nil
+	} else if v, ok := DLQTaskFailureType_shorthandValue[s]; ok {
+		return DLQTaskFailureType(v), nil
+	}
+	return DLQTaskFailureType(0), fmt.Errorf("%s is not a valid DLQTaskFailureType", s)
+}
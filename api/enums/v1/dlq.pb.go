@@ -167,6 +167,68 @@ func (DLQOperationState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_dlq_proto_rawDescGZIP(), []int{1}
 }
 
+// DLQTaskFailureType classifies the failure which caused a history task to be moved to the DLQ.
+type DLQTaskFailureType int32
+
+const (
+	DLQ_TASK_FAILURE_TYPE_UNSPECIFIED DLQTaskFailureType = 0
+	// The task kept failing with unexpected errors which may go away on a later retry, e.g. persistence timeouts.
+	DLQ_TASK_FAILURE_TYPE_TRANSIENT DLQTaskFailureType = 1
+	// The task failed with an error which is not expected to go away on retry, e.g. corrupted workflow data.
+	DLQ_TASK_FAILURE_TYPE_TERMINAL DLQTaskFailureType = 2
+)
+
+// Enum value maps for DLQTaskFailureType.
+var (
+	DLQTaskFailureType_name = map[int32]string{
+		0: "DLQ_TASK_FAILURE_TYPE_UNSPECIFIED",
+		1: "DLQ_TASK_FAILURE_TYPE_TRANSIENT",
+		2: "DLQ_TASK_FAILURE_TYPE_TERMINAL",
+	}
+	DLQTaskFailureType_value = map[string]int32{
+		"DLQ_TASK_FAILURE_TYPE_UNSPECIFIED": 0,
+		"DLQ_TASK_FAILURE_TYPE_TRANSIENT":   1,
+		"DLQ_TASK_FAILURE_TYPE_TERMINAL":    2,
+	}
+)
+
+func (x DLQTaskFailureType) Enum() *DLQTaskFailureType {
+	p := new(DLQTaskFailureType)
+	*p = x
+	return p
+}
+
+func (x DLQTaskFailureType) String() string {
+	switch x {
+	case DLQ_TASK_FAILURE_TYPE_UNSPECIFIED:
+		return "DlqTaskFailureTypeUnspecified"
+	case DLQ_TASK_FAILURE_TYPE_TRANSIENT:
+		return "DlqTaskFailureTypeTransient"
+	case DLQ_TASK_FAILURE_TYPE_TERMINAL:
+		return "DlqTaskFailureTypeTerminal"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (DLQTaskFailureType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_dlq_proto_enumTypes[2].Descriptor()
+}
+
+func (DLQTaskFailureType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_dlq_proto_enumTypes[2]
+}
+
+func (x DLQTaskFailureType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DLQTaskFailureType.Descriptor instead.
+func (DLQTaskFailureType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_dlq_proto_rawDescGZIP(), []int{2}
+}
+
 var File_temporal_server_api_enums_v1_dlq_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_dlq_proto_rawDesc = []byte{
@@ -191,10 +253,19 @@ var file_temporal_server_api_enums_v1_dlq_proto_rawDesc = []byte{
 	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f,
 	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x4c, 0x51,
 	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e,
-	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x84, 0x01, 0x0a, 0x12, 0x44, 0x4c,
+	0x51, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x25, 0x0a, 0x21, 0x44, 0x4c, 0x51, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x44, 0x4c, 0x51, 0x5f, 0x54,
+	0x41, 0x53, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
+	0x44, 0x4c, 0x51, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x02,
+	0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -209,10 +280,11 @@ func file_temporal_server_api_enums_v1_dlq_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_dlq_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_dlq_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_api_enums_v1_dlq_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_temporal_server_api_enums_v1_dlq_proto_goTypes = []interface{}{
-	(DLQOperationType)(0),  // 0: temporal.server.api.enums.v1.DLQOperationType
-	(DLQOperationState)(0), // 1: temporal.server.api.enums.v1.DLQOperationState
+	(DLQOperationType)(0),   // 0: temporal.server.api.enums.v1.DLQOperationType
+	(DLQOperationState)(0),  // 1: temporal.server.api.enums.v1.DLQOperationState
+	(DLQTaskFailureType)(0), // 2: temporal.server.api.enums.v1.DLQTaskFailureType
 }
 var file_temporal_server_api_enums_v1_dlq_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -232,7 +304,7 @@ func file_temporal_server_api_enums_v1_dlq_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_dlq_proto_rawDesc,
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -40,6 +40,7 @@ var (
 		"NamespaceId": 6,
 		"TaskType":    7,
 		"Destination": 8,
+		"WorkflowId":  9,
 	}
 )
 
@@ -56,6 +56,7 @@ const (
 	PREDICATE_TYPE_NAMESPACE_ID PredicateType = 6
 	PREDICATE_TYPE_TASK_TYPE    PredicateType = 7
 	PREDICATE_TYPE_DESTINATION  PredicateType = 8
+	PREDICATE_TYPE_WORKFLOW_ID  PredicateType = 9
 )
 
 // Enum value maps for PredicateType.
@@ -70,6 +71,7 @@ var (
 		6: "PREDICATE_TYPE_NAMESPACE_ID",
 		7: "PREDICATE_TYPE_TASK_TYPE",
 		8: "PREDICATE_TYPE_DESTINATION",
+		9: "PREDICATE_TYPE_WORKFLOW_ID",
 	}
 	PredicateType_value = map[string]int32{
 		"PREDICATE_TYPE_UNSPECIFIED":  0,
@@ -81,6 +83,7 @@ var (
 		"PREDICATE_TYPE_NAMESPACE_ID": 6,
 		"PREDICATE_TYPE_TASK_TYPE":    7,
 		"PREDICATE_TYPE_DESTINATION":  8,
+		"PREDICATE_TYPE_WORKFLOW_ID":  9,
 	}
 )
 
@@ -110,8 +113,13 @@ func (x PredicateType) String() string {
 		return "TaskType"
 	case PREDICATE_TYPE_DESTINATION:
 		return "Destination"
+	case PREDICATE_TYPE_WORKFLOW_ID:
+		return "WorkflowId"
 	default:
-		return strconv.Itoa(int(x))
+		return strconv.
+
+			// Deprecated: Use PredicateType.Descriptor instead.
+			Itoa(int(x))
 	}
 
 }
@@ -128,7 +136,6 @@ func (x PredicateType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use PredicateType.Descriptor instead.
 func (PredicateType) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_predicate_proto_rawDescGZIP(), []int{0}
 }
@@ -140,7 +147,7 @@ var file_temporal_server_api_enums_v1_predicate_proto_rawDesc = []byte{
 	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70,
 	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c,
 	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0x8d, 0x02, 0x0a,
+	0x61, 0x70, 0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2a, 0xad, 0x02, 0x0a,
 	0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
 	0x0a, 0x1a, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
@@ -157,7 +164,9 @@ var file_temporal_server_api_enums_v1_predicate_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
 	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x07, 0x12, 0x1e, 0x0a, 0x1a,
 	0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
-	0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x42, 0x2a, 0x5a, 0x28,
+	0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a,
+	0x50, 0x52, 0x45, 0x44, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57,
+	0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x49, 0x44, 0x10, 0x09, 0x42, 0x2a, 0x5a, 0x28,
 	0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f,
 	0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
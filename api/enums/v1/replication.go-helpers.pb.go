@@ -72,3 +72,25 @@ func NamespaceOperationFromString(s string) (NamespaceOperation, error) {
 	}
 	return NamespaceOperation(0), fmt.Errorf("%s is not a valid NamespaceOperation", s)
 }
+
+var (
+	ReplicationDivergenceType_shorthandValue = map[string]int32{
+		"Unspecified":     0,
+		"InSync":          1,
+		"RemoteBehind":    2,
+		"LocalBehind":     3,
+		"Diverged":        4,
+		"MissingOnRemote": 5,
+	}
+)
+
+// ReplicationDivergenceTypeFromString parses a ReplicationDivergenceType value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ReplicationDivergenceType
+func ReplicationDivergenceTypeFromString(s string) (ReplicationDivergenceType, error) {
+	if v, ok := ReplicationDivergenceType_value[s]; ok {
+		return ReplicationDivergenceType(v), nil
+	} else if v, ok := ReplicationDivergenceType_shorthandValue[s]; ok {
+		return ReplicationDivergenceType(v), nil
+	}
+	return ReplicationDivergenceType(0), fmt.Errorf("%s is not a valid ReplicationDivergenceType", s)
+}
@@ -193,6 +193,85 @@ func (NamespaceOperation) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP(), []int{1}
 }
 
+type ReplicationDivergenceType int32
+
+const (
+	REPLICATION_DIVERGENCE_TYPE_UNSPECIFIED ReplicationDivergenceType = 0
+	// Local and remote histories end at the same event of the same branch.
+	REPLICATION_DIVERGENCE_TYPE_IN_SYNC ReplicationDivergenceType = 1
+	// Remote history is on the same branch as the local history, but has fewer events.
+	REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND ReplicationDivergenceType = 2
+	// Local history is on the same branch as the remote history, but has fewer events.
+	REPLICATION_DIVERGENCE_TYPE_LOCAL_BEHIND ReplicationDivergenceType = 3
+	// Local and remote histories are on different branches.
+	REPLICATION_DIVERGENCE_TYPE_DIVERGED ReplicationDivergenceType = 4
+	// Execution does not exist in the remote cluster.
+	REPLICATION_DIVERGENCE_TYPE_MISSING_ON_REMOTE ReplicationDivergenceType = 5
+)
+
+// Enum value maps for ReplicationDivergenceType.
+var (
+	ReplicationDivergenceType_name = map[int32]string{
+		0: "REPLICATION_DIVERGENCE_TYPE_UNSPECIFIED",
+		1: "REPLICATION_DIVERGENCE_TYPE_IN_SYNC",
+		2: "REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND",
+		3: "REPLICATION_DIVERGENCE_TYPE_LOCAL_BEHIND",
+		4: "REPLICATION_DIVERGENCE_TYPE_DIVERGED",
+		5: "REPLICATION_DIVERGENCE_TYPE_MISSING_ON_REMOTE",
+	}
+	ReplicationDivergenceType_value = map[string]int32{
+		"REPLICATION_DIVERGENCE_TYPE_UNSPECIFIED":       0,
+		"REPLICATION_DIVERGENCE_TYPE_IN_SYNC":           1,
+		"REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND":     2,
+		"REPLICATION_DIVERGENCE_TYPE_LOCAL_BEHIND":      3,
+		"REPLICATION_DIVERGENCE_TYPE_DIVERGED":          4,
+		"REPLICATION_DIVERGENCE_TYPE_MISSING_ON_REMOTE": 5,
+	}
+)
+
+func (x ReplicationDivergenceType) Enum() *ReplicationDivergenceType {
+	p := new(ReplicationDivergenceType)
+	*p = x
+	return p
+}
+
+func (x ReplicationDivergenceType) String() string {
+	switch x {
+	case REPLICATION_DIVERGENCE_TYPE_UNSPECIFIED:
+		return "Unspecified"
+	case REPLICATION_DIVERGENCE_TYPE_IN_SYNC:
+		return "InSync"
+	case REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND:
+		return "RemoteBehind"
+	case REPLICATION_DIVERGENCE_TYPE_LOCAL_BEHIND:
+		return "LocalBehind"
+	case REPLICATION_DIVERGENCE_TYPE_DIVERGED:
+		return "Diverged"
+	case REPLICATION_DIVERGENCE_TYPE_MISSING_ON_REMOTE:
+		return "MissingOnRemote"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (ReplicationDivergenceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_replication_proto_enumTypes[2].Descriptor()
+}
+
+func (ReplicationDivergenceType) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_replication_proto_enumTypes[2]
+}
+
+func (x ReplicationDivergenceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplicationDivergenceType.Descriptor instead.
+func (ReplicationDivergenceType) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP(), []int{2}
+}
+
 var File_temporal_server_api_enums_v1_replication_proto protoreflect.FileDescriptor
 
 var file_temporal_server_api_enums_v1_replication_proto_rawDesc = []byte{
@@ -235,10 +314,29 @@ var file_temporal_server_api_enums_v1_replication_proto_rawDesc = []byte{
 	0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
 	0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x41,
 	0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f,
-	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0xab, 0x02, 0x0a, 0x19, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52, 0x47, 0x45, 0x4e,
+	0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12, 0x2d,
+	0x0a, 0x29, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49,
+	0x56, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x42, 0x45, 0x48, 0x49, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x2c, 0x0a,
+	0x28, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56,
+	0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43,
+	0x41, 0x4c, 0x5f, 0x42, 0x45, 0x48, 0x49, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24, 0x52,
+	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52,
+	0x47, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52,
+	0x47, 0x45, 0x44, 0x10, 0x04, 0x12, 0x31, 0x0a, 0x2d, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x56, 0x45, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x05, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
+	0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -253,10 +351,11 @@ func file_temporal_server_api_enums_v1_replication_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_replication_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_replication_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_api_enums_v1_replication_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_temporal_server_api_enums_v1_replication_proto_goTypes = []interface{}{
-	(ReplicationTaskType)(0), // 0: temporal.server.api.enums.v1.ReplicationTaskType
-	(NamespaceOperation)(0),  // 1: temporal.server.api.enums.v1.NamespaceOperation
+	(ReplicationTaskType)(0),       // 0: temporal.server.api.enums.v1.ReplicationTaskType
+	(NamespaceOperation)(0),        // 1: temporal.server.api.enums.v1.NamespaceOperation
+	(ReplicationDivergenceType)(0), // 2: temporal.server.api.enums.v1.ReplicationDivergenceType
 }
 var file_temporal_server_api_enums_v1_replication_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -276,7 +375,7 @@ func file_temporal_server_api_enums_v1_replication_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_replication_proto_rawDesc,
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
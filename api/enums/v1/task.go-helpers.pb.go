@@ -48,35 +48,57 @@ func TaskSourceFromString(s string) (TaskSource, error) {
 	return TaskSource(0), fmt.Errorf("%s is not a valid TaskSource", s)
 }
 
+var (
+	BacklogTaskDeletionStatus_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Deleted":     1,
+		"NotFound":    2,
+		"InFlight":    3,
+		"Failed":      4,
+	}
+)
+
+// BacklogTaskDeletionStatusFromString parses a BacklogTaskDeletionStatus value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to BacklogTaskDeletionStatus
+func BacklogTaskDeletionStatusFromString(s string) (BacklogTaskDeletionStatus, error) {
+	if v, ok := BacklogTaskDeletionStatus_value[s]; ok {
+		return BacklogTaskDeletionStatus(v), nil
+	} else if v, ok := BacklogTaskDeletionStatus_shorthandValue[s]; ok {
+		return BacklogTaskDeletionStatus(v), nil
+	}
+	return BacklogTaskDeletionStatus(0), fmt.Errorf("%s is not a valid BacklogTaskDeletionStatus", s)
+}
+
 var (
 	TaskType_shorthandValue = map[string]int32{
-		"Unspecified":                  0,
-		"ReplicationHistory":           1,
-		"ReplicationSyncActivity":      2,
-		"TransferWorkflowTask":         3,
-		"TransferActivityTask":         4,
-		"TransferCloseExecution":       5,
-		"TransferCancelExecution":      6,
-		"TransferStartChildExecution":  7,
-		"TransferSignalExecution":      8,
-		"TransferResetWorkflow":        10,
-		"WorkflowTaskTimeout":          12,
-		"ActivityTimeout":              13,
-		"UserTimer":                    14,
-		"WorkflowRunTimeout":           15,
-		"DeleteHistoryEvent":           16,
-		"ActivityRetryTimer":           17,
-		"WorkflowBackoffTimer":         18,
-		"VisibilityStartExecution":     19,
-		"VisibilityUpsertExecution":    20,
-		"VisibilityCloseExecution":     21,
-		"VisibilityDeleteExecution":    22,
-		"TransferDeleteExecution":      24,
-		"ReplicationSyncWorkflowState": 25,
-		"ArchivalArchiveExecution":     26,
-		"StateMachineOutbound":         27,
-		"StateMachineTimer":            28,
-		"WorkflowExecutionTimeout":     29,
+		"Unspecified":                     0,
+		"ReplicationHistory":              1,
+		"ReplicationSyncActivity":         2,
+		"TransferWorkflowTask":            3,
+		"TransferActivityTask":            4,
+		"TransferCloseExecution":          5,
+		"TransferCancelExecution":         6,
+		"TransferStartChildExecution":     7,
+		"TransferSignalExecution":         8,
+		"TransferResetWorkflow":           10,
+		"WorkflowTaskTimeout":             12,
+		"ActivityTimeout":                 13,
+		"UserTimer":                       14,
+		"WorkflowRunTimeout":              15,
+		"DeleteHistoryEvent":              16,
+		"ActivityRetryTimer":              17,
+		"WorkflowBackoffTimer":            18,
+		"VisibilityStartExecution":        19,
+		"VisibilityUpsertExecution":       20,
+		"VisibilityCloseExecution":        21,
+		"VisibilityDeleteExecution":       22,
+		"TransferDeleteExecution":         24,
+		"ReplicationSyncWorkflowState":    25,
+		"ArchivalArchiveExecution":        26,
+		"StateMachineOutbound":            27,
+		"StateMachineTimer":               28,
+		"WorkflowExecutionTimeout":        29,
+		"TransferDeleteOffloadedPayloads": 30,
 	}
 )
 
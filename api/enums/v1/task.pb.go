@@ -106,6 +106,80 @@ func (TaskSource) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{0}
 }
 
+// Outcome of deleting a single task from the persisted backlog of a task queue.
+type BacklogTaskDeletionStatus int32
+
+const (
+	BACKLOG_TASK_DELETION_STATUS_UNSPECIFIED BacklogTaskDeletionStatus = 0
+	// The task was removed from the backlog and, if requested, added to the destination task queue.
+	BACKLOG_TASK_DELETION_STATUS_DELETED BacklogTaskDeletionStatus = 1
+	// The task is not in the backlog, either because it was already completed or never existed.
+	BACKLOG_TASK_DELETION_STATUS_NOT_FOUND BacklogTaskDeletionStatus = 2
+	// The task was already loaded for dispatch and may be matched with a poller at any moment, so it was left alone.
+	BACKLOG_TASK_DELETION_STATUS_IN_FLIGHT BacklogTaskDeletionStatus = 3
+	// The task could not be deleted or moved. It is still in the backlog, possibly under a new task ID.
+	BACKLOG_TASK_DELETION_STATUS_FAILED BacklogTaskDeletionStatus = 4
+)
+
+// Enum value maps for BacklogTaskDeletionStatus.
+var (
+	BacklogTaskDeletionStatus_name = map[int32]string{
+		0: "BACKLOG_TASK_DELETION_STATUS_UNSPECIFIED",
+		1: "BACKLOG_TASK_DELETION_STATUS_DELETED",
+		2: "BACKLOG_TASK_DELETION_STATUS_NOT_FOUND",
+		3: "BACKLOG_TASK_DELETION_STATUS_IN_FLIGHT",
+		4: "BACKLOG_TASK_DELETION_STATUS_FAILED",
+	}
+	BacklogTaskDeletionStatus_value = map[string]int32{
+		"BACKLOG_TASK_DELETION_STATUS_UNSPECIFIED": 0,
+		"BACKLOG_TASK_DELETION_STATUS_DELETED":     1,
+		"BACKLOG_TASK_DELETION_STATUS_NOT_FOUND":   2,
+		"BACKLOG_TASK_DELETION_STATUS_IN_FLIGHT":   3,
+		"BACKLOG_TASK_DELETION_STATUS_FAILED":      4,
+	}
+)
+
+func (x BacklogTaskDeletionStatus) Enum() *BacklogTaskDeletionStatus {
+	p := new(BacklogTaskDeletionStatus)
+	*p = x
+	return p
+}
+
+func (x BacklogTaskDeletionStatus) String() string {
+	switch x {
+	case BACKLOG_TASK_DELETION_STATUS_UNSPECIFIED:
+		return "Unspecified"
+	case BACKLOG_TASK_DELETION_STATUS_DELETED:
+		return "Deleted"
+	case BACKLOG_TASK_DELETION_STATUS_NOT_FOUND:
+		return "NotFound"
+	case BACKLOG_TASK_DELETION_STATUS_IN_FLIGHT:
+		return "InFlight"
+	case BACKLOG_TASK_DELETION_STATUS_FAILED:
+		return "Failed"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (BacklogTaskDeletionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (BacklogTaskDeletionStatus) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_task_proto_enumTypes[1]
+}
+
+func (x BacklogTaskDeletionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BacklogTaskDeletionStatus.Descriptor instead.
+func (BacklogTaskDeletionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
 type TaskType int32
 
 const (
@@ -141,6 +215,8 @@ const (
 	TASK_TYPE_STATE_MACHINE_TIMER TaskType = 28
 	// Timeout task for the entire workflow execution chain.
 	TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT TaskType = 29
+	// Deletes the payloads a deleted workflow run offloaded to the payload store.
+	TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS TaskType = 30
 )
 
 // Enum value maps for TaskType.
@@ -173,35 +249,37 @@ var (
 		27: "TASK_TYPE_STATE_MACHINE_OUTBOUND",
 		28: "TASK_TYPE_STATE_MACHINE_TIMER",
 		29: "TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT",
+		30: "TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS",
 	}
 	TaskType_value = map[string]int32{
-		"TASK_TYPE_UNSPECIFIED":                     0,
-		"TASK_TYPE_REPLICATION_HISTORY":             1,
-		"TASK_TYPE_REPLICATION_SYNC_ACTIVITY":       2,
-		"TASK_TYPE_TRANSFER_WORKFLOW_TASK":          3,
-		"TASK_TYPE_TRANSFER_ACTIVITY_TASK":          4,
-		"TASK_TYPE_TRANSFER_CLOSE_EXECUTION":        5,
-		"TASK_TYPE_TRANSFER_CANCEL_EXECUTION":       6,
-		"TASK_TYPE_TRANSFER_START_CHILD_EXECUTION":  7,
-		"TASK_TYPE_TRANSFER_SIGNAL_EXECUTION":       8,
-		"TASK_TYPE_TRANSFER_RESET_WORKFLOW":         10,
-		"TASK_TYPE_WORKFLOW_TASK_TIMEOUT":           12,
-		"TASK_TYPE_ACTIVITY_TIMEOUT":                13,
-		"TASK_TYPE_USER_TIMER":                      14,
-		"TASK_TYPE_WORKFLOW_RUN_TIMEOUT":            15,
-		"TASK_TYPE_DELETE_HISTORY_EVENT":            16,
-		"TASK_TYPE_ACTIVITY_RETRY_TIMER":            17,
-		"TASK_TYPE_WORKFLOW_BACKOFF_TIMER":          18,
-		"TASK_TYPE_VISIBILITY_START_EXECUTION":      19,
-		"TASK_TYPE_VISIBILITY_UPSERT_EXECUTION":     20,
-		"TASK_TYPE_VISIBILITY_CLOSE_EXECUTION":      21,
-		"TASK_TYPE_VISIBILITY_DELETE_EXECUTION":     22,
-		"TASK_TYPE_TRANSFER_DELETE_EXECUTION":       24,
-		"TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE": 25,
-		"TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION":      26,
-		"TASK_TYPE_STATE_MACHINE_OUTBOUND":          27,
-		"TASK_TYPE_STATE_MACHINE_TIMER":             28,
-		"TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT":      29,
+		"TASK_TYPE_UNSPECIFIED":                        0,
+		"TASK_TYPE_REPLICATION_HISTORY":                1,
+		"TASK_TYPE_REPLICATION_SYNC_ACTIVITY":          2,
+		"TASK_TYPE_TRANSFER_WORKFLOW_TASK":             3,
+		"TASK_TYPE_TRANSFER_ACTIVITY_TASK":             4,
+		"TASK_TYPE_TRANSFER_CLOSE_EXECUTION":           5,
+		"TASK_TYPE_TRANSFER_CANCEL_EXECUTION":          6,
+		"TASK_TYPE_TRANSFER_START_CHILD_EXECUTION":     7,
+		"TASK_TYPE_TRANSFER_SIGNAL_EXECUTION":          8,
+		"TASK_TYPE_TRANSFER_RESET_WORKFLOW":            10,
+		"TASK_TYPE_WORKFLOW_TASK_TIMEOUT":              12,
+		"TASK_TYPE_ACTIVITY_TIMEOUT":                   13,
+		"TASK_TYPE_USER_TIMER":                         14,
+		"TASK_TYPE_WORKFLOW_RUN_TIMEOUT":               15,
+		"TASK_TYPE_DELETE_HISTORY_EVENT":               16,
+		"TASK_TYPE_ACTIVITY_RETRY_TIMER":               17,
+		"TASK_TYPE_WORKFLOW_BACKOFF_TIMER":             18,
+		"TASK_TYPE_VISIBILITY_START_EXECUTION":         19,
+		"TASK_TYPE_VISIBILITY_UPSERT_EXECUTION":        20,
+		"TASK_TYPE_VISIBILITY_CLOSE_EXECUTION":         21,
+		"TASK_TYPE_VISIBILITY_DELETE_EXECUTION":        22,
+		"TASK_TYPE_TRANSFER_DELETE_EXECUTION":          24,
+		"TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE":    25,
+		"TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION":         26,
+		"TASK_TYPE_STATE_MACHINE_OUTBOUND":             27,
+		"TASK_TYPE_STATE_MACHINE_TIMER":                28,
+		"TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT":         29,
+		"TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS": 30,
 	}
 )
 
@@ -269,6 +347,8 @@ func (x TaskType) String() string {
 		return "StateMachineTimer"
 	case TASK_TYPE_WORKFLOW_EXECUTION_TIMEOUT:
 		return "WorkflowExecutionTimeout"
+	case TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS:
+		return "TransferDeleteOffloadedPayloads"
 	default:
 		return strconv.Itoa(int(x))
 	}
@@ -276,11 +356,11 @@ func (x TaskType) String() string {
 }
 
 func (TaskType) Descriptor() protoreflect.EnumDescriptor {
-	return file_temporal_server_api_enums_v1_task_proto_enumTypes[1].Descriptor()
+	return file_temporal_server_api_enums_v1_task_proto_enumTypes[2].Descriptor()
 }
 
 func (TaskType) Type() protoreflect.EnumType {
-	return &file_temporal_server_api_enums_v1_task_proto_enumTypes[1]
+	return &file_temporal_server_api_enums_v1_task_proto_enumTypes[2]
 }
 
 func (x TaskType) Number() protoreflect.EnumNumber {
@@ -288,7 +368,7 @@ func (x TaskType) Number() protoreflect.EnumNumber {
 }
 
 func (TaskType) EnumDescriptor() ([]byte, []int) {
-	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{1}
+	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{2}
 }
 
 var File_temporal_server_api_enums_v1_task_proto protoreflect.FileDescriptor
@@ -304,77 +384,96 @@ var file_temporal_server_api_enums_v1_task_proto_rawDesc = []byte{
 	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
 	0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54,
 	0x41, 0x53, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x42, 0x5f, 0x42, 0x41,
-	0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0xae, 0x08, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x21, 0x0a, 0x1d, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50,
-	0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59,
-	0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x54,
-	0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45,
-	0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10,
-	0x03, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
-	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x41, 0x53, 0x4b, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x4c,
-	0x4f, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12,
-	0x27, 0x0a, 0x23, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x45, 0x58, 0x45,
-	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x2c, 0x0a, 0x28, 0x54, 0x41, 0x53, 0x4b,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53,
-	0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x49, 0x47,
-	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12,
-	0x25, 0x0a, 0x21, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x5f, 0x57, 0x4f, 0x52, 0x4b,
-	0x46, 0x4c, 0x4f, 0x57, 0x10, 0x0a, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x41, 0x53,
-	0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0c, 0x12, 0x1e, 0x0a, 0x1a, 0x54,
-	0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
-	0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0d, 0x12, 0x18, 0x0a, 0x14, 0x54,
-	0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x54, 0x49,
-	0x4d, 0x45, 0x52, 0x10, 0x0e, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
-	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0f, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x41, 0x53,
-	0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x48, 0x49,
-	0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x10, 0x12, 0x22, 0x0a,
-	0x1e, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
-	0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10,
-	0x11, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57,
-	0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46, 0x5f,
-	0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x12, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x41, 0x53, 0x4b, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x13, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56,
-	0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54,
-	0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x14, 0x12, 0x28, 0x0a, 0x24,
-	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49,
-	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x15, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x44,
-	0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x16, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45,
-	0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x18, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x41,
-	0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f,
-	0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x19, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x41, 0x53,
-	0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x41, 0x4c, 0x5f,
-	0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x1a, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x4f,
-	0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1b, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x41, 0x53,
-	0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x43,
-	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x1c, 0x12, 0x28, 0x0a, 0x24,
+	0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0xf4, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x63, 0x6b,
+	0x6c, 0x6f, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x28, 0x42, 0x41, 0x43, 0x4b, 0x4c, 0x4f, 0x47,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x42, 0x41, 0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x5f, 0x54,
+	0x41, 0x53, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2a, 0x0a,
+	0x26, 0x42, 0x41, 0x43, 0x4b, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x42, 0x41, 0x43,
+	0x4b, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49,
+	0x47, 0x48, 0x54, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x42, 0x41, 0x43, 0x4b, 0x4c, 0x4f, 0x47,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xe0,
+	0x08, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x54,
+	0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f,
+	0x57, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41, 0x53, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10, 0x04, 0x12, 0x26,
+	0x0a, 0x22, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12,
+	0x2c, 0x0a, 0x28, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x48, 0x49, 0x4c,
+	0x44, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x27, 0x0a,
+	0x23, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53,
+	0x45, 0x54, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x10, 0x0a, 0x12, 0x23, 0x0a,
+	0x1f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46,
+	0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0x0c, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0x0d, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x0e, 0x12, 0x22, 0x0a, 0x1e,
 	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c,
-	0x4f, 0x57, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x4f, 0x55, 0x54, 0x10, 0x1d, 0x22, 0x04, 0x08, 0x09, 0x10, 0x09, 0x22, 0x04, 0x08, 0x0b,
-	0x10, 0x0b, 0x22, 0x04, 0x08, 0x17, 0x10, 0x17, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
-	0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4f, 0x57, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0f,
+	0x12, 0x22, 0x0a, 0x1e, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x10, 0x10, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59,
+	0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x11, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41, 0x53, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x42,
+	0x41, 0x43, 0x4b, 0x4f, 0x46, 0x46, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x10, 0x12, 0x12, 0x28,
+	0x0a, 0x24, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x13, 0x12, 0x29, 0x0a, 0x25, 0x54, 0x41, 0x53, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x14, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x15, 0x12, 0x29, 0x0a,
+	0x25, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42,
+	0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x45,
+	0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x16, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x41, 0x53, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x18, 0x12, 0x2d, 0x0a, 0x29, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x19,
+	0x12, 0x28, 0x0a, 0x24, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x45,
+	0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1a, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x41,
+	0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x1b,
+	0x12, 0x21, 0x0a, 0x1d, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x52, 0x10, 0x1c, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x1d, 0x12, 0x30, 0x0a,
+	0x2c, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x46, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f,
+	0x41, 0x44, 0x45, 0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x53, 0x10, 0x1e, 0x22,
+	0x04, 0x08, 0x09, 0x10, 0x09, 0x22, 0x04, 0x08, 0x0b, 0x10, 0x0b, 0x22, 0x04, 0x08, 0x17, 0x10,
+	0x17, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x6f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x2e, 0x69, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
+	0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -389,10 +488,11 @@ func file_temporal_server_api_enums_v1_task_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_temporal_server_api_enums_v1_task_proto_goTypes = []interface{}{
-	(TaskSource)(0), // 0: temporal.server.api.enums.v1.TaskSource
-	(TaskType)(0),   // 1: temporal.server.api.enums.v1.TaskType
+	(TaskSource)(0),                // 0: temporal.server.api.enums.v1.TaskSource
+	(BacklogTaskDeletionStatus)(0), // 1: temporal.server.api.enums.v1.BacklogTaskDeletionStatus
+	(TaskType)(0),                  // 2: temporal.server.api.enums.v1.TaskType
 }
 var file_temporal_server_api_enums_v1_task_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -412,7 +512,7 @@ func file_temporal_server_api_enums_v1_task_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_temporal_server_api_enums_v1_task_proto_rawDesc,
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -250,3 +250,40 @@ func (this *TaskRange) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type QueueMitigation to the protobuf v3 wire format
+func (val *QueueMitigation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type QueueMitigation from the protobuf v3 wire format
+func (val *QueueMitigation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *QueueMitigation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two QueueMitigation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *QueueMitigation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *QueueMitigation
+	switch t := that.(type) {
+	case *QueueMitigation:
+		that1 = t
+	case QueueMitigation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
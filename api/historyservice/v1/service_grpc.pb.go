@@ -104,6 +104,14 @@ const (
 	HistoryService_ListQueues_FullMethodName                             = "/temporal.server.api.historyservice.v1.HistoryService/ListQueues"
 	HistoryService_AddTasks_FullMethodName                               = "/temporal.server.api.historyservice.v1.HistoryService/AddTasks"
 	HistoryService_ListTasks_FullMethodName                              = "/temporal.server.api.historyservice.v1.HistoryService/ListTasks"
+	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
+	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
+	HistoryService_SuspendWorkflowExecution_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/SuspendWorkflowExecution"
+	HistoryService_ResumeWorkflowExecution_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/ResumeWorkflowExecution"
+	HistoryService_ListQueueMitigations_FullMethodName                   = "/temporal.server.api.historyservice.v1.HistoryService/ListQueueMitigations"
+	HistoryService_RevertQueueMitigation_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/RevertQueueMitigation"
+	HistoryService_ReserveConcurrencyLimit_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/ReserveConcurrencyLimit"
+	HistoryService_ReleaseConcurrencyLimit_FullMethodName                = "/temporal.server.api.historyservice.v1.HistoryService/ReleaseConcurrencyLimit"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -311,6 +319,28 @@ type HistoryServiceClient interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(ctx context.Context, in *AddTasksRequest, opts ...grpc.CallOption) (*AddTasksResponse, error)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// PauseActivity marks a pending activity as paused. A paused activity is not dispatched to workers and a failed
+	// or timed out attempt is not retried until the activity is unpaused.
+	PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error)
+	// UnpauseActivity clears the paused mark of a pending activity and reschedules it if no attempt is in flight.
+	UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error)
+	// SuspendWorkflowExecution freezes a running workflow execution: no new workflow task, user timer or activity task
+	// is dispatched and incoming signals are buffered until the execution is resumed.
+	SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*SuspendWorkflowExecutionResponse, error)
+	// ResumeWorkflowExecution resumes a suspended workflow execution and regenerates the tasks deferred while it was
+	// suspended.
+	ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResumeWorkflowExecutionResponse, error)
+	// ListQueueMitigations returns the mitigations a task queue of the shard has applied since the shard was loaded.
+	ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error)
+	// RevertQueueMitigation moves the tasks moved by a mitigation back to the default reader of a task queue of the
+	// shard.
+	RevertQueueMitigation(ctx context.Context, in *RevertQueueMitigationRequest, opts ...grpc.CallOption) (*RevertQueueMitigationResponse, error)
+	// ReserveConcurrencyLimit reserves a slot of a workflow concurrency limit for a run about to be created. The
+	// reservations of a limit are kept by the shard owning it.
+	ReserveConcurrencyLimit(ctx context.Context, in *ReserveConcurrencyLimitRequest, opts ...grpc.CallOption) (*ReserveConcurrencyLimitResponse, error)
+	// ReleaseConcurrencyLimit releases the slot of a workflow concurrency limit held by a run which closed or was not
+	// created.
+	ReleaseConcurrencyLimit(ctx context.Context, in *ReleaseConcurrencyLimitRequest, opts ...grpc.CallOption) (*ReleaseConcurrencyLimitResponse, error)
 }
 
 type historyServiceClient struct {
@@ -901,6 +931,78 @@ func (c *historyServiceClient) ListTasks(ctx context.Context, in *ListTasksReque
 	return out, nil
 }
 
+func (c *historyServiceClient) PauseActivity(ctx context.Context, in *PauseActivityRequest, opts ...grpc.CallOption) (*PauseActivityResponse, error) {
+	out := new(PauseActivityResponse)
+	err := c.cc.Invoke(ctx, HistoryService_PauseActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseActivity(ctx context.Context, in *UnpauseActivityRequest, opts ...grpc.CallOption) (*UnpauseActivityResponse, error) {
+	out := new(UnpauseActivityResponse)
+	err := c.cc.Invoke(ctx, HistoryService_UnpauseActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*SuspendWorkflowExecutionResponse, error) {
+	out := new(SuspendWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_SuspendWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResumeWorkflowExecutionResponse, error) {
+	out := new(ResumeWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ResumeWorkflowExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ListQueueMitigations(ctx context.Context, in *ListQueueMitigationsRequest, opts ...grpc.CallOption) (*ListQueueMitigationsResponse, error) {
+	out := new(ListQueueMitigationsResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ListQueueMitigations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) RevertQueueMitigation(ctx context.Context, in *RevertQueueMitigationRequest, opts ...grpc.CallOption) (*RevertQueueMitigationResponse, error) {
+	out := new(RevertQueueMitigationResponse)
+	err := c.cc.Invoke(ctx, HistoryService_RevertQueueMitigation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ReserveConcurrencyLimit(ctx context.Context, in *ReserveConcurrencyLimitRequest, opts ...grpc.CallOption) (*ReserveConcurrencyLimitResponse, error) {
+	out := new(ReserveConcurrencyLimitResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ReserveConcurrencyLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ReleaseConcurrencyLimit(ctx context.Context, in *ReleaseConcurrencyLimitRequest, opts ...grpc.CallOption) (*ReleaseConcurrencyLimitResponse, error) {
+	out := new(ReleaseConcurrencyLimitResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ReleaseConcurrencyLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1106,6 +1208,28 @@ type HistoryServiceServer interface {
 	// in the same shard. Calls to the persistence API will be batched by workflow run.
 	AddTasks(context.Context, *AddTasksRequest) (*AddTasksResponse, error)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// PauseActivity marks a pending activity as paused. A paused activity is not dispatched to workers and a failed
+	// or timed out attempt is not retried until the activity is unpaused.
+	PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error)
+	// UnpauseActivity clears the paused mark of a pending activity and reschedules it if no attempt is in flight.
+	UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error)
+	// SuspendWorkflowExecution freezes a running workflow execution: no new workflow task, user timer or activity task
+	// is dispatched and incoming signals are buffered until the execution is resumed.
+	SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*SuspendWorkflowExecutionResponse, error)
+	// ResumeWorkflowExecution resumes a suspended workflow execution and regenerates the tasks deferred while it was
+	// suspended.
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*ResumeWorkflowExecutionResponse, error)
+	// ListQueueMitigations returns the mitigations a task queue of the shard has applied since the shard was loaded.
+	ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error)
+	// RevertQueueMitigation moves the tasks moved by a mitigation back to the default reader of a task queue of the
+	// shard.
+	RevertQueueMitigation(context.Context, *RevertQueueMitigationRequest) (*RevertQueueMitigationResponse, error)
+	// ReserveConcurrencyLimit reserves a slot of a workflow concurrency limit for a run about to be created. The
+	// reservations of a limit are kept by the shard owning it.
+	ReserveConcurrencyLimit(context.Context, *ReserveConcurrencyLimitRequest) (*ReserveConcurrencyLimitResponse, error)
+	// ReleaseConcurrencyLimit releases the slot of a workflow concurrency limit held by a run which closed or was not
+	// created.
+	ReleaseConcurrencyLimit(context.Context, *ReleaseConcurrencyLimitRequest) (*ReleaseConcurrencyLimitResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1299,6 +1423,30 @@ func (UnimplementedHistoryServiceServer) AddTasks(context.Context, *AddTasksRequ
 func (UnimplementedHistoryServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
 }
+func (UnimplementedHistoryServiceServer) PauseActivity(context.Context, *PauseActivityRequest) (*PauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseActivity not implemented")
+}
+func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *UnpauseActivityRequest) (*UnpauseActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseActivity not implemented")
+}
+func (UnimplementedHistoryServiceServer) SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*SuspendWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*ResumeWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflowExecution not implemented")
+}
+func (UnimplementedHistoryServiceServer) ListQueueMitigations(context.Context, *ListQueueMitigationsRequest) (*ListQueueMitigationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueMitigations not implemented")
+}
+func (UnimplementedHistoryServiceServer) RevertQueueMitigation(context.Context, *RevertQueueMitigationRequest) (*RevertQueueMitigationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertQueueMitigation not implemented")
+}
+func (UnimplementedHistoryServiceServer) ReserveConcurrencyLimit(context.Context, *ReserveConcurrencyLimitRequest) (*ReserveConcurrencyLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveConcurrencyLimit not implemented")
+}
+func (UnimplementedHistoryServiceServer) ReleaseConcurrencyLimit(context.Context, *ReleaseConcurrencyLimitRequest) (*ReleaseConcurrencyLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseConcurrencyLimit not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2436,6 +2584,150 @@ func _HistoryService_ListTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_PauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_PauseActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseActivity(ctx, req.(*PauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_UnpauseActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseActivity(ctx, req.(*UnpauseActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_SuspendWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).SuspendWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_SuspendWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).SuspendWorkflowExecution(ctx, req.(*SuspendWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ResumeWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ResumeWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ResumeWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ResumeWorkflowExecution(ctx, req.(*ResumeWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ListQueueMitigations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueMitigationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ListQueueMitigations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ListQueueMitigations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ListQueueMitigations(ctx, req.(*ListQueueMitigationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_RevertQueueMitigation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertQueueMitigationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RevertQueueMitigation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_RevertQueueMitigation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RevertQueueMitigation(ctx, req.(*RevertQueueMitigationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ReserveConcurrencyLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveConcurrencyLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ReserveConcurrencyLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ReserveConcurrencyLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ReserveConcurrencyLimit(ctx, req.(*ReserveConcurrencyLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ReleaseConcurrencyLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseConcurrencyLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ReleaseConcurrencyLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ReleaseConcurrencyLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ReleaseConcurrencyLimit(ctx, req.(*ReleaseConcurrencyLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2687,6 +2979,38 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTasks",
 			Handler:    _HistoryService_ListTasks_Handler,
 		},
+		{
+			MethodName: "PauseActivity",
+			Handler:    _HistoryService_PauseActivity_Handler,
+		},
+		{
+			MethodName: "UnpauseActivity",
+			Handler:    _HistoryService_UnpauseActivity_Handler,
+		},
+		{
+			MethodName: "SuspendWorkflowExecution",
+			Handler:    _HistoryService_SuspendWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflowExecution",
+			Handler:    _HistoryService_ResumeWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ListQueueMitigations",
+			Handler:    _HistoryService_ListQueueMitigations_Handler,
+		},
+		{
+			MethodName: "RevertQueueMitigation",
+			Handler:    _HistoryService_RevertQueueMitigation_Handler,
+		},
+		{
+			MethodName: "ReserveConcurrencyLimit",
+			Handler:    _HistoryService_ReserveConcurrencyLimit_Handler,
+		},
+		{
+			MethodName: "ReleaseConcurrencyLimit",
+			Handler:    _HistoryService_ReleaseConcurrencyLimit_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -561,6 +561,26 @@ func (mr *MockHistoryServiceClientMockRecorder) IsWorkflowTaskValid(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceClient)(nil).IsWorkflowTaskValid), varargs...)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockHistoryServiceClient) ListQueueMitigations(ctx context.Context, in *historyservice.ListQueueMitigationsRequest, opts ...grpc.CallOption) (*historyservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQueueMitigations", varargs...)
+	ret0, _ := ret[0].(*historyservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockHistoryServiceClientMockRecorder) ListQueueMitigations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockHistoryServiceClient)(nil).ListQueueMitigations), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceClient) ListQueues(ctx context.Context, in *historyservice.ListQueuesRequest, opts ...grpc.CallOption) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -621,6 +641,26 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// PauseActivity mocks base method.
+func (m *MockHistoryServiceClient) PauseActivity(ctx context.Context, in *historyservice.PauseActivityRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseActivity", varargs...)
+	ret0, _ := ret[0].(*historyservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHistoryServiceClientMockRecorder) PauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).PauseActivity), varargs...)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceClient) PollMutableState(ctx context.Context, in *historyservice.PollMutableStateRequest, opts ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -841,6 +881,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RefreshWorkflowTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// ReleaseConcurrencyLimit mocks base method.
+func (m *MockHistoryServiceClient) ReleaseConcurrencyLimit(ctx context.Context, in *historyservice.ReleaseConcurrencyLimitRequest, opts ...grpc.CallOption) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReleaseConcurrencyLimit", varargs...)
+	ret0, _ := ret[0].(*historyservice.ReleaseConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencyLimit indicates an expected call of ReleaseConcurrencyLimit.
+func (mr *MockHistoryServiceClientMockRecorder) ReleaseConcurrencyLimit(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencyLimit", reflect.TypeOf((*MockHistoryServiceClient)(nil).ReleaseConcurrencyLimit), varargs...)
+}
+
 // RemoveSignalMutableState mocks base method.
 func (m *MockHistoryServiceClient) RemoveSignalMutableState(ctx context.Context, in *historyservice.RemoveSignalMutableStateRequest, opts ...grpc.CallOption) (*historyservice.RemoveSignalMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -941,6 +1001,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RequestCancelWorkflowExecution(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).RequestCancelWorkflowExecution), varargs...)
 }
 
+// ReserveConcurrencyLimit mocks base method.
+func (m *MockHistoryServiceClient) ReserveConcurrencyLimit(ctx context.Context, in *historyservice.ReserveConcurrencyLimitRequest, opts ...grpc.CallOption) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReserveConcurrencyLimit", varargs...)
+	ret0, _ := ret[0].(*historyservice.ReserveConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveConcurrencyLimit indicates an expected call of ReserveConcurrencyLimit.
+func (mr *MockHistoryServiceClientMockRecorder) ReserveConcurrencyLimit(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveConcurrencyLimit", reflect.TypeOf((*MockHistoryServiceClient)(nil).ReserveConcurrencyLimit), varargs...)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockHistoryServiceClient) ResetStickyTaskQueue(ctx context.Context, in *historyservice.ResetStickyTaskQueueRequest, opts ...grpc.CallOption) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1081,6 +1161,46 @@ func (mr *MockHistoryServiceClientMockRecorder) RespondWorkflowTaskFailed(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockHistoryServiceClient)(nil).RespondWorkflowTaskFailed), varargs...)
 }
 
+// ResumeWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) ResumeWorkflowExecution(ctx context.Context, in *historyservice.ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.ResumeWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.ResumeWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) ResumeWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).ResumeWorkflowExecution), varargs...)
+}
+
+// RevertQueueMitigation mocks base method.
+func (m *MockHistoryServiceClient) RevertQueueMitigation(ctx context.Context, in *historyservice.RevertQueueMitigationRequest, opts ...grpc.CallOption) (*historyservice.RevertQueueMitigationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevertQueueMitigation", varargs...)
+	ret0, _ := ret[0].(*historyservice.RevertQueueMitigationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertQueueMitigation indicates an expected call of RevertQueueMitigation.
+func (mr *MockHistoryServiceClientMockRecorder) RevertQueueMitigation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertQueueMitigation", reflect.TypeOf((*MockHistoryServiceClient)(nil).RevertQueueMitigation), varargs...)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockHistoryServiceClient) ScheduleWorkflowTask(ctx context.Context, in *historyservice.ScheduleWorkflowTaskRequest, opts ...grpc.CallOption) (*historyservice.ScheduleWorkflowTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -1181,6 +1301,26 @@ func (mr *MockHistoryServiceClientMockRecorder) StreamWorkflowReplicationMessage
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
 }
 
+// SuspendWorkflowExecution mocks base method.
+func (m *MockHistoryServiceClient) SuspendWorkflowExecution(ctx context.Context, in *historyservice.SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.SuspendWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*historyservice.SuspendWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution.
+func (mr *MockHistoryServiceClientMockRecorder) SuspendWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).SuspendWorkflowExecution), varargs...)
+}
+
 // SyncActivity mocks base method.
 func (m *MockHistoryServiceClient) SyncActivity(ctx context.Context, in *historyservice.SyncActivityRequest, opts ...grpc.CallOption) (*historyservice.SyncActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -1241,6 +1381,26 @@ func (mr *MockHistoryServiceClientMockRecorder) TerminateWorkflowExecution(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceClient)(nil).TerminateWorkflowExecution), varargs...)
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockHistoryServiceClient) UnpauseActivity(ctx context.Context, in *historyservice.UnpauseActivityRequest, opts ...grpc.CallOption) (*historyservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseActivity", varargs...)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHistoryServiceClientMockRecorder) UnpauseActivity(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceClient)(nil).UnpauseActivity), varargs...)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceClient) UpdateWorkflowExecution(ctx context.Context, in *historyservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1836,6 +1996,21 @@ func (mr *MockHistoryServiceServerMockRecorder) IsWorkflowTaskValid(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockHistoryServiceServer)(nil).IsWorkflowTaskValid), arg0, arg1)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockHistoryServiceServer) ListQueueMitigations(arg0 context.Context, arg1 *historyservice.ListQueueMitigationsRequest) (*historyservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueueMitigations", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockHistoryServiceServerMockRecorder) ListQueueMitigations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockHistoryServiceServer)(nil).ListQueueMitigations), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockHistoryServiceServer) ListQueues(arg0 context.Context, arg1 *historyservice.ListQueuesRequest) (*historyservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1881,6 +2056,21 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// PauseActivity mocks base method.
+func (m *MockHistoryServiceServer) PauseActivity(arg0 context.Context, arg1 *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockHistoryServiceServerMockRecorder) PauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).PauseActivity), arg0, arg1)
+}
+
 // PollMutableState mocks base method.
 func (m *MockHistoryServiceServer) PollMutableState(arg0 context.Context, arg1 *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2046,6 +2236,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RefreshWorkflowTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHistoryServiceServer)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// ReleaseConcurrencyLimit mocks base method.
+func (m *MockHistoryServiceServer) ReleaseConcurrencyLimit(arg0 context.Context, arg1 *historyservice.ReleaseConcurrencyLimitRequest) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseConcurrencyLimit", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ReleaseConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencyLimit indicates an expected call of ReleaseConcurrencyLimit.
+func (mr *MockHistoryServiceServerMockRecorder) ReleaseConcurrencyLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencyLimit", reflect.TypeOf((*MockHistoryServiceServer)(nil).ReleaseConcurrencyLimit), arg0, arg1)
+}
+
 // RemoveSignalMutableState mocks base method.
 func (m *MockHistoryServiceServer) RemoveSignalMutableState(arg0 context.Context, arg1 *historyservice.RemoveSignalMutableStateRequest) (*historyservice.RemoveSignalMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -2121,6 +2326,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RequestCancelWorkflowExecution(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).RequestCancelWorkflowExecution), arg0, arg1)
 }
 
+// ReserveConcurrencyLimit mocks base method.
+func (m *MockHistoryServiceServer) ReserveConcurrencyLimit(arg0 context.Context, arg1 *historyservice.ReserveConcurrencyLimitRequest) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveConcurrencyLimit", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ReserveConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveConcurrencyLimit indicates an expected call of ReserveConcurrencyLimit.
+func (mr *MockHistoryServiceServerMockRecorder) ReserveConcurrencyLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveConcurrencyLimit", reflect.TypeOf((*MockHistoryServiceServer)(nil).ReserveConcurrencyLimit), arg0, arg1)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockHistoryServiceServer) ResetStickyTaskQueue(arg0 context.Context, arg1 *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -2226,6 +2446,36 @@ func (mr *MockHistoryServiceServerMockRecorder) RespondWorkflowTaskFailed(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockHistoryServiceServer)(nil).RespondWorkflowTaskFailed), arg0, arg1)
 }
 
+// ResumeWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) ResumeWorkflowExecution(arg0 context.Context, arg1 *historyservice.ResumeWorkflowExecutionRequest) (*historyservice.ResumeWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ResumeWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) ResumeWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).ResumeWorkflowExecution), arg0, arg1)
+}
+
+// RevertQueueMitigation mocks base method.
+func (m *MockHistoryServiceServer) RevertQueueMitigation(arg0 context.Context, arg1 *historyservice.RevertQueueMitigationRequest) (*historyservice.RevertQueueMitigationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertQueueMitigation", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RevertQueueMitigationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertQueueMitigation indicates an expected call of RevertQueueMitigation.
+func (mr *MockHistoryServiceServerMockRecorder) RevertQueueMitigation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertQueueMitigation", reflect.TypeOf((*MockHistoryServiceServer)(nil).RevertQueueMitigation), arg0, arg1)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockHistoryServiceServer) ScheduleWorkflowTask(arg0 context.Context, arg1 *historyservice.ScheduleWorkflowTaskRequest) (*historyservice.ScheduleWorkflowTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -2300,6 +2550,21 @@ func (mr *MockHistoryServiceServerMockRecorder) StreamWorkflowReplicationMessage
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).StreamWorkflowReplicationMessages), arg0)
 }
 
+// SuspendWorkflowExecution mocks base method.
+func (m *MockHistoryServiceServer) SuspendWorkflowExecution(arg0 context.Context, arg1 *historyservice.SuspendWorkflowExecutionRequest) (*historyservice.SuspendWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.SuspendWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution.
+func (mr *MockHistoryServiceServerMockRecorder) SuspendWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).SuspendWorkflowExecution), arg0, arg1)
+}
+
 // SyncActivity mocks base method.
 func (m *MockHistoryServiceServer) SyncActivity(arg0 context.Context, arg1 *historyservice.SyncActivityRequest) (*historyservice.SyncActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -2345,6 +2610,21 @@ func (mr *MockHistoryServiceServerMockRecorder) TerminateWorkflowExecution(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHistoryServiceServer)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockHistoryServiceServer) UnpauseActivity(arg0 context.Context, arg1 *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockHistoryServiceServerMockRecorder) UnpauseActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockHistoryServiceServer)(nil).UnpauseActivity), arg0, arg1)
+}
+
 // UpdateWorkflowExecution mocks base method.
 func (m *MockHistoryServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
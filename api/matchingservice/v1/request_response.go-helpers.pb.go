@@ -1361,6 +1361,154 @@ func (this *ForceUnloadTaskQueueResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ListBacklogTasksRequest to the protobuf v3 wire format
+func (val *ListBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBacklogTasksRequest from the protobuf v3 wire format
+func (val *ListBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBacklogTasksRequest
+	switch t := that.(type) {
+	case *ListBacklogTasksRequest:
+		that1 = t
+	case ListBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListBacklogTasksResponse to the protobuf v3 wire format
+func (val *ListBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBacklogTasksResponse from the protobuf v3 wire format
+func (val *ListBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBacklogTasksResponse
+	switch t := that.(type) {
+	case *ListBacklogTasksResponse:
+		that1 = t
+	case ListBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteBacklogTasksRequest to the protobuf v3 wire format
+func (val *DeleteBacklogTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteBacklogTasksRequest from the protobuf v3 wire format
+func (val *DeleteBacklogTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteBacklogTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteBacklogTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteBacklogTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteBacklogTasksRequest
+	switch t := that.(type) {
+	case *DeleteBacklogTasksRequest:
+		that1 = t
+	case DeleteBacklogTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteBacklogTasksResponse to the protobuf v3 wire format
+func (val *DeleteBacklogTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteBacklogTasksResponse from the protobuf v3 wire format
+func (val *DeleteBacklogTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteBacklogTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteBacklogTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteBacklogTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteBacklogTasksResponse
+	switch t := that.(type) {
+	case *DeleteBacklogTasksResponse:
+		that1 = t
+	case DeleteBacklogTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type UpdateTaskQueueUserDataRequest to the protobuf v3 wire format
 func (val *UpdateTaskQueueUserDataRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2100,3 +2248,151 @@ func (this *ListNexusIncomingServicesResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type RecordWorkerHeartbeatRequest to the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordWorkerHeartbeatRequest from the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordWorkerHeartbeatRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordWorkerHeartbeatRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordWorkerHeartbeatRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordWorkerHeartbeatRequest
+	switch t := that.(type) {
+	case *RecordWorkerHeartbeatRequest:
+		that1 = t
+	case RecordWorkerHeartbeatRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordWorkerHeartbeatResponse to the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordWorkerHeartbeatResponse from the protobuf v3 wire format
+func (val *RecordWorkerHeartbeatResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordWorkerHeartbeatResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordWorkerHeartbeatResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordWorkerHeartbeatResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordWorkerHeartbeatResponse
+	switch t := that.(type) {
+	case *RecordWorkerHeartbeatResponse:
+		that1 = t
+	case RecordWorkerHeartbeatResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListWorkersRequest to the protobuf v3 wire format
+func (val *ListWorkersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListWorkersRequest from the protobuf v3 wire format
+func (val *ListWorkersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListWorkersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListWorkersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListWorkersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListWorkersRequest
+	switch t := that.(type) {
+	case *ListWorkersRequest:
+		that1 = t
+	case ListWorkersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListWorkersResponse to the protobuf v3 wire format
+func (val *ListWorkersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListWorkersResponse from the protobuf v3 wire format
+func (val *ListWorkersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListWorkersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListWorkersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListWorkersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListWorkersResponse
+	switch t := that.(type) {
+	case *ListWorkersResponse:
+		that1 = t
+	case ListWorkersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
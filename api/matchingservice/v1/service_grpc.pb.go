@@ -64,6 +64,10 @@ const (
 	MatchingService_ApplyTaskQueueUserDataReplicationEvent_FullMethodName = "/temporal.server.api.matchingservice.v1.MatchingService/ApplyTaskQueueUserDataReplicationEvent"
 	MatchingService_GetBuildIdTaskQueueMapping_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdTaskQueueMapping"
 	MatchingService_ForceUnloadTaskQueue_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue"
+	MatchingService_ListBacklogTasks_FullMethodName                       = "/temporal.server.api.matchingservice.v1.MatchingService/ListBacklogTasks"
+	MatchingService_DeleteBacklogTasks_FullMethodName                     = "/temporal.server.api.matchingservice.v1.MatchingService/DeleteBacklogTasks"
+	MatchingService_RecordWorkerHeartbeat_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/RecordWorkerHeartbeat"
+	MatchingService_ListWorkers_FullMethodName                            = "/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers"
 	MatchingService_UpdateTaskQueueUserData_FullMethodName                = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData"
 	MatchingService_ReplicateTaskQueueUserData_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData"
 	MatchingService_CreateNexusIncomingService_FullMethodName             = "/temporal.server.api.matchingservice.v1.MatchingService/CreateNexusIncomingService"
@@ -150,6 +154,22 @@ type MatchingServiceClient interface {
 	GetBuildIdTaskQueueMapping(ctx context.Context, in *GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*GetBuildIdTaskQueueMappingResponse, error)
 	// Force unloading a task queue. Used for testing only.
 	ForceUnloadTaskQueue(ctx context.Context, in *ForceUnloadTaskQueueRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueueResponse, error)
+	// Lists the persisted backlog of a physical task queue, starting at its ack level.
+	ListBacklogTasks(ctx context.Context, in *ListBacklogTasksRequest, opts ...grpc.CallOption) (*ListBacklogTasksResponse, error)
+	// Deletes specific tasks from the backlog of a physical task queue, optionally re-adding them to another task
+	// queue first.
+	// (-- api-linter: core::0135::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0135::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	DeleteBacklogTasks(ctx context.Context, in *DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteBacklogTasksResponse, error)
+	// Records a worker heartbeat in the worker registry of a task queue partition.
+	RecordWorkerHeartbeat(ctx context.Context, in *RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkerHeartbeatResponse, error)
+	// Lists the workers known to all partitions of a task queue. Must be called on the root workflow partition.
+	ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -403,6 +423,42 @@ func (c *matchingServiceClient) ForceUnloadTaskQueue(ctx context.Context, in *Fo
 	return out, nil
 }
 
+func (c *matchingServiceClient) ListBacklogTasks(ctx context.Context, in *ListBacklogTasksRequest, opts ...grpc.CallOption) (*ListBacklogTasksResponse, error) {
+	out := new(ListBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) DeleteBacklogTasks(ctx context.Context, in *DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*DeleteBacklogTasksResponse, error) {
+	out := new(DeleteBacklogTasksResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DeleteBacklogTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) RecordWorkerHeartbeat(ctx context.Context, in *RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*RecordWorkerHeartbeatResponse, error) {
+	out := new(RecordWorkerHeartbeatResponse)
+	err := c.cc.Invoke(ctx, MatchingService_RecordWorkerHeartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error) {
+	out := new(ListWorkersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_ListWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*UpdateTaskQueueUserDataResponse, error) {
 	out := new(UpdateTaskQueueUserDataResponse)
 	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueUserData_FullMethodName, in, out, opts...)
@@ -535,6 +591,22 @@ type MatchingServiceServer interface {
 	GetBuildIdTaskQueueMapping(context.Context, *GetBuildIdTaskQueueMappingRequest) (*GetBuildIdTaskQueueMappingResponse, error)
 	// Force unloading a task queue. Used for testing only.
 	ForceUnloadTaskQueue(context.Context, *ForceUnloadTaskQueueRequest) (*ForceUnloadTaskQueueResponse, error)
+	// Lists the persisted backlog of a physical task queue, starting at its ack level.
+	ListBacklogTasks(context.Context, *ListBacklogTasksRequest) (*ListBacklogTasksResponse, error)
+	// Deletes specific tasks from the backlog of a physical task queue, optionally re-adding them to another task
+	// queue first.
+	// (-- api-linter: core::0135::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0135::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: DeleteBacklogTasks RPC doesn't follow Google API format. --)
+	DeleteBacklogTasks(context.Context, *DeleteBacklogTasksRequest) (*DeleteBacklogTasksResponse, error)
+	// Records a worker heartbeat in the worker registry of a task queue partition.
+	RecordWorkerHeartbeat(context.Context, *RecordWorkerHeartbeatRequest) (*RecordWorkerHeartbeatResponse, error)
+	// Lists the workers known to all partitions of a task queue. Must be called on the root workflow partition.
+	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
 	// Update task queue user data in owning node for all updates in namespace.
 	// All user data updates must first go through the task queue owner using the `UpdateWorkerBuildIdCompatibility`
 	// API.
@@ -653,6 +725,18 @@ func (UnimplementedMatchingServiceServer) GetBuildIdTaskQueueMapping(context.Con
 func (UnimplementedMatchingServiceServer) ForceUnloadTaskQueue(context.Context, *ForceUnloadTaskQueueRequest) (*ForceUnloadTaskQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueue not implemented")
 }
+func (UnimplementedMatchingServiceServer) ListBacklogTasks(context.Context, *ListBacklogTasksRequest) (*ListBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBacklogTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) DeleteBacklogTasks(context.Context, *DeleteBacklogTasksRequest) (*DeleteBacklogTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBacklogTasks not implemented")
+}
+func (UnimplementedMatchingServiceServer) RecordWorkerHeartbeat(context.Context, *RecordWorkerHeartbeatRequest) (*RecordWorkerHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordWorkerHeartbeat not implemented")
+}
+func (UnimplementedMatchingServiceServer) ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueUserData(context.Context, *UpdateTaskQueueUserDataRequest) (*UpdateTaskQueueUserDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueUserData not implemented")
 }
@@ -1080,6 +1164,78 @@ func _MatchingService_ForceUnloadTaskQueue_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_ListBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListBacklogTasks(ctx, req.(*ListBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_DeleteBacklogTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBacklogTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DeleteBacklogTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DeleteBacklogTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DeleteBacklogTasks(ctx, req.(*DeleteBacklogTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_RecordWorkerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordWorkerHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).RecordWorkerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_RecordWorkerHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).RecordWorkerHeartbeat(ctx, req.(*RecordWorkerHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_ListWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListWorkers(ctx, req.(*ListWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_UpdateTaskQueueUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTaskQueueUserDataRequest)
 	if err := dec(in); err != nil {
@@ -1283,6 +1439,22 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueue",
 			Handler:    _MatchingService_ForceUnloadTaskQueue_Handler,
 		},
+		{
+			MethodName: "ListBacklogTasks",
+			Handler:    _MatchingService_ListBacklogTasks_Handler,
+		},
+		{
+			MethodName: "DeleteBacklogTasks",
+			Handler:    _MatchingService_DeleteBacklogTasks_Handler,
+		},
+		{
+			MethodName: "RecordWorkerHeartbeat",
+			Handler:    _MatchingService_RecordWorkerHeartbeat_Handler,
+		},
+		{
+			MethodName: "ListWorkers",
+			Handler:    _MatchingService_ListWorkers_Handler,
+		},
 		{
 			MethodName: "UpdateTaskQueueUserData",
 			Handler:    _MatchingService_UpdateTaskQueueUserData_Handler,
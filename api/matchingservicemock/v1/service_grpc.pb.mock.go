@@ -160,6 +160,26 @@ func (mr *MockMatchingServiceClientMockRecorder) CreateNexusIncomingService(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNexusIncomingService", reflect.TypeOf((*MockMatchingServiceClient)(nil).CreateNexusIncomingService), varargs...)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) DeleteBacklogTasks(ctx context.Context, in *matchingservice.DeleteBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DeleteBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) DeleteBacklogTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).DeleteBacklogTasks), varargs...)
+}
+
 // DeleteNexusIncomingService mocks base method.
 func (m *MockMatchingServiceClient) DeleteNexusIncomingService(ctx context.Context, in *matchingservice.DeleteNexusIncomingServiceRequest, opts ...grpc.CallOption) (*matchingservice.DeleteNexusIncomingServiceResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +360,26 @@ func (mr *MockMatchingServiceClientMockRecorder) GetWorkerVersioningRules(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetWorkerVersioningRules), varargs...)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockMatchingServiceClient) ListBacklogTasks(ctx context.Context, in *matchingservice.ListBacklogTasksRequest, opts ...grpc.CallOption) (*matchingservice.ListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBacklogTasks", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockMatchingServiceClientMockRecorder) ListBacklogTasks(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListBacklogTasks), varargs...)
+}
+
 // ListNexusIncomingServices mocks base method.
 func (m *MockMatchingServiceClient) ListNexusIncomingServices(ctx context.Context, in *matchingservice.ListNexusIncomingServicesRequest, opts ...grpc.CallOption) (*matchingservice.ListNexusIncomingServicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +420,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ListTaskQueuePartitions(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListTaskQueuePartitions), varargs...)
 }
 
+// ListWorkers mocks base method.
+func (m *MockMatchingServiceClient) ListWorkers(ctx context.Context, in *matchingservice.ListWorkersRequest, opts ...grpc.CallOption) (*matchingservice.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkers", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockMatchingServiceClientMockRecorder) ListWorkers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListWorkers), varargs...)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) PollActivityTaskQueue(ctx context.Context, in *matchingservice.PollActivityTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -460,6 +520,26 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RecordWorkerHeartbeat mocks base method.
+func (m *MockMatchingServiceClient) RecordWorkerHeartbeat(ctx context.Context, in *matchingservice.RecordWorkerHeartbeatRequest, opts ...grpc.CallOption) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordWorkerHeartbeat", varargs...)
+	ret0, _ := ret[0].(*matchingservice.RecordWorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkerHeartbeat indicates an expected call of RecordWorkerHeartbeat.
+func (mr *MockMatchingServiceClientMockRecorder) RecordWorkerHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockMatchingServiceClient)(nil).RecordWorkerHeartbeat), varargs...)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) ReplicateTaskQueueUserData(ctx context.Context, in *matchingservice.ReplicateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -718,6 +798,21 @@ func (mr *MockMatchingServiceServerMockRecorder) CreateNexusIncomingService(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNexusIncomingService", reflect.TypeOf((*MockMatchingServiceServer)(nil).CreateNexusIncomingService), arg0, arg1)
 }
 
+// DeleteBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) DeleteBacklogTasks(arg0 context.Context, arg1 *matchingservice.DeleteBacklogTasksRequest) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DeleteBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBacklogTasks indicates an expected call of DeleteBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) DeleteBacklogTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).DeleteBacklogTasks), arg0, arg1)
+}
+
 // DeleteNexusIncomingService mocks base method.
 func (m *MockMatchingServiceServer) DeleteNexusIncomingService(arg0 context.Context, arg1 *matchingservice.DeleteNexusIncomingServiceRequest) (*matchingservice.DeleteNexusIncomingServiceResponse, error) {
 	m.ctrl.T.Helper()
@@ -853,6 +948,21 @@ func (mr *MockMatchingServiceServerMockRecorder) GetWorkerVersioningRules(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkerVersioningRules", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetWorkerVersioningRules), arg0, arg1)
 }
 
+// ListBacklogTasks mocks base method.
+func (m *MockMatchingServiceServer) ListBacklogTasks(arg0 context.Context, arg1 *matchingservice.ListBacklogTasksRequest) (*matchingservice.ListBacklogTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBacklogTasks", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListBacklogTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBacklogTasks indicates an expected call of ListBacklogTasks.
+func (mr *MockMatchingServiceServerMockRecorder) ListBacklogTasks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBacklogTasks", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListBacklogTasks), arg0, arg1)
+}
+
 // ListNexusIncomingServices mocks base method.
 func (m *MockMatchingServiceServer) ListNexusIncomingServices(arg0 context.Context, arg1 *matchingservice.ListNexusIncomingServicesRequest) (*matchingservice.ListNexusIncomingServicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -883,6 +993,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ListTaskQueuePartitions(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// ListWorkers mocks base method.
+func (m *MockMatchingServiceServer) ListWorkers(arg0 context.Context, arg1 *matchingservice.ListWorkersRequest) (*matchingservice.ListWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkers indicates an expected call of ListWorkers.
+func (mr *MockMatchingServiceServerMockRecorder) ListWorkers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkers", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListWorkers), arg0, arg1)
+}
+
 // PollActivityTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) PollActivityTaskQueue(arg0 context.Context, arg1 *matchingservice.PollActivityTaskQueueRequest) (*matchingservice.PollActivityTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -943,6 +1068,21 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// RecordWorkerHeartbeat mocks base method.
+func (m *MockMatchingServiceServer) RecordWorkerHeartbeat(arg0 context.Context, arg1 *matchingservice.RecordWorkerHeartbeatRequest) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWorkerHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.RecordWorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWorkerHeartbeat indicates an expected call of RecordWorkerHeartbeat.
+func (mr *MockMatchingServiceServerMockRecorder) RecordWorkerHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWorkerHeartbeat", reflect.TypeOf((*MockMatchingServiceServer)(nil).RecordWorkerHeartbeat), arg0, arg1)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) ReplicateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +66,43 @@ func (this *ClusterMetadata) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ShardPlacement to the protobuf v3 wire format
+func (val *ShardPlacement) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ShardPlacement from the protobuf v3 wire format
+func (val *ShardPlacement) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ShardPlacement) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ShardPlacement values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ShardPlacement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ShardPlacement
+	switch t := that.(type) {
+	case *ShardPlacement:
+		that1 = t
+	case ShardPlacement:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type IndexSearchAttributes to the protobuf v3 wire format
 func (val *IndexSearchAttributes) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -66,6 +66,80 @@ func (this *ShardInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ConcurrencyLimitReservations to the protobuf v3 wire format
+func (val *ConcurrencyLimitReservations) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConcurrencyLimitReservations from the protobuf v3 wire format
+func (val *ConcurrencyLimitReservations) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConcurrencyLimitReservations) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConcurrencyLimitReservations values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConcurrencyLimitReservations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConcurrencyLimitReservations
+	switch t := that.(type) {
+	case *ConcurrencyLimitReservations:
+		that1 = t
+	case ConcurrencyLimitReservations:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ConcurrencyLimitReservation to the protobuf v3 wire format
+func (val *ConcurrencyLimitReservation) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ConcurrencyLimitReservation from the protobuf v3 wire format
+func (val *ConcurrencyLimitReservation) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ConcurrencyLimitReservation) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ConcurrencyLimitReservation values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ConcurrencyLimitReservation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ConcurrencyLimitReservation
+	switch t := that.(type) {
+	case *ConcurrencyLimitReservation:
+		that1 = t
+	case ConcurrencyLimitReservation:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type WorkflowExecutionInfo to the protobuf v3 wire format
 func (val *WorkflowExecutionInfo) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -584,6 +658,43 @@ func (this *SignalInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type OffloadedPayload to the protobuf v3 wire format
+func (val *OffloadedPayload) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type OffloadedPayload from the protobuf v3 wire format
+func (val *OffloadedPayload) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *OffloadedPayload) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two OffloadedPayload values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *OffloadedPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *OffloadedPayload
+	switch t := that.(type) {
+	case *OffloadedPayload:
+		that1 = t
+	case OffloadedPayload:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type Checksum to the protobuf v3 wire format
 func (val *Checksum) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -361,3 +361,40 @@ func (this *DestinationPredicateAttributes) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type WorkflowIdPredicateAttributes to the protobuf v3 wire format
+func (val *WorkflowIdPredicateAttributes) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkflowIdPredicateAttributes from the protobuf v3 wire format
+func (val *WorkflowIdPredicateAttributes) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkflowIdPredicateAttributes) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkflowIdPredicateAttributes values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkflowIdPredicateAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkflowIdPredicateAttributes
+	switch t := that.(type) {
+	case *WorkflowIdPredicateAttributes:
+		that1 = t
+	case WorkflowIdPredicateAttributes:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -176,3 +176,188 @@ func (this *TaskQueuePartition) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type BacklogTaskFilter to the protobuf v3 wire format
+func (val *BacklogTaskFilter) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTaskFilter from the protobuf v3 wire format
+func (val *BacklogTaskFilter) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTaskFilter) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTaskFilter values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTaskFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTaskFilter
+	switch t := that.(type) {
+	case *BacklogTaskFilter:
+		that1 = t
+	case BacklogTaskFilter:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BacklogTaskDeletionResult to the protobuf v3 wire format
+func (val *BacklogTaskDeletionResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BacklogTaskDeletionResult from the protobuf v3 wire format
+func (val *BacklogTaskDeletionResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BacklogTaskDeletionResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BacklogTaskDeletionResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BacklogTaskDeletionResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BacklogTaskDeletionResult
+	switch t := that.(type) {
+	case *BacklogTaskDeletionResult:
+		that1 = t
+	case BacklogTaskDeletionResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerHeartbeat to the protobuf v3 wire format
+func (val *WorkerHeartbeat) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerHeartbeat from the protobuf v3 wire format
+func (val *WorkerHeartbeat) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerHeartbeat) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerHeartbeat values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerHeartbeat) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerHeartbeat
+	switch t := that.(type) {
+	case *WorkerHeartbeat:
+		that1 = t
+	case WorkerHeartbeat:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type WorkerInfo to the protobuf v3 wire format
+func (val *WorkerInfo) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerInfo from the protobuf v3 wire format
+func (val *WorkerInfo) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerInfo) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerInfo values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerInfo
+	switch t := that.(type) {
+	case *WorkerInfo:
+		that1 = t
+	case WorkerInfo:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AdditionalActivityTasks to the protobuf v3 wire format
+func (val *AdditionalActivityTasks) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AdditionalActivityTasks from the protobuf v3 wire format
+func (val *AdditionalActivityTasks) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AdditionalActivityTasks) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AdditionalActivityTasks values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AdditionalActivityTasks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AdditionalActivityTasks
+	switch t := that.(type) {
+	case *AdditionalActivityTasks:
+		that1 = t
+	case AdditionalActivityTasks:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -83,6 +83,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteBacklogTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -133,6 +143,46 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeShardPlacement(
+	ctx context.Context,
+	request *adminservice.DescribeShardPlacementRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardPlacementResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeShardPlacement(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeWorker(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExportWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetArchivedMutableState(
+	ctx context.Context,
+	request *adminservice.GetArchivedMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetArchivedMutableStateResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetArchivedMutableState(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -173,6 +223,16 @@ func (c *clientImpl) GetNamespace(
 	return c.client.GetNamespace(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.GetNamespaceReplicationFiltersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceReplicationFiltersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetNamespaceReplicationFilters(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -193,6 +253,16 @@ func (c *clientImpl) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -253,6 +323,16 @@ func (c *clientImpl) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBacklogTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -283,6 +363,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListQueueMitigations(
+	ctx context.Context,
+	request *adminservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListQueueMitigationsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListQueueMitigations(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -293,6 +383,16 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListWorkers(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -313,6 +413,16 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PauseActivity(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -353,6 +463,16 @@ func (c *clientImpl) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) RecordWorkerHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkerHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordWorkerHeartbeatResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RecordWorkerHeartbeat(ctx, request, opts...)
+}
+
 func (c *clientImpl) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -402,3 +522,63 @@ func (c *clientImpl) ResendReplicationTasks(
 	defer cancel()
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResumeWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) RevertQueueMitigation(
+	ctx context.Context,
+	request *adminservice.RevertQueueMitigationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevertQueueMitigationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RevertQueueMitigation(ctx, request, opts...)
+}
+
+func (c *clientImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SuspendWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SuspendWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SuspendWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UnpauseActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFiltersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFiltersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateNamespaceReplicationFilters(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateShardPlacement(
+	ctx context.Context,
+	request *adminservice.UpdateShardPlacementRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateShardPlacementResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateShardPlacement(ctx, request, opts...)
+}
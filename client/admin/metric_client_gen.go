@@ -103,6 +103,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -173,6 +187,62 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeShardPlacement(
+	ctx context.Context,
+	request *adminservice.DescribeShardPlacementRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeShardPlacementResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeShardPlacement")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeShardPlacement(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeWorkerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeWorker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeWorker(ctx, request, opts...)
+}
+
+func (c *metricClient) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExportWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExportWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExportWorkflowExecutions(ctx, request, opts...)
+}
+
+func (c *metricClient) GetArchivedMutableState(
+	ctx context.Context,
+	request *adminservice.GetArchivedMutableStateRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetArchivedMutableStateResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetArchivedMutableState")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetArchivedMutableState(ctx, request, opts...)
+}
+
 func (c *metricClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -229,6 +299,20 @@ func (c *metricClient) GetNamespace(
 	return c.client.GetNamespace(ctx, request, opts...)
 }
 
+func (c *metricClient) GetNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.GetNamespaceReplicationFiltersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetNamespaceReplicationFiltersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetNamespaceReplicationFilters")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetNamespaceReplicationFilters(ctx, request, opts...)
+}
+
 func (c *metricClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -257,6 +341,20 @@ func (c *metricClient) GetReplicationMessages(
 	return c.client.GetReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetReplicationStatus")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetReplicationStatus(ctx, request, opts...)
+}
+
 func (c *metricClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -341,6 +439,20 @@ func (c *metricClient) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ListBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListBacklogTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListBacklogTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListBacklogTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -383,6 +495,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListQueueMitigations(
+	ctx context.Context,
+	request *adminservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListQueueMitigationsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListQueueMitigations")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListQueueMitigations(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -397,6 +523,20 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListWorkersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListWorkers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListWorkers(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -425,6 +565,20 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PauseActivityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPauseActivity")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseActivity(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -481,6 +635,20 @@ func (c *metricClient) RebuildMutableState(
 	return c.client.RebuildMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) RecordWorkerHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkerHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RecordWorkerHeartbeatResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRecordWorkerHeartbeat")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RecordWorkerHeartbeat(ctx, request, opts...)
+}
+
 func (c *metricClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -550,3 +718,87 @@ func (c *metricClient) ResendReplicationTasks(
 
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
+
+func (c *metricClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResumeWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ResumeWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientResumeWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) RevertQueueMitigation(
+	ctx context.Context,
+	request *adminservice.RevertQueueMitigationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RevertQueueMitigationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRevertQueueMitigation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RevertQueueMitigation(ctx, request, opts...)
+}
+
+func (c *metricClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SuspendWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SuspendWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSuspendWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SuspendWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UnpauseActivityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUnpauseActivity")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseActivity(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFiltersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateNamespaceReplicationFiltersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateNamespaceReplicationFilters")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateNamespaceReplicationFilters(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateShardPlacement(
+	ctx context.Context,
+	request *adminservice.UpdateShardPlacementRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateShardPlacementResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateShardPlacement")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateShardPlacement(ctx, request, opts...)
+}
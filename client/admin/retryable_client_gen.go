@@ -110,6 +110,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteBacklogTasksResponse, error) {
+	var resp *adminservice.DeleteBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -185,6 +200,66 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeShardPlacement(
+	ctx context.Context,
+	request *adminservice.DescribeShardPlacementRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeShardPlacementResponse, error) {
+	var resp *adminservice.DescribeShardPlacementResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeShardPlacement(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeWorkerResponse, error) {
+	var resp *adminservice.DescribeWorkerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeWorker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	var resp *adminservice.ExportWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExportWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetArchivedMutableState(
+	ctx context.Context,
+	request *adminservice.GetArchivedMutableStateRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetArchivedMutableStateResponse, error) {
+	var resp *adminservice.GetArchivedMutableStateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetArchivedMutableState(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetDLQMessages(
 	ctx context.Context,
 	request *adminservice.GetDLQMessagesRequest,
@@ -245,6 +320,21 @@ func (c *retryableClient) GetNamespace(
 	return resp, err
 }
 
+func (c *retryableClient) GetNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.GetNamespaceReplicationFiltersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNamespaceReplicationFiltersResponse, error) {
+	var resp *adminservice.GetNamespaceReplicationFiltersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetNamespaceReplicationFilters(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespaceReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetNamespaceReplicationMessagesRequest,
@@ -275,6 +365,21 @@ func (c *retryableClient) GetReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetReplicationStatusResponse, error) {
+	var resp *adminservice.GetReplicationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetReplicationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetSearchAttributes(
 	ctx context.Context,
 	request *adminservice.GetSearchAttributesRequest,
@@ -365,6 +470,21 @@ func (c *retryableClient) ImportWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ListBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBacklogTasksResponse, error) {
+	var resp *adminservice.ListBacklogTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListBacklogTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -410,6 +530,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListQueueMitigations(
+	ctx context.Context,
+	request *adminservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListQueueMitigationsResponse, error) {
+	var resp *adminservice.ListQueueMitigationsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListQueueMitigations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -425,6 +560,21 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkersResponse, error) {
+	var resp *adminservice.ListWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -455,6 +605,21 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseActivityResponse, error) {
+	var resp *adminservice.PauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -515,6 +680,21 @@ func (c *retryableClient) RebuildMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) RecordWorkerHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkerHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RecordWorkerHeartbeatResponse, error) {
+	var resp *adminservice.RecordWorkerHeartbeatResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RecordWorkerHeartbeat(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RefreshWorkflowTasks(
 	ctx context.Context,
 	request *adminservice.RefreshWorkflowTasksRequest,
@@ -589,3 +769,93 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResumeWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResumeWorkflowExecutionResponse, error) {
+	var resp *adminservice.ResumeWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RevertQueueMitigation(
+	ctx context.Context,
+	request *adminservice.RevertQueueMitigationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RevertQueueMitigationResponse, error) {
+	var resp *adminservice.RevertQueueMitigationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RevertQueueMitigation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SuspendWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SuspendWorkflowExecutionResponse, error) {
+	var resp *adminservice.SuspendWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SuspendWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseActivityResponse, error) {
+	var resp *adminservice.UnpauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFiltersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateNamespaceReplicationFiltersResponse, error) {
+	var resp *adminservice.UpdateNamespaceReplicationFiltersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateNamespaceReplicationFilters(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateShardPlacement(
+	ctx context.Context,
+	request *adminservice.UpdateShardPlacementRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateShardPlacementResponse, error) {
+	var resp *adminservice.UpdateShardPlacementResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateShardPlacement(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -438,6 +438,26 @@ func (c *clientImpl) IsWorkflowTaskValid(
 	return response, nil
 }
 
+func (c *clientImpl) ListQueueMitigations(
+	ctx context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListQueueMitigationsResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ListQueueMitigationsResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListQueueMitigations(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *historyservice.MergeDLQMessagesRequest,
@@ -458,6 +478,26 @@ func (c *clientImpl) MergeDLQMessages(
 	return response, nil
 }
 
+func (c *clientImpl) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseActivityResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	var response *historyservice.PauseActivityResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.PauseActivity(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -683,6 +723,26 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return response, nil
 }
 
+func (c *clientImpl) ReleaseConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReleaseConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ReleaseConcurrencyLimitResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ReleaseConcurrencyLimit(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -783,6 +843,26 @@ func (c *clientImpl) RequestCancelWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) ReserveConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.ReserveConcurrencyLimitResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ReserveConcurrencyLimit(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -948,6 +1028,46 @@ func (c *clientImpl) RespondWorkflowTaskFailed(
 	return response, nil
 }
 
+func (c *clientImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ResumeWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResumeWorkflowExecutionResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	var response *historyservice.ResumeWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ResumeWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RevertQueueMitigation(
+	ctx context.Context,
+	request *historyservice.RevertQueueMitigationRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RevertQueueMitigationResponse, error) {
+	shardID := request.GetShardId()
+	var response *historyservice.RevertQueueMitigationResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RevertQueueMitigation(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
@@ -1028,6 +1148,26 @@ func (c *clientImpl) StartWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.SuspendWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.SuspendWorkflowExecutionResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	var response *historyservice.SuspendWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.SuspendWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) SyncActivity(
 	ctx context.Context,
 	request *historyservice.SyncActivityRequest,
@@ -1088,6 +1228,26 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseActivityResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	var response *historyservice.UnpauseActivityResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UnpauseActivity(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
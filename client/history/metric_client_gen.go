@@ -383,6 +383,20 @@ func (c *metricClient) IsWorkflowTaskValid(
 	return c.client.IsWorkflowTaskValid(ctx, request, opts...)
 }
 
+func (c *metricClient) ListQueueMitigations(
+	ctx context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ListQueueMitigationsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientListQueueMitigations")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListQueueMitigations(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -425,6 +439,20 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.PauseActivityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientPauseActivity")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PauseActivity(ctx, request, opts...)
+}
+
 func (c *metricClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -579,6 +607,20 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return c.client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ReleaseConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReleaseConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ReleaseConcurrencyLimitResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientReleaseConcurrencyLimit")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReleaseConcurrencyLimit(ctx, request, opts...)
+}
+
 func (c *metricClient) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -649,6 +691,20 @@ func (c *metricClient) RequestCancelWorkflowExecution(
 	return c.client.RequestCancelWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ReserveConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ReserveConcurrencyLimitResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientReserveConcurrencyLimit")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReserveConcurrencyLimit(ctx, request, opts...)
+}
+
 func (c *metricClient) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -747,6 +803,34 @@ func (c *metricClient) RespondWorkflowTaskFailed(
 	return c.client.RespondWorkflowTaskFailed(ctx, request, opts...)
 }
 
+func (c *metricClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ResumeWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ResumeWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientResumeWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ResumeWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *metricClient) RevertQueueMitigation(
+	ctx context.Context,
+	request *historyservice.RevertQueueMitigationRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RevertQueueMitigationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientRevertQueueMitigation")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RevertQueueMitigation(ctx, request, opts...)
+}
+
 func (c *metricClient) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
@@ -803,6 +887,20 @@ func (c *metricClient) StartWorkflowExecution(
 	return c.client.StartWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.SuspendWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.SuspendWorkflowExecutionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientSuspendWorkflowExecution")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SuspendWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncActivity(
 	ctx context.Context,
 	request *historyservice.SyncActivityRequest,
@@ -845,6 +943,20 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return c.client.TerminateWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.UnpauseActivityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientUnpauseActivity")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UnpauseActivity(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
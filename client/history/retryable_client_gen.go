@@ -410,6 +410,21 @@ func (c *retryableClient) IsWorkflowTaskValid(
 	return resp, err
 }
 
+func (c *retryableClient) ListQueueMitigations(
+	ctx context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ListQueueMitigationsResponse, error) {
+	var resp *historyservice.ListQueueMitigationsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListQueueMitigations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *historyservice.ListQueuesRequest,
@@ -455,6 +470,21 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseActivityResponse, error) {
+	var resp *historyservice.PauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PauseActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PollMutableState(
 	ctx context.Context,
 	request *historyservice.PollMutableStateRequest,
@@ -620,6 +650,21 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ReleaseConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReleaseConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	var resp *historyservice.ReleaseConcurrencyLimitResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReleaseConcurrencyLimit(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveSignalMutableState(
 	ctx context.Context,
 	request *historyservice.RemoveSignalMutableStateRequest,
@@ -695,6 +740,21 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ReserveConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	var resp *historyservice.ReserveConcurrencyLimitResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReserveConcurrencyLimit(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResetStickyTaskQueue(
 	ctx context.Context,
 	request *historyservice.ResetStickyTaskQueueRequest,
@@ -800,6 +860,36 @@ func (c *retryableClient) RespondWorkflowTaskFailed(
 	return resp, err
 }
 
+func (c *retryableClient) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ResumeWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ResumeWorkflowExecutionResponse, error) {
+	var resp *historyservice.ResumeWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ResumeWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RevertQueueMitigation(
+	ctx context.Context,
+	request *historyservice.RevertQueueMitigationRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RevertQueueMitigationResponse, error) {
+	var resp *historyservice.RevertQueueMitigationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RevertQueueMitigation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ScheduleWorkflowTask(
 	ctx context.Context,
 	request *historyservice.ScheduleWorkflowTaskRequest,
@@ -860,6 +950,21 @@ func (c *retryableClient) StartWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.SuspendWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.SuspendWorkflowExecutionResponse, error) {
+	var resp *historyservice.SuspendWorkflowExecutionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SuspendWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncActivity(
 	ctx context.Context,
 	request *historyservice.SyncActivityRequest,
@@ -905,6 +1010,21 @@ func (c *retryableClient) TerminateWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseActivityResponse, error) {
+	var resp *historyservice.UnpauseActivityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UnpauseActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.UpdateWorkflowExecutionRequest,
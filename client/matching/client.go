@@ -71,7 +71,7 @@ func NewClient(
 	logger log.Logger,
 	lb LoadBalancer,
 ) matchingservice.MatchingServiceClient {
-	return &clientImpl{
+	c := &clientImpl{
 		timeout:         timeout,
 		longPollTimeout: longPollTimeout,
 		clients:         clients,
@@ -79,6 +79,13 @@ func NewClient(
 		logger:          logger,
 		loadBalancer:    lb,
 	}
+	if lbWithClient, ok := lb.(interface {
+		setMatchingClient(matchingservice.MatchingServiceClient)
+	}); ok {
+		// the load balancer fetches auto scaled partition counts from the root partitions
+		lbWithClient.setMatchingClient(c)
+	}
+	return c
 }
 
 func (c *clientImpl) AddActivityTask(
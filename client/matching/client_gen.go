@@ -94,6 +94,25 @@ func (c *clientImpl) CreateNexusIncomingService(
 	return client.CreateNexusIncomingService(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DeleteBacklogTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteNexusIncomingService(
 	ctx context.Context,
 	request *matchingservice.DeleteNexusIncomingServiceRequest,
@@ -265,6 +284,25 @@ func (c *clientImpl) GetWorkerVersioningRules(
 	return client.GetWorkerVersioningRules(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListBacklogTasksRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListBacklogTasksResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListBacklogTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListNexusIncomingServices(
 	ctx context.Context,
 	request *matchingservice.ListNexusIncomingServicesRequest,
@@ -303,6 +341,25 @@ func (c *clientImpl) ListTaskQueuePartitions(
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkers(
+	ctx context.Context,
+	request *matchingservice.ListWorkersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListWorkersResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListWorkers(ctx, request, opts...)
+}
+
 func (c *clientImpl) PollNexusTaskQueue(
 	ctx context.Context,
 	request *matchingservice.PollNexusTaskQueueRequest,
@@ -322,6 +379,25 @@ func (c *clientImpl) PollNexusTaskQueue(
 	return client.PollNexusTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) RecordWorkerHeartbeat(
+	ctx context.Context,
+	request *matchingservice.RecordWorkerHeartbeatRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RecordWorkerHeartbeat(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -28,6 +28,8 @@ import (
 	"math/rand"
 	"sync"
 
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
@@ -62,6 +64,8 @@ type (
 		nWritePartitions    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		forceReadPartition  dynamicconfig.IntPropertyFn
 		forceWritePartition dynamicconfig.IntPropertyFn
+		autoScaleEnabled    dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		partitionCounts     *partitionCountsCache
 
 		lock         sync.RWMutex
 		taskQueueLBs map[tqid.TaskQueue]*tqLoadBalancer
@@ -92,6 +96,8 @@ func NewLoadBalancer(
 		nWritePartitions:    dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
 		forceReadPartition:  dc.GetIntProperty(dynamicconfig.TestMatchingLBForceReadPartition, -1),
 		forceWritePartition: dc.GetIntProperty(dynamicconfig.TestMatchingLBForceWritePartition, -1),
+		autoScaleEnabled:    dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePartitionAutoScale, false),
+		partitionCounts:     newPartitionCountsCache(clock.NewRealTimeSource()),
 		lock:                sync.RWMutex{},
 		taskQueueLBs:        make(map[tqid.TaskQueue]*tqLoadBalancer),
 	}
@@ -110,7 +116,7 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 		return taskQueue.RootPartition()
 	}
 
-	n := max(1, lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType()))
+	n := max(1, lb.writePartitionCount(nsName, taskQueue))
 	return taskQueue.NormalPartition(rand.Intn(n))
 }
 
@@ -127,12 +133,35 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 
 	namespaceName, err := lb.namespaceIDToName(taskQueue.NamespaceId())
 	if err == nil {
-		partitionCount = lb.nReadPartitions(string(namespaceName), taskQueue.Name(), taskQueue.TaskType())
+		partitionCount = lb.readPartitionCount(namespaceName, taskQueue)
 	}
 
 	return tqlb.pickReadPartition(partitionCount, lb.forceReadPartition())
 }
 
+// setMatchingClient sets the client used to fetch the partition counts picked by the partition auto scaler.
+func (lb *defaultLoadBalancer) setMatchingClient(client matchingservice.MatchingServiceClient) {
+	lb.partitionCounts.setClient(client)
+}
+
+func (lb *defaultLoadBalancer) readPartitionCount(nsName namespace.Name, taskQueue *tqid.TaskQueue) int {
+	if lb.autoScaleEnabled(nsName.String(), taskQueue.Name(), taskQueue.TaskType()) {
+		if counts := lb.partitionCounts.get(taskQueue); counts != nil {
+			return int(counts.GetRead())
+		}
+	}
+	return lb.nReadPartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType())
+}
+
+func (lb *defaultLoadBalancer) writePartitionCount(nsName namespace.Name, taskQueue *tqid.TaskQueue) int {
+	if lb.autoScaleEnabled(nsName.String(), taskQueue.Name(), taskQueue.TaskType()) {
+		if counts := lb.partitionCounts.get(taskQueue); counts != nil {
+			return int(counts.GetWrite())
+		}
+	}
+	return lb.nWritePartitions(nsName.String(), taskQueue.Name(), taskQueue.TaskType())
+}
+
 func (lb *defaultLoadBalancer) getTaskQueueLoadBalancer(tq *tqid.TaskQueue) *tqLoadBalancer {
 	lb.lock.RLock()
 	tqlb, ok := lb.taskQueueLBs[*tq]
@@ -25,12 +25,22 @@
 package matching
 
 import (
+	"context"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tqid"
+	"google.golang.org/grpc"
 )
 
 func TestTQLoadBalancerMapping(t *testing.T) {
@@ -178,6 +188,115 @@ func TestLoadBalancer_ReducedPartitionCount(t *testing.T) {
 	assert.Equal(t, 2, maxPollerCount(tqlb))
 }
 
+func TestLoadBalancerAutoScaledPartitionCounts(t *testing.T) {
+	controller := gomock.NewController(t)
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+
+	client := matchingservicemock.NewMockMatchingServiceClient(controller)
+	client.EXPECT().GetTaskQueueUserData(gomock.Any(), &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   "fake-namespace-id",
+		TaskQueue:     "fake-taskqueue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	}).Return(&matchingservice.GetTaskQueueUserDataResponse{
+		UserData: &persistencespb.VersionedTaskQueueUserData{
+			Version: 1,
+			Data: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {
+						PartitionCounts: &persistencespb.TaskQueuePartitionCounts{Read: 3, Write: 2},
+					},
+				},
+			},
+		},
+	}, nil).Times(1)
+
+	lb := &defaultLoadBalancer{
+		namespaceIDToName: func(id namespace.ID) (namespace.Name, error) {
+			return "fake-namespace", nil
+		},
+		nReadPartitions:     dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(8),
+		nWritePartitions:    dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(8),
+		forceReadPartition:  dynamicconfig.GetIntPropertyFn(-1),
+		forceWritePartition: dynamicconfig.GetIntPropertyFn(-1),
+		autoScaleEnabled:    dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true),
+		partitionCounts:     newPartitionCountsCache(clock.NewRealTimeSource()),
+		taskQueueLBs:        make(map[tqid.TaskQueue]*tqLoadBalancer),
+	}
+	lb.setMatchingClient(client)
+
+	activityTQ := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	workflowTQ := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	// counts are unknown until fetched, fall back to dynamic config
+	assert.Equal(t, 8, lb.writePartitionCount("fake-namespace", activityTQ))
+	assert.Eventually(t, func() bool {
+		return lb.writePartitionCount("fake-namespace", activityTQ) == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 3, lb.readPartitionCount("fake-namespace", activityTQ))
+	// no counts picked for workflow type
+	assert.Equal(t, 8, lb.readPartitionCount("fake-namespace", workflowTQ))
+	for i := 0; i < 100; i++ {
+		assert.Less(t, lb.PickWritePartition(activityTQ).PartitionId(), 2)
+	}
+
+	lb.autoScaleEnabled = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(false)
+	assert.Equal(t, 8, lb.writePartitionCount("fake-namespace", activityTQ))
+}
+
+func TestPartitionCountsCacheExpiresIdleTaskQueues(t *testing.T) {
+	controller := gomock.NewController(t)
+	f, err := tqid.NewTaskQueueFamily("fake-namespace-id", "fake-taskqueue")
+	assert.NoError(t, err)
+	activityTQ := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+
+	var fetchedVersions []int64
+	var lock sync.Mutex
+	client := matchingservicemock.NewMockMatchingServiceClient(controller)
+	client.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.GetTaskQueueUserDataRequest, _ ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			fetchedVersions = append(fetchedVersions, request.LastKnownUserDataVersion)
+			return &matchingservice.GetTaskQueueUserDataResponse{
+				UserData: &persistencespb.VersionedTaskQueueUserData{
+					Version: 1,
+					Data: &persistencespb.TaskQueueUserData{
+						PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+							int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {
+								PartitionCounts: &persistencespb.TaskQueuePartitionCounts{Read: 3, Write: 2},
+							},
+						},
+					},
+				},
+			}, nil
+		}).AnyTimes()
+	fetches := func() []int64 {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]int64(nil), fetchedVersions...)
+	}
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	c := newPartitionCountsCache(timeSource)
+	c.setClient(client)
+
+	assert.Nil(t, c.get(activityTQ))
+	assert.Eventually(t, func() bool { return c.get(activityTQ) != nil }, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{0}, fetches())
+
+	// in use task queues are refreshed with the last known version
+	timeSource.Advance(partitionCountsRefreshInterval)
+	assert.NotNil(t, c.get(activityTQ))
+	assert.Eventually(t, func() bool { return len(fetches()) == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{0, 1}, fetches())
+
+	// idle task queues are evicted and fetched from scratch when used again
+	timeSource.Advance(partitionCountsIdleTTL + time.Second)
+	assert.Nil(t, c.get(activityTQ))
+	assert.Eventually(t, func() bool { return len(fetches()) == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, []int64{0, 1, 0}, fetches())
+}
+
 func maxPollerCount(tqlb *tqLoadBalancer) int {
 	res := -1
 	for _, c := range tqlb.pollerCounts {
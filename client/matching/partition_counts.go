@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/tqid"
+)
+
+const (
+	// how often cached partition counts are refreshed from the root partition
+	partitionCountsRefreshInterval = 10 * time.Second
+	partitionCountsFetchTimeout    = 5 * time.Second
+	// entries are only refreshed while the task queue is in use, idle task queues expire after this long
+	partitionCountsIdleTTL      = 5 * time.Minute
+	partitionCountsCacheMaxSize = 10000
+)
+
+type (
+	// partitionCountsCache caches the partition counts picked by the matching partition auto scaler, which are
+	// stored in the user data of the root workflow partition of each task queue. Entries are refreshed in the
+	// background so that picking a partition never blocks on a remote call. Entries are only refreshed when they
+	// are read, so the cache entries of idle task queues expire and are evicted.
+	partitionCountsCache struct {
+		refreshInterval time.Duration
+		timeSource      clock.TimeSource
+
+		lock    sync.Mutex
+		client  matchingservice.MatchingServiceClient
+		entries cache.Cache // tqid.TaskQueueFamily -> *partitionCountsEntry
+	}
+
+	partitionCountsEntry struct {
+		userData  *persistencespb.VersionedTaskQueueUserData
+		fetchTime time.Time
+		fetching  bool
+	}
+)
+
+func newPartitionCountsCache(timeSource clock.TimeSource) *partitionCountsCache {
+	return &partitionCountsCache{
+		refreshInterval: partitionCountsRefreshInterval,
+		timeSource:      timeSource,
+		entries: cache.New(
+			partitionCountsCacheMaxSize,
+			&cache.Options{TTL: partitionCountsIdleTTL, TimeSource: timeSource},
+			metrics.NoopMetricsHandler,
+		),
+	}
+}
+
+func (c *partitionCountsCache) setClient(client matchingservice.MatchingServiceClient) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.client = client
+}
+
+// get returns the cached partition counts of the given task queue, or nil if they are unknown. A refresh is
+// started in the background if the cached value is stale.
+func (c *partitionCountsCache) get(taskQueue *tqid.TaskQueue) *persistencespb.TaskQueuePartitionCounts {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+	family := *taskQueue.Family()
+	entry, ok := c.entries.Get(family).(*partitionCountsEntry)
+	if !ok {
+		entry = &partitionCountsEntry{}
+		c.entries.Put(family, entry)
+	}
+	if !entry.fetching && c.timeSource.Now().Sub(entry.fetchTime) >= c.refreshInterval {
+		entry.fetching = true
+		go c.refresh(c.client, &family, entry.userData.GetVersion())
+	}
+
+	counts := entry.userData.GetData().GetPerType()[int32(taskQueue.TaskType())].GetPartitionCounts()
+	if counts.GetRead() <= 0 || counts.GetWrite() <= 0 {
+		return nil
+	}
+	return counts
+}
+
+func (c *partitionCountsCache) refresh(
+	client matchingservice.MatchingServiceClient,
+	family *tqid.TaskQueueFamily,
+	knownVersion int64,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), partitionCountsFetchTimeout)
+	defer cancel()
+
+	rootPartition := family.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition()
+	resp, err := client.GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:              family.NamespaceId().String(),
+		TaskQueue:                rootPartition.RpcName(),
+		TaskQueueType:            rootPartition.TaskType(),
+		LastKnownUserDataVersion: knownVersion,
+	})
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries.Get(*family).(*partitionCountsEntry)
+	if !ok {
+		// evicted while fetching
+		entry = &partitionCountsEntry{}
+	}
+	entry.fetching = false
+	// On error, keep the previous value and retry after the refresh interval.
+	entry.fetchTime = c.timeSource.Now()
+	if err == nil && resp.GetUserData() != nil {
+		entry.userData = resp.GetUserData()
+	}
+	// Put resets the TTL of the entry, it is refreshed at most once per refresh interval while in use.
+	c.entries.Put(*family, entry)
+}
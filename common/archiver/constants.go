@@ -46,6 +46,9 @@ const (
 	ErrReasonReadHistory = "failed to read history batches"
 	// ErrReasonHistoryMutated is the error reason for mutated history
 	ErrReasonHistoryMutated = "history was mutated"
+
+	// MutableStateSnapshotFormatVersion is the version of the format of the archived mutable state snapshots
+	MutableStateSnapshotFormatVersion = 1
 )
 
 var (
@@ -65,4 +68,8 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrInvalidGetMutableStateSnapshotRequest is the error for invalid GetMutableStateSnapshot request
+	ErrInvalidGetMutableStateSnapshotRequest = errors.New("get archived mutable state snapshot request is invalid")
+	// ErrMutableStateSnapshotNotExist is the error for non-exist mutable state snapshot
+	ErrMutableStateSnapshotNotExist = errors.New("requested mutable state snapshot does not exist")
 )
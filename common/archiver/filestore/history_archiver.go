@@ -36,6 +36,10 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// If the Archive() request includes a mutable state snapshot, it is written to a file named in the
+// format of hash(namespaceID, workflowID, runID)_version.mutablestate next to the history file.
+// GetMutableStateSnapshot() reads it back.
+
 package filestore
 
 import (
@@ -44,10 +48,12 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
+	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/codec"
@@ -60,9 +66,14 @@ const (
 	// URIScheme is the scheme for the filestore implementation
 	URIScheme = "file"
 
-	errEncodeHistory = "failed to encode history batches"
-	errMakeDirectory = "failed to make directory"
-	errWriteFile     = "failed to write history to file"
+	errEncodeHistory      = "failed to encode history batches"
+	errEncodeMutableState = "failed to encode mutable state snapshot"
+	errMakeDirectory      = "failed to make directory"
+	errWriteFile          = "failed to write history to file"
+	errWriteSnapshotFile  = "failed to write mutable state snapshot to file"
+
+	historyFileExtension      = ".history"
+	mutableStateFileExtension = ".mutablestate"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
@@ -195,6 +206,20 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	if request.MutableStateSnapshot == nil {
+		return nil
+	}
+	encodedSnapshot, err := encode(request.MutableStateSnapshot)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeMutableState), tag.Error(err))
+		return err
+	}
+	filename = constructMutableStateFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if err := writeFile(path.Join(dirPath, filename), encodedSnapshot, h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteSnapshotFile), tag.Error(err))
+		return err
+	}
+
 	return nil
 }
 
@@ -232,7 +257,7 @@ func (h *historyArchiver) Get(
 			NextBatchIdx:         0,
 		}
 	} else {
-		highestVersion, err := getHighestVersion(dirPath, request)
+		highestVersion, err := getHighestVersion(dirPath, request.NamespaceID, request.WorkflowID, request.RunID, historyFileExtension)
 		if err != nil {
 			return nil, serviceerror.NewInternal(err.Error())
 		}
@@ -288,6 +313,59 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) GetMutableStateSnapshot(
+	_ context.Context,
+	URI archiver.URI,
+	request *archiver.GetMutableStateSnapshotRequest,
+) (*archiverspb.MutableStateSnapshot, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetMutableStateSnapshotRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetMutableStateSnapshotRequest.Error())
+	}
+
+	dirPath := URI.Path()
+	exists, err := directoryExists(dirPath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return nil, serviceerror.NewNotFound(archiver.ErrMutableStateSnapshotNotExist.Error())
+	}
+
+	version := request.CloseFailoverVersion
+	if version == nil {
+		version, err = getHighestVersion(dirPath, request.NamespaceID, request.WorkflowID, request.RunID, mutableStateFileExtension)
+		if err == archiver.ErrHistoryNotExist {
+			return nil, serviceerror.NewNotFound(archiver.ErrMutableStateSnapshotNotExist.Error())
+		}
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+
+	filepath := path.Join(dirPath, constructMutableStateFilename(request.NamespaceID, request.WorkflowID, request.RunID, *version))
+	exists, err = fileExists(filepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		return nil, serviceerror.NewNotFound(archiver.ErrMutableStateSnapshotNotExist.Error())
+	}
+
+	encodedSnapshot, err := readFile(filepath)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	snapshot, err := decodeMutableStateSnapshot(encodedSnapshot)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	return snapshot, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -296,14 +374,17 @@ func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	return validateDirPath(URI.Path())
 }
 
-func getHighestVersion(dirPath string, request *archiver.GetHistoryRequest) (*int64, error) {
-	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(request.NamespaceID, request.WorkflowID, request.RunID))
+func getHighestVersion(dirPath string, namespaceID, workflowID, runID string, extension string) (*int64, error) {
+	filenames, err := listFilesByPrefix(dirPath, constructHistoryFilenamePrefix(namespaceID, workflowID, runID))
 	if err != nil {
 		return nil, err
 	}
 
 	var highestVersion *int64
 	for _, filename := range filenames {
+		if !strings.HasSuffix(filename, extension) {
+			continue
+		}
 		version, err := extractCloseFailoverVersion(filename)
 		if err != nil {
 			continue
@@ -32,6 +32,7 @@ import (
 	"testing"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -42,12 +43,15 @@ import (
 	"github.com/stretchr/testify/suite"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/testing/protorequire"
 )
 
 const (
@@ -578,6 +582,88 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGetMutableStateSnapshot() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchiveAndGetMutableStateSnapshot")
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	snapshot := &archiverspb.MutableStateSnapshot{
+		FormatVersion:        archiver.MutableStateSnapshotFormatVersion,
+		NamespaceId:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowId:           testWorkflowID,
+		RunId:                testRunID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		ExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution:     &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID},
+			Status:        enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			HistoryLength: testNextEventID - 1,
+		},
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("test-value")},
+		},
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("test-memo")},
+		},
+	}
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		MutableStateSnapshot: snapshot,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	expectedFilename := constructMutableStateFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	s.assertFileExists(path.Join(dir, expectedFilename))
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	archivedSnapshot, err := historyArchiver.GetMutableStateSnapshot(context.Background(), URI, &archiver.GetMutableStateSnapshotRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	})
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), snapshot, archivedSnapshot)
+
+	_, err = historyArchiver.GetMutableStateSnapshot(context.Background(), URI, &archiver.GetMutableStateSnapshotRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       "other-run-id",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
@@ -154,6 +154,16 @@ func encodeHistories(histories []*historypb.History) ([]byte, error) {
 	return encoder.EncodeHistories(histories)
 }
 
+func decodeMutableStateSnapshot(data []byte) (*archiverspb.MutableStateSnapshot, error) {
+	snapshot := &archiverspb.MutableStateSnapshot{}
+	encoder := codec.NewJSONPBEncoder()
+	err := encoder.Decode(data, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
 func decodeVisibilityRecord(data []byte) (*archiverspb.VisibilityRecord, error) {
 	record := &archiverspb.VisibilityRecord{}
 	encoder := codec.NewJSONPBEncoder()
@@ -187,7 +197,12 @@ func deserializeQueryVisibilityToken(bytes []byte) (*queryVisibilityToken, error
 
 func constructHistoryFilename(namespaceID, workflowID, runID string, version int64) string {
 	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
-	return fmt.Sprintf("%s_%v.history", combinedHash, version)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, historyFileExtension)
+}
+
+func constructMutableStateFilename(namespaceID, workflowID, runID string, version int64) string {
+	combinedHash := constructHistoryFilenamePrefix(namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s_%v%s", combinedHash, version, mutableStateFileExtension)
 }
 
 func constructHistoryFilenamePrefix(namespaceID, workflowID, runID string) string {
@@ -52,6 +52,9 @@ type (
 		BranchToken          []byte
 		NextEventID          int64
 		CloseFailoverVersion int64
+		// MutableStateSnapshot is the final mutable state of the workflow, with its search attributes and memo. It is
+		// optional and only archived by HistoryArchivers which also implement MutableStateSnapshotArchiver.
+		MutableStateSnapshot *archiverspb.MutableStateSnapshot
 	}
 
 	// GetHistoryRequest is the request to Get archived history
@@ -96,6 +99,23 @@ type (
 		ValidateURI(uri URI) error
 	}
 
+	// GetMutableStateSnapshotRequest is the request to Get an archived mutable state snapshot
+	GetMutableStateSnapshotRequest struct {
+		NamespaceID          string
+		WorkflowID           string
+		RunID                string
+		CloseFailoverVersion *int64
+	}
+
+	// MutableStateSnapshotArchiver is optionally implemented by a HistoryArchiver which archives the mutable state
+	// snapshot of an ArchiveHistoryRequest along with the history.
+	MutableStateSnapshotArchiver interface {
+		// GetMutableStateSnapshot is used to access an archived mutable state snapshot. If no close failover version is
+		// given, the snapshot with the highest close failover version is returned.
+		// This method should emit api service errors, including NotFound when the snapshot was not archived.
+		GetMutableStateSnapshot(ctx context.Context, uri URI, request *GetMutableStateSnapshotRequest) (*archiverspb.MutableStateSnapshot, error)
+	}
+
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
 		Logger          log.Logger
@@ -108,6 +108,44 @@ func (mr *MockHistoryArchiverMockRecorder) ValidateURI(uri interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateURI", reflect.TypeOf((*MockHistoryArchiver)(nil).ValidateURI), uri)
 }
 
+// MockMutableStateSnapshotArchiver is a mock of MutableStateSnapshotArchiver interface.
+type MockMutableStateSnapshotArchiver struct {
+	ctrl     *gomock.Controller
+	recorder *MockMutableStateSnapshotArchiverMockRecorder
+}
+
+// MockMutableStateSnapshotArchiverMockRecorder is the mock recorder for MockMutableStateSnapshotArchiver.
+type MockMutableStateSnapshotArchiverMockRecorder struct {
+	mock *MockMutableStateSnapshotArchiver
+}
+
+// NewMockMutableStateSnapshotArchiver creates a new mock instance.
+func NewMockMutableStateSnapshotArchiver(ctrl *gomock.Controller) *MockMutableStateSnapshotArchiver {
+	mock := &MockMutableStateSnapshotArchiver{ctrl: ctrl}
+	mock.recorder = &MockMutableStateSnapshotArchiverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMutableStateSnapshotArchiver) EXPECT() *MockMutableStateSnapshotArchiverMockRecorder {
+	return m.recorder
+}
+
+// GetMutableStateSnapshot mocks base method.
+func (m *MockMutableStateSnapshotArchiver) GetMutableStateSnapshot(ctx context.Context, uri URI, request *GetMutableStateSnapshotRequest) (*v1.MutableStateSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutableStateSnapshot", ctx, uri, request)
+	ret0, _ := ret[0].(*v1.MutableStateSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutableStateSnapshot indicates an expected call of GetMutableStateSnapshot.
+func (mr *MockMutableStateSnapshotArchiverMockRecorder) GetMutableStateSnapshot(ctx, uri, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableStateSnapshot", reflect.TypeOf((*MockMutableStateSnapshotArchiver)(nil).GetMutableStateSnapshot), ctx, uri, request)
+}
+
 // MockVisibilityArchiver is a mock of VisibilityArchiver interface.
 type MockVisibilityArchiver struct {
 	ctrl     *gomock.Controller
@@ -41,6 +41,8 @@ type (
 	ArchiveFeatureCatalog struct {
 		ProgressManager   ProgressManager
 		NonRetryableError NonRetryableError
+		// OverwriteExisting makes the archiver upload blobs which already exist instead of skipping them.
+		OverwriteExisting bool
 	}
 
 	// NonRetryableError returns an error indicating archiver has encountered an non-retryable error
@@ -99,3 +101,11 @@ func GetNonRetryableErrorOption(nonRetryableErr error) ArchiveOption {
 		}
 	}
 }
+
+// GetOverwriteExistingArchiveOption returns an ArchiveOption so that archiver overwrites blobs
+// which already exist. It should be used to replace an archive which is known to be corrupt.
+func GetOverwriteExistingArchiveOption() ArchiveOption {
+	return func(catalog *ArchiveFeatureCatalog) {
+		catalog.OverwriteExisting = true
+	}
+}
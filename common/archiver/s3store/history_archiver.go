@@ -23,6 +23,7 @@
 // THE SOFTWARE.
 
 // S3 History Archiver will archive workflow histories to amazon s3
+// If the archive request includes a mutable state snapshot, it is archived to a separate key after the history.
 
 package s3store
 
@@ -57,7 +58,9 @@ const (
 	// URIScheme is the scheme for the s3 implementation
 	URIScheme               = "s3"
 	errEncodeHistory        = "failed to encode history batches"
+	errEncodeMutableState   = "failed to encode mutable state snapshot"
 	errWriteKey             = "failed to write history to s3"
+	errWriteSnapshotKey     = "failed to write mutable state snapshot to s3"
 	defaultBlobstoreTimeout = time.Minute
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
 )
@@ -196,14 +199,17 @@ func (h *historyArchiver) Archive(
 		}
 		key := constructHistoryKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
 
-		exists, err := KeyExists(ctx, h.s3cli, URI, key)
-		if err != nil {
-			if isRetryableError(err) {
-				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-			} else {
-				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+		exists := false
+		if !featureCatalog.OverwriteExisting {
+			exists, err = KeyExists(ctx, h.s3cli, URI, key)
+			if err != nil {
+				if isRetryableError(err) {
+					logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+				} else {
+					logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
+				}
+				return err
 			}
-			return err
 		}
 		blobSize := int64(binary.Size(encodedHistoryBlob))
 		if exists {
@@ -226,6 +232,24 @@ func (h *historyArchiver) Archive(
 		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
 	}
 
+	if request.MutableStateSnapshot != nil {
+		encodedSnapshot, err := Encode(request.MutableStateSnapshot)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeMutableState), tag.Error(err))
+			return err
+		}
+		key := constructMutableStateKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+		if err := Upload(ctx, h.s3cli, URI, key, encodedSnapshot); err != nil {
+			if isRetryableError(err) {
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteSnapshotKey), tag.Error(err))
+			} else {
+				logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteSnapshotKey), tag.Error(err))
+			}
+			return err
+		}
+		progress.uploadedSize += int64(binary.Size(encodedSnapshot))
+	}
+
 	handler.Histogram(metrics.HistoryArchiverTotalUploadSize.Name(), metrics.HistoryArchiverTotalUploadSize.Unit()).Record(progress.uploadedSize)
 	handler.Histogram(metrics.HistoryArchiverHistorySize.Name(), metrics.HistoryArchiverHistorySize.Unit()).Record(progress.historySize)
 	metrics.HistoryArchiverArchiveSuccessCount.With(handler).Record(1)
@@ -354,6 +378,54 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) GetMutableStateSnapshot(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.GetMutableStateSnapshotRequest,
+) (*archiverspb.MutableStateSnapshot, error) {
+	if err := SoftValidateURI(URI); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidURI.Error())
+	}
+
+	if err := archiver.ValidateGetMutableStateSnapshotRequest(request); err != nil {
+		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidGetMutableStateSnapshotRequest.Error())
+	}
+
+	version := request.CloseFailoverVersion
+	if version == nil {
+		var err error
+		version, err = h.getHighestMutableStateVersion(ctx, URI, request)
+		if err != nil {
+			if err == archiver.ErrMutableStateSnapshotNotExist {
+				return nil, serviceerror.NewNotFound(err.Error())
+			}
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+	}
+
+	key := constructMutableStateKey(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID, *version)
+	encodedSnapshot, err := Download(ctx, h.s3cli, URI, key)
+	if err != nil {
+		if isRetryableError(err) {
+			return nil, serviceerror.NewUnavailable(err.Error())
+		}
+		switch err.(type) {
+		case *serviceerror.NotFound:
+			return nil, serviceerror.NewNotFound(archiver.ErrMutableStateSnapshotNotExist.Error())
+		case *serviceerror.InvalidArgument, *serviceerror.Unavailable:
+			return nil, err
+		default:
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+
+	snapshot := &archiverspb.MutableStateSnapshot{}
+	if err := codec.NewJSONPBEncoder().Decode(encodedSnapshot, snapshot); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	return snapshot, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := SoftValidateURI(URI)
 	if err != nil {
@@ -395,6 +467,39 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	return highestVersion, nil
 }
 
+func (h *historyArchiver) getHighestMutableStateVersion(ctx context.Context, URI archiver.URI, request *archiver.GetMutableStateSnapshotRequest) (*int64, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	var prefix = constructMutableStateKeyPrefix(URI.Path(), request.NamespaceID, request.WorkflowID, request.RunID) + "/"
+	results, err := h.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(URI.Hostname()),
+		Prefix:    aws.String(prefix),
+		Delimiter: aws.String("/"),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			return nil, serviceerror.NewInvalidArgument(errBucketNotExists.Error())
+		}
+		return nil, err
+	}
+	var highestVersion *int64
+
+	for _, v := range results.Contents {
+		var version int64
+		version, err = strconv.ParseInt(strings.TrimPrefix(*v.Key, prefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		if highestVersion == nil || version > *highestVersion {
+			highestVersion = &version
+		}
+	}
+	if highestVersion == nil {
+		return nil, archiver.ErrMutableStateSnapshotNotExist
+	}
+	return highestVersion, nil
+}
+
 func isRetryableError(err error) bool {
 	if err == nil {
 		return false
@@ -43,9 +43,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
@@ -55,6 +57,8 @@ import (
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/util"
 )
 
@@ -694,6 +698,145 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_OverwriteExisting() {
+	runID := "test-overwrite-run-id"
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGet_OverwriteExisting")
+	s.NoError(err)
+	// Write a blob with the wrong history to the key of the first blob, as if the archive was corrupt.
+	encoder := codec.NewJSONPBEncoder()
+	data, err := encoder.Encode(s.historyBatchesV1[0])
+	s.NoError(err)
+	_, err = s.s3cli.PutObjectWithContext(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String(testBucket),
+		Key:    aws.String(constructHistoryKey(URI.Path(), testNamespaceID, testWorkflowID, runID, testCloseFailoverVersion, 0)),
+		Body:   bytes.NewReader(data),
+	})
+	s.NoError(err)
+
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                runID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest, archiver.GetOverwriteExistingArchiveOption())
+	s.NoError(err)
+
+	getRequest := &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       runID,
+		PageSize:    testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGetMutableStateSnapshot() {
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndGetMutableStateSnapshot")
+	s.NoError(err)
+
+	newSnapshot := func(version int64) *archiverspb.MutableStateSnapshot {
+		return &archiverspb.MutableStateSnapshot{
+			FormatVersion:        archiver.MutableStateSnapshotFormatVersion,
+			NamespaceId:          testNamespaceID,
+			Namespace:            testNamespace,
+			WorkflowId:           testWorkflowID,
+			RunId:                testRunID,
+			CloseFailoverVersion: version,
+			ExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution:     &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID},
+				Status:        enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+				HistoryLength: testNextEventID - 1,
+			},
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("test-value")},
+			},
+			Memo: &commonpb.Memo{
+				Fields: map[string]*commonpb.Payload{"memo": payload.EncodeString("test-memo")},
+			},
+		}
+	}
+	// snapshot archived before a failover, with a lower close failover version
+	oldSnapshot := newSnapshot(1)
+	data, err := Encode(oldSnapshot)
+	s.NoError(err)
+	_, err = s.s3cli.PutObjectWithContext(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String(testBucket),
+		Key:    aws.String(constructMutableStateKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, 1)),
+		Body:   bytes.NewReader(data),
+	})
+	s.NoError(err)
+
+	historyIterator := archiver.NewMockHistoryIterator(s.controller)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next(gomock.Any()).Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	snapshot := newSnapshot(testCloseFailoverVersion)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		MutableStateSnapshot: snapshot,
+	}
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+	s.assertKeyExists(constructMutableStateKey(URI.Path(), testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion))
+
+	response, err := historyArchiver.Get(context.Background(), URI, &archiver.GetHistoryRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+		PageSize:    testPageSize,
+	})
+	s.NoError(err)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+
+	getRequest := &archiver.GetMutableStateSnapshotRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}
+	archivedSnapshot, err := historyArchiver.GetMutableStateSnapshot(context.Background(), URI, getRequest)
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), snapshot, archivedSnapshot)
+
+	getRequest.CloseFailoverVersion = util.Ptr(int64(1))
+	archivedSnapshot, err = historyArchiver.GetMutableStateSnapshot(context.Background(), URI, getRequest)
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), oldSnapshot, archivedSnapshot)
+
+	getRequest.CloseFailoverVersion = nil
+	getRequest.RunID = "other-run-id"
+	_, err = historyArchiver.GetMutableStateSnapshot(context.Background(), URI, getRequest)
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	// config := &config.S3Archiver{}
 	// archiver, err := newHistoryArchiver(s.container, config, historyIterator)
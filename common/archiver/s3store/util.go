@@ -150,6 +150,15 @@ func constructHistoryKeyPrefix(path, namespaceID, workflowID, runID string) stri
 	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "history", workflowID, runID}, "/"), "/")
 }
 
+func constructMutableStateKey(path, namespaceID, workflowID, runID string, version int64) string {
+	prefix := constructMutableStateKeyPrefix(path, namespaceID, workflowID, runID)
+	return fmt.Sprintf("%s/%v", prefix, version)
+}
+
+func constructMutableStateKeyPrefix(path, namespaceID, workflowID, runID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, namespaceID, "mutablestate", workflowID, runID}, "/"), "/")
+}
+
 func constructTimeBasedSearchKey(path, namespaceID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, t time.Time, precision string) string {
 	var timeFormat = ""
 	switch precision {
@@ -108,6 +108,20 @@ func ValidateGetRequest(request *GetHistoryRequest) error {
 	return nil
 }
 
+// ValidateGetMutableStateSnapshotRequest validates the get archived mutable state snapshot request
+func ValidateGetMutableStateSnapshotRequest(request *GetMutableStateSnapshotRequest) error {
+	if request.NamespaceID == "" {
+		return errEmptyNamespaceID
+	}
+	if request.WorkflowID == "" {
+		return errEmptyWorkflowID
+	}
+	if request.RunID == "" {
+		return errEmptyRunID
+	}
+	return nil
+}
+
 // ValidateVisibilityArchivalRequest validates the archive visibility request
 func ValidateVisibilityArchivalRequest(request *archiverspb.VisibilityRecord) error {
 	if request.GetNamespaceId() == "" {
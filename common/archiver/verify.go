@@ -0,0 +1,180 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/proto"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// VerifyHistoryRequest is the request to verify an archived workflow history against the history in primary
+	// storage
+	VerifyHistoryRequest struct {
+		ShardID     int32
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		BranchToken []byte
+		// LastFirstEventID is the ID of the first event of the last batch of events, which is used to read the last
+		// event from primary storage without reading the whole history.
+		LastFirstEventID     int64
+		NextEventID          int64
+		CloseFailoverVersion int64
+	}
+)
+
+const verifyHistoryPageSize = 250
+
+var (
+	// ErrArchivedHistoryNotFound is the error for an archived history which cannot be read back
+	ErrArchivedHistoryNotFound = errors.New("archived workflow history does not exist")
+	// ErrArchivedHistoryMismatch is the error for an archived history which differs from the history in primary storage
+	ErrArchivedHistoryMismatch = errors.New("archived workflow history does not match the history in primary storage")
+)
+
+// VerifyHistory reads an archived history back through the history archiver, and compares its event count and the
+// checksum of its last event with the history in primary storage. It returns ErrArchivedHistoryNotFound or
+// ErrArchivedHistoryMismatch (possibly wrapped) if the archived history is missing or incomplete, in which case the
+// history must not be deleted from primary storage.
+func VerifyHistory(
+	ctx context.Context,
+	executionManager persistence.ExecutionManager,
+	historyArchiver HistoryArchiver,
+	uri URI,
+	request *VerifyHistoryRequest,
+) error {
+	lastEvent, err := readLastHistoryEvent(ctx, executionManager, request)
+	if err != nil {
+		return err
+	}
+	expectedChecksum, err := historyEventChecksum(lastEvent)
+	if err != nil {
+		return err
+	}
+
+	closeFailoverVersion := request.CloseFailoverVersion
+	getRequest := &GetHistoryRequest{
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		CloseFailoverVersion: &closeFailoverVersion,
+		PageSize:             verifyHistoryPageSize,
+	}
+	var eventCount int64
+	var archivedLastEvent *historypb.HistoryEvent
+	for {
+		resp, err := historyArchiver.Get(ctx, uri, getRequest)
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return ErrArchivedHistoryNotFound
+			}
+			return err
+		}
+		for _, batch := range resp.HistoryBatches {
+			events := batch.GetEvents()
+			eventCount += int64(len(events))
+			if len(events) > 0 {
+				archivedLastEvent = events[len(events)-1]
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		getRequest.NextPageToken = resp.NextPageToken
+	}
+
+	expectedEventCount := request.NextEventID - common.FirstEventID
+	if eventCount != expectedEventCount {
+		return fmt.Errorf("%w: %d events archived, expected %d", ErrArchivedHistoryMismatch, eventCount, expectedEventCount)
+	}
+	checksum, err := historyEventChecksum(archivedLastEvent)
+	if err != nil {
+		return err
+	}
+	if checksum != expectedChecksum {
+		return fmt.Errorf(
+			"%w: checksum of last event %d is %08x, expected %08x",
+			ErrArchivedHistoryMismatch,
+			archivedLastEvent.GetEventId(),
+			checksum,
+			expectedChecksum,
+		)
+	}
+	return nil
+}
+
+// readLastHistoryEvent reads the last batch of events of the history from primary storage and returns its last event.
+func readLastHistoryEvent(
+	ctx context.Context,
+	executionManager persistence.ExecutionManager,
+	request *VerifyHistoryRequest,
+) (*historypb.HistoryEvent, error) {
+	readRequest := &persistence.ReadHistoryBranchRequest{
+		ShardID:     request.ShardID,
+		BranchToken: request.BranchToken,
+		MinEventID:  max(request.LastFirstEventID, common.FirstEventID),
+		MaxEventID:  request.NextEventID,
+		PageSize:    verifyHistoryPageSize,
+	}
+	var lastEvent *historypb.HistoryEvent
+	for {
+		events, _, token, err := persistence.ReadFullPageEvents(ctx, executionManager, readRequest)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 {
+			lastEvent = events[len(events)-1]
+		}
+		if len(token) == 0 {
+			break
+		}
+		readRequest.NextPageToken = token
+	}
+	if lastEvent == nil || lastEvent.GetEventId() != request.NextEventID-1 {
+		return nil, serviceerror.NewInternal(
+			fmt.Sprintf("unable to read event %d from primary storage", request.NextEventID-1),
+		)
+	}
+	return lastEvent, nil
+}
+
+func historyEventChecksum(event *historypb.HistoryEvent) (uint32, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	return crc32.ChecksumIEEE(data), nil
+}
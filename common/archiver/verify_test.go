@@ -0,0 +1,168 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestVerifyHistory(t *testing.T) {
+	t.Parallel()
+
+	newEvent := func(eventID int64, result string) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventId:   eventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+			Version:   testCloseFailoverVersion,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+				WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{
+					Result: &commonpb.Payloads{Payloads: []*commonpb.Payload{{Data: []byte(result)}}},
+				},
+			},
+		}
+	}
+	archivedHistory := func(numEvents int64, lastEvent *historypb.HistoryEvent) []*historypb.History {
+		var events []*historypb.HistoryEvent
+		for eventID := int64(1); eventID < numEvents; eventID++ {
+			events = append(events, &historypb.HistoryEvent{EventId: eventID})
+		}
+		// Split the history in two pages to make sure that all of them are read.
+		return []*historypb.History{
+			{Events: events[:len(events)/2]},
+			{Events: append(events[len(events)/2:], lastEvent)},
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		archived   []*historypb.History
+		getErr     error
+		expectedFn func(t *testing.T, err error)
+	}{
+		{
+			name:     "complete",
+			archived: archivedHistory(10, newEvent(10, "result")),
+			expectedFn: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "not found",
+			getErr: serviceerror.NewNotFound(ErrHistoryNotExist.Error()),
+			expectedFn: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrArchivedHistoryNotFound)
+			},
+		},
+		{
+			name:     "missing events",
+			archived: archivedHistory(9, newEvent(9, "result")),
+			expectedFn: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrArchivedHistoryMismatch)
+				assert.ErrorContains(t, err, "9 events archived, expected 10")
+			},
+		},
+		{
+			name:     "corrupt last event",
+			archived: archivedHistory(10, newEvent(10, "corrupt")),
+			expectedFn: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrArchivedHistoryMismatch)
+				assert.ErrorContains(t, err, "checksum of last event 10")
+			},
+		},
+		{
+			name:   "read error",
+			getErr: serviceerror.NewUnavailable("backend unavailable"),
+			expectedFn: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "backend unavailable")
+				assert.False(t, errors.Is(err, ErrArchivedHistoryMismatch))
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			executionManager := persistence.NewMockExecutionManager(ctrl)
+			executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), &persistence.ReadHistoryBranchRequest{
+				ShardID:     testShardID,
+				BranchToken: testBranchToken,
+				MinEventID:  8,
+				MaxEventID:  11,
+				PageSize:    verifyHistoryPageSize,
+			}).Return(&persistence.ReadHistoryBranchResponse{
+				HistoryEvents: []*historypb.HistoryEvent{{EventId: 8}, {EventId: 9}, newEvent(10, "result")},
+			}, nil)
+
+			historyArchiver := NewMockHistoryArchiver(ctrl)
+			uri, err := NewURI("test:///archival")
+			require.NoError(t, err)
+			if tc.getErr != nil {
+				historyArchiver.EXPECT().Get(gomock.Any(), uri, gomock.Any()).Return(nil, tc.getErr)
+			} else {
+				first := historyArchiver.EXPECT().Get(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ URI, request *GetHistoryRequest) (*GetHistoryResponse, error) {
+						assert.Equal(t, int64(testCloseFailoverVersion), *request.CloseFailoverVersion)
+						assert.Nil(t, request.NextPageToken)
+						return &GetHistoryResponse{
+							HistoryBatches: tc.archived[:1],
+							NextPageToken:  []byte("next"),
+						}, nil
+					},
+				)
+				historyArchiver.EXPECT().Get(gomock.Any(), uri, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ URI, request *GetHistoryRequest) (*GetHistoryResponse, error) {
+						assert.Equal(t, []byte("next"), request.NextPageToken)
+						return &GetHistoryResponse{HistoryBatches: tc.archived[1:]}, nil
+					},
+				).After(first)
+			}
+
+			err = VerifyHistory(context.Background(), executionManager, historyArchiver, uri, &VerifyHistoryRequest{
+				ShardID:              testShardID,
+				NamespaceID:          testNamespaceID,
+				WorkflowID:           testWorkflowID,
+				RunID:                testRunID,
+				BranchToken:          testBranchToken,
+				LastFirstEventID:     8,
+				NextEventID:          11,
+				CloseFailoverVersion: testCloseFailoverVersion,
+			})
+			tc.expectedFn(t, err)
+		})
+	}
+}
@@ -60,6 +60,8 @@ type (
 		Services map[string]Service `yaml:"services"`
 		// Archival is the config for archival
 		Archival Archival `yaml:"archival"`
+		// PayloadStore is the config for the blob store that oversized payloads are offloaded to
+		PayloadStore PayloadStore `yaml:"payloadStore"`
 		// PublicClient is config for connecting to temporal frontend
 		PublicClient PublicClient `yaml:"publicClient"`
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
@@ -253,6 +255,8 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// BlobCompressionThreshold is the smallest blob size to compress, 0 disables compression
+		BlobCompressionThreshold dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -504,6 +508,18 @@ type (
 		LogLevel         uint    `yaml:"logLevel"`
 	}
 
+	// PayloadStore contains the config for the payload store. Histories only keep references to offloaded payloads,
+	// and those references are replicated as is, so in a multi-cluster setup every cluster must use the same store.
+	// A filestore is only suitable for a single cluster, unless its directory is on a file system shared by all of
+	// them.
+	PayloadStore struct {
+		// URI of the store, e.g. file:///tmp/temporal_payloads or s3://bucket/prefix.
+		// Payloads are never offloaded if it is empty.
+		URI       string             `yaml:"uri"`
+		Filestore *FilestoreArchiver `yaml:"filestore"`
+		S3store   *S3Archiver        `yaml:"s3store"`
+	}
+
 	// PublicClient is the config for internal nodes (history/matching/worker) connecting to
 	// frontend. There are three methods of connecting:
 	// 1. Use membership to locate "internal-frontend" and connect to them using the Internode
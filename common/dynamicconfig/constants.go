@@ -71,6 +71,13 @@ const (
 	EnableNamespaceNotActiveAutoForwarding = "system.enableNamespaceNotActiveAutoForwarding"
 	// TransactionSizeLimit is the largest allowed transaction size to persistence
 	TransactionSizeLimit = "system.transactionSizeLimit"
+	// BlobCompressionThreshold is the minimum size in bytes of a history batch or mutable state blob
+	// to be compressed with zstd before it is written to persistence. 0 disables compression. Blobs
+	// written while compression is enabled use a storage only encoding type which older binaries
+	// reject with an unknown encoding type error, so only enable it once every node of the cluster
+	// runs a version able to read compressed blobs. Disabling it again does not make those blobs
+	// readable by older binaries.
+	BlobCompressionThreshold = "system.persistenceBlobCompressionThreshold"
 	// DisallowQuery is the key to disallow query for a namespace
 	DisallowQuery = "system.disallowQuery"
 	// EnableCrossNamespaceCommands is the key to enable commands for external namespaces
@@ -146,6 +153,9 @@ const (
 	BlobSizeLimitError = "limit.blobSize.error"
 	// BlobSizeLimitWarn is the per event blob size limit for warning
 	BlobSizeLimitWarn = "limit.blobSize.warn"
+	// PayloadOffloadSizeThreshold is the payload size at which activity results and signal inputs are
+	// offloaded to the configured payload store by history, 0 disables offloading
+	PayloadOffloadSizeThreshold = "limit.payloadOffloadSize"
 	// MemoSizeLimitError is the per event memo size limit
 	MemoSizeLimitError = "limit.memoSize.error"
 	// MemoSizeLimitWarn is the per event memo size limit for warning
@@ -405,6 +415,10 @@ const (
 	KeepAliveTimeout = "frontend.keepAliveTimeout"
 	// FrontendEnableSchedules enables schedule-related RPCs in the frontend
 	FrontendEnableSchedules = "frontend.enableSchedules"
+	// FrontendAllowFailoverWithReplicationFilters allows failing over a namespace to a cluster its replication
+	// filters exclude workflow executions from. The failover is refused otherwise, since the excluded executions
+	// do not exist on the new active cluster.
+	FrontendAllowFailoverWithReplicationFilters = "frontend.allowFailoverWithReplicationFilters"
 	// FrontendEnableNexusAPIs enables serving Nexus HTTP requests in the frontend.
 	FrontendEnableNexusAPIs = "frontend.enableNexusAPIs"
 	// FrontendRefreshNexusIncomingServicesLongPollTimeout is the maximum duration of background long poll requests to update Nexus incoming services.
@@ -514,12 +528,54 @@ const (
 	MatchingMaxTaskBatchSize = "matching.maxTaskBatchSize"
 	// MatchingMaxTaskDeleteBatchSize is the max batch size for range deletion of tasks
 	MatchingMaxTaskDeleteBatchSize = "matching.maxTaskDeleteBatchSize"
+	// MatchingMaxBacklogTaskTombstones is the max number of outstanding tasks deleted through the admin API
+	// (tombstones above the ack level) that a physical task queue keeps in its metadata
+	MatchingMaxBacklogTaskTombstones = "matching.maxBacklogTaskTombstones"
 	// MatchingThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	MatchingThrottledLogRPS = "matching.throttledLogRPS"
 	// MatchingNumTaskqueueWritePartitions is the number of write partitions for a task queue
 	MatchingNumTaskqueueWritePartitions = "matching.numTaskqueueWritePartitions"
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
 	MatchingNumTaskqueueReadPartitions = "matching.numTaskqueueReadPartitions"
+	// MatchingMaxTasksPerPoll is the max number of activity tasks returned in a single poll response when the
+	// poller asks for more than one
+	MatchingMaxTasksPerPoll = "matching.maxTasksPerPoll"
+	// MatchingMultiTaskPollWait is how long a poll asking for more than one task waits for additional tasks after
+	// the first one is found
+	MatchingMultiTaskPollWait = "matching.multiTaskPollWait"
+	// MatchingWorkerRegistryTTL is how long a worker stays in the worker registry of a task queue partition after its
+	// last heartbeat or poll
+	MatchingWorkerRegistryTTL = "matching.workerRegistryTTL"
+	// MatchingWorkerRegistryMaxSize is the max number of workers the worker registry of a task queue partition keeps.
+	// The least recently seen worker is dropped when it is exceeded. Changes apply to partitions loaded afterward.
+	MatchingWorkerRegistryMaxSize = "matching.workerRegistryMaxSize"
+	// MatchingMaxBufferedDelayedTasks is the max number of delayed tasks a physical task queue holds in memory while
+	// waiting for their visibility time. Delayed tasks past it only keep their ID in memory and are read again from
+	// persistence when they are due.
+	MatchingMaxBufferedDelayedTasks = "matching.maxBufferedDelayedTasks"
+	// MatchingEnablePartitionAutoScale enables automatic scaling of the number of task queue partitions based on
+	// observed add rate, backlog and poller count. When enabled, partition counts chosen by the scaler take
+	// precedence over MatchingNumTaskqueueWritePartitions and MatchingNumTaskqueueReadPartitions.
+	MatchingEnablePartitionAutoScale = "matching.enablePartitionAutoScale"
+	// MatchingPartitionAutoScaleInterval is how often the root partition re-evaluates the partition count
+	MatchingPartitionAutoScaleInterval = "matching.partitionAutoScaleInterval"
+	// MatchingPartitionAutoScaleSustainDuration is how long a new partition count recommendation must hold before
+	// it is applied
+	MatchingPartitionAutoScaleSustainDuration = "matching.partitionAutoScaleSustainDuration"
+	// MatchingPartitionAutoScalePropagationDelay is how long the scaler waits after raising the read partition count
+	// before it raises the write partition count, so that the new read count reaches pollers and matching clients
+	// before tasks are written to the new partitions
+	MatchingPartitionAutoScalePropagationDelay = "matching.partitionAutoScalePropagationDelay"
+	// MatchingPartitionAutoScaleTargetAddRate is the target tasks/sec added to a single partition
+	MatchingPartitionAutoScaleTargetAddRate = "matching.partitionAutoScaleTargetAddRate"
+	// MatchingPartitionAutoScaleTargetPollers is the target number of pollers on a single partition
+	MatchingPartitionAutoScaleTargetPollers = "matching.partitionAutoScaleTargetPollers"
+	// MatchingPartitionAutoScaleTargetBacklog is the target number of backlogged tasks on a single partition
+	MatchingPartitionAutoScaleTargetBacklog = "matching.partitionAutoScaleTargetBacklog"
+	// MatchingPartitionAutoScaleMinPartitions is the lower bound on the number of partitions picked by the scaler
+	MatchingPartitionAutoScaleMinPartitions = "matching.partitionAutoScaleMinPartitions"
+	// MatchingPartitionAutoScaleMaxPartitions is the upper bound on the number of partitions picked by the scaler
+	MatchingPartitionAutoScaleMaxPartitions = "matching.partitionAutoScaleMaxPartitions"
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls = "matching.forwarderMaxOutstandingPolls"
 	// MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder
@@ -650,6 +706,27 @@ const (
 	// persistence layers that are missing AssertShardOwnership support.
 	// If set to zero, shards will not delay closing.
 	ShardLingerTimeLimit = "history.shardLingerTimeLimit"
+	// ShardPlacementMode is the mode used to place history shards on history hosts: "hash" places shards on the
+	// host membership assigns them to, "load-aware" additionally honors the placements pinned by operators and moves
+	// shards away from overloaded hosts. Enabling HistoryClientOwnershipCachingEnabled is recommended in the
+	// load-aware mode, so that history clients don't keep being redirected for moved shards.
+	ShardPlacementMode = "history.shardPlacementMode"
+	// ShardPlacementHostWeights maps the identity of history hosts to their relative capacity in the load-aware
+	// shard placement mode. Hosts not listed have a weight of 1.
+	ShardPlacementHostWeights = "history.shardPlacementHostWeights"
+	// ShardPlacementLoadWeights are the weights of the components of the load of a shard in the load-aware shard
+	// placement mode: "requestsPerSecond", "taskBacklog" and "mutableStateLoadsPerSecond".
+	ShardPlacementLoadWeights = "history.shardPlacementLoadWeights"
+	// ShardPlacementRefreshInterval is the interval at which history hosts reload the shard placements
+	ShardPlacementRefreshInterval = "history.shardPlacementRefreshInterval"
+	// ShardPlacementRebalanceInterval is the interval at which shards are moved away from overloaded hosts in the
+	// load-aware shard placement mode
+	ShardPlacementRebalanceInterval = "history.shardPlacementRebalanceInterval"
+	// ShardPlacementMaxMovesPerRebalance is the max number of shards moved per rebalance, so that load moves gradually
+	ShardPlacementMaxMovesPerRebalance = "history.shardPlacementMaxMovesPerRebalance"
+	// ShardPlacementImbalanceThreshold is how much the load per weight of a host may exceed the average before
+	// shards are moved away from it, e.g. 0.2 for 20%
+	ShardPlacementImbalanceThreshold = "history.shardPlacementImbalanceThreshold"
 	// ShardOwnershipAssertionEnabled configures if the shard ownership is asserted
 	// for API requests when a NotFound or NamespaceNotFound error is returned from
 	// persistence.
@@ -683,6 +760,18 @@ const (
 	// QueueCriticalSlicesCount is the max number of slices in one queue
 	// before force compacting slices
 	QueueCriticalSlicesCount = "history.queueCriticalSlicesCount"
+	// QueueWorkflowPendingTaskCriticalShare is the fraction of QueuePendingTaskCriticalCount a single workflow
+	// can have pending in one queue before its tasks are moved to the slow lane reader of the queue.
+	// Zero disables the mitigation.
+	QueueWorkflowPendingTaskCriticalShare = "history.queueWorkflowPendingTaskCriticalShare"
+	// QueueNamespacePendingTaskCriticalShare is the fraction of QueuePendingTaskCriticalCount a single namespace
+	// can have pending in one queue before its tasks are moved to the slow lane reader of the queue and throttled.
+	// Zero disables the mitigation.
+	QueueNamespacePendingTaskCriticalShare = "history.queueNamespacePendingTaskCriticalShare"
+	// QueueTaskTypeFailingCriticalCount is the max number of pending tasks of one task type in one queue which have
+	// been attempted several times before tasks of that type are moved to the slow lane reader of the queue and
+	// throttled. Zero disables the mitigation.
+	QueueTaskTypeFailingCriticalCount = "history.queueTaskTypeFailingCriticalCount"
 	// QueuePendingTaskMaxCount is the max number of task pending tasks in one queue before stop
 	// loading new tasks into memory. While QueuePendingTaskCriticalCount won't stop task loading
 	// for the entire queue but only trigger a queue action to unload tasks. Ideally this max count
@@ -712,6 +801,15 @@ const (
 	// TaskSchedulerNamespaceMaxQPS is the max qps task schedulers on a host can schedule tasks for a certain namespace
 	// If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 	TaskSchedulerNamespaceMaxQPS = "history.taskSchedulerNamespaceMaxQPS"
+	// TaskSchedulerEnableEarliestDeadlineFirst indicates if host level task schedulers should dispatch tasks within
+	// each priority class in the order of their deadline (task visibility time plus deadline budget) instead of
+	// in the order of submission. Changing this value requires a restart of history hosts.
+	TaskSchedulerEnableEarliestDeadlineFirst = "history.taskSchedulerEnableEarliestDeadlineFirst"
+	// TaskSchedulerDeadlineBudgets is a map from history task type name (e.g. TASK_TYPE_USER_TIMER) to the deadline
+	// budget of the task type when earliest deadline first scheduling is enabled
+	TaskSchedulerDeadlineBudgets = "history.taskSchedulerDeadlineBudgets"
+	// TaskSchedulerDefaultDeadlineBudget is the deadline budget for task types not in TaskSchedulerDeadlineBudgets
+	TaskSchedulerDefaultDeadlineBudget = "history.taskSchedulerDefaultDeadlineBudget"
 
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize = "history.timerTaskBatchSize"
@@ -737,6 +835,9 @@ const (
 	TimerProcessorPollBackoffInterval = "history.timerProcessorPollBackoffInterval"
 	// TimerProcessorMaxTimeShift is the max shift timer processor can have
 	TimerProcessorMaxTimeShift = "history.timerProcessorMaxTimeShift"
+	// TimerCoalescingWindow is the window within which user timers and activity timeouts of one execution share a
+	// single timer task. Coalesced timers fire up to the window late. 0 disables coalescing.
+	TimerCoalescingWindow = "history.timerCoalescingWindow"
 	// TimerQueueMaxReaderCount is the max number of readers in one multi-cursor timer queue
 	TimerQueueMaxReaderCount = "history.timerQueueMaxReaderCount"
 	// RetentionTimerJitterDuration is a time duration jitter to distribute timer from T0 to T0 + jitter duration
@@ -850,11 +951,20 @@ const (
 	ArchivalBackendMaxRPS = "history.archivalBackendMaxRPS"
 	// ArchivalQueueMaxReaderCount is the max number of readers in one multi-cursor archival queue
 	ArchivalQueueMaxReaderCount = "history.archivalQueueMaxReaderCount"
+	// ArchivalVerifyHistoryBeforeDelete is whether the archived history of a workflow is read back and compared with
+	// the history in primary storage before the history is deleted. Deletion is blocked if they don't match.
+	ArchivalVerifyHistoryBeforeDelete = "history.archivalVerifyHistoryBeforeDelete"
+	// ArchivalMutableStateSnapshot is whether the final mutable state of a workflow, with its search attributes and
+	// memo, is archived with its history. Only archivers which support mutable state snapshots archive it.
+	ArchivalMutableStateSnapshot = "history.archivalMutableStateSnapshot"
 
 	// WorkflowExecutionMaxInFlightUpdates is the max number of updates that can be in-flight (admitted but not yet completed) for any given workflow execution.
 	WorkflowExecutionMaxInFlightUpdates = "history.maxInFlightUpdates"
 	// WorkflowExecutionMaxTotalUpdates is the max number of updates that any given workflow execution can receive.
 	WorkflowExecutionMaxTotalUpdates = "history.maxTotalUpdates"
+	// EnableDurableUpdateAdmission persists the admission of a workflow update with an UpdateAdmitted event before
+	// acknowledging it, so that admitted updates survive shard movement and host restarts.
+	EnableDurableUpdateAdmission = "history.enableDurableUpdateAdmission"
 
 	// ReplicatorTaskBatchSize is batch size for ReplicatorProcessor
 	ReplicatorTaskBatchSize = "history.replicatorTaskBatchSize"
@@ -886,11 +996,52 @@ const (
 	// DefaultActivityRetryPolicy represents the out-of-box retry policy for activities where
 	// the user has not specified an explicit RetryPolicy
 	DefaultActivityRetryPolicy = "history.defaultActivityRetryPolicy"
+	// ActivityRetryDispatchLead is how long before an activity retry is due its task is sent to matching, which holds
+	// it until the retry time. This takes retries off the history timer queue. 0 sends the task when the retry is due.
+	ActivityRetryDispatchLead = "history.activityRetryDispatchLead"
 	// DefaultWorkflowRetryPolicy represents the out-of-box retry policy for unset fields
 	// where the user has set an explicit RetryPolicy, but not specified all the fields
 	DefaultWorkflowRetryPolicy = "history.defaultWorkflowRetryPolicy"
 	// HistoryMaxAutoResetPoints is the key for max number of auto reset points stored in mutableState
 	HistoryMaxAutoResetPoints = "history.historyMaxAutoResetPoints"
+	// AutoResetNonDeterministicFailures is the number of consecutive workflow task failures with a non-deterministic
+	// error on the same build after which a workflow is automatically reset to the last reset point created by another
+	// build. 0 disables the automatic reset.
+	AutoResetNonDeterministicFailures = "history.autoResetNonDeterministicFailures"
+	// AutoResetNonDeterministicFailuresByWorkflowType overrides AutoResetNonDeterministicFailures for the workflow
+	// types used as keys of the map
+	AutoResetNonDeterministicFailuresByWorkflowType = "history.autoResetNonDeterministicFailuresByWorkflowType"
+	// AutoResetMaxResetsPerWindow is the max number of automatic resets of a workflow within AutoResetWindow
+	AutoResetMaxResetsPerWindow = "history.autoResetMaxResetsPerWindow"
+	// AutoResetWindow is the window AutoResetMaxResetsPerWindow applies to
+	AutoResetWindow = "history.autoResetWindow"
+	// RequestIDDedupWindowSize is the max number of signal request IDs and update IDs (each) remembered by a
+	// workflow to deduplicate retried requests across runs. 0 disables the dedup window.
+	RequestIDDedupWindowSize = "history.requestIDDedupWindowSize"
+	// RequestIDDedupWindowTTL is how long a signal request ID or update ID is remembered in the dedup window
+	RequestIDDedupWindowTTL = "history.requestIDDedupWindowTTL"
+	// MaxConcurrentWorkflowExecutions is the max number of running workflow executions of a namespace. Starts beyond
+	// the limit are handled according to ConcurrencyLimitMode. 0 disables the limit.
+	// A start reserves a slot of each concurrency limit before creating its run, from the shard owning the limit, which
+	// persists its reservations. The slot is released when the workflow closes, or handed over to the run continuing it.
+	// Only the workflows started while a limit is set hold a slot of it.
+	MaxConcurrentWorkflowExecutions = "history.maxConcurrentWorkflowExecutions"
+	// MaxConcurrentWorkflowExecutionsByWorkflowType is the max number of running workflow executions of a namespace for
+	// the workflow types used as keys of the map
+	MaxConcurrentWorkflowExecutionsByWorkflowType = "history.maxConcurrentWorkflowExecutionsByWorkflowType"
+	// MaxConcurrentWorkflowExecutionsBySearchAttribute is the max number of running workflow executions of a namespace
+	// sharing the same value of the keyword search attributes used as keys of the map
+	MaxConcurrentWorkflowExecutionsBySearchAttribute = "history.maxConcurrentWorkflowExecutionsBySearchAttribute"
+	// ConcurrencyLimitMode is how a start exceeding a concurrency limit is handled: "reject" fails it with a resource
+	// exhausted error, "queue" holds it until capacity frees up or the request deadline is reached
+	ConcurrencyLimitMode = "history.concurrencyLimitMode"
+	// ConcurrencyLimitQueueCheckInterval is the interval at which a queued start rechecks the concurrency limits
+	ConcurrencyLimitQueueCheckInterval = "history.concurrencyLimitQueueCheckInterval"
+	// ConcurrencyLimitReservationGracePeriod is how long a slot of a concurrency limit is held without being verified.
+	// When a limit is reached, the slots held for longer are verified against the workflows holding them, and the ones
+	// of workflows which are not running, e.g. because their start failed, are released. It must be longer than the
+	// time a start takes to create its run after reserving a slot.
+	ConcurrencyLimitReservationGracePeriod = "history.concurrencyLimitReservationGracePeriod"
 	// EnableParentClosePolicy whether to  ParentClosePolicy
 	EnableParentClosePolicy = "history.enableParentClosePolicy"
 	// ParentClosePolicyThreshold decides that parent close policy will be processed by sys workers(if enabled) if
@@ -1074,4 +1225,25 @@ const (
 	// WorkerDeleteNamespaceActivityLimitsConfig is a map that contains a copy of relevant sdkworker.Options
 	// settings for controlling remote activity concurrency for delete namespace workflows.
 	WorkerDeleteNamespaceActivityLimitsConfig = "worker.deleteNamespaceActivityLimitsConfig"
+	// WorkerEnableDLQAutoRetry controls whether to start the workflow which automatically retries history task DLQ
+	// tasks according to per-namespace retry policies
+	WorkerEnableDLQAutoRetry = "worker.enableDLQAutoRetry"
+	// WorkerDLQAutoRetryPollInterval is the max interval between two scans of the history task DLQs by the auto-retry
+	// workflow
+	WorkerDLQAutoRetryPollInterval = "worker.dlqAutoRetryPollInterval"
+	// WorkerDLQAutoRetryNamespaceEnabled controls whether DLQ tasks of a namespace are automatically retried
+	WorkerDLQAutoRetryNamespaceEnabled = "worker.dlqAutoRetryNamespaceEnabled"
+	// WorkerDLQAutoRetryInitialInterval is the backoff before the first automatic retry of a DLQ task of a namespace
+	WorkerDLQAutoRetryInitialInterval = "worker.dlqAutoRetryInitialInterval"
+	// WorkerDLQAutoRetryBackoffCoefficient is the coefficient applied to the backoff after each automatic retry of a
+	// DLQ task of a namespace
+	WorkerDLQAutoRetryBackoffCoefficient = "worker.dlqAutoRetryBackoffCoefficient"
+	// WorkerDLQAutoRetryMaxInterval is the max backoff between two automatic retries of a DLQ task of a namespace
+	WorkerDLQAutoRetryMaxInterval = "worker.dlqAutoRetryMaxInterval"
+	// WorkerDLQAutoRetryHorizon is how long after its first failure a DLQ task of a namespace is retried before it is
+	// marked as permanently failed and left in the DLQ
+	WorkerDLQAutoRetryHorizon = "worker.dlqAutoRetryHorizon"
+	// WorkerDLQAutoRetryTerminalFailures controls whether DLQ tasks of a namespace which failed with a terminal
+	// (non-retryable) error are automatically retried as well
+	WorkerDLQAutoRetryTerminalFailures = "worker.dlqAutoRetryTerminalFailures"
 )
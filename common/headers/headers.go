@@ -36,6 +36,17 @@ const (
 	SupportedServerVersionsHeaderName = "supported-server-versions"
 	SupportedFeaturesHeaderName       = "supported-features"
 	SupportedFeaturesHeaderDelim      = ","
+	// WorkerHeartbeatHeaderName is an optional header of poll requests that carries a serialized
+	// temporal.server.api.taskqueue.v1.WorkerHeartbeat, which lets workers report their state along with their polls.
+	WorkerHeartbeatHeaderName = "worker-heartbeat-bin"
+	// PollMaxTasksHeaderName is an optional header of PollActivityTaskQueue requests with the number of task slots the
+	// worker has free. Values above 1 opt in to receiving more than one task per poll.
+	PollMaxTasksHeaderName = "poll-max-tasks"
+	// AdditionalActivityTasksHeaderName is a response header of PollActivityTaskQueue that carries a serialized
+	// temporal.server.api.taskqueue.v1.AdditionalActivityTasks when the poll asked for more than one task. Every task in
+	// it is recorded as started, so a worker that opts in must read it and accept response headers as large as the
+	// tasks it asks for.
+	AdditionalActivityTasksHeaderName = "additional-activity-tasks-bin"
 
 	callerNameHeaderName = "caller-name"
 	CallerTypeHeaderName = "caller-type"
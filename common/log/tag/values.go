@@ -41,9 +41,12 @@ var (
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
 	WorkflowActionWorkflowPropertiesModified     = workflowAction("add-workflow-properties-modified-event")
+	WorkflowActionWorkflowSuspended              = workflowAction("add-workflow-suspended-event")
+	WorkflowActionWorkflowResumed                = workflowAction("add-workflow-resumed-event")
 
 	// workflow update
 	WorkflowActionUpdateAccepted  = workflowAction("add-workflow-update-accepted-event")
+	WorkflowActionUpdateRejected  = workflowAction("reject-workflow-update-admission")
 	WorkflowActionUpdateCompleted = workflowAction("add-workflow-update-completed-event")
 	WorkflowActionUpdateAdmitted  = workflowAction("add-workflow-update-admitted-event")
 
@@ -68,6 +71,8 @@ var (
 	WorkflowActionActivityTaskCancelRequested = workflowAction("add-activitytask-cancel-requested-event")
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
+	WorkflowActionActivityTaskPaused          = workflowAction("pause-activitytask")
+	WorkflowActionActivityTaskUnpaused        = workflowAction("unpause-activitytask")
 
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
@@ -115,6 +115,8 @@ const (
 	PersistenceDeleteHistoryBranchScope = "DeleteHistoryBranch"
 	// PersistenceTrimHistoryBranchScope tracks TrimHistoryBranch calls made by service to persistence layer
 	PersistenceTrimHistoryBranchScope = "TrimHistoryBranch"
+	// PersistenceGetHistoryTreeContainingBranchScope tracks GetHistoryTreeContainingBranch calls made by service to persistence layer
+	PersistenceGetHistoryTreeContainingBranchScope = "GetHistoryTreeContainingBranch"
 	// PersistenceGetAllHistoryTreeBranchesScope tracks GetAllHistoryTreeBranches calls made by service to persistence layer
 	PersistenceGetAllHistoryTreeBranchesScope = "GetAllHistoryTreeBranches"
 	// PersistenceNamespaceReplicationQueueScope is the metrics scope for namespace replication queue
@@ -373,6 +375,10 @@ const (
 	HistoryRecordActivityTaskStartedScope = "RecordActivityTaskStarted"
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope = "SignalWithStartWorkflowExecution"
+	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
+	HistorySignalWorkflowExecutionScope = "SignalWorkflowExecution"
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope = "UpdateWorkflowExecution"
 	// HistorySyncShardStatusScope tracks HistorySyncShardStatus API calls received by service
 	HistorySyncShardStatusScope = "SyncShardStatus"
 	// HistoryShardControllerScope is the scope used by shard controller
@@ -546,44 +552,46 @@ const (
 
 // History task type
 const (
-	TaskTypeTransferActiveTaskActivity               = "TransferActiveTaskActivity"
-	TaskTypeTransferActiveTaskWorkflowTask           = "TransferActiveTaskWorkflowTask"
-	TaskTypeTransferActiveTaskCloseExecution         = "TransferActiveTaskCloseExecution"
-	TaskTypeTransferActiveTaskCancelExecution        = "TransferActiveTaskCancelExecution"
-	TaskTypeTransferActiveTaskSignalExecution        = "TransferActiveTaskSignalExecution"
-	TaskTypeTransferActiveTaskStartChildExecution    = "TransferActiveTaskStartChildExecution"
-	TaskTypeTransferActiveTaskResetWorkflow          = "TransferActiveTaskResetWorkflow"
-	TaskTypeTransferActiveTaskDeleteExecution        = "TransferActiveTaskDeleteExecution"
-	TaskTypeTransferStandbyTaskActivity              = "TransferStandbyTaskActivity"
-	TaskTypeTransferStandbyTaskWorkflowTask          = "TransferStandbyTaskWorkflowTask"
-	TaskTypeTransferStandbyTaskCloseExecution        = "TransferStandbyTaskCloseExecution"
-	TaskTypeTransferStandbyTaskCancelExecution       = "TransferStandbyTaskCancelExecution"
-	TaskTypeTransferStandbyTaskSignalExecution       = "TransferStandbyTaskSignalExecution"
-	TaskTypeTransferStandbyTaskStartChildExecution   = "TransferStandbyTaskStartChildExecution"
-	TaskTypeTransferStandbyTaskResetWorkflow         = "TransferStandbyTaskResetWorkflow"
-	TaskTypeTransferStandbyTaskDeleteExecution       = "TransferStandbyTaskDeleteExecution"
-	TaskTypeVisibilityTaskStartExecution             = "VisibilityTaskStartExecution"
-	TaskTypeVisibilityTaskUpsertExecution            = "VisibilityTaskUpsertExecution"
-	TaskTypeVisibilityTaskCloseExecution             = "VisibilityTaskCloseExecution"
-	TaskTypeVisibilityTaskDeleteExecution            = "VisibilityTaskDeleteExecution"
-	TaskTypeArchivalTaskArchiveExecution             = "ArchivalTaskArchiveExecution"
-	TaskTypeTimerActiveTaskActivityTimeout           = "TimerActiveTaskActivityTimeout"
-	TaskTypeTimerActiveTaskWorkflowTaskTimeout       = "TimerActiveTaskWorkflowTaskTimeout"
-	TaskTypeTimerActiveTaskUserTimer                 = "TimerActiveTaskUserTimer"
-	TaskTypeTimerActiveTaskWorkflowRunTimeout        = "TimerActiveTaskWorkflowRunTimeout"
-	TaskTypeTimerActiveTaskWorkflowExecutionTimeout  = "TimerActiveTaskWorkflowExecutionTimeout"
-	TaskTypeTimerActiveTaskActivityRetryTimer        = "TimerActiveTaskActivityRetryTimer"
-	TaskTypeTimerActiveTaskWorkflowBackoffTimer      = "TimerActiveTaskWorkflowBackoffTimer"
-	TaskTypeTimerActiveTaskDeleteHistoryEvent        = "TimerActiveTaskDeleteHistoryEvent"
-	TaskTypeTimerStandbyTaskActivityTimeout          = "TimerStandbyTaskActivityTimeout"
-	TaskTypeTimerStandbyTaskWorkflowTaskTimeout      = "TimerStandbyTaskWorkflowTaskTimeout"
-	TaskTypeTimerStandbyTaskUserTimer                = "TimerStandbyTaskUserTimer"
-	TaskTypeTimerStandbyTaskWorkflowRunTimeout       = "TimerStandbyTaskWorkflowRunTimeout"
-	TaskTypeTimerStandbyTaskWorkflowExecutionTimeout = "TimerStandbyTaskWorkflowExecutionTimeout"
-	TaskTypeTimerStandbyTaskActivityRetryTimer       = "TimerStandbyTaskActivityRetryTimer"
-	TaskTypeTimerStandbyTaskWorkflowBackoffTimer     = "TimerStandbyTaskWorkflowBackoffTimer"
-	TaskTypeTimerStandbyTaskDeleteHistoryEvent       = "TimerStandbyTaskDeleteHistoryEvent"
-	TaskTypeMemoryScheduledTaskWorkflowTaskTimeout   = "MemoryScheduledTaskWorkflowTaskTimeout"
+	TaskTypeTransferActiveTaskActivity                 = "TransferActiveTaskActivity"
+	TaskTypeTransferActiveTaskWorkflowTask             = "TransferActiveTaskWorkflowTask"
+	TaskTypeTransferActiveTaskCloseExecution           = "TransferActiveTaskCloseExecution"
+	TaskTypeTransferActiveTaskCancelExecution          = "TransferActiveTaskCancelExecution"
+	TaskTypeTransferActiveTaskSignalExecution          = "TransferActiveTaskSignalExecution"
+	TaskTypeTransferActiveTaskStartChildExecution      = "TransferActiveTaskStartChildExecution"
+	TaskTypeTransferActiveTaskResetWorkflow            = "TransferActiveTaskResetWorkflow"
+	TaskTypeTransferActiveTaskDeleteExecution          = "TransferActiveTaskDeleteExecution"
+	TaskTypeTransferActiveTaskDeleteOffloadedPayloads  = "TransferActiveTaskDeleteOffloadedPayloads"
+	TaskTypeTransferStandbyTaskActivity                = "TransferStandbyTaskActivity"
+	TaskTypeTransferStandbyTaskWorkflowTask            = "TransferStandbyTaskWorkflowTask"
+	TaskTypeTransferStandbyTaskCloseExecution          = "TransferStandbyTaskCloseExecution"
+	TaskTypeTransferStandbyTaskCancelExecution         = "TransferStandbyTaskCancelExecution"
+	TaskTypeTransferStandbyTaskSignalExecution         = "TransferStandbyTaskSignalExecution"
+	TaskTypeTransferStandbyTaskStartChildExecution     = "TransferStandbyTaskStartChildExecution"
+	TaskTypeTransferStandbyTaskResetWorkflow           = "TransferStandbyTaskResetWorkflow"
+	TaskTypeTransferStandbyTaskDeleteExecution         = "TransferStandbyTaskDeleteExecution"
+	TaskTypeTransferStandbyTaskDeleteOffloadedPayloads = "TransferStandbyTaskDeleteOffloadedPayloads"
+	TaskTypeVisibilityTaskStartExecution               = "VisibilityTaskStartExecution"
+	TaskTypeVisibilityTaskUpsertExecution              = "VisibilityTaskUpsertExecution"
+	TaskTypeVisibilityTaskCloseExecution               = "VisibilityTaskCloseExecution"
+	TaskTypeVisibilityTaskDeleteExecution              = "VisibilityTaskDeleteExecution"
+	TaskTypeArchivalTaskArchiveExecution               = "ArchivalTaskArchiveExecution"
+	TaskTypeTimerActiveTaskActivityTimeout             = "TimerActiveTaskActivityTimeout"
+	TaskTypeTimerActiveTaskWorkflowTaskTimeout         = "TimerActiveTaskWorkflowTaskTimeout"
+	TaskTypeTimerActiveTaskUserTimer                   = "TimerActiveTaskUserTimer"
+	TaskTypeTimerActiveTaskWorkflowRunTimeout          = "TimerActiveTaskWorkflowRunTimeout"
+	TaskTypeTimerActiveTaskWorkflowExecutionTimeout    = "TimerActiveTaskWorkflowExecutionTimeout"
+	TaskTypeTimerActiveTaskActivityRetryTimer          = "TimerActiveTaskActivityRetryTimer"
+	TaskTypeTimerActiveTaskWorkflowBackoffTimer        = "TimerActiveTaskWorkflowBackoffTimer"
+	TaskTypeTimerActiveTaskDeleteHistoryEvent          = "TimerActiveTaskDeleteHistoryEvent"
+	TaskTypeTimerStandbyTaskActivityTimeout            = "TimerStandbyTaskActivityTimeout"
+	TaskTypeTimerStandbyTaskWorkflowTaskTimeout        = "TimerStandbyTaskWorkflowTaskTimeout"
+	TaskTypeTimerStandbyTaskUserTimer                  = "TimerStandbyTaskUserTimer"
+	TaskTypeTimerStandbyTaskWorkflowRunTimeout         = "TimerStandbyTaskWorkflowRunTimeout"
+	TaskTypeTimerStandbyTaskWorkflowExecutionTimeout   = "TimerStandbyTaskWorkflowExecutionTimeout"
+	TaskTypeTimerStandbyTaskActivityRetryTimer         = "TimerStandbyTaskActivityRetryTimer"
+	TaskTypeTimerStandbyTaskWorkflowBackoffTimer       = "TimerStandbyTaskWorkflowBackoffTimer"
+	TaskTypeTimerStandbyTaskDeleteHistoryEvent         = "TimerStandbyTaskDeleteHistoryEvent"
+	TaskTypeMemoryScheduledTaskWorkflowTaskTimeout     = "MemoryScheduledTaskWorkflowTaskTimeout"
 )
 
 // Schedule action types
@@ -711,6 +719,7 @@ var (
 	ArchivalTaskInvalidURI              = NewCounterDef("archival_task_invalid_uri")
 	ArchiverArchiveLatency              = NewTimerDef("archiver_archive_latency")
 	ArchiverArchiveTargetLatency        = NewTimerDef("archiver_archive_target_latency")
+	ArchiverHistoryVerificationFailure  = NewCounterDef("archiver_history_verification_failure")
 	ShardContextClosedCounter           = NewCounterDef("shard_closed_count")
 	ShardContextCreatedCounter          = NewCounterDef("sharditem_created_count")
 	ShardContextRemovedCounter          = NewCounterDef("sharditem_removed_count")
@@ -794,7 +803,11 @@ var (
 		"pending_tasks",
 		WithDescription("A histogram across history shards for the number of in-memory pending history tasks."),
 	)
-	TaskSchedulerThrottled      = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerThrottled = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerLateness  = NewTimerDef(
+		"task_scheduler_lateness",
+		WithDescription("Time between the deadline of a history task and its dispatch to task workers by the earliest deadline first scheduler, zero if dispatched before the deadline."),
+	)
 	QueueScheduleLatency        = NewTimerDef("queue_latency_schedule") // latency for scheduling 100 tasks in one task channel
 	QueueReaderCountHistogram   = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram    = NewDimensionlessHistogramDef("queue_slice_count")
@@ -854,6 +867,11 @@ var (
 	StaleMutableStateCounter                      = NewCounterDef("stale_mutable_state")
 	AutoResetPointsLimitExceededCounter           = NewCounterDef("auto_reset_points_exceed_limit")
 	AutoResetPointCorruptionCounter               = NewCounterDef("auto_reset_point_corruption")
+	AutoResetNonDeterministicErrorCounter         = NewCounterDef("auto_reset_non_deterministic_error")
+	AutoResetLimitExceededCounter                 = NewCounterDef("auto_reset_limit_exceeded")
+	RequestIDDedupHitCounter                      = NewCounterDef("request_id_dedup_hit")
+	ConcurrencyLimitExceededCounter               = NewCounterDef("concurrency_limit_exceeded")
+	TimerTasksCoalescedCounter                    = NewCounterDef("timer_tasks_coalesced")
 	BatchableTaskBatchCount                       = NewGaugeDef("batchable_task_batch_count")
 	ConcurrencyUpdateFailureCounter               = NewCounterDef("concurrency_update_failure")
 	ServiceErrShardOwnershipLostCounter           = NewCounterDef("service_errors_shard_ownership_lost")
@@ -992,6 +1010,9 @@ var (
 	SyncThrottlePerTaskQueueCounter           = NewCounterDef("sync_throttle_count")
 	BufferThrottlePerTaskQueueCounter         = NewCounterDef("buffer_throttle_count")
 	ExpiredTasksPerTaskQueueCounter           = NewCounterDef("tasks_expired")
+	DeletedTasksPerTaskQueueCounter           = NewCounterDef("tasks_deleted")
+	DelayedTasksPerTaskQueueCounter           = NewCounterDef("tasks_delayed")
+	ParkedDelayedTasksPerTaskQueueCounter     = NewCounterDef("tasks_delayed_parked")
 	ForwardedPerTaskQueueCounter              = NewCounterDef("forwarded_per_tl")
 	ForwardTaskErrorsPerTaskQueue             = NewCounterDef("forward_task_errors")
 	LocalToLocalMatchPerTaskQueueCounter      = NewCounterDef("local_to_local_matches")
@@ -1010,6 +1031,7 @@ var (
 	NoRecentPollerTasksPerTaskQueueCounter    = NewCounterDef("no_poller_tasks")
 	UnknownBuildPollsCounter                  = NewCounterDef("unknown_build_polls")
 	UnknownBuildTasksCounter                  = NewCounterDef("unknown_build_tasks")
+	WorkerRegistryEvictionsPerTaskQueue       = NewCounterDef("worker_registry_evictions")
 	TaskDispatchLatencyPerTaskQueue           = NewTimerDef("task_dispatch_latency")
 
 	// Worker
@@ -1157,6 +1179,10 @@ var (
 	VisibilityPersistenceLatency           = NewTimerDef("visibility_persistence_latency")
 	CassandraInitSessionLatency            = NewTimerDef("cassandra_init_session_latency")
 	CassandraSessionRefreshFailures        = NewCounterDef("cassandra_session_refresh_failures")
+	PersistenceBlobCompressionRatio        = NewDimensionlessHistogramDef(
+		"persistence_blob_compression_ratio",
+		WithDescription("Uncompressed to compressed size ratio of compressed persistence blobs, multiplied by 100"),
+	)
 
 	// Common service base metrics
 	RestartCount         = NewCounterDef("restarts")
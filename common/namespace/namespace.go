@@ -266,6 +266,15 @@ func (ns *Namespace) IsOnCluster(clusterName string) bool {
 	return false
 }
 
+// ReplicationFilters returns the filters excluding workflow executions of this
+// namespace from replication.
+func (ns *Namespace) ReplicationFilters() []*persistencespb.ReplicationFilter {
+	if ns.replicationConfig == nil {
+		return nil
+	}
+	return ns.replicationConfig.ReplicationFilters
+}
+
 // ConfigVersion return the namespace config version
 func (ns *Namespace) ConfigVersion() int64 {
 	return ns.configVersion
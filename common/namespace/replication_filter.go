@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+// ReplicationExcludedClusters returns the sorted names of the clusters the replication filters of the namespace
+// exclude a workflow execution from. The active cluster, which generates the replication tasks, is never excluded.
+func (ns *Namespace) ReplicationExcludedClusters(
+	activeCluster string,
+	workflowType string,
+	taskQueue string,
+	searchAttributes map[string]*commonpb.Payload,
+) []string {
+	excluded := make(map[string]struct{})
+	for _, filter := range ns.ReplicationFilters() {
+		if !ReplicationFilterMatches(filter, workflowType, taskQueue, searchAttributes) {
+			continue
+		}
+		for _, clusterName := range ns.ClusterNames() {
+			if clusterName != activeCluster && ReplicationFilterExcludesCluster(filter, clusterName) {
+				excluded[clusterName] = struct{}{}
+			}
+		}
+	}
+	if len(excluded) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(excluded))
+	for clusterName := range excluded {
+		result = append(result, clusterName)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ReplicationFilterExcludesCluster returns whether the executions matching the filter are excluded from the given
+// standby cluster.
+func ReplicationFilterExcludesCluster(
+	filter *persistencespb.ReplicationFilter,
+	clusterName string,
+) bool {
+	return len(filter.TargetClusters) == 0 || slices.Contains(filter.TargetClusters, clusterName)
+}
+
+// ReplicationFilterMatches returns whether a workflow execution matches all the criteria set on the filter.
+func ReplicationFilterMatches(
+	filter *persistencespb.ReplicationFilter,
+	workflowType string,
+	taskQueue string,
+	searchAttributes map[string]*commonpb.Payload,
+) bool {
+	if len(filter.WorkflowTypes) != 0 && !slices.Contains(filter.WorkflowTypes, workflowType) {
+		return false
+	}
+	if len(filter.TaskQueues) != 0 && !slices.Contains(filter.TaskQueues, taskQueue) {
+		return false
+	}
+	if filter.SearchAttributeName != "" {
+		value, ok := searchAttributes[filter.SearchAttributeName]
+		if !ok {
+			return false
+		}
+		return searchAttributeValueMatches(value, filter.SearchAttributeValues)
+	}
+	return true
+}
+
+// ValidateReplicationFilters checks that each filter sets at least one criterion and only targets clusters of
+// the namespace.
+func ValidateReplicationFilters(
+	filters []*persistencespb.ReplicationFilter,
+	clusterNames []string,
+) error {
+	for i, filter := range filters {
+		if len(filter.WorkflowTypes) == 0 && len(filter.TaskQueues) == 0 && filter.SearchAttributeName == "" {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("replication filter %d matches every workflow execution", i))
+		}
+		if filter.SearchAttributeName == "" && len(filter.SearchAttributeValues) != 0 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("replication filter %d sets search attribute values without a search attribute name", i))
+		}
+		if filter.SearchAttributeName != "" && len(filter.SearchAttributeValues) == 0 {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("replication filter %d sets a search attribute name without values", i))
+		}
+		for _, clusterName := range filter.TargetClusters {
+			if !slices.Contains(clusterNames, clusterName) {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf("replication filter %d targets cluster %v which the namespace is not replicated to", i, clusterName))
+			}
+		}
+	}
+	return nil
+}
+
+// searchAttributeValueMatches returns whether a keyword, text or keyword list search attribute value is one of
+// the given values.
+func searchAttributeValueMatches(
+	value *commonpb.Payload,
+	values []string,
+) bool {
+	var decoded interface{}
+	if err := payload.Decode(value, &decoded); err != nil {
+		return false
+	}
+	switch decoded := decoded.(type) {
+	case string:
+		return slices.Contains(values, decoded)
+	case []interface{}:
+		for _, item := range decoded {
+			if s, ok := item.(string); ok && slices.Contains(values, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+)
+
+func filteredNamespace(filters ...*persistencespb.ReplicationFilter) *namespace.Namespace {
+	return namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: namespace.NewID().String(), Name: "filtered"},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName:  "active",
+			Clusters:           []string{"active", "standby-1", "standby-2"},
+			ReplicationFilters: filters,
+		},
+		1,
+	)
+}
+
+func TestReplicationExcludedClusters(t *testing.T) {
+	keywordList, err := payload.Encode([]string{"pii", "eu"})
+	require.NoError(t, err)
+	searchAttributes := map[string]*commonpb.Payload{
+		"Keyword01":     payload.EncodeString("gold"),
+		"KeywordList01": keywordList,
+	}
+
+	for _, tt := range [...]struct {
+		name     string
+		filters  []*persistencespb.ReplicationFilter
+		expected []string
+	}{
+		{
+			name:     "no filters",
+			expected: nil,
+		},
+		{
+			name: "workflow type to all standby clusters",
+			filters: []*persistencespb.ReplicationFilter{
+				{WorkflowTypes: []string{"other", "wf-type"}},
+			},
+			expected: []string{"standby-1", "standby-2"},
+		},
+		{
+			name: "task queue to one cluster",
+			filters: []*persistencespb.ReplicationFilter{
+				{TargetClusters: []string{"standby-2"}, TaskQueues: []string{"tq"}},
+			},
+			expected: []string{"standby-2"},
+		},
+		{
+			name: "all criteria must match",
+			filters: []*persistencespb.ReplicationFilter{
+				{WorkflowTypes: []string{"wf-type"}, TaskQueues: []string{"other"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "any filter may match",
+			filters: []*persistencespb.ReplicationFilter{
+				{TargetClusters: []string{"standby-1"}, TaskQueues: []string{"other"}},
+				{TargetClusters: []string{"standby-2"}, SearchAttributeName: "Keyword01", SearchAttributeValues: []string{"gold"}},
+			},
+			expected: []string{"standby-2"},
+		},
+		{
+			name: "keyword list value",
+			filters: []*persistencespb.ReplicationFilter{
+				{TargetClusters: []string{"standby-1"}, SearchAttributeName: "KeywordList01", SearchAttributeValues: []string{"eu"}},
+			},
+			expected: []string{"standby-1"},
+		},
+		{
+			name: "missing search attribute",
+			filters: []*persistencespb.ReplicationFilter{
+				{SearchAttributeName: "Keyword02", SearchAttributeValues: []string{"gold"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "active cluster is never excluded",
+			filters: []*persistencespb.ReplicationFilter{
+				{TargetClusters: []string{"active"}, WorkflowTypes: []string{"wf-type"}},
+			},
+			expected: nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := filteredNamespace(tt.filters...)
+			require.Equal(t, tt.expected, ns.ReplicationExcludedClusters("active", "wf-type", "tq", searchAttributes))
+		})
+	}
+}
+
+func TestValidateReplicationFilters(t *testing.T) {
+	clusters := []string{"active", "standby"}
+
+	require.NoError(t, namespace.ValidateReplicationFilters(nil, clusters))
+	require.NoError(t, namespace.ValidateReplicationFilters([]*persistencespb.ReplicationFilter{
+		{TargetClusters: []string{"standby"}, WorkflowTypes: []string{"wf-type"}},
+	}, clusters))
+
+	require.Error(t, namespace.ValidateReplicationFilters([]*persistencespb.ReplicationFilter{
+		{TargetClusters: []string{"standby"}},
+	}, clusters))
+	require.Error(t, namespace.ValidateReplicationFilters([]*persistencespb.ReplicationFilter{
+		{SearchAttributeValues: []string{"gold"}},
+	}, clusters))
+	require.Error(t, namespace.ValidateReplicationFilters([]*persistencespb.ReplicationFilter{
+		{SearchAttributeName: "Keyword01"},
+	}, clusters))
+	require.Error(t, namespace.ValidateReplicationFilters([]*persistencespb.ReplicationFilter{
+		{TargetClusters: []string{"unknown"}, TaskQueues: []string{"tq"}},
+	}, clusters))
+}
@@ -178,8 +178,9 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				CustomSearchAttributeAliases: task.Config.GetCustomSearchAttributeAliases(),
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
-				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
-				Clusters:          ConvertClusterReplicationConfigFromProto(task.ReplicationConfig.Clusters),
+				ActiveClusterName:  task.ReplicationConfig.GetActiveClusterName(),
+				Clusters:           ConvertClusterReplicationConfigFromProto(task.ReplicationConfig.Clusters),
+				ReplicationFilters: task.GetReplicationFilters(),
 			},
 			ConfigVersion:   task.GetConfigVersion(),
 			FailoverVersion: task.GetFailoverVersion(),
@@ -312,6 +313,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
 		}
 		request.Namespace.ReplicationConfig.Clusters = ConvertClusterReplicationConfigFromProto(task.ReplicationConfig.Clusters)
+		request.Namespace.ReplicationConfig.ReplicationFilters = task.GetReplicationFilters()
 		request.Namespace.ConfigVersion = task.GetConfigVersion()
 		request.Namespace.OutgoingServices = task.GetNexusOutgoingServices()
 	}
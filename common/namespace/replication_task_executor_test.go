@@ -512,6 +512,9 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 			ClusterName: updateClusterStandby,
 		},
 	}
+	updateReplicationFilters := []*persistencespb.ReplicationFilter{
+		{TargetClusters: []string{updateClusterStandby}, TaskQueues: []string{"some random task queue"}},
+	}
 	updateTask := &replicationspb.NamespaceTaskAttributes{
 		NamespaceOperation: updateOperation,
 		Id:                 id,
@@ -533,8 +536,9 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 			ActiveClusterName: updateClusterActive,
 			Clusters:          updateClusters,
 		},
-		ConfigVersion:   updateConfigVersion,
-		FailoverVersion: updateFailoverVersion,
+		ReplicationFilters: updateReplicationFilters,
+		ConfigVersion:      updateConfigVersion,
+		FailoverVersion:    updateFailoverVersion,
 	}
 
 	s.namespaceReplicator.currentCluster = updateClusterStandby
@@ -568,7 +572,8 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 				VisibilityArchivalUri:   updateTask.Config.VisibilityArchivalUri,
 			},
 			ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
-				Clusters: []string{updateClusterActive, updateClusterStandby},
+				Clusters:           []string{updateClusterActive, updateClusterStandby},
+				ReplicationFilters: updateReplicationFilters,
 			},
 			ConfigVersion:               updateConfigVersion,
 			FailoverNotificationVersion: 0,
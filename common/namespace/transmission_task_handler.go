@@ -127,6 +127,7 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(
 				Clusters:          convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
 			NexusOutgoingServices: nexusOutgoingServices,
+			ReplicationFilters:    replicationConfig.GetReplicationFilters(),
 			ConfigVersion:         configVersion,
 			FailoverVersion:       failoverVersion,
 			FailoverHistory:       convertFailoverHistoryToReplicationProto(failoverHistoy),
@@ -244,6 +244,9 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 	configVersion := int64(0)
 	failoverVersion := int64(59)
 	clusters := []string{clusterActive, clusterStandby}
+	replicationFilters := []*persistencespb.ReplicationFilter{
+		{TargetClusters: []string{clusterStandby}, WorkflowTypes: []string{"some random workflow type"}},
+	}
 
 	namespaceOperation := enumsspb.NAMESPACE_OPERATION_UPDATE
 	info := &persistencespb.NamespaceInfo{
@@ -263,8 +266,9 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 		BadBinaries:             &namespacepb.BadBinaries{Binaries: map[string]*namespacepb.BadBinaryInfo{}},
 	}
 	replicationConfig := &persistencespb.NamespaceReplicationConfig{
-		ActiveClusterName: clusterActive,
-		Clusters:          clusters,
+		ActiveClusterName:  clusterActive,
+		Clusters:           clusters,
+		ReplicationFilters: replicationFilters,
 	}
 	isGlobalNamespace := true
 
@@ -296,6 +300,7 @@ func (s *transmissionTaskSuite) TestHandleTransmissionTask_UpdateNamespaceTask_I
 				ConfigVersion:         configVersion,
 				FailoverVersion:       failoverVersion,
 				NexusOutgoingServices: make([]*persistencespb.NexusOutgoingService, 0),
+				ReplicationFilters:    replicationFilters,
 			},
 		},
 	}).Return(nil)
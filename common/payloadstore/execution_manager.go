@@ -0,0 +1,72 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// rehydratingExecutionManager resolves the payload references of the history it reads.
+	rehydratingExecutionManager struct {
+		persistence.ExecutionManager
+		store Store
+	}
+)
+
+// NewRehydratingExecutionManager returns an ExecutionManager which resolves the payload references of the history
+// read through ReadHistoryBranchByBatch, so that histories copied out of the cluster, e.g. by archival, do not
+// depend on payloads which are deleted along with the workflow run. executionManager is returned as is if store is
+// nil.
+func NewRehydratingExecutionManager(
+	executionManager persistence.ExecutionManager,
+	store Store,
+) persistence.ExecutionManager {
+	if store == nil {
+		return executionManager
+	}
+	return &rehydratingExecutionManager{
+		ExecutionManager: executionManager,
+		store:            store,
+	}
+}
+
+func (m *rehydratingExecutionManager) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *persistence.ReadHistoryBranchRequest,
+) (*persistence.ReadHistoryBranchByBatchResponse, error) {
+	resp, err := m.ExecutionManager.ReadHistoryBranchByBatch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, batch := range resp.History {
+		if err := rehydrate(ctx, m.store, batch); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
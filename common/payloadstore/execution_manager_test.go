@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestRehydratingExecutionManager_ReadHistoryBranchByBatch(t *testing.T) {
+	ctx := context.Background()
+	controller := gomock.NewController(t)
+	store := newTestFilestore(t)
+	offloader := NewOffloader(store, dynamicconfig.GetIntPropertyFilteredByNamespace(100))
+
+	original := payloads.EncodeString(strings.Repeat("large", 100))
+	input := payloads.EncodeString(strings.Repeat("large", 100))
+	_, err := offloader.Offload(ctx, namespace.ID("ns-id"), namespace.Name("ns"), input)
+	require.NoError(t, err)
+	require.True(t, IsReference(input.Payloads[0]))
+
+	newBatch := func(input *historypb.WorkflowExecutionSignaledEventAttributes) *historypb.History {
+		return &historypb.History{Events: []*historypb.HistoryEvent{{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+				WorkflowExecutionSignaledEventAttributes: input,
+			},
+		}}}
+	}
+	request := &persistence.ReadHistoryBranchRequest{ShardID: 1, MinEventID: 1, MaxEventID: 10, PageSize: 10}
+	mockExecutionManager := persistence.NewMockExecutionManager(controller)
+	mockExecutionManager.EXPECT().ReadHistoryBranchByBatch(gomock.Any(), request).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*historypb.History{newBatch(&historypb.WorkflowExecutionSignaledEventAttributes{Input: input})},
+	}, nil)
+
+	require.Same(t, mockExecutionManager, NewRehydratingExecutionManager(mockExecutionManager, nil))
+	resp, err := NewRehydratingExecutionManager(mockExecutionManager, store).ReadHistoryBranchByBatch(ctx, request)
+	require.NoError(t, err)
+	protorequire.ProtoSliceEqual(
+		t,
+		[]*historypb.History{newBatch(&historypb.WorkflowExecutionSignaledEventAttributes{Input: original})},
+		resp.History,
+	)
+}
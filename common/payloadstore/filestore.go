@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// FilestoreURIScheme is the scheme for the filesystem payload store
+	FilestoreURIScheme = "file"
+)
+
+var (
+	errInvalidFileMode = errors.New("invalid file mode")
+	errInvalidDirMode  = errors.New("invalid directory mode")
+	errInvalidKey      = errors.New("invalid payload key")
+)
+
+type (
+	filestore struct {
+		dir      string
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+// NewFilestore creates a Store which keeps each payload in a file under dir. Other clusters cannot resolve references
+// to these payloads unless dir is on a file system they share.
+func NewFilestore(dir string, cfg *config.FilestoreArchiver) (Store, error) {
+	fileMode, err := strconv.ParseUint(cfg.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(cfg.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	return &filestore{
+		dir:      dir,
+		fileMode: os.FileMode(fileMode),
+		dirMode:  os.FileMode(dirMode),
+	}, nil
+}
+
+func (f *filestore) Put(_ context.Context, key string, payload *commonpb.Payload) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), f.dirMode); err != nil {
+		return err
+	}
+	// write to a temporary file first so that readers never observe a partial payload
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, f.fileMode); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (f *filestore) Get(_ context.Context, key string) (*commonpb.Payload, error) {
+	path, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrPayloadNotFound
+		}
+		return nil, err
+	}
+	payload := &commonpb.Payload{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func (f *filestore) Delete(_ context.Context, key string) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (f *filestore) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(key) {
+		return "", errInvalidKey
+	}
+	return filepath.Join(f.dir, key), nil
+}
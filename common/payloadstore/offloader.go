@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/proto"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+)
+
+const (
+	// ReferenceEncoding is the encoding metadata value of payloads which were replaced by a
+	// reference to the offloaded original.
+	ReferenceEncoding = "temporal/claim-check"
+
+	metadataEncoding = "encoding"
+	metadataKey      = "claimCheckKey"
+	metadataSize     = "claimCheckSize"
+)
+
+// referenceKeyPlaceholder has the length of the keys generated by Offload for a namespace ID, to estimate the size
+// of a reference.
+var referenceKeyPlaceholder = strings.Repeat("0", len(uuid.Nil.String())+1+len(uuid.Nil.String()))
+
+type (
+	// Offloader replaces oversized payloads by references to a copy kept in a Store, and resolves
+	// those references back when history is returned to clients.
+	Offloader struct {
+		store     Store
+		threshold dynamicconfig.IntPropertyFnWithNamespaceFilter
+	}
+)
+
+// NewOffloader creates an Offloader. Offloading is disabled if store is nil. A nil Offloader is
+// valid too and never offloads.
+func NewOffloader(
+	store Store,
+	threshold dynamicconfig.IntPropertyFnWithNamespaceFilter,
+) *Offloader {
+	return &Offloader{
+		store:     store,
+		threshold: threshold,
+	}
+}
+
+// Offload moves every payload of payloads that is at least as large as the namespace threshold to
+// the store, and replaces it in place by a reference. It returns the keys of the payloads it moved.
+func (o *Offloader) Offload(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	namespaceName namespace.Name,
+	payloads *commonpb.Payloads,
+) ([]string, error) {
+	threshold := o.offloadThreshold(namespaceName)
+	if threshold <= 0 || payloads == nil {
+		return nil, nil
+	}
+	var keys []string
+	for i, payload := range payloads.Payloads {
+		size := payload.Size()
+		if size < threshold || IsReference(payload) {
+			continue
+		}
+		key := namespaceID.String() + "/" + uuid.NewString()
+		if err := o.store.Put(ctx, key, payload); err != nil {
+			return keys, serviceerror.NewUnavailable(fmt.Sprintf("unable to offload payload: %v", err))
+		}
+		payloads.Payloads[i] = newReference(key, size)
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Size returns the size of payloads once Offload replaced its oversized payloads by references.
+func (o *Offloader) Size(namespaceName namespace.Name, payloads *commonpb.Payloads) int {
+	size := payloads.Size()
+	threshold := o.offloadThreshold(namespaceName)
+	if threshold <= 0 {
+		return size
+	}
+	for _, payload := range payloads.GetPayloads() {
+		if payloadSize := payload.Size(); payloadSize >= threshold && !IsReference(payload) {
+			size += newReference(referenceKeyPlaceholder, payloadSize).Size() - payloadSize
+		}
+	}
+	return size
+}
+
+// Delete deletes the offloaded payloads stored under keys.
+func (o *Offloader) Delete(ctx context.Context, keys []string) error {
+	if o == nil || o.store == nil {
+		return nil
+	}
+	for _, key := range keys {
+		if err := o.store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Offloader) offloadThreshold(namespaceName namespace.Name) int {
+	if o == nil || o.store == nil {
+		return 0
+	}
+	return o.threshold(namespaceName.String())
+}
+
+// Rehydrate replaces in place every payload reference within msg by the offloaded payload.
+func (o *Offloader) Rehydrate(ctx context.Context, msg proto.Message) error {
+	if o == nil || o.store == nil {
+		return nil
+	}
+	return rehydrate(ctx, o.store, msg)
+}
+
+func rehydrate(ctx context.Context, store Store, msg proto.Message) error {
+	if msg == nil {
+		return nil
+	}
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		SkipSearchAttributes: true,
+		Visitor: func(vpc *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			for _, payload := range payloads {
+				if !IsReference(payload) {
+					continue
+				}
+				original, err := store.Get(vpc, string(payload.Metadata[metadataKey]))
+				if err != nil {
+					return nil, serviceerror.NewUnavailable(fmt.Sprintf("unable to load offloaded payload: %v", err))
+				}
+				// mutate in place, the visitor does not propagate replaced single payload fields
+				proto.Reset(payload)
+				proto.Merge(payload, original)
+			}
+			return payloads, nil
+		},
+	})
+}
+
+// IsReference returns true if payload is a reference to an offloaded payload.
+func IsReference(payload *commonpb.Payload) bool {
+	return payload != nil && string(payload.GetMetadata()[metadataEncoding]) == ReferenceEncoding
+}
+
+func newReference(key string, size int) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			metadataEncoding: []byte(ReferenceEncoding),
+			metadataKey:      []byte(key),
+			metadataSize:     []byte(strconv.Itoa(size)),
+		},
+	}
+}
@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func newTestFilestore(t *testing.T) Store {
+	store, err := NewFilestore(t.TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	require.NoError(t, err)
+	return store
+}
+
+func TestOffloader_OffloadAndRehydrate(t *testing.T) {
+	ctx := context.Background()
+	offloader := NewOffloader(newTestFilestore(t), dynamicconfig.GetIntPropertyFilteredByNamespace(100))
+
+	small := "small"
+	large := strings.Repeat("large", 100)
+	input := payloads.EncodeString(small)
+	input.Payloads = append(input.Payloads, payloads.EncodeString(large).Payloads...)
+	original := payloads.EncodeString(small)
+	original.Payloads = append(original.Payloads, payloads.EncodeString(large).Payloads...)
+
+	keys, err := offloader.Offload(ctx, namespace.ID("ns-id"), namespace.Name("ns"), input)
+	require.NoError(t, err)
+	require.False(t, IsReference(input.Payloads[0]))
+	require.True(t, IsReference(input.Payloads[1]))
+	require.Less(t, input.Payloads[1].Size(), original.Payloads[1].Size())
+	require.Equal(t, []string{string(input.Payloads[1].Metadata[metadataKey])}, keys)
+	require.True(t, strings.HasPrefix(keys[0], "ns-id/"))
+
+	// offloading again is a noop
+	keys, err = offloader.Offload(ctx, namespace.ID("ns-id"), namespace.Name("ns"), input)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	history := &historypb.History{
+		Events: []*historypb.HistoryEvent{
+			{
+				EventId:   5,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+					WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+						SignalName: "signal",
+						Input:      input,
+					},
+				},
+			},
+		},
+	}
+	require.NoError(t, offloader.Rehydrate(ctx, history))
+	protorequire.ProtoEqual(t, original, history.Events[0].GetWorkflowExecutionSignaledEventAttributes().GetInput())
+}
+
+func TestOffloader_Size(t *testing.T) {
+	ctx := context.Background()
+	offloader := NewOffloader(newTestFilestore(t), dynamicconfig.GetIntPropertyFilteredByNamespace(100))
+	namespaceID := namespace.ID(uuid.NewString())
+
+	input := payloads.EncodeString(strings.Repeat("large", 100))
+	input.Payloads = append(input.Payloads, payloads.EncodeString("small").Payloads...)
+	size := offloader.Size(namespace.Name("ns"), input)
+	require.Less(t, size, input.Size())
+
+	_, err := offloader.Offload(ctx, namespaceID, namespace.Name("ns"), input)
+	require.NoError(t, err)
+	require.Equal(t, input.Size(), size)
+	require.Equal(t, size, offloader.Size(namespace.Name("ns"), input))
+}
+
+func TestOffloader_Delete(t *testing.T) {
+	ctx := context.Background()
+	store := newTestFilestore(t)
+	offloader := NewOffloader(store, dynamicconfig.GetIntPropertyFilteredByNamespace(100))
+
+	input := payloads.EncodeString(strings.Repeat("large", 100))
+	keys, err := offloader.Offload(ctx, namespace.ID("ns-id"), namespace.Name("ns"), input)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	require.NoError(t, offloader.Delete(ctx, keys))
+	_, err = store.Get(ctx, keys[0])
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+	// deleting a payload twice is fine
+	require.NoError(t, offloader.Delete(ctx, keys))
+}
+
+func TestOffloader_Disabled(t *testing.T) {
+	ctx := context.Background()
+	large := payloads.EncodeString(strings.Repeat("large", 100))
+
+	offloader := NewOffloader(nil, dynamicconfig.GetIntPropertyFilteredByNamespace(1))
+	keys, err := offloader.Offload(ctx, namespace.ID("ns-id"), namespace.Name("ns"), large)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	require.False(t, IsReference(large.Payloads[0]))
+	require.Equal(t, large.Size(), offloader.Size(namespace.Name("ns"), large))
+
+	offloader = NewOffloader(newTestFilestore(t), dynamicconfig.GetIntPropertyFilteredByNamespace(0))
+	keys, err = offloader.Offload(ctx, namespace.ID("ns-id"), namespace.Name("ns"), large)
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	require.False(t, IsReference(large.Payloads[0]))
+}
+
+func TestOffloader_RehydrateMissingPayload(t *testing.T) {
+	offloader := NewOffloader(newTestFilestore(t), dynamicconfig.GetIntPropertyFilteredByNamespace(1))
+	input := &commonpb.Payloads{Payloads: []*commonpb.Payload{newReference("ns-id/missing", 10)}}
+	require.Error(t, offloader.Rehydrate(context.Background(), input))
+}
+
+func TestFilestore_InvalidKey(t *testing.T) {
+	store := newTestFilestore(t)
+	_, err := store.Get(context.Background(), "../escape")
+	require.ErrorIs(t, err, errInvalidKey)
+	_, err = store.Get(context.Background(), "ns-id/missing")
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore(&config.PayloadStore{})
+	require.NoError(t, err)
+	require.Nil(t, store)
+
+	_, err = NewStore(&config.PayloadStore{URI: "unknown://bucket"})
+	require.ErrorIs(t, err, errUnknownScheme)
+
+	store, err = NewStore(&config.PayloadStore{
+		URI:       "file://" + t.TempDir(),
+		Filestore: &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, store)
+}
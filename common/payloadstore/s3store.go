@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// S3URIScheme is the scheme for the s3 payload store
+	S3URIScheme = "s3"
+)
+
+var (
+	errNoBucketSpecified = errors.New("no bucket specified")
+	errEmptyAwsRegion    = errors.New("empty aws region")
+)
+
+type (
+	s3Store struct {
+		s3cli  s3iface.S3API
+		bucket string
+		prefix string
+	}
+)
+
+// NewS3Store creates a Store which keeps each payload as an object under prefix in bucket.
+func NewS3Store(bucket string, prefix string, cfg *config.S3Archiver) (Store, error) {
+	if len(cfg.Region) == 0 {
+		return nil, errEmptyAwsRegion
+	}
+	s3Config := &aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+		LogLevel:         (*aws.LogLevelType)(&cfg.LogLevel),
+	}
+	sess, err := session.NewSession(s3Config)
+	if err != nil {
+		return nil, err
+	}
+	return newS3Store(s3.New(sess), bucket, prefix)
+}
+
+func newS3Store(s3cli s3iface.S3API, bucket string, prefix string) (*s3Store, error) {
+	if bucket == "" {
+		return nil, errNoBucketSpecified
+	}
+	return &s3Store{
+		s3cli:  s3cli,
+		bucket: bucket,
+		prefix: strings.Trim(prefix, "/"),
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, payload *commonpb.Payload) error {
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = s.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (*commonpb.Payload, error) {
+	result, err := s.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrPayloadNotFound
+		}
+		return nil, err
+	}
+	defer func() { _ = result.Body.Close() }()
+	data, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	payload := &commonpb.Payload{}
+	if err := proto.Unmarshal(data, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	// S3 reports success for keys that do not exist
+	_, err := s.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.objectKey(key)),
+	})
+	return err
+}
+
+func (s *s3Store) objectKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return s.prefix + "/" + key
+}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package payloadstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/archiver/s3store/mocks"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestS3Store_PutGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	s3cli := mocks.NewMockS3API(ctrl)
+	store, err := newS3Store(s3cli, "bucket", "/payloads/")
+	require.NoError(t, err)
+
+	objects := make(map[string][]byte)
+	s3cli.EXPECT().PutObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) (*s3.PutObjectOutput, error) {
+			require.Equal(t, "bucket", *input.Bucket)
+			data, err := io.ReadAll(input.Body)
+			require.NoError(t, err)
+			objects[*input.Key] = data
+			return &s3.PutObjectOutput{}, nil
+		})
+	s3cli.EXPECT().GetObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+			data, ok := objects[*input.Key]
+			if !ok {
+				return nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil)
+			}
+			return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+		}).Times(2)
+
+	payload := payloads.EncodeString("payload").Payloads[0]
+	require.NoError(t, store.Put(context.Background(), "ns-id/key", payload))
+	require.Contains(t, objects, "payloads/ns-id/key")
+
+	result, err := store.Get(context.Background(), "ns-id/key")
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, payload, result)
+
+	_, err = store.Get(context.Background(), "ns-id/missing")
+	require.ErrorIs(t, err, ErrPayloadNotFound)
+
+	s3cli.EXPECT().DeleteObjectWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ aws.Context, input *s3.DeleteObjectInput, _ ...request.Option) (*s3.DeleteObjectOutput, error) {
+			delete(objects, *input.Key)
+			return &s3.DeleteObjectOutput{}, nil
+		})
+	require.NoError(t, store.Delete(context.Background(), "ns-id/key"))
+	require.NotContains(t, objects, "payloads/ns-id/key")
+}
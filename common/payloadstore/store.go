@@ -0,0 +1,80 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package payloadstore offloads oversized payloads to an external blob store and keeps only a
+// reference (claim check) to them in workflow history.
+package payloadstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+)
+
+type (
+	// Store persists offloaded payloads under an opaque key.
+	Store interface {
+		Put(ctx context.Context, key string, payload *commonpb.Payload) error
+		Get(ctx context.Context, key string) (*commonpb.Payload, error)
+		// Delete removes the payload stored under key. Deleting a key that does not exist is not an error.
+		Delete(ctx context.Context, key string) error
+	}
+)
+
+var (
+	errUnknownScheme = errors.New("unknown payload store scheme")
+	// ErrPayloadNotFound is returned by Store.Get when no payload exists for the key.
+	ErrPayloadNotFound = errors.New("offloaded payload not found")
+)
+
+// NewStore creates the Store configured by cfg. It returns nil if no store is configured, in which
+// case payloads are never offloaded.
+func NewStore(cfg *config.PayloadStore) (Store, error) {
+	if cfg == nil || cfg.URI == "" {
+		return nil, nil
+	}
+	uri, err := archiver.NewURI(cfg.URI)
+	if err != nil {
+		return nil, err
+	}
+	switch uri.Scheme() {
+	case FilestoreURIScheme:
+		if cfg.Filestore == nil {
+			return nil, fmt.Errorf("filestore config is required for payload store %v", cfg.URI)
+		}
+		return NewFilestore(uri.Path(), cfg.Filestore)
+	case S3URIScheme:
+		if cfg.S3store == nil {
+			return nil, fmt.Errorf("s3store config is required for payload store %v", cfg.URI)
+		}
+		return NewS3Store(uri.Hostname(), uri.Path(), cfg.S3store)
+	default:
+		return nil, fmt.Errorf("%w: %v", errUnknownScheme, uri.Scheme())
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/server/common/convert"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -1113,8 +1114,8 @@ func createHistoryEventBatchBlob(
 		switch k {
 		case "encoding_type":
 			encodingStr := v.(string)
-			if encoding, err := enumspb.EncodingTypeFromString(encodingStr); err == nil {
-				eventBatch.EncodingType = enumspb.EncodingType(encoding)
+			if encoding, err := serialization.ParseEncodingType(encodingStr); err == nil {
+				eventBatch.EncodingType = encoding
 			}
 		case "data":
 			eventBatch.Data = v.([]byte)
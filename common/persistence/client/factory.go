@@ -193,7 +193,15 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 		return nil, err
 	}
 
-	result := p.NewExecutionManager(store, f.serializer, f.eventBlobCache, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewExecutionManager(
+		store,
+		f.serializer,
+		f.eventBlobCache,
+		f.logger,
+		f.metricsHandler,
+		f.config.TransactionSizeLimit,
+		f.config.BlobCompressionThreshold,
+	)
 	if f.systemRateLimiter != nil && f.namespaceRateLimiter != nil {
 		result = p.NewExecutionPersistenceRateLimitedClient(result, f.systemRateLimiter, f.namespaceRateLimiter, f.logger)
 	}
@@ -24,6 +24,18 @@
 
 package persistence
 
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+const (
+	updateShardPlacementsMaxAttempts = 5
+)
+
 // GetOrUseDefaultActiveCluster return the current cluster name or use the input if valid
 func GetOrUseDefaultActiveCluster(currentClusterName string, activeClusterName string) string {
 	if len(activeClusterName) == 0 {
@@ -39,3 +51,52 @@ func GetOrUseDefaultClusters(currentClusterName string, clusters []string) []str
 	}
 	return clusters
 }
+
+// GetShardPlacements returns the history shard placements stored in the current cluster metadata.
+func GetShardPlacements(
+	ctx context.Context,
+	clusterMetadataManager ClusterMetadataManager,
+) (map[int32]*persistencespb.ShardPlacement, error) {
+	resp, err := clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetShardPlacements(), nil
+}
+
+// UpdateShardPlacements applies updateFn to the history shard placements stored in the current cluster metadata,
+// retrying if the cluster metadata was updated concurrently.
+func UpdateShardPlacements(
+	ctx context.Context,
+	clusterMetadataManager ClusterMetadataManager,
+	updateFn func(placements map[int32]*persistencespb.ShardPlacement) error,
+) error {
+	for attempt := 0; attempt < updateShardPlacementsMaxAttempts; attempt++ {
+		resp, err := clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+		if err != nil {
+			return err
+		}
+		clusterMetadata := resp.ClusterMetadata
+		if clusterMetadata.ShardPlacements == nil {
+			clusterMetadata.ShardPlacements = make(map[int32]*persistencespb.ShardPlacement)
+		}
+		if err := updateFn(clusterMetadata.ShardPlacements); err != nil {
+			return err
+		}
+		applied, err := clusterMetadataManager.SaveClusterMetadata(ctx, &SaveClusterMetadataRequest{
+			ClusterMetadata: clusterMetadata,
+			Version:         resp.Version,
+		})
+		if _, isUnavailable := err.(*serviceerror.Unavailable); isUnavailable {
+			// SQL stores report a version mismatch as unavailable
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if applied {
+			return nil
+		}
+	}
+	return serviceerror.NewUnavailable("unable to update shard placements: cluster metadata was updated concurrently")
+}
@@ -40,6 +40,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -926,6 +927,20 @@ type (
 		Info       string
 	}
 
+	// GetHistoryTreeContainingBranchRequest is a request of GetHistoryTreeContainingBranch
+	GetHistoryTreeContainingBranchRequest struct {
+		// Get data from this shard
+		ShardID int32
+		// A branch of the tree
+		BranchToken []byte
+	}
+
+	// GetHistoryTreeContainingBranchResponse is a response to GetHistoryTreeContainingBranch
+	GetHistoryTreeContainingBranchResponse struct {
+		// all branches of the tree
+		BranchInfos []*persistencespb.HistoryBranch
+	}
+
 	// GetAllHistoryTreeBranchesRequest is a request of GetAllHistoryTreeBranches
 	GetAllHistoryTreeBranchesRequest struct {
 		// pagination token
@@ -1121,6 +1136,8 @@ type (
 		DeleteHistoryBranch(ctx context.Context, request *DeleteHistoryBranchRequest) error
 		// TrimHistoryBranch validate & trim a history branch
 		TrimHistoryBranch(ctx context.Context, request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error)
+		// GetHistoryTreeContainingBranch returns all branches of the tree containing the specified branch
+		GetHistoryTreeContainingBranch(ctx context.Context, request *GetHistoryTreeContainingBranchRequest) (*GetHistoryTreeContainingBranchResponse, error)
 		// GetAllHistoryTreeBranches returns all branches of all trees
 		GetAllHistoryTreeBranches(ctx context.Context, request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
@@ -1241,6 +1258,8 @@ type (
 		// SourceShardID of the task in its original cluster. Note that tasks may move between clusters, so this shard
 		// id may not be the same as the shard id of the task in the current cluster.
 		SourceShardID int
+		// DLQFailure is optional, and describes why the task is enqueued when the queue is a DLQ.
+		DLQFailure *commonspb.HistoryDLQTaskFailure
 	}
 
 	EnqueueTaskResponse struct {
@@ -416,6 +416,21 @@ func (mr *MockExecutionManagerMockRecorder) GetHistoryTasks(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTasks", reflect.TypeOf((*MockExecutionManager)(nil).GetHistoryTasks), ctx, request)
 }
 
+// GetHistoryTreeContainingBranch mocks base method.
+func (m *MockExecutionManager) GetHistoryTreeContainingBranch(ctx context.Context, request *GetHistoryTreeContainingBranchRequest) (*GetHistoryTreeContainingBranchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryTreeContainingBranch", ctx, request)
+	ret0, _ := ret[0].(*GetHistoryTreeContainingBranchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryTreeContainingBranch indicates an expected call of GetHistoryTreeContainingBranch.
+func (mr *MockExecutionManagerMockRecorder) GetHistoryTreeContainingBranch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryTreeContainingBranch", reflect.TypeOf((*MockExecutionManager)(nil).GetHistoryTreeContainingBranch), ctx, request)
+}
+
 // GetName mocks base method.
 func (m *MockExecutionManager) GetName() string {
 	m.ctrl.T.Helper()
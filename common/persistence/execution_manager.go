@@ -41,6 +41,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -54,7 +55,9 @@ type (
 		persistence           ExecutionStore
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
+		metricsHandler        metrics.Handler
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		compressionThreshold  dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -66,15 +69,25 @@ func NewExecutionManager(
 	serializer serialization.Serializer,
 	eventBlobCache XDCCache,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	compressionThreshold dynamicconfig.IntPropertyFn,
 ) ExecutionManager {
+	if metricsHandler == nil {
+		metricsHandler = metrics.NoopMetricsHandler
+	}
+	if compressionThreshold == nil {
+		compressionThreshold = dynamicconfig.GetIntPropertyFn(0)
+	}
 	return &executionManagerImpl{
 		serializer:            serializer,
 		eventBlobCache:        eventBlobCache,
 		persistence:           persistence,
 		logger:                logger,
+		metricsHandler:        metricsHandler,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		compressionThreshold:  compressionThreshold,
 	}
 }
 
@@ -590,6 +603,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 	if err != nil {
 		return nil, err
 	}
+	result.ExecutionInfoBlob = m.compressBlob(result.ExecutionInfoBlob)
 	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState, enumspb.ENCODING_TYPE_PROTO3)
 	if err != nil {
 		return nil, err
@@ -600,7 +614,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		if err != nil {
 			return nil, err
 		}
-		result.UpsertActivityInfos[key] = blob
+		result.UpsertActivityInfos[key] = m.compressBlob(blob)
 	}
 
 	for key, info := range input.UpsertTimerInfos {
@@ -616,7 +630,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		if err != nil {
 			return nil, err
 		}
-		result.UpsertChildExecutionInfos[key] = blob
+		result.UpsertChildExecutionInfos[key] = m.compressBlob(blob)
 	}
 
 	for key, info := range input.UpsertRequestCancelInfos {
@@ -632,7 +646,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		if err != nil {
 			return nil, err
 		}
-		result.UpsertSignalInfos[key] = blob
+		result.UpsertSignalInfos[key] = m.compressBlob(blob)
 	}
 
 	if len(input.NewBufferedEvents) > 0 {
@@ -640,6 +654,7 @@ func (m *executionManagerImpl) SerializeWorkflowMutation( // unexport
 		if err != nil {
 			return nil, err
 		}
+		result.NewBufferedEvents = m.compressBlob(result.NewBufferedEvents)
 	}
 
 	result.LastWriteVersion, err = getCurrentBranchLastWriteVersion(input.ExecutionInfo.VersionHistories)
@@ -689,6 +704,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	if err != nil {
 		return nil, err
 	}
+	result.ExecutionInfoBlob = m.compressBlob(result.ExecutionInfoBlob)
 	result.ExecutionStateBlob, err = m.serializer.WorkflowExecutionStateToBlob(input.ExecutionState, enumspb.ENCODING_TYPE_PROTO3)
 	if err != nil {
 		return nil, err
@@ -703,7 +719,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		if err != nil {
 			return nil, err
 		}
-		result.ActivityInfos[key] = blob
+		result.ActivityInfos[key] = m.compressBlob(blob)
 	}
 	for key, info := range input.TimerInfos {
 		blob, err := m.serializer.TimerInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
@@ -717,7 +733,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		if err != nil {
 			return nil, err
 		}
-		result.ChildExecutionInfos[key] = blob
+		result.ChildExecutionInfos[key] = m.compressBlob(blob)
 	}
 	for key, info := range input.RequestCancelInfos {
 		blob, err := m.serializer.RequestCancelInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
@@ -731,7 +747,7 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 		if err != nil {
 			return nil, err
 		}
-		result.SignalInfos[key] = blob
+		result.SignalInfos[key] = m.compressBlob(blob)
 	}
 	for key := range input.SignalRequestedIDs {
 		result.SignalRequestedIDs[key] = struct{}{}
@@ -745,6 +761,21 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot( // unexport
 	return result, nil
 }
 
+// compressBlob converts blob to the compressed proto3 encoding while compression is enabled,
+// compressing it if it exceeds the configured threshold, and records the achieved compression ratio.
+func (m *executionManagerImpl) compressBlob(
+	blob *commonpb.DataBlob,
+) *commonpb.DataBlob {
+	compressed, ok := serialization.CompressBlob(blob, m.compressionThreshold())
+	if !ok {
+		return compressed
+	}
+	metrics.PersistenceBlobCompressionRatio.With(m.metricsHandler).Record(
+		int64(len(blob.Data) * 100 / len(compressed.Data)),
+	)
+	return compressed
+}
+
 func (m *executionManagerImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *DeleteWorkflowExecutionRequest,
@@ -37,6 +37,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -224,6 +225,25 @@ findDeleteRanges:
 	return m.persistence.DeleteHistoryBranch(ctx, req)
 }
 
+// GetHistoryTreeContainingBranch returns all branches of the tree containing the specified branch
+func (m *executionManagerImpl) GetHistoryTreeContainingBranch(
+	ctx context.Context,
+	request *GetHistoryTreeContainingBranchRequest,
+) (*GetHistoryTreeContainingBranchResponse, error) {
+	historyTreeResp, err := m.persistence.GetHistoryTreeContainingBranch(ctx, &InternalGetHistoryTreeContainingBranchRequest{
+		BranchToken: request.BranchToken,
+		ShardID:     request.ShardID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	branchInfos, err := m.deserializeBranchInfos(historyTreeResp)
+	if err != nil {
+		return nil, err
+	}
+	return &GetHistoryTreeContainingBranchResponse{BranchInfos: branchInfos}, nil
+}
+
 // TrimHistoryBranch trims a branch
 func (m *executionManagerImpl) TrimHistoryBranch(
 	ctx context.Context,
@@ -504,10 +524,12 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
+	size := len(req.Node.Events.Data)
+	req.Node.Events = m.compressBlob(req.Node.Events)
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -522,6 +544,7 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 		return nil, err
 	}
 
+	req.Node.Events = m.compressBlob(req.Node.Events)
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 	return &AppendHistoryNodesResponse{
 		Size: len(request.History.Data),
@@ -674,6 +697,9 @@ func (m *executionManagerImpl) readRawHistoryBranch(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := decompressHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -735,10 +761,26 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := decompressHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
 
+// decompressHistoryNodes replaces compressed event blobs in place so that callers, including raw
+// history consumers such as replication, never observe the storage level compression.
+func decompressHistoryNodes(nodes []InternalHistoryNode) error {
+	for i := range nodes {
+		events, err := serialization.DecompressBlob(nodes[i].Events)
+		if err != nil {
+			return err
+		}
+		nodes[i].Events = events
+	}
+	return nil
+}
+
 func (m *executionManagerImpl) readRawHistoryBranchAndFilter(
 	ctx context.Context,
 	request *ReadHistoryBranchRequest,
@@ -89,8 +89,9 @@ func (m *HistoryTaskQueueManagerImpl) EnqueueTask(
 
 	taskCategory := request.Task.GetCategory()
 	task := persistencespb.HistoryTask{
-		ShardId: int32(request.SourceShardID),
-		Blob:    blob,
+		ShardId:    int32(request.SourceShardID),
+		Blob:       blob,
+		DlqFailure: request.DLQFailure,
 	}
 	taskBytes, _ := task.Marshal()
 	blob = &commonpb.DataBlob{
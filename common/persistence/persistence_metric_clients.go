@@ -930,6 +930,18 @@ func (p *executionPersistenceClient) TrimHistoryBranch(
 	return p.persistence.TrimHistoryBranch(ctx, request)
 }
 
+func (p *executionPersistenceClient) GetHistoryTreeContainingBranch(
+	ctx context.Context,
+	request *GetHistoryTreeContainingBranchRequest,
+) (_ *GetHistoryTreeContainingBranchResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.recordRequestMetrics(metrics.PersistenceGetHistoryTreeContainingBranchScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.GetHistoryTreeContainingBranch(ctx, request)
+}
+
 func (p *executionPersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
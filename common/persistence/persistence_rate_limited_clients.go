@@ -815,6 +815,17 @@ func (p *executionRateLimitedPersistenceClient) TrimHistoryBranch(
 	return resp, err
 }
 
+func (p *executionRateLimitedPersistenceClient) GetHistoryTreeContainingBranch(
+	ctx context.Context,
+	request *GetHistoryTreeContainingBranchRequest,
+) (*GetHistoryTreeContainingBranchResponse, error) {
+	if err := allow(ctx, "GetHistoryTreeContainingBranch", request.ShardID, p.systemRateLimiter, p.namespaceRateLimiter); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetHistoryTreeContainingBranch(ctx, request)
+	return response, err
+}
+
 func (p *executionRateLimitedPersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
@@ -607,6 +607,21 @@ func (p *executionRetryablePersistenceClient) TrimHistoryBranch(
 	return response, err
 }
 
+func (p *executionRetryablePersistenceClient) GetHistoryTreeContainingBranch(
+	ctx context.Context,
+	request *GetHistoryTreeContainingBranchRequest,
+) (*GetHistoryTreeContainingBranchResponse, error) {
+	var response *GetHistoryTreeContainingBranchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.GetHistoryTreeContainingBranch(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 func (p *executionRetryablePersistenceClient) GetAllHistoryTreeBranches(
 	ctx context.Context,
 	request *GetAllHistoryTreeBranchesRequest,
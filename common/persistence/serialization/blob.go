@@ -158,11 +158,11 @@ func decode(
 		return nil
 	}
 
-	enc, _ := enumspb.EncodingTypeFromString(encoding)
+	enc, _ := ParseEncodingType(encoding)
 	switch enc {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(blob, result)
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Compressed:
 		return proto3Decode(blob, encoding, result)
 	default:
 		return NewUnknownEncodingTypeError(encoding, enumspb.ENCODING_TYPE_JSON, enumspb.ENCODING_TYPE_PROTO3)
@@ -183,18 +183,22 @@ func proto3Encode(m proto.Message) (*commonpb.DataBlob, error) {
 }
 
 func proto3Decode(blob []byte, encoding string, result proto.Message) error {
-	e, _ := enumspb.EncodingTypeFromString(encoding)
-	if e != enumspb.ENCODING_TYPE_PROTO3 {
+	e, _ := ParseEncodingType(encoding)
+	if !IsProto3Encoding(e) {
 		return NewUnknownEncodingTypeError(encoding, enumspb.ENCODING_TYPE_PROTO3)
 	}
 	return Proto3Decode(blob, e, result)
 }
 
 func Proto3Decode(blob []byte, e enumspb.EncodingType, result proto.Message) error {
-	if e != enumspb.ENCODING_TYPE_PROTO3 {
+	if !IsProto3Encoding(e) {
 		return NewUnknownEncodingTypeError(e.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
-	err := proto.Unmarshal(blob, result)
+	blob, err := DecompressBlobData(blob)
+	if err != nil {
+		return err
+	}
+	err = proto.Unmarshal(blob, result)
 	if err == nil {
 		err = utf8validator.Validate(result, utf8validator.SourcePersistence)
 	}
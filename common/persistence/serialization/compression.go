@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// BlobCodec identifies the codec used to compress a persisted proto3 blob.
+	BlobCodec byte
+)
+
+const (
+	BlobCodecSnappy BlobCodec = 1
+	BlobCodecZstd   BlobCodec = 2
+)
+
+// EncodingTypeProto3Compressed is a storage only encoding type which is never sent over the wire.
+// Blobs of this type hold a proto3 message which is optionally compressed and framed by a header
+// identifying the codec. It is persisted as its decimal value, which binaries predating blob
+// compression fail to parse and therefore reject with an unknown encoding type error instead of
+// unmarshalling compressed bytes as proto3.
+const EncodingTypeProto3Compressed = enumspb.EncodingType(1 << 16)
+
+// compressedBlobMagic prefixes every compressed blob. A valid proto3 message can never start with
+// a zero byte since field number 0 is reserved, which makes the header unambiguous and lets readers
+// handle both plain and compressed payloads.
+var compressedBlobMagic = []byte{0x00, 'T', 'C'}
+
+const compressedBlobHeaderSize = 4
+
+var (
+	errUnknownBlobCodec = errors.New("unknown blob codec")
+
+	// zstd encoders and decoders are safe for concurrent use through EncodeAll and DecodeAll.
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseEncodingType parses an encoding type persisted alongside a blob, including
+// EncodingTypeProto3Compressed.
+func ParseEncodingType(encoding string) (enumspb.EncodingType, error) {
+	if encoding == EncodingTypeProto3Compressed.String() {
+		return EncodingTypeProto3Compressed, nil
+	}
+	return enumspb.EncodingTypeFromString(encoding)
+}
+
+// IsProto3Encoding returns true if blobs of encoding type e hold a proto3 message.
+func IsProto3Encoding(e enumspb.EncodingType) bool {
+	return e == enumspb.ENCODING_TYPE_PROTO3 || e == EncodingTypeProto3Compressed
+}
+
+// IsCompressedBlobData returns true if data was produced by CompressBlob.
+func IsCompressedBlobData(data []byte) bool {
+	return len(data) >= compressedBlobHeaderSize && bytes.HasPrefix(data, compressedBlobMagic)
+}
+
+// CompressBlob converts a proto3 blob to EncodingTypeProto3Compressed and compresses its payload
+// with zstd if it is at least threshold bytes. A non-positive threshold disables compression and
+// returns blob unchanged, as do non proto3 blobs. Blobs below the threshold, or which do not shrink
+// when compressed, are still converted so that all blobs written while compression is enabled
+// share one encoding type. The returned bool reports whether the payload was compressed.
+func CompressBlob(blob *commonpb.DataBlob, threshold int) (*commonpb.DataBlob, bool) {
+	if blob == nil || threshold <= 0 || !IsProto3Encoding(blob.EncodingType) {
+		return blob, false
+	}
+	result := &commonpb.DataBlob{
+		EncodingType: EncodingTypeProto3Compressed,
+		Data:         blob.Data,
+	}
+	if len(blob.Data) < threshold || IsCompressedBlobData(blob.Data) {
+		return result, false
+	}
+	data := make([]byte, compressedBlobHeaderSize, compressedBlobHeaderSize+len(blob.Data))
+	copy(data, compressedBlobMagic)
+	data[len(compressedBlobMagic)] = byte(BlobCodecZstd)
+	data = zstdEncoder.EncodeAll(blob.Data, data)
+	if len(data) >= len(blob.Data) {
+		return result, false
+	}
+	result.Data = data
+	return result, true
+}
+
+// DecompressBlobData returns the uncompressed payload of data. Data which was not produced by
+// CompressBlob is returned as is.
+func DecompressBlobData(data []byte) ([]byte, error) {
+	if !IsCompressedBlobData(data) {
+		return data, nil
+	}
+	codec := BlobCodec(data[len(compressedBlobMagic)])
+	var decoded []byte
+	var err error
+	switch codec {
+	case BlobCodecSnappy:
+		decoded, err = snappy.Decode(nil, data[compressedBlobHeaderSize:])
+	case BlobCodecZstd:
+		decoded, err = zstdDecoder.DecodeAll(data[compressedBlobHeaderSize:], nil)
+	default:
+		err = fmt.Errorf("%w: %d", errUnknownBlobCodec, codec)
+	}
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeProto3Compressed, err)
+	}
+	return decoded, nil
+}
+
+// DecompressBlob returns blob with its payload decompressed and its encoding type converted back to
+// ENCODING_TYPE_PROTO3, so that the storage only encoding type never leaves persistence. Other
+// blobs are returned as is.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob == nil || !IsProto3Encoding(blob.EncodingType) {
+		return blob, nil
+	}
+	if blob.EncodingType == enumspb.ENCODING_TYPE_PROTO3 && !IsCompressedBlobData(blob.Data) {
+		return blob, nil
+	}
+	data, err := DecompressBlobData(blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         data,
+	}, nil
+}
@@ -0,0 +1,172 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+func TestCompressBlob_Threshold(t *testing.T) {
+	blob := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte(strings.Repeat("a", 1024)),
+	}
+
+	result, ok := CompressBlob(blob, 0)
+	require.False(t, ok)
+	require.Same(t, blob, result)
+
+	// blobs below the threshold are not compressed but still use the compressed encoding type
+	result, ok = CompressBlob(blob, 2048)
+	require.False(t, ok)
+	require.Equal(t, EncodingTypeProto3Compressed, result.EncodingType)
+	require.Equal(t, blob.Data, result.Data)
+
+	result, ok = CompressBlob(blob, 1024)
+	require.True(t, ok)
+	require.True(t, IsCompressedBlobData(result.Data))
+	require.Equal(t, BlobCodecZstd, BlobCodec(result.Data[len(compressedBlobMagic)]))
+	require.Less(t, len(result.Data), len(blob.Data))
+	require.Equal(t, EncodingTypeProto3Compressed, result.EncodingType)
+
+	// already compressed blobs are never compressed twice
+	again, ok := CompressBlob(result, 1)
+	require.False(t, ok)
+	require.Equal(t, result.Data, again.Data)
+
+	decompressed, err := DecompressBlob(result)
+	require.NoError(t, err)
+	require.Equal(t, blob.Data, decompressed.Data)
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, decompressed.EncodingType)
+}
+
+func TestParseEncodingType(t *testing.T) {
+	encoding, err := ParseEncodingType(EncodingTypeProto3Compressed.String())
+	require.NoError(t, err)
+	require.Equal(t, EncodingTypeProto3Compressed, encoding)
+
+	encoding, err = ParseEncodingType(enumspb.ENCODING_TYPE_PROTO3.String())
+	require.NoError(t, err)
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, encoding)
+
+	// binaries predating compression only know the public enum and must reject the persisted value
+	_, err = enumspb.EncodingTypeFromString(EncodingTypeProto3Compressed.String())
+	require.Error(t, err)
+}
+
+func TestDecompressBlob_Snappy(t *testing.T) {
+	payload := []byte(strings.Repeat("a", 1024))
+	data := append(append([]byte{}, compressedBlobMagic...), byte(BlobCodecSnappy))
+	data = append(data, snappy.Encode(nil, payload)...)
+
+	decompressed, err := DecompressBlob(&commonpb.DataBlob{
+		EncodingType: EncodingTypeProto3Compressed,
+		Data:         data,
+	})
+	require.NoError(t, err)
+	require.Equal(t, payload, decompressed.Data)
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, decompressed.EncodingType)
+}
+
+func TestCompressBlob_SkipsIncompressibleAndNonProto(t *testing.T) {
+	blob := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         []byte{1, 2, 3},
+	}
+	result, ok := CompressBlob(blob, 1)
+	require.False(t, ok)
+	require.Equal(t, EncodingTypeProto3Compressed, result.EncodingType)
+	require.Equal(t, blob.Data, result.Data)
+
+	jsonBlob := &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         []byte(strings.Repeat("a", 1024)),
+	}
+	result, ok = CompressBlob(jsonBlob, 1)
+	require.False(t, ok)
+	require.Same(t, jsonBlob, result)
+}
+
+func TestDecompressBlobData_UnknownCodec(t *testing.T) {
+	data := append(append([]byte{}, compressedBlobMagic...), 0xff, 1, 2, 3)
+	_, err := DecompressBlobData(data)
+	require.ErrorIs(t, err, errUnknownBlobCodec)
+}
+
+func TestDecode_CompressedAndPlainBlobs(t *testing.T) {
+	serializer := NewSerializer()
+	protoAssert := protorequire.New(t)
+
+	events := []*historypb.HistoryEvent{
+		{EventId: 1, Version: 1},
+		{EventId: 2, Version: 1},
+	}
+	for i := range events {
+		events[i].EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED
+		events[i].Attributes = &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{
+			WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+				SignalName: strings.Repeat("signal", 100),
+			},
+		}
+	}
+	plain, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	compressed, ok := CompressBlob(plain, 1)
+	require.True(t, ok)
+
+	for _, blob := range []*commonpb.DataBlob{plain, compressed} {
+		result, err := serializer.DeserializeEvents(blob)
+		require.NoError(t, err)
+		require.Len(t, result, len(events))
+		for i := range events {
+			protoAssert.ProtoEqual(events[i], result[i])
+		}
+	}
+
+	info := &persistencespb.WorkflowExecutionInfo{
+		NamespaceId: "namespace-id",
+		WorkflowId:  strings.Repeat("workflow-id", 100),
+	}
+	plain, err = serializer.WorkflowExecutionInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	compressed, ok = CompressBlob(plain, 1)
+	require.True(t, ok)
+
+	for _, blob := range []*commonpb.DataBlob{plain, compressed} {
+		result, err := serializer.WorkflowExecutionInfoFromBlob(blob)
+		require.NoError(t, err)
+		protoAssert.ProtoEqual(info, result)
+	}
+}
@@ -164,9 +164,13 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	events := &historypb.History{}
 	var err error
 	switch data.EncodingType {
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Compressed:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = events.Unmarshal(data.Data)
+		var payload []byte
+		if payload, err = DecompressBlobData(data.Data); err != nil {
+			return nil, err
+		}
+		err = events.Unmarshal(payload)
 	default:
 		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
@@ -197,9 +201,13 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	event := &historypb.HistoryEvent{}
 	var err error
 	switch data.EncodingType {
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Compressed:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
-		err = event.Unmarshal(data.Data)
+		var payload []byte
+		if payload, err = DecompressBlobData(data.Data); err != nil {
+			return nil, err
+		}
+		err = event.Unmarshal(payload)
 	default:
 		return nil, NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_PROTO3)
 	}
@@ -573,7 +581,7 @@ func decodeBlob(data *commonpb.DataBlob, result proto.Message) error {
 	switch data.EncodingType {
 	case enumspb.ENCODING_TYPE_JSON:
 		return codec.NewJSONPBEncoder().Decode(data.Data, result)
-	case enumspb.ENCODING_TYPE_PROTO3:
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Compressed:
 		return ProtoDecodeBlob(data, result)
 	default:
 		return NewUnknownEncodingTypeError(data.EncodingType.String(), enumspb.ENCODING_TYPE_JSON, enumspb.ENCODING_TYPE_PROTO3)
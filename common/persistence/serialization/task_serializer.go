@@ -114,6 +114,8 @@ func (s *TaskSerializer) serializeTransferTask(
 		transferTask = s.transferResetTaskToProto(task)
 	case *tasks.DeleteExecutionTask:
 		transferTask = s.transferDeleteExecutionTaskToProto(task)
+	case *tasks.DeleteOffloadedPayloadsTask:
+		transferTask = s.transferDeleteOffloadedPayloadsTaskToProto(task)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", task))
 	}
@@ -147,6 +149,8 @@ func (s *TaskSerializer) deserializeTransferTasks(
 		task = s.transferResetTaskFromProto(transferTask)
 	case enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION:
 		task = s.transferDeleteExecutionTaskFromProto(transferTask)
+	case enumsspb.TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS:
+		task = s.transferDeleteOffloadedPayloadsTaskFromProto(transferTask)
 	default:
 		return nil, serviceerror.NewInternal(fmt.Sprintf("Unknown transfer task type: %v", transferTask.TaskType))
 	}
@@ -637,6 +641,41 @@ func (s *TaskSerializer) transferDeleteExecutionTaskFromProto(
 	}
 }
 
+func (s *TaskSerializer) transferDeleteOffloadedPayloadsTaskToProto(
+	deleteOffloadedPayloadsTask *tasks.DeleteOffloadedPayloadsTask,
+) *persistencespb.TransferTaskInfo {
+	return &persistencespb.TransferTaskInfo{
+		NamespaceId:    deleteOffloadedPayloadsTask.WorkflowKey.NamespaceID,
+		WorkflowId:     deleteOffloadedPayloadsTask.WorkflowKey.WorkflowID,
+		RunId:          deleteOffloadedPayloadsTask.WorkflowKey.RunID,
+		TaskType:       enumsspb.TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS,
+		Version:        deleteOffloadedPayloadsTask.Version,
+		TaskId:         deleteOffloadedPayloadsTask.TaskID,
+		VisibilityTime: timestamppb.New(deleteOffloadedPayloadsTask.VisibilityTimestamp),
+		TaskDetails: &persistencespb.TransferTaskInfo_DeleteOffloadedPayloadsTaskDetails_{
+			DeleteOffloadedPayloadsTaskDetails: &persistencespb.TransferTaskInfo_DeleteOffloadedPayloadsTaskDetails{
+				Keys: deleteOffloadedPayloadsTask.Keys,
+			},
+		},
+	}
+}
+
+func (s *TaskSerializer) transferDeleteOffloadedPayloadsTaskFromProto(
+	deleteOffloadedPayloadsTask *persistencespb.TransferTaskInfo,
+) *tasks.DeleteOffloadedPayloadsTask {
+	return &tasks.DeleteOffloadedPayloadsTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			deleteOffloadedPayloadsTask.NamespaceId,
+			deleteOffloadedPayloadsTask.WorkflowId,
+			deleteOffloadedPayloadsTask.RunId,
+		),
+		VisibilityTimestamp: deleteOffloadedPayloadsTask.VisibilityTime.AsTime(),
+		TaskID:              deleteOffloadedPayloadsTask.TaskId,
+		Version:             deleteOffloadedPayloadsTask.Version,
+		Keys:                deleteOffloadedPayloadsTask.GetDeleteOffloadedPayloadsTaskDetails().GetKeys(),
+	}
+}
+
 func (s *TaskSerializer) timerWorkflowTaskToProto(
 	workflowTimer *tasks.WorkflowTaskTimeoutTask,
 ) *persistencespb.TimerTaskInfo {
@@ -1078,6 +1117,7 @@ func (s *TaskSerializer) replicationActivityTaskToProto(
 		BranchToken:       nil,
 		NewRunBranchToken: nil,
 		VisibilityTime:    timestamppb.New(activityTask.VisibilityTimestamp),
+		ExcludedClusters:  activityTask.ExcludedClusters,
 	}
 }
 
@@ -1098,6 +1138,7 @@ func (s *TaskSerializer) replicationActivityTaskFromProto(
 		Version:             activityTask.Version,
 		TaskID:              activityTask.TaskId,
 		ScheduledEventID:    activityTask.ScheduledEventId,
+		ExcludedClusters:    activityTask.ExcludedClusters,
 	}
 }
 
@@ -1118,6 +1159,7 @@ func (s *TaskSerializer) replicationHistoryTaskToProto(
 		NewRunBranchToken: historyTask.NewRunBranchToken,
 		NewRunId:          historyTask.NewRunID,
 		VisibilityTime:    timestamppb.New(historyTask.VisibilityTimestamp),
+		ExcludedClusters:  historyTask.ExcludedClusters,
 	}
 }
 
@@ -1142,6 +1184,7 @@ func (s *TaskSerializer) replicationHistoryTaskFromProto(
 		BranchToken:         historyTask.BranchToken,
 		NewRunBranchToken:   historyTask.NewRunBranchToken,
 		NewRunID:            historyTask.NewRunId,
+		ExcludedClusters:    historyTask.ExcludedClusters,
 	}
 }
 
@@ -1182,13 +1225,14 @@ func (s *TaskSerializer) replicationSyncWorkflowStateTaskToProto(
 	syncWorkflowStateTask *tasks.SyncWorkflowStateTask,
 ) *persistencespb.ReplicationTaskInfo {
 	return &persistencespb.ReplicationTaskInfo{
-		NamespaceId:    syncWorkflowStateTask.WorkflowKey.NamespaceID,
-		WorkflowId:     syncWorkflowStateTask.WorkflowKey.WorkflowID,
-		RunId:          syncWorkflowStateTask.WorkflowKey.RunID,
-		TaskType:       enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE,
-		TaskId:         syncWorkflowStateTask.TaskID,
-		Version:        syncWorkflowStateTask.Version,
-		VisibilityTime: timestamppb.New(syncWorkflowStateTask.VisibilityTimestamp),
+		NamespaceId:      syncWorkflowStateTask.WorkflowKey.NamespaceID,
+		WorkflowId:       syncWorkflowStateTask.WorkflowKey.WorkflowID,
+		RunId:            syncWorkflowStateTask.WorkflowKey.RunID,
+		TaskType:         enumsspb.TASK_TYPE_REPLICATION_SYNC_WORKFLOW_STATE,
+		TaskId:           syncWorkflowStateTask.TaskID,
+		Version:          syncWorkflowStateTask.Version,
+		VisibilityTime:   timestamppb.New(syncWorkflowStateTask.VisibilityTimestamp),
+		ExcludedClusters: syncWorkflowStateTask.ExcludedClusters,
 	}
 }
 
@@ -1208,6 +1252,7 @@ func (s *TaskSerializer) replicationSyncWorkflowStateTaskFromProto(
 		VisibilityTimestamp: visibilityTimestamp,
 		Version:             syncWorkflowStateTask.Version,
 		TaskID:              syncWorkflowStateTask.TaskId,
+		ExcludedClusters:    syncWorkflowStateTask.ExcludedClusters,
 	}
 }
 
@@ -337,6 +337,7 @@ func (s *taskSerializerSuite) TestReplicateHistoryTask() {
 		NextEventID:         rand.Int63(),
 		BranchToken:         shuffle.Bytes([]byte("random branch token")),
 		NewRunBranchToken:   shuffle.Bytes([]byte("random new branch token")),
+		ExcludedClusters:    []string{"standby"},
 	}
 
 	s.assertEqualTasks(replicateHistoryTask)
@@ -365,6 +366,18 @@ func (s *taskSerializerSuite) TestDeleteExecutionTask() {
 	s.assertEqualTasks(replicateHistoryTask)
 }
 
+func (s *taskSerializerSuite) TestDeleteOffloadedPayloadsTask() {
+	deleteOffloadedPayloadsTask := &tasks.DeleteOffloadedPayloadsTask{
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: time.Unix(0, rand.Int63()).UTC(),
+		TaskID:              rand.Int63(),
+		Version:             rand.Int63(),
+		Keys:                []string{uuid.New().String(), uuid.New().String()},
+	}
+
+	s.assertEqualTasks(deleteOffloadedPayloadsTask)
+}
+
 func (s *taskSerializerSuite) TestArchiveExecutionTask() {
 	task := &tasks.ArchiveExecutionTask{
 		WorkflowKey:         s.workflowKey,
@@ -44,6 +44,7 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/testing/protorequire"
@@ -91,7 +92,9 @@ func NewExecutionMutableStateSuite(
 			serializer,
 			nil,
 			logger,
+			metrics.NoopMetricsHandler,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(0),
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
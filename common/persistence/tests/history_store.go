@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/testing/protorequire"
@@ -81,6 +82,25 @@ func NewHistoryEventsSuite(
 	t *testing.T,
 	store p.ExecutionStore,
 	logger log.Logger,
+) *HistoryEventsSuite {
+	return newHistoryEventsSuite(t, store, logger, 0)
+}
+
+// NewCompressedHistoryEventsSuite runs the history events tests with every history batch compressed. Compression
+// happens in the persistence manager, so running it against one store is enough.
+func NewCompressedHistoryEventsSuite(
+	t *testing.T,
+	store p.ExecutionStore,
+	logger log.Logger,
+) *HistoryEventsSuite {
+	return newHistoryEventsSuite(t, store, logger, 1)
+}
+
+func newHistoryEventsSuite(
+	t *testing.T,
+	store p.ExecutionStore,
+	logger log.Logger,
+	compressionThreshold int,
 ) *HistoryEventsSuite {
 	eventSerializer := serialization.NewSerializer()
 	return &HistoryEventsSuite{
@@ -91,7 +111,9 @@ func NewHistoryEventsSuite(
 			eventSerializer,
 			nil,
 			logger,
+			metrics.NoopMetricsHandler,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			dynamicconfig.GetIntPropertyFn(compressionThreshold),
 		),
 		serializer: eventSerializer,
 		logger:     logger,
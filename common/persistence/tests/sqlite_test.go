@@ -192,6 +192,27 @@ func TestSQLiteHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestSQLiteCompressedHistoryStoreSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	logger := log.NewNoopLogger()
+	factory := sql.NewFactory(
+		*cfg,
+		resolver.NewNoopResolver(),
+		testSQLiteClusterName,
+		logger,
+	)
+	store, err := factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create SQLite DB: %v", err)
+	}
+	defer func() {
+		factory.Close()
+	}()
+
+	s := NewCompressedHistoryEventsSuite(t, store, logger)
+	suite.Run(t, s)
+}
+
 func TestSQLiteTaskQueueSuite(t *testing.T) {
 	cfg := NewSQLiteMemoryConfig()
 	logger := log.NewNoopLogger()
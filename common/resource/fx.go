@@ -56,6 +56,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -109,6 +110,7 @@ var Module = fx.Options(
 		fx.ResultTags(`group:"deadlockDetectorRoots"`),
 	)),
 	fx.Provide(serialization.NewSerializer),
+	fx.Provide(PayloadStoreProvider),
 	fx.Provide(HistoryBootstrapContainerProvider),
 	fx.Provide(VisibilityBootstrapContainerProvider),
 	fx.Provide(ClientFactoryProvider),
@@ -283,14 +285,21 @@ func VisibilityBootstrapContainerProvider(
 	}
 }
 
+// PayloadStoreProvider provides the store oversized payloads are offloaded to, or nil if none is configured.
+func PayloadStoreProvider(cfg *config.Config) (payloadstore.Store, error) {
+	return payloadstore.NewStore(&cfg.PayloadStore)
+}
+
 func HistoryBootstrapContainerProvider(
 	logger log.SnTaggedLogger,
 	metricsHandler metrics.Handler,
 	clusterMetadata cluster.Metadata,
 	executionManager persistence.ExecutionManager,
+	payloadStore payloadstore.Store,
 ) *archiver.HistoryBootstrapContainer {
 	return &archiver.HistoryBootstrapContainer{
-		ExecutionManager: executionManager,
+		// archived histories must not reference offloaded payloads, which are deleted along with the workflow run
+		ExecutionManager: payloadstore.NewRehydratingExecutionManager(executionManager, payloadStore),
 		Logger:           logger,
 		MetricsHandler:   metricsHandler,
 		ClusterMetadata:  clusterMetadata,
@@ -339,6 +348,7 @@ func MatchingClientProvider(matchingRawClient MatchingRawClient) MatchingClient
 
 func PersistenceConfigProvider(persistenceConfig config.Persistence, dc *dynamicconfig.Collection) *config.Persistence {
 	persistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	persistenceConfig.BlobCompressionThreshold = dc.GetIntProperty(dynamicconfig.BlobCompressionThreshold, 0)
 	return &persistenceConfig
 }
 
@@ -81,6 +81,8 @@ var (
 		"AddTasks":                  {},
 		"ListQueues":                {},
 		"ListTasks":                 {},
+		"ListQueueMitigations":      {},
+		"RevertQueueMitigation":     {},
 	}
 )
 
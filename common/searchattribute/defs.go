@@ -68,6 +68,9 @@ const (
 	// Used by scheduler workflow.
 	TemporalSchedulePaused = "TemporalSchedulePaused"
 
+	// IDs of the pending activities of a workflow that are paused.
+	TemporalPausedActivities = "TemporalPausedActivities"
+
 	ReservedPrefix = "Temporal"
 
 	// Query clause that mentions TemporalNamespaceDivision to disable special handling of that
@@ -104,6 +107,7 @@ var (
 		TemporalScheduledStartTime: enumspb.INDEXED_VALUE_TYPE_DATETIME,
 		TemporalScheduledById:      enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		TemporalSchedulePaused:     enumspb.INDEXED_VALUE_TYPE_BOOL,
+		TemporalPausedActivities:   enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST,
 		TemporalNamespaceDivision:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 
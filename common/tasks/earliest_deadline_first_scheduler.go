@@ -0,0 +1,358 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+const (
+	EarliestDeadlineFirstSchedulerDefaultQueueSize = 1000
+)
+
+var _ Scheduler[Task] = (*EarliestDeadlineFirstScheduler[Task, struct{}])(nil)
+
+type (
+	// EarliestDeadlineFirstSchedulerOptions is the config for
+	// earliest deadline first scheduler
+	EarliestDeadlineFirstSchedulerOptions[T Task, K comparable] struct {
+		// Required for mapping a task to it's priority class (task channel)
+		TaskChannelKeyFn TaskChannelKeyFn[T, K]
+		// Required for getting the weight for a priority class
+		ChannelWeightFn ChannelWeightFn[K]
+		// Required for getting the deadline of a task
+		TaskDeadlineFn TaskDeadlineFn[T]
+		// Optional, if specified, re-evaluate priority class weight when there are pending tasks
+		ChannelWeightUpdateCh chan struct{}
+		// Optional, max number of tasks pending dispatch across all priority classes,
+		// default to EarliestDeadlineFirstSchedulerDefaultQueueSize
+		QueueSize int
+	}
+
+	// TaskDeadlineFn is the function for getting the time by which a task should start processing
+	TaskDeadlineFn[T Task] func(T) time.Time
+
+	// EarliestDeadlineFirstScheduler dispatches tasks across priority classes with
+	// interleaved weighted round robin, and within each priority class, in the order
+	// of task deadline. Tasks with the same deadline are dispatched in submission order.
+	EarliestDeadlineFirstScheduler[T Task, K comparable] struct {
+		status int32
+
+		fifoScheduler  Scheduler[T]
+		timeSource     clock.TimeSource
+		metricTagsFn   MetricTagsFn[T]
+		logger         log.Logger
+		metricsHandler metrics.Handler
+
+		notifyChan   chan struct{}
+		shutdownChan chan struct{}
+		shutdownWG   sync.WaitGroup
+
+		options EarliestDeadlineFirstSchedulerOptions[T, K]
+
+		// pendingSlots bounds the number of tasks pending dispatch,
+		// a slot is acquired on submission and released on dispatch
+		pendingSlots chan struct{}
+
+		sync.Mutex
+		nextSequence   int64
+		deadlineQueues map[K]*deadlineQueue[T]
+	}
+
+	deadlineQueue[T Task] struct {
+		weight int
+		tasks  collection.Queue[deadlineTask[T]]
+	}
+
+	deadlineTask[T Task] struct {
+		task     T
+		deadline time.Time
+		sequence int64
+	}
+)
+
+func NewEarliestDeadlineFirstScheduler[T Task, K comparable](
+	options EarliestDeadlineFirstSchedulerOptions[T, K],
+	fifoScheduler Scheduler[T],
+	timeSource clock.TimeSource,
+	metricTagsFn MetricTagsFn[T],
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) *EarliestDeadlineFirstScheduler[T, K] {
+	queueSize := options.QueueSize
+	if queueSize <= 0 {
+		queueSize = EarliestDeadlineFirstSchedulerDefaultQueueSize
+	}
+
+	return &EarliestDeadlineFirstScheduler[T, K]{
+		status: common.DaemonStatusInitialized,
+
+		fifoScheduler:  fifoScheduler,
+		timeSource:     timeSource,
+		metricTagsFn:   metricTagsFn,
+		logger:         logger,
+		metricsHandler: metricsHandler,
+
+		notifyChan:   make(chan struct{}, 1),
+		shutdownChan: make(chan struct{}),
+
+		options: options,
+
+		pendingSlots: make(chan struct{}, queueSize),
+
+		deadlineQueues: make(map[K]*deadlineQueue[T]),
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&s.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	s.fifoScheduler.Start()
+
+	s.shutdownWG.Add(1)
+	go s.eventLoop()
+
+	s.logger.Info("earliest deadline first task scheduler started")
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&s.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(s.shutdownChan)
+
+	s.fifoScheduler.Stop()
+
+	s.abortTasks()
+
+	if success := common.AwaitWaitGroup(&s.shutdownWG, time.Minute); !success {
+		s.logger.Warn("earliest deadline first task scheduler timed out on shutdown.")
+	}
+	s.logger.Info("earliest deadline first task scheduler stopped")
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) Submit(
+	task T,
+) {
+	select {
+	case s.pendingSlots <- struct{}{}:
+		s.enqueue(task)
+	case <-s.shutdownChan:
+		task.Abort()
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) TrySubmit(
+	task T,
+) bool {
+	select {
+	case s.pendingSlots <- struct{}{}:
+		s.enqueue(task)
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) enqueue(
+	task T,
+) {
+	channelKey := s.options.TaskChannelKeyFn(task)
+	deadline := s.options.TaskDeadlineFn(task)
+
+	s.Lock()
+	if s.isStopped() {
+		s.Unlock()
+		<-s.pendingSlots
+		task.Abort()
+		return
+	}
+
+	queue, ok := s.deadlineQueues[channelKey]
+	if !ok {
+		queue = &deadlineQueue[T]{
+			weight: s.channelWeight(channelKey),
+			tasks:  collection.NewPriorityQueue(deadlineTaskLess[T]),
+		}
+		s.deadlineQueues[channelKey] = queue
+	}
+	queue.tasks.Add(deadlineTask[T]{
+		task:     task,
+		deadline: deadline,
+		sequence: s.nextSequence,
+	})
+	s.nextSequence++
+	s.Unlock()
+
+	s.notifyDispatcher()
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) eventLoop() {
+	defer s.shutdownWG.Done()
+
+	for {
+		select {
+		case <-s.notifyChan:
+			s.dispatchTasks()
+		case <-s.shutdownChan:
+			return
+		}
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) notifyDispatcher() {
+	select {
+	case s.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) dispatchTasks() {
+	for {
+		tasks := s.nextRound()
+		if len(tasks) == 0 {
+			return
+		}
+
+		now := s.timeSource.Now()
+		for _, task := range tasks {
+			lateness := max(now.Sub(task.deadline), 0)
+			metrics.TaskSchedulerLateness.With(s.metricsHandler).Record(lateness, s.metricTagsFn(task.task)...)
+			s.fifoScheduler.Submit(task.task)
+		}
+	}
+}
+
+// nextRound pops the tasks for one interleaved weighted round robin round,
+// i.e. up to weight number of tasks with the earliest deadlines from each priority class.
+func (s *EarliestDeadlineFirstScheduler[T, K]) nextRound() []deadlineTask[T] {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.receiveWeightUpdateNotification() {
+		for channelKey, queue := range s.deadlineQueues {
+			queue.weight = s.channelWeight(channelKey)
+		}
+	}
+
+	queues := make([]*deadlineQueue[T], 0, len(s.deadlineQueues))
+	for _, queue := range s.deadlineQueues {
+		if !queue.tasks.IsEmpty() {
+			queues = append(queues, queue)
+		}
+	}
+	if len(queues) == 0 {
+		return nil
+	}
+	sort.Slice(queues, func(i, j int) bool {
+		return queues[i].weight > queues[j].weight
+	})
+
+	var tasks []deadlineTask[T]
+	for round := queues[0].weight - 1; round > -1; round-- {
+		for index := 0; index < len(queues) && queues[index].weight > round; index++ {
+			if !queues[index].tasks.IsEmpty() {
+				tasks = append(tasks, queues[index].tasks.Remove())
+			}
+		}
+	}
+	for range tasks {
+		<-s.pendingSlots
+	}
+	return tasks
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) channelWeight(
+	channelKey K,
+) int {
+	// a priority class with non-positive weight would never be dispatched
+	return max(s.options.ChannelWeightFn(channelKey), 1)
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) receiveWeightUpdateNotification() bool {
+	if s.options.ChannelWeightUpdateCh == nil {
+		return false
+	}
+
+	select {
+	case <-s.options.ChannelWeightUpdateCh:
+		// drain the channel as we don't know the channel size
+		for {
+			select {
+			case <-s.options.ChannelWeightUpdateCh:
+			default:
+				return true
+			}
+		}
+	default:
+		return false
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) abortTasks() {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, queue := range s.deadlineQueues {
+		for !queue.tasks.IsEmpty() {
+			queue.tasks.Remove().task.Abort()
+			<-s.pendingSlots
+		}
+	}
+}
+
+func (s *EarliestDeadlineFirstScheduler[T, K]) isStopped() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStopped
+}
+
+func deadlineTaskLess[T Task](
+	this deadlineTask[T],
+	that deadlineTask[T],
+) bool {
+	if !this.deadline.Equal(that.deadline) {
+		return this.deadline.Before(that.deadline)
+	}
+	return this.sequence < that.sequence
+}
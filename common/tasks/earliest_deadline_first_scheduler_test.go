@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+type (
+	earliestDeadlineFirstSchedulerSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller        *gomock.Controller
+		mockFIFOScheduler *MockScheduler[*testTask]
+
+		channelKeyToWeight map[int]int
+		deadlines          sync.Map // *testTask -> time.Time
+		timeSource         *clock.EventTimeSource
+		metricsCapture     *metricstest.Capture
+
+		scheduler *EarliestDeadlineFirstScheduler[*testTask, int]
+	}
+)
+
+func TestEarliestDeadlineFirstSchedulerSuite(t *testing.T) {
+	s := new(earliestDeadlineFirstSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockFIFOScheduler = NewMockScheduler[*testTask](s.controller)
+
+	s.channelKeyToWeight = map[int]int{
+		0: 2,
+		1: 1,
+	}
+	s.deadlines = sync.Map{}
+	s.timeSource = clock.NewEventTimeSource()
+	metricsHandler := metricstest.NewCaptureHandler()
+	s.metricsCapture = metricsHandler.StartCapture()
+
+	s.scheduler = NewEarliestDeadlineFirstScheduler(
+		EarliestDeadlineFirstSchedulerOptions[*testTask, int]{
+			TaskChannelKeyFn: func(task *testTask) int { return task.channelKey },
+			ChannelWeightFn:  func(key int) int { return s.channelKeyToWeight[key] },
+			TaskDeadlineFn: func(task *testTask) time.Time {
+				deadline, _ := s.deadlines.Load(task)
+				return deadline.(time.Time)
+			},
+			QueueSize: 10,
+		},
+		Scheduler[*testTask](s.mockFIFOScheduler),
+		s.timeSource,
+		func(*testTask) []metrics.Tag { return nil },
+		log.NewTestLogger(),
+		metricsHandler,
+	)
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) TearDownTest() {
+	s.scheduler.Stop()
+	s.controller.Finish()
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) TestSubmit_DeadlineOrder() {
+	now := s.timeSource.Now()
+	lateTask := s.newTestTask(0, now.Add(-time.Second))
+	onTimeTask := s.newTestTask(0, now.Add(time.Minute))
+	urgentTask := s.newTestTask(0, now.Add(-time.Minute))
+	sameDeadlineTask := s.newTestTask(0, now.Add(time.Minute))
+
+	// submit before starting the scheduler so that all tasks are pending dispatch
+	s.scheduler.Submit(onTimeTask)
+	s.scheduler.Submit(lateTask)
+	s.scheduler.Submit(sameDeadlineTask)
+	s.scheduler.Submit(urgentTask)
+
+	gomock.InOrder(
+		s.mockFIFOScheduler.EXPECT().Submit(urgentTask),
+		s.mockFIFOScheduler.EXPECT().Submit(lateTask),
+		s.mockFIFOScheduler.EXPECT().Submit(onTimeTask),
+		s.mockFIFOScheduler.EXPECT().Submit(sameDeadlineTask),
+	)
+	s.mockFIFOScheduler.EXPECT().Start()
+	s.mockFIFOScheduler.EXPECT().Stop()
+	s.scheduler.Start()
+
+	s.Eventually(func() bool {
+		return len(s.metricsCapture.Snapshot()[metrics.TaskSchedulerLateness.Name()]) == 4
+	}, time.Second, 10*time.Millisecond)
+
+	recordings := s.metricsCapture.Snapshot()[metrics.TaskSchedulerLateness.Name()]
+	s.Equal(time.Minute, recordings[0].Value)
+	s.Equal(time.Second, recordings[1].Value)
+	s.Equal(time.Duration(0), recordings[2].Value)
+	s.Equal(time.Duration(0), recordings[3].Value)
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) TestSubmit_WeightedAcrossPriorityClasses() {
+	now := s.timeSource.Now()
+	var highTasks, lowTasks []*testTask
+	for i := 0; i < 3; i++ {
+		// low priority class tasks have earlier deadlines, but deadlines only
+		// order tasks within a priority class
+		highTasks = append(highTasks, s.newTestTask(0, now.Add(time.Duration(i)*time.Second)))
+		lowTasks = append(lowTasks, s.newTestTask(1, now.Add(-time.Hour)))
+	}
+	for i := 0; i < 3; i++ {
+		s.scheduler.Submit(highTasks[i])
+		s.scheduler.Submit(lowTasks[i])
+	}
+
+	var dispatchLock sync.Mutex
+	var dispatchedKeys []int
+	s.mockFIFOScheduler.EXPECT().Submit(gomock.Any()).Do(func(task *testTask) {
+		dispatchLock.Lock()
+		defer dispatchLock.Unlock()
+		dispatchedKeys = append(dispatchedKeys, task.channelKey)
+	}).Times(6)
+
+	s.mockFIFOScheduler.EXPECT().Start()
+	s.mockFIFOScheduler.EXPECT().Stop()
+	s.scheduler.Start()
+
+	s.Eventually(func() bool {
+		dispatchLock.Lock()
+		defer dispatchLock.Unlock()
+		return len(dispatchedKeys) == 6
+	}, time.Second, 10*time.Millisecond)
+	s.Equal([]int{0, 0, 1, 0, 1, 1}, dispatchedKeys)
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) TestTrySubmit_QueueFull() {
+	now := s.timeSource.Now()
+	for i := 0; i < 10; i++ {
+		s.True(s.scheduler.TrySubmit(s.newTestTask(0, now)))
+	}
+	s.False(s.scheduler.TrySubmit(s.newTestTask(0, now)))
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) TestStop_AbortPendingTasks() {
+	s.mockFIFOScheduler.EXPECT().Start()
+	s.mockFIFOScheduler.EXPECT().Stop()
+	s.scheduler.Start()
+	s.scheduler.Stop()
+
+	task := s.newTestTask(0, s.timeSource.Now())
+	task.EXPECT().Abort().Times(1)
+	s.scheduler.Submit(task)
+}
+
+func (s *earliestDeadlineFirstSchedulerSuite) newTestTask(
+	channelKey int,
+	deadline time.Time,
+) *testTask {
+	task := newTestTask(s.controller, channelKey)
+	s.deadlines.Store(task, deadline)
+	return task
+}
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/rpc"
 )
 
@@ -75,6 +76,14 @@ type (
 			endEventID int64,
 			endEventVersion int64,
 		) error
+		// GetRemoteVersionHistory returns the current version history of a run in the remote cluster
+		GetRemoteVersionHistory(
+			ctx context.Context,
+			remoteClusterName string,
+			namespaceID namespace.ID,
+			workflowID string,
+			runID string,
+		) (*historyspb.VersionHistory, error)
 	}
 
 	// NDCHistoryResenderImpl is the implementation of NDCHistoryResender
@@ -188,6 +197,44 @@ func (n *NDCHistoryResenderImpl) SendSingleWorkflowHistory(
 	return nil
 }
 
+// GetRemoteVersionHistory returns the current version history of a run in the remote cluster
+func (n *NDCHistoryResenderImpl) GetRemoteVersionHistory(
+	ctx context.Context,
+	remoteClusterName string,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+) (*historyspb.VersionHistory, error) {
+
+	namespaceName, err := n.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := rpc.NewContextFromParentWithTimeoutAndVersionHeaders(ctx, resendContextTimeout)
+	defer cancel()
+
+	adminClient, err := n.clientBean.GetRemoteAdminClient(remoteClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := adminClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: namespaceName.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return versionhistory.GetCurrentVersionHistory(
+		response.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories(),
+	)
+}
+
 func (n *NDCHistoryResenderImpl) getPaginationFn(
 	ctx context.Context,
 	remoteClusterName string,
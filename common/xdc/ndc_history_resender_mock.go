@@ -33,6 +33,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	history "go.temporal.io/server/api/history/v1"
 	namespace "go.temporal.io/server/common/namespace"
 )
 
@@ -59,6 +60,21 @@ func (m *MockNDCHistoryResender) EXPECT() *MockNDCHistoryResenderMockRecorder {
 	return m.recorder
 }
 
+// GetRemoteVersionHistory mocks base method.
+func (m *MockNDCHistoryResender) GetRemoteVersionHistory(ctx context.Context, remoteClusterName string, namespaceID namespace.ID, workflowID, runID string) (*history.VersionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteVersionHistory", ctx, remoteClusterName, namespaceID, workflowID, runID)
+	ret0, _ := ret[0].(*history.VersionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteVersionHistory indicates an expected call of GetRemoteVersionHistory.
+func (mr *MockNDCHistoryResenderMockRecorder) GetRemoteVersionHistory(ctx, remoteClusterName, namespaceID, workflowID, runID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteVersionHistory", reflect.TypeOf((*MockNDCHistoryResender)(nil).GetRemoteVersionHistory), ctx, remoteClusterName, namespaceID, workflowID, runID)
+}
+
 // SendSingleWorkflowHistory mocks base method.
 func (m *MockNDCHistoryResender) SendSingleWorkflowHistory(ctx context.Context, remoteClusterName string, namespaceID namespace.ID, workflowID, runID string, startEventID, startEventVersion, endEventID, endEventVersion int64) error {
 	m.ctrl.T.Helper()
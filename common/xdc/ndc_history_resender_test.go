@@ -297,6 +297,53 @@ func (s *nDCHistoryResenderSuite) TestGetHistory() {
 	s.Equal(response, out)
 }
 
+func (s *nDCHistoryResenderSuite) TestGetRemoteVersionHistory() {
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	versionHistory := &historyspb.VersionHistory{
+		BranchToken: []byte("some random branch token"),
+		Items: []*historyspb.VersionHistoryItem{
+			{EventId: 10, Version: 1},
+			{EventId: 20, Version: 2},
+		},
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.namespaceID).Return(s.namespace, nil)
+	s.mockAdminClient.EXPECT().DescribeMutableState(gomock.Any(), &adminservice.DescribeMutableStateRequest{
+		Namespace: s.namespace.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	}).Return(&adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{versionHistory},
+				},
+			},
+		},
+	}, nil)
+
+	rereplicator := NewNDCHistoryResender(
+		s.mockNamespaceCache,
+		s.mockClientBean,
+		nil,
+		serialization.NewSerializer(),
+		nil,
+		s.logger,
+	)
+	out, err := rereplicator.GetRemoteVersionHistory(
+		context.Background(),
+		cluster.TestAlternativeClusterName,
+		s.namespaceID,
+		workflowID,
+		runID,
+	)
+	s.NoError(err)
+	s.Equal(versionHistory, out)
+}
+
 func (s *nDCHistoryResenderSuite) serializeEvents(events []*historypb.HistoryEvent) *commonpb.DataBlob {
 	blob, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
 	s.Nil(err)
@@ -0,0 +1,2 @@
+ALTER TABLE executions_visibility ADD COLUMN TemporalPausedActivities JSONB GENERATED ALWAYS AS (search_attributes->'TemporalPausedActivities') STORED;
+CREATE INDEX by_temporal_paused_activities ON executions_visibility USING GIN (namespace_id, TemporalPausedActivities jsonb_path_ops);
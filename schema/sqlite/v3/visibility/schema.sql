@@ -27,6 +27,7 @@ CREATE TABLE executions_visibility (
   TemporalSchedulePaused        BOOLEAN       GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalSchedulePaused")),
   TemporalNamespaceDivision     VARCHAR(255)  GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalNamespaceDivision")),
   BuildIds                      TEXT          GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.BuildIds"))              STORED,
+  TemporalPausedActivities      TEXT          GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalPausedActivities")) STORED,
 
   -- Pre-allocated custom search attributes
   Bool01          BOOLEAN         GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.Bool01")),
@@ -124,6 +125,7 @@ CREATE VIRTUAL TABLE executions_visibility_fts_keyword_list USING fts5 (
   TemporalChangeVersion,
   BinaryChecksums,
   BuildIds,
+  TemporalPausedActivities,
   KeywordList01,
   KeywordList02,
   KeywordList03,
@@ -151,6 +153,7 @@ BEGIN
     TemporalChangeVersion,
     BinaryChecksums,
     BuildIds,
+    TemporalPausedActivities,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -159,6 +162,7 @@ BEGIN
     NEW.TemporalChangeVersion,
     NEW.BinaryChecksums,
     NEW.BuildIds,
+    NEW.TemporalPausedActivities,
     NEW.KeywordList01,
     NEW.KeywordList02,
     NEW.KeywordList03
@@ -188,6 +192,7 @@ BEGIN
     TemporalChangeVersion,
     BinaryChecksums,
     BuildIds,
+    TemporalPausedActivities,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -197,6 +202,7 @@ BEGIN
     OLD.TemporalChangeVersion,
     OLD.BinaryChecksums,
     OLD.BuildIds,
+    OLD.TemporalPausedActivities,
     OLD.KeywordList01,
     OLD.KeywordList02,
     OLD.KeywordList03
@@ -237,6 +243,7 @@ BEGIN
     TemporalChangeVersion,
     BinaryChecksums,
     BuildIds,
+    TemporalPausedActivities,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -246,6 +253,7 @@ BEGIN
     OLD.TemporalChangeVersion,
     OLD.BinaryChecksums,
     OLD.BuildIds,
+    OLD.TemporalPausedActivities,
     OLD.KeywordList01,
     OLD.KeywordList02,
     OLD.KeywordList03
@@ -255,6 +263,7 @@ BEGIN
     TemporalChangeVersion,
     BinaryChecksums,
     BuildIds,
+    TemporalPausedActivities,
     KeywordList01,
     KeywordList02,
     KeywordList03
@@ -263,6 +272,7 @@ BEGIN
     NEW.TemporalChangeVersion,
     NEW.BinaryChecksums,
     NEW.BuildIds,
+    NEW.TemporalPausedActivities,
     NEW.KeywordList01,
     NEW.KeywordList02,
     NEW.KeywordList03
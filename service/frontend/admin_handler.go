@@ -25,11 +25,13 @@
 package frontend
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -44,6 +46,8 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
@@ -71,6 +75,8 @@ import (
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
@@ -83,11 +89,13 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
@@ -97,6 +105,9 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	defaultExportWorkflowsPageSize          = 10
+	defaultReplicationStatusSampleSize      = 100
+	maxReplicationStatusSampleSize          = 1000
 )
 
 type (
@@ -114,12 +125,14 @@ type (
 		eventSerializer            serialization.Serializer
 		visibilityMgr              manager.VisibilityManager
 		namespaceReplicationQueue  persistence.NamespaceReplicationQueue
+		namespaceReplicator        namespace.Replicator
 		taskManager                persistence.TaskManager
 		clusterMetadataManager     persistence.ClusterMetadataManager
 		persistenceMetadataManager persistence.MetadataManager
 		clientFactory              serverClient.Factory
 		clientBean                 serverClient.Bean
 		historyClient              historyservice.HistoryServiceClient
+		matchingClient             matchingservice.MatchingServiceClient
 		sdkClientFactory           sdk.ClientFactory
 		membershipMonitor          membership.Monitor
 		hostInfoProvider           membership.HostInfoProvider
@@ -129,6 +142,8 @@ type (
 		saManager                  searchattribute.Manager
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
+		archiverProvider           provider.ArchiverProvider
+		archivalMetadata           archiver.ArchivalMetadata
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -150,6 +165,7 @@ type (
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
+		MatchingClient                      matchingservice.MatchingServiceClient
 		sdkClientFactory                    sdk.ClientFactory
 		MembershipMonitor                   membership.Monitor
 		HostInfoProvider                    membership.HostInfoProvider
@@ -161,6 +177,8 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ArchiverProvider                    provider.ArchiverProvider
+		ArchivalMetadata                    archiver.ArchivalMetadata
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -200,12 +218,14 @@ func NewAdminHandler(
 		ESClient:                    args.EsClient,
 		persistenceExecutionManager: args.PersistenceExecutionManager,
 		namespaceReplicationQueue:   args.NamespaceReplicationQueue,
+		namespaceReplicator:         namespace.NewNamespaceReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
 		taskManager:                 args.TaskManager,
 		clusterMetadataManager:      args.ClusterMetadataManager,
 		persistenceMetadataManager:  args.PersistenceMetadataManager,
 		clientFactory:               args.ClientFactory,
 		clientBean:                  args.ClientBean,
 		historyClient:               args.HistoryClient,
+		matchingClient:              args.MatchingClient,
 		sdkClientFactory:            args.sdkClientFactory,
 		membershipMonitor:           args.MembershipMonitor,
 		hostInfoProvider:            args.HostInfoProvider,
@@ -215,6 +235,8 @@ func NewAdminHandler(
 		saManager:                   args.SaManager,
 		clusterMetadata:             args.ClusterMetadata,
 		healthServer:                args.HealthServer,
+		archiverProvider:            args.ArchiverProvider,
+		archivalMetadata:            args.ArchivalMetadata,
 		taskCategoryRegistry:        args.CategoryRegistry,
 	}
 }
@@ -770,6 +792,44 @@ func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.R
 	return &adminservice.RemoveTaskResponse{}, err
 }
 
+// ListQueueMitigations returns the mitigations a history task queue has applied since the shard was loaded
+func (adh *AdminHandler) ListQueueMitigations(ctx context.Context, request *adminservice.ListQueueMitigationsRequest) (_ *adminservice.ListQueueMitigationsResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.historyClient.ListQueueMitigations(ctx, &historyservice.ListQueueMitigationsRequest{
+		ShardId:  request.GetShardId(),
+		Category: request.GetCategory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListQueueMitigationsResponse{Mitigations: resp.GetMitigations()}, nil
+}
+
+// RevertQueueMitigation moves the tasks moved by a mitigation back to the default reader of a history task queue
+func (adh *AdminHandler) RevertQueueMitigation(ctx context.Context, request *adminservice.RevertQueueMitigationRequest) (_ *adminservice.RevertQueueMitigationResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetMitigationId() == "" {
+		return nil, serviceerror.NewInvalidArgument("Mitigation ID is not set on request.")
+	}
+	_, err := adh.historyClient.RevertQueueMitigation(ctx, &historyservice.RevertQueueMitigationRequest{
+		ShardId:      request.GetShardId(),
+		Category:     request.GetCategory(),
+		MitigationId: request.GetMitigationId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RevertQueueMitigationResponse{}, nil
+}
+
 // GetShard returns information about the internal states of a shard
 func (adh *AdminHandler) GetShard(ctx context.Context, request *adminservice.GetShardRequest) (_ *adminservice.GetShardResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1477,7 +1537,25 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	if request == nil {
 		return nil, errRequestNotSet
 	}
-	resender := xdc.NewNDCHistoryResender(
+	resender := adh.newNDCHistoryResender()
+	if err := resender.SendSingleWorkflowHistory(
+		ctx,
+		request.GetRemoteCluster(),
+		namespace.ID(request.GetNamespaceId()),
+		request.GetWorkflowId(),
+		request.GetRunId(),
+		resendStartEventID,
+		request.StartVersion,
+		common.EmptyEventID,
+		common.EmptyVersion,
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.ResendReplicationTasksResponse{}, nil
+}
+
+func (adh *AdminHandler) newNDCHistoryResender() xdc.NDCHistoryResender {
+	return xdc.NewNDCHistoryResender(
 		adh.namespaceRegistry,
 		adh.clientBean,
 		func(
@@ -1509,20 +1587,6 @@ func (adh *AdminHandler) ResendReplicationTasks(
 		nil,
 		adh.logger,
 	)
-	if err := resender.SendSingleWorkflowHistory(
-		ctx,
-		request.GetRemoteCluster(),
-		namespace.ID(request.GetNamespaceId()),
-		request.GetWorkflowId(),
-		request.GetRunId(),
-		resendStartEventID,
-		request.StartVersion,
-		common.EmptyEventID,
-		common.EmptyVersion,
-	); err != nil {
-		return nil, err
-	}
-	return &adminservice.ResendReplicationTasksResponse{}, nil
 }
 
 // GetTaskQueueTasks returns tasks from task queue
@@ -1560,6 +1624,205 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// ListBacklogTasks returns a filtered page of tasks in the backlog of a task queue partition.
+func (adh *AdminHandler) ListBacklogTasks(
+	ctx context.Context,
+	request *adminservice.ListBacklogTasksRequest,
+) (_ *adminservice.ListBacklogTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	namespaceID, partitionName, err := adh.taskQueuePartition(request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType(), request.GetPartitionId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ListBacklogTasks(ctx, &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     partitionName,
+		TaskQueueType: request.GetTaskQueueType(),
+		BuildId:       request.GetBuildId(),
+		Filter:        request.GetFilter(),
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.ListBacklogTasksResponse{
+		Tasks:         resp.GetTasks(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// DeleteBacklogTasks deletes the given tasks from the backlog of a task queue partition, optionally
+// re-enqueueing them to another task queue first.
+func (adh *AdminHandler) DeleteBacklogTasks(
+	ctx context.Context,
+	request *adminservice.DeleteBacklogTasksRequest,
+) (_ *adminservice.DeleteBacklogTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetTaskIds()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("TaskIds are not set on request.")
+	}
+
+	namespaceID, partitionName, err := adh.taskQueuePartition(request.GetNamespace(), request.GetTaskQueue(), request.GetTaskQueueType(), request.GetPartitionId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.DeleteBacklogTasks(ctx, &matchingservice.DeleteBacklogTasksRequest{
+		NamespaceId:          namespaceID.String(),
+		TaskQueue:            partitionName,
+		TaskQueueType:        request.GetTaskQueueType(),
+		BuildId:              request.GetBuildId(),
+		TaskIds:              request.GetTaskIds(),
+		DestinationTaskQueue: request.GetDestinationTaskQueue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.DeleteBacklogTasksResponse{
+		Results: resp.GetResults(),
+	}, nil
+}
+
+// taskQueuePartition resolves the namespace ID and the RPC name of the given task queue partition.
+func (adh *AdminHandler) taskQueuePartition(
+	namespaceName string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+	partitionID int32,
+) (namespace.ID, string, error) {
+	if taskQueue == "" {
+		return "", "", errTaskQueueNotSet
+	}
+	if taskQueueType != enumspb.TASK_QUEUE_TYPE_WORKFLOW && taskQueueType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return "", "", serviceerror.NewInvalidArgument("TaskQueueType must be workflow or activity.")
+	}
+	if partitionID < 0 {
+		return "", "", errTaskQueuePartitionInvalid
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(namespaceName))
+	if err != nil {
+		return "", "", err
+	}
+	family, err := tqid.NewTaskQueueFamily(namespaceID.String(), taskQueue)
+	if err != nil {
+		return "", "", serviceerror.NewInvalidArgument(err.Error())
+	}
+	return namespaceID, family.TaskQueue(taskQueueType).NormalPartition(int(partitionID)).RpcName(), nil
+}
+
+// RecordWorkerHeartbeat stores the state reported by a worker in the worker registry of the root partition of a task
+// queue. Workers normally attach their state to polls with the worker-heartbeat-bin header instead, which is recorded
+// by the partition receiving the poll.
+func (adh *AdminHandler) RecordWorkerHeartbeat(
+	ctx context.Context,
+	request *adminservice.RecordWorkerHeartbeatRequest,
+) (_ *adminservice.RecordWorkerHeartbeatResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetHeartbeat().GetIdentity() == "" {
+		return nil, serviceerror.NewInvalidArgument("Heartbeat identity is not set on request.")
+	}
+
+	taskQueueTypes := []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}
+	if request.GetTaskQueueType() != enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		taskQueueTypes = []enumspb.TaskQueueType{request.GetTaskQueueType()}
+	}
+	for _, taskQueueType := range taskQueueTypes {
+		namespaceID, partitionName, err := adh.taskQueuePartition(request.GetNamespace(), request.GetTaskQueue(), taskQueueType, 0)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := adh.matchingClient.RecordWorkerHeartbeat(ctx, &matchingservice.RecordWorkerHeartbeatRequest{
+			NamespaceId:   namespaceID.String(),
+			TaskQueue:     partitionName,
+			TaskQueueType: taskQueueType,
+			Heartbeat:     request.GetHeartbeat(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return &adminservice.RecordWorkerHeartbeatResponse{}, nil
+}
+
+// ListWorkers returns the workers known to any partition of a task queue.
+func (adh *AdminHandler) ListWorkers(
+	ctx context.Context,
+	request *adminservice.ListWorkersRequest,
+) (_ *adminservice.ListWorkersResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	workers, err := adh.listWorkers(ctx, request.GetNamespace(), request.GetTaskQueue(), "")
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListWorkersResponse{Workers: workers}, nil
+}
+
+// DescribeWorker returns a single worker of a task queue.
+func (adh *AdminHandler) DescribeWorker(
+	ctx context.Context,
+	request *adminservice.DescribeWorkerRequest,
+) (_ *adminservice.DescribeWorkerResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetIdentity() == "" {
+		return nil, serviceerror.NewInvalidArgument("Identity is not set on request.")
+	}
+
+	workers, err := adh.listWorkers(ctx, request.GetNamespace(), request.GetTaskQueue(), request.GetIdentity())
+	if err != nil {
+		return nil, err
+	}
+	if len(workers) == 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("worker %q not found on task queue %q", request.GetIdentity(), request.GetTaskQueue()))
+	}
+	return &adminservice.DescribeWorkerResponse{Worker: workers[0]}, nil
+}
+
+func (adh *AdminHandler) listWorkers(
+	ctx context.Context,
+	namespaceName string,
+	taskQueue string,
+	identity string,
+) ([]*taskqueuespb.WorkerInfo, error) {
+	namespaceID, partitionName, err := adh.taskQueuePartition(namespaceName, taskQueue, enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.matchingClient.ListWorkers(ctx, &matchingservice.ListWorkersRequest{
+		NamespaceId: namespaceID.String(),
+		TaskQueue:   partitionName,
+		Identity:    identity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetWorkers(), nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -1994,6 +2257,589 @@ func (adh *AdminHandler) ListQueues(
 	}, nil
 }
 
+// PauseActivity stops dispatching and retrying a pending activity until it is unpaused.
+func (adh *AdminHandler) PauseActivity(
+	ctx context.Context,
+	request *adminservice.PauseActivityRequest,
+) (_ *adminservice.PauseActivityResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetActivityId() == "" {
+		return nil, errActivityIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.PauseActivity(ctx, &historyservice.PauseActivityRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ActivityId:  request.GetActivityId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseActivityResponse{}, nil
+}
+
+// UnpauseActivity resumes a paused activity, optionally restarting its retry policy from the first attempt.
+func (adh *AdminHandler) UnpauseActivity(
+	ctx context.Context,
+	request *adminservice.UnpauseActivityRequest,
+) (_ *adminservice.UnpauseActivityResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	if request.GetActivityId() == "" {
+		return nil, errActivityIDNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.UnpauseActivity(ctx, &historyservice.UnpauseActivityRequest{
+		NamespaceId:   namespaceID.String(),
+		Execution:     request.Execution,
+		ActivityId:    request.GetActivityId(),
+		ResetAttempts: request.GetResetAttempts(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseActivityResponse{}, nil
+}
+
+// SuspendWorkflowExecution stops dispatching workflow tasks, user timers and activities of a workflow execution and
+// buffers its incoming signals until it is resumed.
+func (adh *AdminHandler) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.SuspendWorkflowExecutionRequest,
+) (_ *adminservice.SuspendWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.SuspendWorkflowExecution(ctx, &historyservice.SuspendWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Reason:      request.GetReason(),
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SuspendWorkflowExecutionResponse{}, nil
+}
+
+// ResumeWorkflowExecution resumes a suspended workflow execution.
+func (adh *AdminHandler) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ResumeWorkflowExecutionRequest,
+) (_ *adminservice.ResumeWorkflowExecutionResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = adh.historyClient.ResumeWorkflowExecution(ctx, &historyservice.ResumeWorkflowExecutionRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Identity:    request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ResumeWorkflowExecutionResponse{}, nil
+}
+
+// ExportWorkflowExecutions returns one page of the executions matching a visibility query with their mutable state.
+func (adh *AdminHandler) ExportWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionsRequest,
+) (_ *adminservice.ExportWorkflowExecutionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	nsName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(nsName)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultExportWorkflowsPageSize
+	}
+
+	listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   namespaceID,
+		Namespace:     nsName,
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+		Query:         request.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]*adminservice.WorkflowExecutionExport, 0, len(listResp.Executions))
+	for _, executionInfo := range listResp.Executions {
+		export, err := adh.exportWorkflowExecution(ctx, namespaceID, executionInfo.GetExecution())
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				// the execution was deleted after it was listed
+				continue
+			}
+			return nil, err
+		}
+		export.Namespace = nsName.String()
+		executions = append(executions, export)
+	}
+	return &adminservice.ExportWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: listResp.NextPageToken,
+	}, nil
+}
+
+func (adh *AdminHandler) exportWorkflowExecution(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+) (*adminservice.WorkflowExecutionExport, error) {
+	msResp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.WorkflowExecutionExport{
+		Execution:    execution,
+		MutableState: msResp.GetDatabaseMutableState(),
+	}, nil
+}
+
+// DescribeShardPlacement returns the owner, placement and load of each history shard.
+func (adh *AdminHandler) DescribeShardPlacement(
+	ctx context.Context,
+	request *adminservice.DescribeShardPlacementRequest,
+) (_ *adminservice.DescribeShardPlacementResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	placements, err := persistence.GetShardPlacements(ctx, adh.clusterMetadataManager)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[int32]string, adh.numberOfHistoryShards)
+	loads := make(map[int32]*adminservice.ShardLoad, adh.numberOfHistoryShards)
+	for _, member := range resolver.Members() {
+		resp, err := adh.historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+			HostAddress: member.GetAddress(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, shardID := range resp.GetShardIds() {
+			owners[shardID] = resp.GetAddress()
+		}
+		for _, load := range resp.GetShardLoads() {
+			loads[load.GetShardId()] = load
+		}
+	}
+
+	shards := make([]*adminservice.ShardPlacementInfo, 0, adh.numberOfHistoryShards)
+	for shardID := int32(1); shardID <= adh.numberOfHistoryShards; shardID++ {
+		hashOwner, err := resolver.Lookup(convert.Int32ToString(shardID))
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, &adminservice.ShardPlacementInfo{
+			ShardId:   shardID,
+			Owner:     owners[shardID],
+			HashOwner: hashOwner.Identity(),
+			Placement: placements[shardID],
+			Load:      loads[shardID],
+		})
+	}
+	return &adminservice.DescribeShardPlacementResponse{
+		Shards: shards,
+	}, nil
+}
+
+// UpdateShardPlacement pins a history shard to a host, or removes its placement.
+func (adh *AdminHandler) UpdateShardPlacement(
+	ctx context.Context,
+	request *adminservice.UpdateShardPlacementRequest,
+) (_ *adminservice.UpdateShardPlacementResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	shardID := request.GetShardId()
+	if shardID <= 0 || shardID > adh.numberOfHistoryShards {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("shard id must be between 1 and %v", adh.numberOfHistoryShards))
+	}
+
+	owner := request.GetOwner()
+	if len(owner) != 0 {
+		resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+		if err != nil {
+			return nil, err
+		}
+		isMember := false
+		for _, member := range resolver.Members() {
+			if member.Identity() == owner {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("%v is not a history host", owner))
+		}
+	}
+
+	if err := persistence.UpdateShardPlacements(ctx, adh.clusterMetadataManager, func(
+		placements map[int32]*persistencespb.ShardPlacement,
+	) error {
+		if len(owner) == 0 {
+			delete(placements, shardID)
+			return nil
+		}
+		placements[shardID] = &persistencespb.ShardPlacement{
+			Owner:      owner,
+			Pinned:     true,
+			UpdateTime: timestamppb.Now(),
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateShardPlacementResponse{}, nil
+}
+
+// GetNamespaceReplicationFilters returns the filters excluding workflow executions of a namespace from replication.
+func (adh *AdminHandler) GetNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.GetNamespaceReplicationFiltersRequest,
+) (_ *adminservice.GetNamespaceReplicationFiltersResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil || len(request.GetNamespace()) == 0 {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetNamespaceReplicationFiltersResponse{
+		Filters: resp.Namespace.GetReplicationConfig().GetReplicationFilters(),
+	}, nil
+}
+
+// UpdateNamespaceReplicationFilters replaces the filters excluding workflow executions of a namespace from replication.
+func (adh *AdminHandler) UpdateNamespaceReplicationFilters(
+	ctx context.Context,
+	request *adminservice.UpdateNamespaceReplicationFiltersRequest,
+) (_ *adminservice.UpdateNamespaceReplicationFiltersResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil || len(request.GetNamespace()) == 0 {
+		return nil, errRequestNotSet
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.IsGlobalNamespace {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("namespace %v is not a global namespace", request.GetNamespace()))
+	}
+	// filters are evaluated by the active cluster, and replicated to the standby clusters with the namespace
+	replicationConfig := resp.Namespace.ReplicationConfig
+	currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+	if replicationConfig.GetActiveClusterName() != currentClusterName {
+		return nil, serviceerror.NewNamespaceNotActive(request.GetNamespace(), currentClusterName, replicationConfig.GetActiveClusterName())
+	}
+
+	filters := request.GetFilters()
+	if err := namespace.ValidateReplicationFilters(filters, replicationConfig.GetClusters()); err != nil {
+		return nil, err
+	}
+	// filters are evaluated against the search attributes of the executions, which are keyed by field name
+	aliasToField := util.InverseMap(resp.Namespace.Config.GetCustomSearchAttributeAliases())
+	for _, filter := range filters {
+		if fieldName, ok := aliasToField[filter.SearchAttributeName]; ok {
+			filter.SearchAttributeName = fieldName
+		}
+	}
+
+	replicationConfig.ReplicationFilters = filters
+	resp.Namespace.ConfigVersion++
+	if err := adh.persistenceMetadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace:           resp.Namespace,
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	}); err != nil {
+		return nil, err
+	}
+	if err := adh.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		resp.Namespace.Info,
+		resp.Namespace.Config,
+		replicationConfig,
+		resp.Namespace.OutgoingServices,
+		false,
+		resp.Namespace.ConfigVersion,
+		resp.Namespace.FailoverVersion,
+		resp.IsGlobalNamespace,
+		replicationConfig.FailoverHistory,
+	); err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateNamespaceReplicationFiltersResponse{}, nil
+}
+
+// GetReplicationStatus returns the replication backlog of each history shard for the remote clusters, and compares
+// the version histories of sampled executions with the remote clusters.
+func (adh *AdminHandler) GetReplicationStatus(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+) (_ *adminservice.GetReplicationStatusResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetSampleSize() < 0 || request.GetSampleSize() > maxReplicationStatusSampleSize {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("sample size must be between 0 and %d", maxReplicationStatusSampleSize))
+	}
+
+	historyResp, err := adh.historyClient.GetReplicationStatus(ctx, &historyservice.GetReplicationStatusRequest{
+		RemoteClusters: request.GetRemoteClusters(),
+		IncludeBacklog: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+	resp := &adminservice.GetReplicationStatusResponse{}
+	for _, shard := range historyResp.GetShards() {
+		shardStatus := &adminservice.ShardReplicationStatus{
+			ShardId:                          shard.GetShardId(),
+			MaxReplicationTaskId:             shard.GetMaxReplicationTaskId(),
+			MaxReplicationTaskVisibilityTime: shard.GetMaxReplicationTaskVisibilityTime(),
+			ShardLocalTime:                   shard.GetShardLocalTime(),
+			RemoteClusters:                   make(map[string]*adminservice.ShardReplicationStatusPerCluster),
+		}
+		for clusterName, clusterStatus := range shard.GetRemoteClusters() {
+			if clusterName == currentClusterName {
+				continue
+			}
+			shardStatus.RemoteClusters[clusterName] = &adminservice.ShardReplicationStatusPerCluster{
+				AckedTaskId:             clusterStatus.GetAckedTaskId(),
+				AckedTaskVisibilityTime: clusterStatus.GetAckedTaskVisibilityTime(),
+				OutstandingTaskCount:    clusterStatus.GetOutstandingTaskCount(),
+				OldestUnackedTaskAge:    clusterStatus.GetOldestUnackedTaskAge(),
+				DlqSize:                 clusterStatus.GetDlqSize(),
+				BacklogTruncated:        clusterStatus.GetBacklogTruncated(),
+			}
+		}
+		resp.Shards = append(resp.Shards, shardStatus)
+	}
+	slices.SortFunc(resp.Shards, func(a, b *adminservice.ShardReplicationStatus) int {
+		return cmp.Compare(a.GetShardId(), b.GetShardId())
+	})
+
+	if request.GetNamespace() == "" {
+		return resp, nil
+	}
+	resp.SampledExecutions, resp.Divergences, err = adh.sampleReplicationDivergences(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sampleReplicationDivergences compares the current version history of open executions of the namespace with the
+// one of the remote clusters, and returns the number of sampled executions with the ones not in sync.
+func (adh *AdminHandler) sampleReplicationDivergences(
+	ctx context.Context,
+	request *adminservice.GetReplicationStatusRequest,
+) (int32, []*adminservice.ReplicationDivergence, error) {
+	nsName := namespace.Name(request.GetNamespace())
+	ns, err := adh.namespaceRegistry.GetNamespace(nsName)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !ns.IsGlobalNamespace() {
+		return 0, nil, serviceerror.NewInvalidArgument(fmt.Sprintf("namespace %s is not a global namespace", nsName))
+	}
+	currentClusterName := adh.clusterMetadata.GetCurrentClusterName()
+	var remoteClusters []string
+	for _, clusterName := range ns.ClusterNames() {
+		if clusterName == currentClusterName {
+			continue
+		}
+		if len(request.GetRemoteClusters()) > 0 && !slices.Contains(request.GetRemoteClusters(), clusterName) {
+			continue
+		}
+		remoteClusters = append(remoteClusters, clusterName)
+	}
+	sampleSize := int(request.GetSampleSize())
+	if sampleSize == 0 {
+		sampleSize = defaultReplicationStatusSampleSize
+	}
+
+	listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: ns.ID(),
+		Namespace:   nsName,
+		PageSize:    sampleSize,
+		Query:       fmt.Sprintf("%s = '%s'", searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()),
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resender := adh.newNDCHistoryResender()
+	var sampled int32
+	var divergences []*adminservice.ReplicationDivergence
+	for _, executionInfo := range listResp.Executions {
+		execution := executionInfo.GetExecution()
+		msResp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+			NamespaceId: ns.ID().String(),
+			Execution:   execution,
+		})
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				// the execution was deleted after it was listed
+				continue
+			}
+			return 0, nil, err
+		}
+		localVersionHistory, err := versionhistory.GetCurrentVersionHistory(
+			msResp.GetDatabaseMutableState().GetExecutionInfo().GetVersionHistories(),
+		)
+		if err != nil {
+			return 0, nil, err
+		}
+		sampled++
+
+		for _, clusterName := range remoteClusters {
+			divergence := &adminservice.ReplicationDivergence{
+				RemoteCluster:       clusterName,
+				Execution:           execution,
+				LocalVersionHistory: localVersionHistory,
+			}
+			remoteVersionHistory, err := resender.GetRemoteVersionHistory(
+				ctx,
+				clusterName,
+				ns.ID(),
+				execution.GetWorkflowId(),
+				execution.GetRunId(),
+			)
+			var notFound *serviceerror.NotFound
+			switch {
+			case errors.As(err, &notFound):
+				divergence.Type = enumsspb.REPLICATION_DIVERGENCE_TYPE_MISSING_ON_REMOTE
+			case err != nil:
+				divergence.Error = err.Error()
+			default:
+				divergence.RemoteVersionHistory = remoteVersionHistory
+				divergence.Type = compareReplicatedVersionHistories(localVersionHistory, remoteVersionHistory)
+			}
+			if divergence.Type != enumsspb.REPLICATION_DIVERGENCE_TYPE_IN_SYNC {
+				divergences = append(divergences, divergence)
+			}
+		}
+	}
+	return sampled, divergences, nil
+}
+
+// compareReplicatedVersionHistories tells whether the remote copy of an execution is in sync with the local one.
+func compareReplicatedVersionHistories(
+	local *historyspb.VersionHistory,
+	remote *historyspb.VersionHistory,
+) enumsspb.ReplicationDivergenceType {
+	localLastItem, err := versionhistory.GetLastVersionHistoryItem(local)
+	if err != nil {
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_DIVERGED
+	}
+	remoteLastItem, err := versionhistory.GetLastVersionHistoryItem(remote)
+	if err != nil {
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_DIVERGED
+	}
+	lcaItem, err := versionhistory.FindLCAVersionHistoryItem(local, remote)
+	if err != nil {
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_DIVERGED
+	}
+
+	switch {
+	case versionhistory.IsEqualVersionHistoryItem(localLastItem, remoteLastItem):
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_IN_SYNC
+	case versionhistory.IsEqualVersionHistoryItem(lcaItem, remoteLastItem):
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND
+	case versionhistory.IsEqualVersionHistoryItem(lcaItem, localLastItem):
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_LOCAL_BEHIND
+	default:
+		return enumsspb.REPLICATION_DIVERGENCE_TYPE_DIVERGED
+	}
+}
+
 func (adh *AdminHandler) getDLQWorkflowID(
 	key *commonspb.HistoryDLQKey,
 ) string {
@@ -2047,3 +2893,59 @@ func convertFailoverHistoryToReplicationProto(
 
 	return replicationProto
 }
+
+// GetArchivedMutableState returns the final mutable state of a workflow execution archived with its history.
+func (adh *AdminHandler) GetArchivedMutableState(
+	ctx context.Context,
+	request *adminservice.GetArchivedMutableStateRequest,
+) (_ *adminservice.GetArchivedMutableStateResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+	if request.GetExecution().GetRunId() == "" {
+		return nil, errRunIDNotSet
+	}
+	if !adh.archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() {
+		return nil, errClusterIsNotConfiguredForReadingArchivalHistory
+	}
+
+	ns, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	URIString := ns.HistoryArchivalState().URI
+	if URIString == "" {
+		// if URI is empty, it means the namespace has never enabled for archival.
+		return nil, errNamespaceIsNotConfiguredForHistoryArchival
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return nil, err
+	}
+
+	historyArchiver, err := adh.archiverProvider.GetHistoryArchiver(URI.Scheme(), string(primitives.FrontendService))
+	if err != nil {
+		return nil, err
+	}
+	snapshotArchiver, ok := historyArchiver.(archiver.MutableStateSnapshotArchiver)
+	if !ok {
+		return nil, serviceerror.NewUnimplemented(
+			fmt.Sprintf("History archiver for scheme %s does not support mutable state snapshots.", URI.Scheme()),
+		)
+	}
+
+	snapshot, err := snapshotArchiver.GetMutableStateSnapshot(ctx, URI, &archiver.GetMutableStateSnapshotRequest{
+		NamespaceID: ns.ID().String(),
+		WorkflowID:  request.GetExecution().GetWorkflowId(),
+		RunID:       request.GetExecution().GetRunId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetArchivedMutableStateResponse{Snapshot: snapshot}, nil
+}
@@ -31,6 +31,7 @@ import (
 	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -49,14 +50,22 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	archiverspb "go.temporal.io/server/api/archiver/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -160,6 +169,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
+		s.mockResource.GetMatchingClient(),
 		s.mockResource.GetSDKClientFactory(),
 		s.mockResource.GetMembershipMonitor(),
 		s.mockResource.GetHostInfoProvider(),
@@ -171,6 +181,8 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockResource.GetArchiverProvider(),
+		s.mockResource.GetArchivalMetadata(),
 		s.mockResource.GetExecutionManager(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 	}
@@ -1668,3 +1680,567 @@ func (s *adminHandlerSuite) TestListQueues_Err() {
 	_, err := s.handler.ListQueues(context.Background(), &adminservice.ListQueuesRequest{})
 	s.ErrorIs(err, assert.AnError)
 }
+
+func (s *adminHandlerSuite) TestListBacklogTasks() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	filter := &taskqueuespb.BacklogTaskFilter{WorkflowId: "some random workflow ID"}
+	task := &persistencespb.AllocatedTaskInfo{TaskId: 1234, Data: &persistencespb.TaskInfo{WorkflowId: filter.WorkflowId}}
+	s.mockResource.MatchingClient.EXPECT().ListBacklogTasks(gomock.Any(), &matchingservice.ListBacklogTasksRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     "/_sys/some random task queue/2",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Filter:        filter,
+		PageSize:      10,
+	}).Return(&matchingservice.ListBacklogTasksResponse{
+		Tasks:         []*persistencespb.AllocatedTaskInfo{task},
+		NextPageToken: []byte("token"),
+	}, nil)
+
+	resp, err := s.handler.ListBacklogTasks(context.Background(), &adminservice.ListBacklogTasksRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "some random task queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		PartitionId:   2,
+		Filter:        filter,
+		PageSize:      10,
+	})
+	s.NoError(err)
+	protorequire.ProtoSliceEqual(s.T(), []*persistencespb.AllocatedTaskInfo{task}, resp.GetTasks())
+	s.Equal([]byte("token"), resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) TestDeleteBacklogTasks() {
+	results := []*taskqueuespb.BacklogTaskDeletionResult{
+		{TaskId: 1, Status: enumsspb.BACKLOG_TASK_DELETION_STATUS_DELETED},
+		{TaskId: 2, Status: enumsspb.BACKLOG_TASK_DELETION_STATUS_NOT_FOUND},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().DeleteBacklogTasks(gomock.Any(), &matchingservice.DeleteBacklogTasksRequest{
+		NamespaceId:          s.namespaceID.String(),
+		TaskQueue:            "some random task queue",
+		TaskQueueType:        enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskIds:              []int64{1, 2},
+		DestinationTaskQueue: "another task queue",
+	}).Return(&matchingservice.DeleteBacklogTasksResponse{
+		Results: results,
+	}, nil)
+
+	resp, err := s.handler.DeleteBacklogTasks(context.Background(), &adminservice.DeleteBacklogTasksRequest{
+		Namespace:            s.namespace.String(),
+		TaskQueue:            "some random task queue",
+		TaskQueueType:        enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskIds:              []int64{1, 2},
+		DestinationTaskQueue: "another task queue",
+	})
+	s.NoError(err)
+	protorequire.ProtoSliceEqual(s.T(), results, resp.GetResults())
+}
+
+func (s *adminHandlerSuite) TestDeleteBacklogTasks_InvalidRequest() {
+	_, err := s.handler.DeleteBacklogTasks(context.Background(), &adminservice.DeleteBacklogTasksRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "some random task queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.handler.DeleteBacklogTasks(context.Background(), &adminservice.DeleteBacklogTasksRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "some random task queue",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_NEXUS,
+		TaskIds:       []int64{1},
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestRecordWorkerHeartbeat() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	heartbeat := &taskqueuespb.WorkerHeartbeat{Identity: "worker@host", UsedSlots: 3}
+	for _, taskQueueType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		s.mockResource.MatchingClient.EXPECT().RecordWorkerHeartbeat(gomock.Any(), &matchingservice.RecordWorkerHeartbeatRequest{
+			NamespaceId:   s.namespaceID.String(),
+			TaskQueue:     "some random task queue",
+			TaskQueueType: taskQueueType,
+			Heartbeat:     heartbeat,
+		}).Return(&matchingservice.RecordWorkerHeartbeatResponse{}, nil)
+	}
+
+	_, err := s.handler.RecordWorkerHeartbeat(context.Background(), &adminservice.RecordWorkerHeartbeatRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "some random task queue",
+		Heartbeat: heartbeat,
+	})
+	s.NoError(err)
+
+	_, err = s.handler.RecordWorkerHeartbeat(context.Background(), &adminservice.RecordWorkerHeartbeatRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "some random task queue",
+		Heartbeat: &taskqueuespb.WorkerHeartbeat{},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestDescribeWorker() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	worker := &taskqueuespb.WorkerInfo{
+		Heartbeat:      &taskqueuespb.WorkerHeartbeat{Identity: "worker@host"},
+		TaskQueueTypes: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+	}
+	s.mockResource.MatchingClient.EXPECT().ListWorkers(gomock.Any(), &matchingservice.ListWorkersRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "some random task queue",
+		Identity:    "worker@host",
+	}).Return(&matchingservice.ListWorkersResponse{Workers: []*taskqueuespb.WorkerInfo{worker}}, nil)
+	s.mockResource.MatchingClient.EXPECT().ListWorkers(gomock.Any(), &matchingservice.ListWorkersRequest{
+		NamespaceId: s.namespaceID.String(),
+		TaskQueue:   "some random task queue",
+		Identity:    "another worker",
+	}).Return(&matchingservice.ListWorkersResponse{}, nil)
+
+	resp, err := s.handler.DescribeWorker(context.Background(), &adminservice.DescribeWorkerRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "some random task queue",
+		Identity:  "worker@host",
+	})
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), worker, resp.GetWorker())
+
+	_, err = s.handler.DescribeWorker(context.Background(), &adminservice.DescribeWorkerRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "some random task queue",
+		Identity:  "another worker",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *adminHandlerSuite) TestPauseAndUnpauseActivity() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	execution := &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()}
+	s.mockHistoryClient.EXPECT().PauseActivity(gomock.Any(), &historyservice.PauseActivityRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		ActivityId:  "activity",
+	}).Return(&historyservice.PauseActivityResponse{}, nil)
+	s.mockHistoryClient.EXPECT().UnpauseActivity(gomock.Any(), &historyservice.UnpauseActivityRequest{
+		NamespaceId:   s.namespaceID.String(),
+		Execution:     execution,
+		ActivityId:    "activity",
+		ResetAttempts: true,
+	}).Return(&historyservice.UnpauseActivityResponse{}, nil)
+
+	_, err := s.handler.PauseActivity(context.Background(), &adminservice.PauseActivityRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		ActivityId: "activity",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.UnpauseActivity(context.Background(), &adminservice.UnpauseActivityRequest{
+		Namespace:     s.namespace.String(),
+		Execution:     execution,
+		ActivityId:    "activity",
+		ResetAttempts: true,
+	})
+	s.NoError(err)
+
+	_, err = s.handler.PauseActivity(context.Background(), &adminservice.PauseActivityRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestSuspendAndResumeWorkflowExecution() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	execution := &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()}
+	s.mockHistoryClient.EXPECT().SuspendWorkflowExecution(gomock.Any(), &historyservice.SuspendWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		Reason:      "maintenance",
+		Identity:    "operator",
+	}).Return(&historyservice.SuspendWorkflowExecutionResponse{}, nil)
+	s.mockHistoryClient.EXPECT().ResumeWorkflowExecution(gomock.Any(), &historyservice.ResumeWorkflowExecutionRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+		Identity:    "operator",
+	}).Return(&historyservice.ResumeWorkflowExecutionResponse{}, nil)
+
+	_, err := s.handler.SuspendWorkflowExecution(context.Background(), &adminservice.SuspendWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Reason:    "maintenance",
+		Identity:  "operator",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.ResumeWorkflowExecution(context.Background(), &adminservice.ResumeWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+		Identity:  "operator",
+	})
+	s.NoError(err)
+
+	_, err = s.handler.SuspendWorkflowExecution(context.Background(), &adminservice.SuspendWorkflowExecutionRequest{
+		Namespace: s.namespace.String(),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestListAndRevertQueueMitigations() {
+	mitigationID := "workflow:" + s.namespaceID.String() + "/some random workflow ID"
+	mitigations := []*historyspb.QueueMitigation{
+		{Id: mitigationID, Action: "workflow-slow-lane", CreateTime: timestamppb.Now()},
+	}
+	s.mockHistoryClient.EXPECT().ListQueueMitigations(gomock.Any(), &historyservice.ListQueueMitigationsRequest{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTransfer),
+	}).Return(&historyservice.ListQueueMitigationsResponse{Mitigations: mitigations}, nil)
+	s.mockHistoryClient.EXPECT().RevertQueueMitigation(gomock.Any(), &historyservice.RevertQueueMitigationRequest{
+		ShardId:      1,
+		Category:     int32(tasks.CategoryIDTransfer),
+		MitigationId: mitigationID,
+	}).Return(&historyservice.RevertQueueMitigationResponse{}, nil)
+
+	listResp, err := s.handler.ListQueueMitigations(context.Background(), &adminservice.ListQueueMitigationsRequest{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTransfer),
+	})
+	s.NoError(err)
+	s.Equal(mitigations, listResp.GetMitigations())
+
+	_, err = s.handler.RevertQueueMitigation(context.Background(), &adminservice.RevertQueueMitigationRequest{
+		ShardId:      1,
+		Category:     int32(tasks.CategoryIDTransfer),
+		MitigationId: mitigationID,
+	})
+	s.NoError(err)
+
+	_, err = s.handler.RevertQueueMitigation(context.Background(), &adminservice.RevertQueueMitigationRequest{
+		ShardId:  1,
+		Category: int32(tasks.CategoryIDTransfer),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestExportWorkflowExecutions() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	execution := &commonpb.WorkflowExecution{WorkflowId: "some random workflow ID", RunId: uuid.New()}
+	deletedExecution := &commonpb.WorkflowExecution{WorkflowId: "deleted workflow ID", RunId: uuid.New()}
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    defaultExportWorkflowsPageSize,
+		Query:       "WorkflowType = 'some-type'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: execution},
+			{Execution: deletedExecution},
+		},
+		NextPageToken: []byte("next-page"),
+	}, nil)
+
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			VersionHistories: &historyspb.VersionHistories{
+				Histories: []*historyspb.VersionHistory{{
+					BranchToken: []byte("branch-token"),
+					Items:       []*historyspb.VersionHistoryItem{{EventId: 3, Version: 1}},
+				}},
+			},
+		},
+	}
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   execution,
+	}).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: mutableState}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), &historyservice.DescribeMutableStateRequest{
+		NamespaceId: s.namespaceID.String(),
+		Execution:   deletedExecution,
+	}).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	resp, err := s.handler.ExportWorkflowExecutions(context.Background(), &adminservice.ExportWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowType = 'some-type'",
+	})
+	s.NoError(err)
+	s.Equal([]byte("next-page"), resp.NextPageToken)
+	s.Len(resp.Executions, 1)
+	export := resp.Executions[0]
+	s.Equal(s.namespace.String(), export.Namespace)
+	protorequire.ProtoEqual(s.T(), execution, export.Execution)
+	protorequire.ProtoEqual(s.T(), mutableState, export.MutableState)
+	s.Empty(export.Branches, "history is read separately, one page at a time")
+}
+
+func (s *adminHandlerSuite) TestUpdateNamespaceReplicationFilters() {
+	currentClusterName := s.mockMetadata.GetCurrentClusterName()
+	getNamespaceResponse := func(activeClusterName string) *persistence.GetNamespaceResponse {
+		return &persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info: &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+				Config: &persistencespb.NamespaceConfig{
+					CustomSearchAttributeAliases: map[string]string{"Keyword01": "Tenant"},
+				},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: activeClusterName,
+					Clusters:          []string{activeClusterName, "standby"},
+				},
+				ConfigVersion: 3,
+			},
+			IsGlobalNamespace: true,
+		}
+	}
+	request := &adminservice.UpdateNamespaceReplicationFiltersRequest{
+		Namespace: s.namespace.String(),
+		Filters: []*persistencespb.ReplicationFilter{
+			{TargetClusters: []string{"standby"}, SearchAttributeName: "Tenant", SearchAttributeValues: []string{"eu"}},
+		},
+	}
+
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(getNamespaceResponse(currentClusterName), nil)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(7), request.NotificationVersion)
+			s.Equal(int64(4), request.Namespace.ConfigVersion)
+			filters := request.Namespace.ReplicationConfig.ReplicationFilters
+			s.Len(filters, 1)
+			// search attribute aliases are stored as field names
+			s.Equal("Keyword01", filters[0].SearchAttributeName)
+			return nil
+		},
+	)
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			attributes := task.GetNamespaceTaskAttributes()
+			s.Equal(enumsspb.NAMESPACE_OPERATION_UPDATE, attributes.GetNamespaceOperation())
+			s.Equal(int64(4), attributes.GetConfigVersion())
+			s.Len(attributes.GetReplicationFilters(), 1, "filters should be replicated to the standby clusters")
+			return nil
+		},
+	)
+	_, err := s.handler.UpdateNamespaceReplicationFilters(context.Background(), request)
+	s.NoError(err)
+
+	// filters can only be updated on the active cluster
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 8}, nil)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(getNamespaceResponse("other-cluster"), nil)
+	_, err = s.handler.UpdateNamespaceReplicationFilters(context.Background(), request)
+	var notActive *serviceerror.NamespaceNotActive
+	s.ErrorAs(err, &notActive)
+
+	_, err = s.handler.UpdateNamespaceReplicationFilters(context.Background(), &adminservice.UpdateNamespaceReplicationFiltersRequest{})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) TestGetReplicationStatus() {
+	currentClusterName := s.mockMetadata.GetCurrentClusterName()
+	s.mockHistoryClient.EXPECT().GetReplicationStatus(gomock.Any(), &historyservice.GetReplicationStatusRequest{
+		IncludeBacklog: true,
+	}).Return(&historyservice.GetReplicationStatusResponse{
+		Shards: []*historyservice.ShardReplicationStatus{
+			{
+				ShardId:              2,
+				MaxReplicationTaskId: 20,
+				RemoteClusters: map[string]*historyservice.ShardReplicationStatusPerCluster{
+					"remote": {
+						AckedTaskId:          10,
+						OutstandingTaskCount: 3,
+						OldestUnackedTaskAge: durationpb.New(time.Minute),
+						DlqSize:              1,
+					},
+					currentClusterName: {AckedTaskId: 20},
+				},
+			},
+			{ShardId: 1, MaxReplicationTaskId: 5},
+		},
+	}, nil)
+
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		nil,
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: currentClusterName,
+			Clusters:          []string{currentClusterName, "remote"},
+		},
+		1,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.namespaceID).Return(s.namespace, nil).AnyTimes()
+
+	inSync := &commonpb.WorkflowExecution{WorkflowId: "in-sync", RunId: uuid.New()}
+	behind := &commonpb.WorkflowExecution{WorkflowId: "behind", RunId: uuid.New()}
+	missing := &commonpb.WorkflowExecution{WorkflowId: "missing", RunId: uuid.New()}
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    defaultReplicationStatusSampleSize,
+		Query:       "ExecutionStatus = 'Running'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: inSync},
+			{Execution: behind},
+			{Execution: missing},
+		},
+	}, nil)
+
+	mutableState := func(items ...*historyspb.VersionHistoryItem) *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				VersionHistories: &historyspb.VersionHistories{
+					Histories: []*historyspb.VersionHistory{{Items: items}},
+				},
+			},
+		}
+	}
+	localMutableState := mutableState(&historyspb.VersionHistoryItem{EventId: 10, Version: 1})
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeMutableStateResponse{DatabaseMutableState: localMutableState}, nil,
+	).Times(3)
+	s.mockResource.RemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.DescribeMutableStateRequest, _ ...interface{}) (*adminservice.DescribeMutableStateResponse, error) {
+			s.Equal(s.namespace.String(), request.Namespace)
+			switch request.Execution.WorkflowId {
+			case inSync.WorkflowId:
+				return &adminservice.DescribeMutableStateResponse{DatabaseMutableState: localMutableState}, nil
+			case behind.WorkflowId:
+				return &adminservice.DescribeMutableStateResponse{
+					DatabaseMutableState: mutableState(&historyspb.VersionHistoryItem{EventId: 5, Version: 1}),
+				}, nil
+			default:
+				return nil, serviceerror.NewNotFound("workflow not found")
+			}
+		},
+	).Times(3)
+
+	resp, err := s.handler.GetReplicationStatus(context.Background(), &adminservice.GetReplicationStatusRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.Shards, 2)
+	s.Equal(int32(1), resp.Shards[0].ShardId)
+	s.Equal(int32(2), resp.Shards[1].ShardId)
+	s.Len(resp.Shards[1].RemoteClusters, 1)
+	remoteStatus := resp.Shards[1].RemoteClusters["remote"]
+	s.Equal(int64(10), remoteStatus.AckedTaskId)
+	s.Equal(int64(3), remoteStatus.OutstandingTaskCount)
+	s.Equal(time.Minute, remoteStatus.OldestUnackedTaskAge.AsDuration())
+	s.Equal(int64(1), remoteStatus.DlqSize)
+
+	s.Equal(int32(3), resp.SampledExecutions)
+	s.Len(resp.Divergences, 2)
+	s.Equal(behind.WorkflowId, resp.Divergences[0].Execution.WorkflowId)
+	s.Equal(enumsspb.REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND, resp.Divergences[0].Type)
+	s.Equal(missing.WorkflowId, resp.Divergences[1].Execution.WorkflowId)
+	s.Equal(enumsspb.REPLICATION_DIVERGENCE_TYPE_MISSING_ON_REMOTE, resp.Divergences[1].Type)
+}
+
+func (s *adminHandlerSuite) TestCompareReplicatedVersionHistories() {
+	versionHistory := func(items ...*historyspb.VersionHistoryItem) *historyspb.VersionHistory {
+		return &historyspb.VersionHistory{Items: items}
+	}
+	local := versionHistory(
+		&historyspb.VersionHistoryItem{EventId: 10, Version: 1},
+		&historyspb.VersionHistoryItem{EventId: 20, Version: 2},
+	)
+
+	s.Equal(enumsspb.REPLICATION_DIVERGENCE_TYPE_IN_SYNC, compareReplicatedVersionHistories(local, local))
+	s.Equal(enumsspb.REPLICATION_DIVERGENCE_TYPE_REMOTE_BEHIND, compareReplicatedVersionHistories(
+		local,
+		versionHistory(&historyspb.VersionHistoryItem{EventId: 8, Version: 1}),
+	))
+	s.Equal(enumsspb.REPLICATION_DIVERGENCE_TYPE_LOCAL_BEHIND, compareReplicatedVersionHistories(
+		local,
+		versionHistory(
+			&historyspb.VersionHistoryItem{EventId: 10, Version: 1},
+			&historyspb.VersionHistoryItem{EventId: 25, Version: 2},
+		),
+	))
+	s.Equal(enumsspb.REPLICATION_DIVERGENCE_TYPE_DIVERGED, compareReplicatedVersionHistories(
+		local,
+		versionHistory(
+			&historyspb.VersionHistoryItem{EventId: 12, Version: 1},
+			&historyspb.VersionHistoryItem{EventId: 15, Version: 3},
+		),
+	))
+}
+
+func (s *adminHandlerSuite) TestGetArchivedMutableState() {
+	ctx := context.Background()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	historyArchivalURI := "test:///history/archival"
+	nsEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		&persistencespb.NamespaceConfig{
+			HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+			HistoryArchivalUri:   historyArchivalURI,
+		},
+		cluster.TestCurrentClusterName,
+	)
+	URI, err := archiver.NewURI(historyArchivalURI)
+	s.NoError(err)
+	snapshot := &archiverspb.MutableStateSnapshot{
+		FormatVersion: archiver.MutableStateSnapshotFormatVersion,
+		NamespaceId:   s.namespaceID.String(),
+		Namespace:     s.namespace.String(),
+		WorkflowId:    execution.GetWorkflowId(),
+		RunId:         execution.GetRunId(),
+		ExecutionInfo: &workflowpb.WorkflowExecutionInfo{Execution: execution, HistoryLength: 9},
+	}
+	historyConfig := archiver.NewArchivalConfig("enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "enabled", historyArchivalURI)
+
+	s.Run("success", func() {
+		historyArchiver := &snapshotHistoryArchiver{
+			MockHistoryArchiver:              archiver.NewMockHistoryArchiver(s.controller),
+			MockMutableStateSnapshotArchiver: archiver.NewMockMutableStateSnapshotArchiver(s.controller),
+		}
+		s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(historyConfig)
+		s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nsEntry, nil)
+		s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.FrontendService)).Return(historyArchiver, nil)
+		historyArchiver.MockMutableStateSnapshotArchiver.EXPECT().GetMutableStateSnapshot(gomock.Any(), URI, &archiver.GetMutableStateSnapshotRequest{
+			NamespaceID: s.namespaceID.String(),
+			WorkflowID:  execution.GetWorkflowId(),
+			RunID:       execution.GetRunId(),
+		}).Return(snapshot, nil)
+
+		resp, err := s.handler.GetArchivedMutableState(ctx, &adminservice.GetArchivedMutableStateRequest{
+			Namespace: s.namespace.String(),
+			Execution: execution,
+		})
+		s.NoError(err)
+		protorequire.ProtoEqual(s.T(), snapshot, resp.GetSnapshot())
+	})
+
+	s.Run("archiver does not support snapshots", func() {
+		s.mockResource.ArchivalMetadata.EXPECT().GetHistoryConfig().Return(historyConfig)
+		s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nsEntry, nil)
+		s.mockResource.ArchiverProvider.EXPECT().GetHistoryArchiver("test", string(primitives.FrontendService)).
+			Return(archiver.NewMockHistoryArchiver(s.controller), nil)
+
+		_, err := s.handler.GetArchivedMutableState(ctx, &adminservice.GetArchivedMutableStateRequest{
+			Namespace: s.namespace.String(),
+			Execution: execution,
+		})
+		var unimplemented *serviceerror.Unimplemented
+		s.ErrorAs(err, &unimplemented)
+	})
+
+	s.Run("run ID not set", func() {
+		_, err := s.handler.GetArchivedMutableState(ctx, &adminservice.GetArchivedMutableStateRequest{
+			Namespace: s.namespace.String(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+		})
+		s.Equal(errRunIDNotSet, err)
+	})
+}
+
+type snapshotHistoryArchiver struct {
+	*archiver.MockHistoryArchiver
+	*archiver.MockMutableStateSnapshotArchiver
+}
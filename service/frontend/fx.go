@@ -49,6 +49,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/serialization"
@@ -106,6 +107,7 @@ var Module = fx.Options(
 	fx.Provide(AuthorizationInterceptorProvider),
 	fx.Provide(NamespaceCheckerProvider),
 	fx.Provide(func(so GrpcServerOptions) *grpc.Server { return grpc.NewServer(so.Options...) }),
+	fx.Provide(PayloadOffloaderProvider),
 	fx.Provide(HandlerProvider),
 	fx.Provide(AdminHandlerProvider),
 	fx.Provide(OperatorHandlerProvider),
@@ -568,6 +570,7 @@ func AdminHandlerProvider(
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient resource.HistoryClient,
+	matchingClient resource.MatchingClient,
 	sdkClientFactory sdk.ClientFactory,
 	membershipMonitor membership.Monitor,
 	hostInfoProvider membership.HostInfoProvider,
@@ -579,6 +582,8 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	archiverProvider provider.ArchiverProvider,
+	archivalMetadata archiver.ArchivalMetadata,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
@@ -595,6 +600,7 @@ func AdminHandlerProvider(
 		clientFactory,
 		clientBean,
 		historyClient,
+		matchingClient,
 		sdkClientFactory,
 		membershipMonitor,
 		hostInfoProvider,
@@ -606,6 +612,8 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		archiverProvider,
+		archivalMetadata,
 		persistenceExecutionManager,
 		taskCategoryRegistry,
 	}
@@ -674,6 +682,7 @@ func HandlerProvider(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	payloadOffloader *payloadstore.Offloader,
 ) Handler {
 	wfHandler := NewWorkflowHandler(
 		serviceConfig,
@@ -698,10 +707,18 @@ func HandlerProvider(
 		membershipMonitor,
 		healthInterceptor,
 		scheduleSpecBuilder,
+		payloadOffloader,
 	)
 	return wfHandler
 }
 
+func PayloadOffloaderProvider(
+	store payloadstore.Store,
+	serviceConfig *Config,
+) *payloadstore.Offloader {
+	return payloadstore.NewOffloader(store, serviceConfig.PayloadOffloadSizeThreshold)
+}
+
 func NexusHTTPHandlerProvider(
 	serviceConfig *Config,
 	serviceName primitives.ServiceName,
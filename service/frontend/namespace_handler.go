@@ -60,16 +60,17 @@ type (
 	// namespaceHandler implements the namespace-related APIs specified by the [workflowservice] package,
 	// such as registering, updating, and querying namespaces.
 	namespaceHandler struct {
-		maxBadBinaryCount      dynamicconfig.IntPropertyFnWithNamespaceFilter
-		logger                 log.Logger
-		metadataMgr            persistence.MetadataManager
-		clusterMetadata        cluster.Metadata
-		namespaceReplicator    namespace.Replicator
-		namespaceAttrValidator *namespace.AttrValidatorImpl
-		archivalMetadata       archiver.ArchivalMetadata
-		archiverProvider       provider.ArchiverProvider
-		supportsSchedules      dynamicconfig.BoolPropertyFnWithNamespaceFilter
-		timeSource             clock.TimeSource
+		maxBadBinaryCount                   dynamicconfig.IntPropertyFnWithNamespaceFilter
+		logger                              log.Logger
+		metadataMgr                         persistence.MetadataManager
+		clusterMetadata                     cluster.Metadata
+		namespaceReplicator                 namespace.Replicator
+		namespaceAttrValidator              *namespace.AttrValidatorImpl
+		archivalMetadata                    archiver.ArchivalMetadata
+		archiverProvider                    provider.ArchiverProvider
+		supportsSchedules                   dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		allowFailoverWithReplicationFilters dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		timeSource                          clock.TimeSource
 	}
 )
 
@@ -97,19 +98,21 @@ func newNamespaceHandler(
 	archivalMetadata archiver.ArchivalMetadata,
 	archiverProvider provider.ArchiverProvider,
 	supportsSchedules dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	allowFailoverWithReplicationFilters dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	timeSource clock.TimeSource,
 ) *namespaceHandler {
 	return &namespaceHandler{
-		maxBadBinaryCount:      maxBadBinaryCount,
-		logger:                 logger,
-		metadataMgr:            metadataMgr,
-		clusterMetadata:        clusterMetadata,
-		namespaceReplicator:    namespaceReplicator,
-		namespaceAttrValidator: namespace.NewAttrValidator(clusterMetadata),
-		archivalMetadata:       archivalMetadata,
-		archiverProvider:       archiverProvider,
-		supportsSchedules:      supportsSchedules,
-		timeSource:             timeSource,
+		maxBadBinaryCount:                   maxBadBinaryCount,
+		logger:                              logger,
+		metadataMgr:                         metadataMgr,
+		clusterMetadata:                     clusterMetadata,
+		namespaceReplicator:                 namespaceReplicator,
+		namespaceAttrValidator:              namespace.NewAttrValidator(clusterMetadata),
+		archivalMetadata:                    archivalMetadata,
+		archiverProvider:                    archiverProvider,
+		supportsSchedules:                   supportsSchedules,
+		allowFailoverWithReplicationFilters: allowFailoverWithReplicationFilters,
+		timeSource:                          timeSource,
 	}
 }
 
@@ -439,6 +442,7 @@ func (d *namespaceHandler) UpdateNamespace(
 	configurationChanged := false
 	// whether replication cluster list is changed
 	clusterListChanged := false
+	previousActiveClusterName := replicationConfig.GetActiveClusterName()
 
 	if updateRequest.UpdateInfo != nil {
 		updatedInfo := updateRequest.UpdateInfo
@@ -557,6 +561,11 @@ func (d *namespaceHandler) UpdateNamespace(
 			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("global namespace is not enabled on this "+
 				"cluster, cannot update global namespace or promote local namespace: %v", updateRequest.Namespace))
 		}
+		if activeClusterChanged && replicationConfig.ActiveClusterName != previousActiveClusterName {
+			if err := d.validateFailoverWithReplicationFilters(updateRequest.GetNamespace(), replicationConfig); err != nil {
+				return nil, err
+			}
+		}
 	} else {
 		if err := d.namespaceAttrValidator.ValidateNamespaceReplicationConfigForLocalNamespace(
 			replicationConfig,
@@ -641,6 +650,33 @@ func (d *namespaceHandler) UpdateNamespace(
 	return response, nil
 }
 
+// validateFailoverWithReplicationFilters refuses to fail a namespace over to a cluster its replication filters
+// exclude workflow executions from, since those executions don't exist on that cluster, unless it is allowed
+// by dynamic config.
+func (d *namespaceHandler) validateFailoverWithReplicationFilters(
+	namespaceName string,
+	replicationConfig *persistencespb.NamespaceReplicationConfig,
+) error {
+	for _, filter := range replicationConfig.ReplicationFilters {
+		if !namespace.ReplicationFilterExcludesCluster(filter, replicationConfig.ActiveClusterName) {
+			continue
+		}
+		if !d.allowFailoverWithReplicationFilters(namespaceName) {
+			return serviceerror.NewFailedPrecondition(fmt.Sprintf(
+				"Replication filters of namespace %v exclude workflow executions from cluster %v, which would not be available after failover.",
+				namespaceName,
+				replicationConfig.ActiveClusterName,
+			))
+		}
+		d.logger.Warn("Failing over namespace to a cluster its replication filters exclude workflow executions from.",
+			tag.WorkflowNamespace(namespaceName),
+			tag.ClusterName(replicationConfig.ActiveClusterName),
+		)
+		return nil
+	}
+	return nil
+}
+
 // DeprecateNamespace deprecates a namespace
 // Deprecated.
 func (d *namespaceHandler) DeprecateNamespace(
@@ -117,6 +117,7 @@ func (s *namespaceHandlerCommonSuite) SetupTest() {
 		s.archivalMetadata,
 		s.mockArchiverProvider,
 		func(s string) bool { return strings.HasSuffix(s, "sched") },
+		func(s string) bool { return strings.HasSuffix(s, "allow-filtered-failover") },
 		s.fakeClock,
 	)
 }
@@ -760,6 +761,66 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_ChangeActiveClusterWit
 	s.NoError(err)
 }
 
+func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_ChangeActiveClusterWithReplicationFilters() {
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
+	clusterName1 := "cluster1"
+	clusterName2 := "cluster2"
+	s.mockMetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{
+		NotificationVersion: 100,
+	}, nil).Times(2)
+	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().IsMasterCluster().Return(true).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]cluster.ClusterInformation{
+		clusterName1: {
+			Enabled:                true,
+			InitialFailoverVersion: 1,
+		},
+		clusterName2: {
+			Enabled:                true,
+			InitialFailoverVersion: 2,
+		},
+	}).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(clusterName1).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetNextFailoverVersion(clusterName2, int64(0)).Return(int64(2))
+	getNamespaceResponse := func(name string) *persistence.GetNamespaceResponse {
+		return &persistence.GetNamespaceResponse{
+			Namespace: &persistencespb.NamespaceDetail{
+				Info:   &persistencespb.NamespaceInfo{Id: uuid.New(), Name: name},
+				Config: &persistencespb.NamespaceConfig{},
+				ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: clusterName1,
+					Clusters:          []string{clusterName1, clusterName2},
+					ReplicationFilters: []*persistencespb.ReplicationFilter{
+						{WorkflowTypes: []string{"local-only"}},
+					},
+				},
+			},
+			IsGlobalNamespace: true,
+		}
+	}
+
+	// failing over to a cluster the filters exclude workflows from is refused
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(getNamespaceResponse("global-ns"), nil)
+	_, err := s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "global-ns",
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterName2,
+		},
+	})
+	s.IsType(&serviceerror.FailedPrecondition{}, err)
+
+	// unless dynamic config allows it
+	s.mockMetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).Return(getNamespaceResponse("global-ns-allow-filtered-failover"), nil)
+	s.mockMetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
+	_, err = s.handler.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{
+		Namespace: "global-ns-allow-filtered-failover",
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: clusterName2,
+		},
+	})
+	s.NoError(err)
+}
+
 // Test that the number of replication statuses is limited
 func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_UpdateActiveCluster_LimitRecordHistory() {
 	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
@@ -30,6 +30,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -48,16 +49,20 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	schedspb "go.temporal.io/server/api/schedule/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
@@ -76,6 +81,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility"
@@ -137,6 +143,7 @@ type (
 		membershipMonitor               membership.Monitor
 		healthInterceptor               *interceptor.HealthInterceptor
 		scheduleSpecBuilder             *scheduler.SpecBuilder
+		payloadOffloader                *payloadstore.Offloader
 
 		// DEPRECATED
 		persistenceExecutionManager persistence.ExecutionManager
@@ -167,6 +174,7 @@ func NewWorkflowHandler(
 	membershipMonitor membership.Monitor,
 	healthInterceptor *interceptor.HealthInterceptor,
 	scheduleSpecBuilder *scheduler.SpecBuilder,
+	payloadOffloader *payloadstore.Offloader,
 ) *WorkflowHandler {
 
 	handler := &WorkflowHandler{
@@ -183,6 +191,7 @@ func NewWorkflowHandler(
 			archivalMetadata,
 			archiverProvider,
 			config.EnableSchedules,
+			config.AllowFailoverWithReplicationFilters,
 			timeSource,
 		),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
@@ -217,6 +226,7 @@ func NewWorkflowHandler(
 		membershipMonitor:   membershipMonitor,
 		healthInterceptor:   healthInterceptor,
 		scheduleSpecBuilder: scheduleSpecBuilder,
+		payloadOffloader:    payloadOffloader,
 	}
 
 	return handler
@@ -630,26 +640,30 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		request.MaximumPageSize = common.GetHistoryMaxPageSize
 	}
 
-	if !request.GetSkipArchival() {
-		enableArchivalRead := wh.archivalMetadata.GetHistoryConfig().ReadEnabled()
-		historyArchived := wh.historyArchived(ctx, request, namespaceID)
-		if enableArchivalRead && historyArchived {
-			return wh.getArchivedHistory(ctx, request, namespaceID)
-		}
-	}
-
-	if dynamicconfig.AccessHistory(wh.config.AccessHistoryFraction, wh.metricsScope(ctx).WithTags(metrics.OperationTag(metrics.FrontendGetWorkflowExecutionHistoryTag))) {
-		response, err := wh.historyClient.GetWorkflowExecutionHistory(ctx,
+	var response *workflowservice.GetWorkflowExecutionHistoryResponse
+	if !request.GetSkipArchival() &&
+		wh.archivalMetadata.GetHistoryConfig().ReadEnabled() &&
+		wh.historyArchived(ctx, request, namespaceID) {
+		response, err = wh.getArchivedHistory(ctx, request, namespaceID)
+	} else if dynamicconfig.AccessHistory(wh.config.AccessHistoryFraction, wh.metricsScope(ctx).WithTags(metrics.OperationTag(metrics.FrontendGetWorkflowExecutionHistoryTag))) {
+		var historyResponse *historyservice.GetWorkflowExecutionHistoryResponse
+		historyResponse, err = wh.historyClient.GetWorkflowExecutionHistory(ctx,
 			&historyservice.GetWorkflowExecutionHistoryRequest{
 				NamespaceId: namespaceID.String(),
 				Request:     request,
 			})
-		if err != nil {
-			return nil, err
-		}
-		return response.Response, nil
+		response = historyResponse.GetResponse()
+	} else {
+		response, err = wh.getWorkflowExecutionHistory(ctx, request)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return wh.getWorkflowExecutionHistory(ctx, request)
+
+	if err := wh.payloadOffloader.Rehydrate(ctx, response.GetHistory()); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // GetWorkflowExecutionHistory returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if specified workflow
@@ -683,18 +697,26 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistoryReverse(ctx context.Contex
 		request.MaximumPageSize = common.GetHistoryMaxPageSize
 	}
 
+	var response *workflowservice.GetWorkflowExecutionHistoryReverseResponse
 	if dynamicconfig.AccessHistory(wh.config.AccessHistoryFraction, wh.metricsScope(ctx).WithTags(metrics.OperationTag(metrics.FrontendGetWorkflowExecutionHistoryReverseTag))) {
-		response, err := wh.historyClient.GetWorkflowExecutionHistoryReverse(ctx,
+		var historyResponse *historyservice.GetWorkflowExecutionHistoryReverseResponse
+		historyResponse, err = wh.historyClient.GetWorkflowExecutionHistoryReverse(ctx,
 			&historyservice.GetWorkflowExecutionHistoryReverseRequest{
 				NamespaceId: namespaceID.String(),
 				Request:     request,
 			})
-		if err != nil {
-			return nil, err
-		}
-		return response.Response, nil
+		response = historyResponse.GetResponse()
+	} else {
+		response, err = wh.getWorkflowExecutionHistoryReverse(ctx, request)
 	}
-	return wh.getWorkflowExecutionHistoryReverse(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wh.payloadOffloader.Rehydrate(ctx, response.GetHistory()); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // PollWorkflowTaskQueue is called by application worker to process WorkflowTask from a specific task queue.  A
@@ -736,6 +758,11 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		return nil, err
 	}
 
+	workerHeartbeat, err := workerHeartbeatFromContext(ctx, request.GetIdentity())
+	if err != nil {
+		return nil, err
+	}
+
 	callTime := time.Now().UTC()
 
 	namespaceEntry, err := wh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
@@ -755,9 +782,10 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 
 	pollerID := uuid.New()
 	matchingResp, err := wh.matchingClient.PollWorkflowTaskQueue(ctx, &matchingservice.PollWorkflowTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:     namespaceID.String(),
+		PollerId:        pollerID,
+		PollRequest:     request,
+		WorkerHeartbeat: workerHeartbeat,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(ctx, namespaceID, enumspb.TASK_QUEUE_TYPE_WORKFLOW, request.TaskQueue, pollerID)
@@ -790,12 +818,23 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	if matchingResp.History == nil {
 		// Got an old matching response, need to lookup history
 		// Eventually empty history will only happen for sticky query tasks
-		return wh.createPollWorkflowTaskQueueResponse(
+		resp, err := wh.createPollWorkflowTaskQueueResponse(
 			ctx,
 			namespaceID,
 			matchingResp,
 			matchingResp.BranchToken,
 		)
+		if err != nil {
+			return nil, err
+		}
+		if err := wh.payloadOffloader.Rehydrate(ctx, resp.GetHistory()); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	if err := wh.payloadOffloader.Rehydrate(ctx, matchingResp.History); err != nil {
+		return nil, err
 	}
 
 	return &workflowservice.PollWorkflowTaskQueueResponse{
@@ -817,6 +856,34 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 	}, nil
 }
 
+// workerHeartbeatFromContext returns the worker state attached to a poll with the worker-heartbeat-bin header, or nil
+// if there is none. The identity of the poll takes precedence over the one in the header.
+func workerHeartbeatFromContext(ctx context.Context, identity string) (*taskqueuespb.WorkerHeartbeat, error) {
+	value := headers.GetValues(ctx, headers.WorkerHeartbeatHeaderName)[0]
+	if value == "" {
+		return nil, nil
+	}
+	heartbeat := &taskqueuespb.WorkerHeartbeat{}
+	if err := proto.Unmarshal([]byte(value), heartbeat); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid %s header: %v.", headers.WorkerHeartbeatHeaderName, err))
+	}
+	heartbeat.Identity = identity
+	return heartbeat, nil
+}
+
+// pollMaxTasksFromContext returns the number of free task slots declared by the poller, or 0 if it did not declare any.
+func pollMaxTasksFromContext(ctx context.Context) (int32, error) {
+	value := headers.GetValues(ctx, headers.PollMaxTasksHeaderName)[0]
+	if value == "" {
+		return 0, nil
+	}
+	maxTasks, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || maxTasks < 0 {
+		return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid %s header: %q.", headers.PollMaxTasksHeaderName, value))
+	}
+	return int32(maxTasks), nil
+}
+
 func contextNearDeadline(ctx context.Context, tailroom time.Duration) bool {
 	if ctxDeadline, ok := ctx.Deadline(); ok {
 		return time.Now().Add(tailroom).After(ctxDeadline)
@@ -854,13 +921,14 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(
 
 	wh.overrides.DisableEagerActivityDispatchForBuggyClients(ctx, request)
 
+	var response *workflowservice.RespondWorkflowTaskCompletedResponse
 	if dynamicconfig.AccessHistory(wh.config.AccessHistoryFraction, wh.metricsScope(ctx).WithTags(metrics.OperationTag(metrics.FrontendRespondWorkflowTaskCompletedTag))) {
 		namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
 		if err != nil {
 			return nil, err
 		}
 
-		response, err := wh.historyClient.RespondWorkflowTaskCompleted(ctx,
+		historyResponse, err := wh.historyClient.RespondWorkflowTaskCompleted(ctx,
 			&historyservice.RespondWorkflowTaskCompletedRequest{
 				NamespaceId:     namespaceID.String(),
 				CompleteRequest: request,
@@ -870,13 +938,22 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(
 			return nil, err
 		}
 
-		return &workflowservice.RespondWorkflowTaskCompletedResponse{
-			WorkflowTask:        response.NewWorkflowTask,
-			ActivityTasks:       response.ActivityTasks,
-			ResetHistoryEventId: response.ResetHistoryEventId,
-		}, nil
+		response = &workflowservice.RespondWorkflowTaskCompletedResponse{
+			WorkflowTask:        historyResponse.NewWorkflowTask,
+			ActivityTasks:       historyResponse.ActivityTasks,
+			ResetHistoryEventId: historyResponse.ResetHistoryEventId,
+		}
+	} else {
+		var err error
+		if response, err = wh.respondWorkflowTaskCompleted(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := wh.payloadOffloader.Rehydrate(ctx, response.GetWorkflowTask().GetHistory()); err != nil {
+		return nil, err
 	}
-	return wh.respondWorkflowTaskCompleted(ctx, request)
+	return response, nil
 }
 
 // RespondWorkflowTaskFailed is called by application worker to indicate failure.  This results in
@@ -984,6 +1061,15 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, err
 	}
 
+	workerHeartbeat, err := workerHeartbeatFromContext(ctx, request.GetIdentity())
+	if err != nil {
+		return nil, err
+	}
+	maxTasks, err := pollMaxTasksFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	namespaceID, err := wh.namespaceRegistry.GetNamespaceID(namespaceName)
 	if err != nil {
 		return nil, err
@@ -995,9 +1081,11 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 
 	pollerID := uuid.New()
 	matchingResponse, err := wh.matchingClient.PollActivityTaskQueue(ctx, &matchingservice.PollActivityTaskQueueRequest{
-		NamespaceId: namespaceID.String(),
-		PollerId:    pollerID,
-		PollRequest: request,
+		NamespaceId:     namespaceID.String(),
+		PollerId:        pollerID,
+		PollRequest:     request,
+		WorkerHeartbeat: workerHeartbeat,
+		MaxTasks:        maxTasks,
 	})
 	if err != nil {
 		contextWasCanceled := wh.cancelOutstandingPoll(ctx, namespaceID, enumspb.TASK_QUEUE_TYPE_ACTIVITY, request.TaskQueue, pollerID)
@@ -1028,6 +1116,19 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, err
 	}
 
+	if additionalTasks := matchingResponse.GetAdditionalTasks(); len(additionalTasks) > 0 {
+		if err := setAdditionalActivityTasksHeader(ctx, additionalTasks); err != nil {
+			// the tasks are started already, they are retried once their start-to-close timeout is reached
+			wh.logger.Error("Unable to return additional activity tasks to poller.",
+				tag.WorkflowTaskQueueName(request.GetTaskQueue().GetName()),
+				tag.Counter(len(additionalTasks)),
+				tag.Error(err))
+		}
+	}
+	return newPollActivityTaskQueueResponse(matchingResponse), nil
+}
+
+func newPollActivityTaskQueueResponse(matchingResponse *matchingservice.PollActivityTaskQueueResponse) *workflowservice.PollActivityTaskQueueResponse {
 	return &workflowservice.PollActivityTaskQueueResponse{
 		TaskToken:                   matchingResponse.TaskToken,
 		WorkflowExecution:           matchingResponse.WorkflowExecution,
@@ -1045,7 +1146,23 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		WorkflowType:                matchingResponse.WorkflowType,
 		WorkflowNamespace:           matchingResponse.WorkflowNamespace,
 		Header:                      matchingResponse.Header,
-	}, nil
+	}
+}
+
+// setAdditionalActivityTasksHeader returns the tasks of a multi-task poll beyond the first one in a response header,
+// since the PollActivityTaskQueue response has room for a single task only.
+func setAdditionalActivityTasksHeader(ctx context.Context, tasks []*matchingservice.PollActivityTaskQueueResponse) error {
+	additionalTasks := &taskqueuespb.AdditionalActivityTasks{
+		Tasks: make([]*workflowservice.PollActivityTaskQueueResponse, len(tasks)),
+	}
+	for i, task := range tasks {
+		additionalTasks.Tasks[i] = newPollActivityTaskQueueResponse(task)
+	}
+	blob, err := proto.Marshal(additionalTasks)
+	if err != nil {
+		return err
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(headers.AdditionalActivityTasksHeaderName, string(blob)))
 }
 
 // RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
@@ -1239,8 +1356,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.Name().String())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.Name().String())
 
+	// oversized payloads are offloaded by history, only the references count towards the limit
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		wh.payloadOffloader.Size(namespaceEntry.Name(), request.GetResult()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceId.String(),
@@ -1331,8 +1449,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	sizeLimitError := wh.config.BlobSizeLimitError(namespaceEntry.Name().String())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(namespaceEntry.Name().String())
 
+	// oversized payloads are offloaded by history, only the references count towards the limit
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetResult().Size(),
+		wh.payloadOffloader.Size(namespaceEntry.Name(), request.GetResult()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID.String(),
@@ -1797,8 +1916,9 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 
 	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
+	// oversized payloads are offloaded by history, only the references count towards the limit
 	if err := common.CheckEventBlobSizeLimit(
-		request.GetInput().Size(),
+		wh.payloadOffloader.Size(namespace.Name(request.GetNamespace()), request.GetInput()),
 		sizeLimitWarn,
 		sizeLimitError,
 		namespaceID.String(),
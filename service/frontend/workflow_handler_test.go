@@ -50,23 +50,29 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	dc "go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
@@ -193,6 +199,7 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockResource.GetMembershipMonitor(),
 		healthInterceptor,
 		scheduler.NewSpecBuilder(),
+		payloadstore.NewOffloader(nil, config.PayloadOffloadSizeThreshold),
 	)
 }
 
@@ -286,6 +293,44 @@ func (s *workflowHandlerSuite) TestPollForTask_Failed_ContextTimeoutTooShort() {
 	s.Equal(common.ErrContextTimeoutTooShort, err)
 }
 
+func (s *workflowHandlerSuite) TestPollActivityTaskQueue_AdditionalTasks() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(namespace.NewID(), nil)
+	s.mockMatchingClient.EXPECT().PollActivityTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *matchingservice.PollActivityTaskQueueRequest, _ ...grpc.CallOption) (*matchingservice.PollActivityTaskQueueResponse, error) {
+			s.Equal(int32(3), request.GetMaxTasks())
+			return &matchingservice.PollActivityTaskQueueResponse{
+				ActivityId: "activity-1",
+				AdditionalTasks: []*matchingservice.PollActivityTaskQueueResponse{
+					{ActivityId: "activity-2"},
+					{ActivityId: "activity-3"},
+				},
+			}, nil
+		})
+
+	stream := &fakeServerTransportStream{}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.PollMaxTasksHeaderName, "3"))
+	ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	resp, err := wh.PollActivityTaskQueue(ctx, &workflowservice.PollActivityTaskQueueRequest{
+		Namespace: s.testNamespace.String(),
+		TaskQueue: &taskqueuepb.TaskQueue{Name: "task-queue"},
+	})
+	s.NoError(err)
+	s.Equal("activity-1", resp.GetActivityId())
+
+	values := stream.header.Get(headers.AdditionalActivityTasksHeaderName)
+	s.Len(values, 1)
+	additionalTasks := &taskqueuespb.AdditionalActivityTasks{}
+	s.NoError(proto.Unmarshal([]byte(values[0]), additionalTasks))
+	s.Len(additionalTasks.GetTasks(), 2)
+	s.Equal("activity-2", additionalTasks.GetTasks()[0].GetActivityId())
+	s.Equal("activity-3", additionalTasks.GetTasks()[1].GetActivityId())
+}
+
 func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_StartRequestNotSet() {
 	config := s.newConfig()
 	config.RPS = dc.GetIntPropertyFn(10)
@@ -723,6 +768,38 @@ func (s *workflowHandlerSuite) TestSignalWithStartWorkflowExecution_DefaultWorkf
 	s.True(resp.Started)
 }
 
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_LargeInputOffloadedByHistory() {
+	serviceConfig := s.newConfig()
+	serviceConfig.PayloadOffloadSizeThreshold = dc.GetIntPropertyFilteredByNamespace(100)
+	// the input is above the limit, but its reference is not
+	serviceConfig.BlobSizeLimitError = dc.GetIntPropertyFilteredByNamespace(400)
+	store, err := payloadstore.NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.NoError(err)
+	wh := s.getWorkflowHandler(serviceConfig)
+	wh.payloadOffloader = payloadstore.NewOffloader(store, serviceConfig.PayloadOffloadSizeThreshold)
+
+	input := payloads.EncodeString(strings.Repeat("input", 100))
+	expectedInput := common.CloneProto(input)
+
+	var signalInput *commonpb.Payloads
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil)
+	s.mockHistoryClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.SignalWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.SignalWorkflowExecutionResponse, error) {
+			signalInput = request.SignalRequest.GetInput()
+			return &historyservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	_, err = wh.SignalWorkflowExecution(context.Background(), &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace:         s.testNamespace.String(),
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+		SignalName:        "SIGNAL",
+		Input:             input,
+	})
+	s.NoError(err)
+	// history offloads the input once it accepted the signal
+	s.True(proto.Equal(expectedInput, signalInput))
+}
+
 func (s *workflowHandlerSuite) TestRegisterNamespace_Failure_InvalidArchivalURI() {
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(false)
 	s.mockArchivalMetadata.EXPECT().GetHistoryConfig().Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
@@ -2643,6 +2720,57 @@ func TestContextNearDeadline(t *testing.T) {
 	assert.False(t, contextNearDeadline(ctx, time.Millisecond))
 }
 
+func TestWorkerHeartbeatFromContext(t *testing.T) {
+	heartbeat, err := workerHeartbeatFromContext(context.Background(), "identity")
+	assert.NoError(t, err)
+	assert.Nil(t, heartbeat)
+
+	blob, err := proto.Marshal(&taskqueuespb.WorkerHeartbeat{Identity: "other", UsedSlots: 3})
+	assert.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.WorkerHeartbeatHeaderName, string(blob)))
+	heartbeat, err = workerHeartbeatFromContext(ctx, "identity")
+	assert.NoError(t, err)
+	assert.Equal(t, "identity", heartbeat.GetIdentity())
+	assert.Equal(t, int32(3), heartbeat.GetUsedSlots())
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.WorkerHeartbeatHeaderName, "\xff"))
+	_, err = workerHeartbeatFromContext(ctx, "identity")
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestPollMaxTasksFromContext(t *testing.T) {
+	maxTasks, err := pollMaxTasksFromContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), maxTasks)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.PollMaxTasksHeaderName, "8"))
+	maxTasks, err = pollMaxTasksFromContext(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(8), maxTasks)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headers.PollMaxTasksHeaderName, "-1"))
+	_, err = pollMaxTasksFromContext(ctx)
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
+
+// fakeServerTransportStream records the response headers set by a handler.
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (f *fakeServerTransportStream) Method() string { return "" }
+
+func (f *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	f.header = metadata.Join(f.header, md)
+	return nil
+}
+
+func (f *fakeServerTransportStream) SendHeader(md metadata.MD) error { return f.SetHeader(md) }
+
+func (f *fakeServerTransportStream) SetTrailer(metadata.MD) error { return nil }
+
 func TestValidateRequestId(t *testing.T) {
 	req := workflowservice.StartWorkflowExecutionRequest{RequestId: ""}
 	err := validateRequestId(&req.RequestId, 100)
@@ -0,0 +1,333 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+const (
+	ConcurrencyLimitModeReject = "reject"
+	ConcurrencyLimitModeQueue  = "queue"
+)
+
+type (
+	concurrencyLimit struct {
+		scope string
+		key   string
+		limit int
+	}
+
+	// ConcurrencyLimitReservation holds the slots of the concurrency limits reserved for a run about to be created.
+	ConcurrencyLimitReservation struct {
+		shardContext shard.Context
+		namespaceID  namespace.ID
+		workflowID   string
+		runID        string
+		keys         []string
+		// skipped is set if the slots were not reserved, as the request was not expected to create a run
+		skipped bool
+	}
+)
+
+// SkipConcurrencyLimits returns a reservation holding no slot for a request which is not expected to create a run,
+// e.g. one which is going to signal or reuse the running run of the workflow. If it turns out to create a run anyway,
+// CheckReserved fails it. It returns nil if no limit applies.
+func SkipConcurrencyLimits(
+	shardContext shard.Context,
+	namespaceEntry *namespace.Namespace,
+	request *workflowservice.StartWorkflowExecutionRequest,
+) *ConcurrencyLimitReservation {
+	if len(concurrencyLimitsForNamespace(shardContext, namespaceEntry, request)) == 0 {
+		return nil
+	}
+	return &ConcurrencyLimitReservation{
+		shardContext: shardContext,
+		namespaceID:  namespaceEntry.ID(),
+		workflowID:   request.GetWorkflowId(),
+		skipped:      true,
+	}
+}
+
+// ReserveConcurrencyLimits reserves a slot of each concurrency limit configured for the namespace, workflow type and
+// search attributes of the request, for the given run of the workflow to start. It returns nil if no limit applies.
+//
+// The reservations of a limit are persisted by the shard owning it, whatever the shards of the workflows holding them,
+// so a limit holds across the shards of the namespace. A workflow holds a single slot of a limit, whatever its runs: a
+// new run of a workflow holding a slot, e.g. one terminating the running run, takes the slot over without being
+// checked against the limit. The slots are recorded in the mutable state of the run by
+// ConcurrencyLimitReservation.Record, and released when the workflow closes by ReleaseConcurrencyLimits. Callers must
+// call ConcurrencyLimitReservation.Release once they know whether the run was created.
+//
+// In reject mode a breach fails with a resource exhausted error. In queue mode the reservation is retried until
+// capacity frees up, and the breach is only reported once the request deadline does not leave room for another try.
+func ReserveConcurrencyLimits(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceEntry *namespace.Namespace,
+	request *workflowservice.StartWorkflowExecutionRequest,
+	runID string,
+	operation string,
+) (*ConcurrencyLimitReservation, error) {
+	config := shardContext.GetConfig()
+	namespaceName := namespaceEntry.Name().String()
+
+	limits := concurrencyLimitsForNamespace(shardContext, namespaceEntry, request)
+	if len(limits) == 0 {
+		return nil, nil
+	}
+	reservation := &ConcurrencyLimitReservation{
+		shardContext: shardContext,
+		namespaceID:  namespaceEntry.ID(),
+		workflowID:   request.GetWorkflowId(),
+		runID:        runID,
+	}
+
+	queue := config.ConcurrencyLimitMode(namespaceName) == ConcurrencyLimitModeQueue
+	checkInterval := config.ConcurrencyLimitQueueCheckInterval(namespaceName)
+	for {
+		exceeded, err := reservation.reserve(ctx, limits)
+		if err != nil {
+			return nil, err
+		}
+		if exceeded == nil {
+			return reservation, nil
+		}
+
+		deadline, hasDeadline := ctx.Deadline()
+		if !queue || (hasDeadline && time.Until(deadline) < checkInterval) {
+			metrics.ConcurrencyLimitExceededCounter.With(shardContext.GetMetricsHandler()).Record(
+				1,
+				metrics.OperationTag(operation),
+				metrics.NamespaceTag(namespaceName),
+			)
+			return nil, serviceerror.NewResourceExhausted(
+				enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT,
+				fmt.Sprintf("Concurrency limit of %d running workflow executions reached for %s.", exceeded.limit, exceeded.scope),
+			)
+		}
+
+		timer := time.NewTimer(checkInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve reserves a slot of each limit in turn. If a limit is reached, it releases the slots reserved so far and
+// returns that limit.
+func (r *ConcurrencyLimitReservation) reserve(
+	ctx context.Context,
+	limits []concurrencyLimit,
+) (*concurrencyLimit, error) {
+	for i := range limits {
+		resp, err := r.shardContext.GetHistoryClient().ReserveConcurrencyLimit(ctx, &historyservice.ReserveConcurrencyLimitRequest{
+			ShardId:          concurrencyLimitShardID(r.shardContext, r.namespaceID, limits[i].key),
+			NamespaceId:      r.namespaceID.String(),
+			Key:              limits[i].key,
+			Limit:            int32(limits[i].limit),
+			HolderWorkflowId: r.workflowID,
+			HolderRunId:      r.runID,
+		})
+		if err != nil || !resp.GetReserved() {
+			r.Release(ctx, "")
+			if err != nil {
+				return nil, err
+			}
+			return &limits[i], nil
+		}
+		r.keys = append(r.keys, limits[i].key)
+	}
+	return nil, nil
+}
+
+// CheckReserved must be called before creating the run. It fails with a retryable error if the slots were skipped, as
+// the current execution of the workflow changed since the request was expected not to create a run.
+func (r *ConcurrencyLimitReservation) CheckReserved() error {
+	if !r.Skipped() {
+		return nil
+	}
+	return serviceerror.NewUnavailable("Concurrency limits were not reserved as the current workflow execution changed, please retry.")
+}
+
+// Skipped returns whether the slots were skipped as the request was not expected to create a run.
+func (r *ConcurrencyLimitReservation) Skipped() bool {
+	return r != nil && r.skipped
+}
+
+// Record records the keys of the reserved slots in the mutable state of the new run, so that they are released when
+// it closes. It does nothing if no limit applies.
+func (r *ConcurrencyLimitReservation) Record(mutableState workflow.MutableState) {
+	if r == nil {
+		return
+	}
+	mutableState.GetExecutionInfo().ConcurrencyLimitKeys = append([]string(nil), r.keys...)
+}
+
+// Release releases the reserved slots, unless createdRunID is the run they were reserved for. A run whose creation
+// failed with an unknown outcome keeps its slots, since the owners of the limits release the slots of a workflow
+// only if it is not running. Failures are logged: the slots of a workflow which is not running are released anyway
+// when the limit is reached. It does nothing if no limit applies.
+func (r *ConcurrencyLimitReservation) Release(ctx context.Context, createdRunID string) {
+	if r == nil || len(r.keys) == 0 || createdRunID == r.runID {
+		return
+	}
+	if err := ReleaseConcurrencyLimits(ctx, r.shardContext, r.namespaceID, r.workflowID, r.runID, r.keys); err != nil {
+		r.shardContext.GetLogger().Warn("Failed to release concurrency limits of a run which was not created",
+			tag.WorkflowNamespaceID(r.namespaceID.String()),
+			tag.WorkflowID(r.workflowID),
+			tag.WorkflowRunID(r.runID),
+			tag.Error(err),
+		)
+	}
+	r.keys = nil
+}
+
+// ReleaseConcurrencyLimits releases the slots of the given concurrency limits held by a run which closed, or hands
+// them over to the running run of the workflow if there is one, e.g. the run it continued as.
+func ReleaseConcurrencyLimits(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+	runID string,
+	keys []string,
+) error {
+	for _, key := range keys {
+		if _, err := shardContext.GetHistoryClient().ReleaseConcurrencyLimit(ctx, &historyservice.ReleaseConcurrencyLimitRequest{
+			ShardId:          concurrencyLimitShardID(shardContext, namespaceID, key),
+			NamespaceId:      namespaceID.String(),
+			Key:              key,
+			HolderWorkflowId: workflowID,
+			HolderRunId:      runID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// concurrencyLimitShardID returns the shard owning a concurrency limit of a namespace.
+func concurrencyLimitShardID(
+	shardContext shard.Context,
+	namespaceID namespace.ID,
+	key string,
+) int32 {
+	return shardContext.GetConfig().GetShardID(namespaceID, key)
+}
+
+// HasConcurrencyLimits reports whether any concurrency limit applies to the given request.
+func HasConcurrencyLimits(
+	shardContext shard.Context,
+	namespaceEntry *namespace.Namespace,
+	request *workflowservice.StartWorkflowExecutionRequest,
+) bool {
+	return len(concurrencyLimitsForNamespace(shardContext, namespaceEntry, request)) > 0
+}
+
+func concurrencyLimitsForNamespace(
+	shardContext shard.Context,
+	namespaceEntry *namespace.Namespace,
+	request *workflowservice.StartWorkflowExecutionRequest,
+) []concurrencyLimit {
+	config := shardContext.GetConfig()
+	namespaceName := namespaceEntry.Name().String()
+	return concurrencyLimitsForRequest(
+		config.MaxConcurrentWorkflowExecutions(namespaceName),
+		config.MaxConcurrentWorkflowExecutionsByWorkflowType(namespaceName),
+		config.MaxConcurrentWorkflowExecutionsBySearchAttribute(namespaceName),
+		request.GetWorkflowType().GetName(),
+		request.GetSearchAttributes(),
+	)
+}
+
+func concurrencyLimitsForRequest(
+	namespaceLimit int,
+	workflowTypeLimits map[string]any,
+	searchAttributeLimits map[string]any,
+	workflowType string,
+	searchAttributes *commonpb.SearchAttributes,
+) []concurrencyLimit {
+	var limits []concurrencyLimit
+	if namespaceLimit > 0 {
+		limits = append(limits, concurrencyLimit{
+			scope: "namespace",
+			key:   "namespace",
+			limit: namespaceLimit,
+		})
+	}
+	if limit := concurrencyLimitValue(workflowTypeLimits[workflowType]); limit > 0 {
+		limits = append(limits, concurrencyLimit{
+			scope: fmt.Sprintf("workflow type %q", workflowType),
+			key:   "workflow-type/" + workflowType,
+			limit: limit,
+		})
+	}
+	for name, value := range searchAttributes.GetIndexedFields() {
+		limit := concurrencyLimitValue(searchAttributeLimits[name])
+		if limit <= 0 {
+			continue
+		}
+		// limits only apply to single string values, i.e. keyword search attributes
+		var keyword string
+		if err := payload.Decode(value, &keyword); err != nil {
+			continue
+		}
+		limits = append(limits, concurrencyLimit{
+			scope: fmt.Sprintf("search attribute %s = %q", name, keyword),
+			key:   fmt.Sprintf("search-attribute/%s/%s", name, keyword),
+			limit: limit,
+		})
+	}
+	return limits
+}
+
+func concurrencyLimitValue(value any) int {
+	switch value := value.(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+	return 0
+}
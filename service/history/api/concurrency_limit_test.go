@@ -0,0 +1,267 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+const (
+	namespaceLimitKey       = "namespace"
+	workflowTypeLimitKey    = "workflow-type/test-workflow-type"
+	searchAttributeLimitKey = "search-attribute/CustomKeywordField/tenant-1"
+)
+
+// fakeConcurrencyLimits holds the reservations of the concurrency limits, keyed by limit and workflow ID, as the
+// shards owning the limits would.
+type fakeConcurrencyLimits struct {
+	reservations map[string]map[string]string
+	releases     int
+	onRelease    func()
+}
+
+func (f *fakeConcurrencyLimits) reserve(
+	_ context.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+	_ ...grpc.CallOption,
+) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	reservations := f.reservations[request.GetKey()]
+	if reservations == nil {
+		reservations = make(map[string]string)
+		f.reservations[request.GetKey()] = reservations
+	}
+	if _, ok := reservations[request.GetHolderWorkflowId()]; !ok && len(reservations) >= int(request.GetLimit()) {
+		return &historyservice.ReserveConcurrencyLimitResponse{Reserved: false}, nil
+	}
+	reservations[request.GetHolderWorkflowId()] = request.GetHolderRunId()
+	return &historyservice.ReserveConcurrencyLimitResponse{Reserved: true}, nil
+}
+
+func (f *fakeConcurrencyLimits) release(
+	_ context.Context,
+	request *historyservice.ReleaseConcurrencyLimitRequest,
+	_ ...grpc.CallOption,
+) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	f.releases++
+	if f.onRelease != nil {
+		f.onRelease()
+	}
+	if f.reservations[request.GetKey()][request.GetHolderWorkflowId()] == request.GetHolderRunId() {
+		delete(f.reservations[request.GetKey()], request.GetHolderWorkflowId())
+	}
+	return &historyservice.ReleaseConcurrencyLimitResponse{}, nil
+}
+
+func (f *fakeConcurrencyLimits) holders(key string) []string {
+	var workflowIDs []string
+	for workflowID := range f.reservations[key] {
+		workflowIDs = append(workflowIDs, workflowID)
+	}
+	sort.Strings(workflowIDs)
+	return workflowIDs
+}
+
+func TestReserveConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		WorkflowId:   "test-workflow-id",
+		WorkflowType: &commonpb.WorkflowType{Name: "test-workflow-type"},
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{
+				"CustomKeywordField": payload.EncodeString("tenant-1"),
+			},
+		},
+	}
+
+	setup := func(t *testing.T, mode string) (*shard.MockContext, *fakeConcurrencyLimits) {
+		controller := gomock.NewController(t)
+		config := tests.NewDynamicConfig()
+		config.MaxConcurrentWorkflowExecutions = dynamicconfig.GetIntPropertyFilteredByNamespace(10)
+		config.MaxConcurrentWorkflowExecutionsByWorkflowType = dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]any{
+			"test-workflow-type": 5,
+		})
+		config.MaxConcurrentWorkflowExecutionsBySearchAttribute = dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]any{
+			"CustomKeywordField": float64(2),
+		})
+		config.ConcurrencyLimitMode = func(string) string { return mode }
+		config.ConcurrencyLimitQueueCheckInterval = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(10 * time.Millisecond)
+
+		limits := &fakeConcurrencyLimits{reservations: make(map[string]map[string]string)}
+		historyClient := historyservicemock.NewMockHistoryServiceClient(controller)
+		historyClient.EXPECT().ReserveConcurrencyLimit(gomock.Any(), gomock.Any()).DoAndReturn(limits.reserve).AnyTimes()
+		historyClient.EXPECT().ReleaseConcurrencyLimit(gomock.Any(), gomock.Any()).DoAndReturn(limits.release).AnyTimes()
+
+		shardContext := shard.NewMockContext(controller)
+		shardContext.EXPECT().GetConfig().Return(config).AnyTimes()
+		shardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
+		shardContext.EXPECT().GetLogger().Return(log.NewTestLogger()).AnyTimes()
+		shardContext.EXPECT().GetHistoryClient().Return(historyClient).AnyTimes()
+		return shardContext, limits
+	}
+	fill := func(limits *fakeConcurrencyLimits, key string, workflowIDs ...string) {
+		limits.reservations[key] = make(map[string]string)
+		for _, workflowID := range workflowIDs {
+			limits.reservations[key][workflowID] = "other-run-id"
+		}
+	}
+
+	t.Run("under limits", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeReject)
+		fill(limits, searchAttributeLimitKey, "other-workflow-id")
+
+		reservation, err := api.ReserveConcurrencyLimits(context.Background(), shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		require.NoError(t, err)
+		require.NoError(t, reservation.CheckReserved())
+		for _, key := range []string{namespaceLimitKey, workflowTypeLimitKey, searchAttributeLimitKey} {
+			assert.Equal(t, "test-run-id", limits.reservations[key][request.WorkflowId], key)
+		}
+
+		mutableState := workflow.NewMockMutableState(gomock.NewController(t))
+		executionInfo := &persistencespb.WorkflowExecutionInfo{}
+		mutableState.EXPECT().GetExecutionInfo().Return(executionInfo)
+		reservation.Record(mutableState)
+		assert.Equal(t, []string{namespaceLimitKey, workflowTypeLimitKey, searchAttributeLimitKey}, executionInfo.ConcurrencyLimitKeys)
+
+		// the run was created, it keeps its slots until it closes
+		reservation.Release(context.Background(), "test-run-id")
+		assert.Equal(t, []string{"other-workflow-id", request.WorkflowId}, limits.holders(searchAttributeLimitKey))
+	})
+
+	t.Run("release when the run is not created", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeReject)
+
+		reservation, err := api.ReserveConcurrencyLimits(context.Background(), shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		require.NoError(t, err)
+
+		reservation.Release(context.Background(), "current-run-id")
+		for _, key := range []string{namespaceLimitKey, workflowTypeLimitKey, searchAttributeLimitKey} {
+			assert.Empty(t, limits.holders(key), key)
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeReject)
+		fill(limits, searchAttributeLimitKey, "workflow-id-1", "workflow-id-2")
+
+		_, err := api.ReserveConcurrencyLimits(context.Background(), shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		var resourceExhausted *serviceerror.ResourceExhausted
+		require.ErrorAs(t, err, &resourceExhausted)
+		assert.Equal(t, enumspb.RESOURCE_EXHAUSTED_CAUSE_CONCURRENT_LIMIT, resourceExhausted.Cause)
+		assert.Contains(t, resourceExhausted.Message, "CustomKeywordField")
+		// the slots reserved before reaching the limit are released
+		assert.Empty(t, limits.holders(namespaceLimitKey))
+		assert.Empty(t, limits.holders(workflowTypeLimitKey))
+	})
+
+	t.Run("workflow holding a slot", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeReject)
+		fill(limits, searchAttributeLimitKey, "workflow-id-1", request.WorkflowId)
+
+		_, err := api.ReserveConcurrencyLimits(context.Background(), shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		require.NoError(t, err)
+		assert.Equal(t, "test-run-id", limits.reservations[searchAttributeLimitKey][request.WorkflowId])
+	})
+
+	t.Run("queue until capacity frees up", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeQueue)
+		fill(limits, searchAttributeLimitKey, "workflow-id-1", "workflow-id-2")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		limits.onRelease = func() {
+			if limits.releases == 2 {
+				// a workflow holding the search attribute limit closes while the start is queued
+				delete(limits.reservations[searchAttributeLimitKey], "workflow-id-1")
+			}
+		}
+
+		_, err := api.ReserveConcurrencyLimits(ctx, shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{request.WorkflowId, "workflow-id-2"}, limits.holders(searchAttributeLimitKey))
+	})
+
+	t.Run("queue until deadline", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeQueue)
+		fill(limits, namespaceLimitKey, "workflow-id-1", "workflow-id-2", "workflow-id-3", "workflow-id-4", "workflow-id-5",
+			"workflow-id-6", "workflow-id-7", "workflow-id-8", "workflow-id-9", "workflow-id-10")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := api.ReserveConcurrencyLimits(ctx, shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		var resourceExhausted *serviceerror.ResourceExhausted
+		require.ErrorAs(t, err, &resourceExhausted)
+	})
+
+	t.Run("skipped", func(t *testing.T) {
+		shardContext, limits := setup(t, api.ConcurrencyLimitModeReject)
+
+		reservation := api.SkipConcurrencyLimits(shardContext, tests.GlobalNamespaceEntry, request)
+		require.True(t, reservation.Skipped())
+		var unavailable *serviceerror.Unavailable
+		require.ErrorAs(t, reservation.CheckReserved(), &unavailable)
+		reservation.Release(context.Background(), "")
+		assert.Empty(t, limits.reservations)
+	})
+
+	t.Run("no limits", func(t *testing.T) {
+		shardContext, _ := setup(t, api.ConcurrencyLimitModeReject)
+		config := shardContext.GetConfig()
+		config.MaxConcurrentWorkflowExecutions = dynamicconfig.GetIntPropertyFilteredByNamespace(0)
+		config.MaxConcurrentWorkflowExecutionsByWorkflowType = dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]any{})
+		config.MaxConcurrentWorkflowExecutionsBySearchAttribute = dynamicconfig.GetMapPropertyFnWithNamespaceFilter(map[string]any{})
+
+		reservation, err := api.ReserveConcurrencyLimits(context.Background(), shardContext, tests.GlobalNamespaceEntry, request, "test-run-id", "test")
+		require.NoError(t, err)
+		require.Nil(t, reservation)
+		require.Nil(t, api.SkipConcurrencyLimits(shardContext, tests.GlobalNamespaceEntry, request))
+		require.NoError(t, reservation.CheckReserved())
+		reservation.Release(context.Background(), "")
+	})
+}
@@ -0,0 +1,225 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package concurrencylimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+)
+
+const testKey = "namespace"
+
+type testContext struct {
+	shardContext  *shard.MockContext
+	historyClient *historyservicemock.MockHistoryServiceClient
+	reservations  map[string]*persistencespb.ConcurrencyLimitReservation
+	now           time.Time
+}
+
+func newTestContext(t *testing.T) *testContext {
+	controller := gomock.NewController(t)
+	tc := &testContext{
+		shardContext:  shard.NewMockContext(controller),
+		historyClient: historyservicemock.NewMockHistoryServiceClient(controller),
+		reservations:  make(map[string]*persistencespb.ConcurrencyLimitReservation),
+		now:           time.Now().UTC(),
+	}
+	tc.shardContext.EXPECT().GetConfig().Return(tests.NewDynamicConfig()).AnyTimes()
+	tc.shardContext.EXPECT().GetTimeSource().Return(clock.NewEventTimeSource().Update(tc.now)).AnyTimes()
+	tc.shardContext.EXPECT().GetHistoryClient().Return(tc.historyClient).AnyTimes()
+	tc.shardContext.EXPECT().GetConcurrencyLimitReservations(reservationsKey(tests.NamespaceID.String(), testKey)).DoAndReturn(
+		func(string) map[string]*persistencespb.ConcurrencyLimitReservation {
+			result := make(map[string]*persistencespb.ConcurrencyLimitReservation, len(tc.reservations))
+			for workflowID, reservation := range tc.reservations {
+				result[workflowID] = &persistencespb.ConcurrencyLimitReservation{
+					RunId:      reservation.GetRunId(),
+					UpdateTime: reservation.GetUpdateTime(),
+				}
+			}
+			return result
+		},
+	).AnyTimes()
+	tc.shardContext.EXPECT().UpdateConcurrencyLimitReservations(reservationsKey(tests.NamespaceID.String(), testKey), gomock.Any()).DoAndReturn(
+		func(_ string, updateFn func(map[string]*persistencespb.ConcurrencyLimitReservation) bool) error {
+			updateFn(tc.reservations)
+			return nil
+		},
+	).AnyTimes()
+	return tc
+}
+
+func (tc *testContext) hold(workflowID string, runID string, age time.Duration) {
+	tc.reservations[workflowID] = &persistencespb.ConcurrencyLimitReservation{
+		RunId:      runID,
+		UpdateTime: timestamppb.New(tc.now.Add(-age)),
+	}
+}
+
+func (tc *testContext) expectRunning(workflowID string, runID string, status enumspb.WorkflowExecutionStatus) {
+	tc.historyClient.EXPECT().GetMutableState(gomock.Any(), protomock.Eq(&historyservice.GetMutableStateRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: workflowID},
+	})).Return(&historyservice.GetMutableStateResponse{
+		Execution:      &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+		WorkflowStatus: status,
+	}, nil)
+}
+
+func (tc *testContext) expectNotFound(workflowID string) {
+	tc.historyClient.EXPECT().GetMutableState(gomock.Any(), protomock.Eq(&historyservice.GetMutableStateRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: workflowID},
+	})).Return(nil, serviceerror.NewNotFound("workflow not found"))
+}
+
+func (tc *testContext) reserve(t *testing.T, workflowID string, runID string, limit int32) bool {
+	resp, err := Reserve(context.Background(), tc.shardContext, &historyservice.ReserveConcurrencyLimitRequest{
+		NamespaceId:      tests.NamespaceID.String(),
+		Key:              testKey,
+		Limit:            limit,
+		HolderWorkflowId: workflowID,
+		HolderRunId:      runID,
+	})
+	require.NoError(t, err)
+	return resp.GetReserved()
+}
+
+func (tc *testContext) release(t *testing.T, workflowID string, runID string) {
+	_, err := Release(context.Background(), tc.shardContext, &historyservice.ReleaseConcurrencyLimitRequest{
+		NamespaceId:      tests.NamespaceID.String(),
+		Key:              testKey,
+		HolderWorkflowId: workflowID,
+		HolderRunId:      runID,
+	})
+	require.NoError(t, err)
+}
+
+func TestReserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("under limit", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", 0)
+
+		require.True(t, tc.reserve(t, "workflow-2", "run-2", 2))
+		assert.Equal(t, "run-2", tc.reservations["workflow-2"].GetRunId())
+	})
+
+	t.Run("workflow holding a slot", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", time.Hour)
+
+		require.True(t, tc.reserve(t, "workflow-1", "run-2", 1))
+		assert.Equal(t, "run-2", tc.reservations["workflow-1"].GetRunId())
+		assert.Equal(t, tc.now, tc.reservations["workflow-1"].GetUpdateTime().AsTime())
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", 0)
+
+		require.False(t, tc.reserve(t, "workflow-2", "run-2", 1))
+		assert.NotContains(t, tc.reservations, "workflow-2")
+	})
+
+	t.Run("stale reservation of a closed workflow", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", time.Hour)
+		tc.hold("workflow-2", "run-2", time.Hour)
+		tc.expectRunning("workflow-1", "run-1", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+		tc.expectNotFound("workflow-2")
+
+		require.True(t, tc.reserve(t, "workflow-3", "run-3", 2))
+		assert.Len(t, tc.reservations, 1)
+		assert.Contains(t, tc.reservations, "workflow-3")
+	})
+
+	t.Run("stale reservation of a running workflow", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", time.Hour)
+		tc.expectRunning("workflow-1", "run-2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+		require.False(t, tc.reserve(t, "workflow-2", "run-3", 1))
+		assert.Equal(t, "run-2", tc.reservations["workflow-1"].GetRunId())
+		assert.Equal(t, tc.now, tc.reservations["workflow-1"].GetUpdateTime().AsTime())
+	})
+}
+
+func TestRelease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("closed", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", 0)
+		tc.expectRunning("workflow-1", "run-1", enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED)
+
+		tc.release(t, "workflow-1", "run-1")
+		assert.Empty(t, tc.reservations)
+	})
+
+	t.Run("continued as new", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", time.Hour)
+		tc.expectRunning("workflow-1", "run-2", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+		tc.release(t, "workflow-1", "run-1")
+		assert.Equal(t, "run-2", tc.reservations["workflow-1"].GetRunId())
+		assert.Equal(t, tc.now, tc.reservations["workflow-1"].GetUpdateTime().AsTime())
+	})
+
+	t.Run("created", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-1", 0)
+		tc.expectRunning("workflow-1", "run-1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+		tc.release(t, "workflow-1", "run-1")
+		assert.Equal(t, "run-1", tc.reservations["workflow-1"].GetRunId())
+	})
+
+	t.Run("held by another run", func(t *testing.T) {
+		tc := newTestContext(t)
+		tc.hold("workflow-1", "run-2", 0)
+
+		tc.release(t, "workflow-1", "run-1")
+		assert.Equal(t, "run-2", tc.reservations["workflow-1"].GetRunId())
+	})
+}
@@ -0,0 +1,102 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package concurrencylimit
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/shard"
+)
+
+// Release releases the slot of a concurrency limit owned by the shard held by a run of a workflow. It does nothing if
+// the slot is not held by that run. The running run of the workflow is verified first: if there is one, e.g. the run
+// the holder continued as, it takes the slot over.
+func Release(
+	ctx context.Context,
+	shardContext shard.Context,
+	request *historyservice.ReleaseConcurrencyLimitRequest,
+) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	key := reservationsKey(request.GetNamespaceId(), request.GetKey())
+	verified, ok := shardContext.GetConcurrencyLimitReservations(key)[request.GetHolderWorkflowId()]
+	if !ok || verified.GetRunId() != request.GetHolderRunId() {
+		return &historyservice.ReleaseConcurrencyLimitResponse{}, nil
+	}
+
+	runningRunID, err := getRunningRunID(ctx, shardContext, namespace.ID(request.GetNamespaceId()), request.GetHolderWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	if runningRunID == request.GetHolderRunId() {
+		return &historyservice.ReleaseConcurrencyLimitResponse{}, nil
+	}
+
+	now := shardContext.GetTimeSource().Now()
+	if err := shardContext.UpdateConcurrencyLimitReservations(
+		key,
+		func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool {
+			return updateReservation(reservations, request.GetHolderWorkflowId(), verified, runningRunID, now)
+		},
+	); err != nil {
+		return nil, err
+	}
+	return &historyservice.ReleaseConcurrencyLimitResponse{}, nil
+}
+
+// getRunningRunID returns the running run of a workflow, or an empty string if the workflow is not running.
+func getRunningRunID(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceID namespace.ID,
+	workflowID string,
+) (string, error) {
+	resp, err := shardContext.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+		},
+	})
+	if err != nil {
+		if common.IsNotFoundError(err) {
+			return "", nil
+		}
+		var namespaceNotFound *serviceerror.NamespaceNotFound
+		if errors.As(err, &namespaceNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if resp.GetWorkflowStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return "", nil
+	}
+	return resp.GetExecution().GetRunId(), nil
+}
@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package concurrencylimit
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/shard"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxVerifiedReservations is the max number of reservations verified by a reservation which reached the limit
+	maxVerifiedReservations = 100
+)
+
+// Reserve reserves a slot of a concurrency limit owned by the shard for a run of a workflow. The slot is reserved if
+// the workflow already holds one, in which case the run takes it over, or if the limit is not reached. Otherwise, the
+// oldest reservations held for longer than the grace period are verified, and the ones of workflows which are not
+// running are released before trying again.
+func Reserve(
+	ctx context.Context,
+	shardContext shard.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	reserved, err := reserve(shardContext, request)
+	if err != nil || reserved {
+		return &historyservice.ReserveConcurrencyLimitResponse{Reserved: reserved}, err
+	}
+
+	released, err := releaseStaleReservations(
+		ctx,
+		shardContext,
+		namespace.ID(request.GetNamespaceId()),
+		reservationsKey(request.GetNamespaceId(), request.GetKey()),
+	)
+	if err != nil || !released {
+		return &historyservice.ReserveConcurrencyLimitResponse{Reserved: false}, err
+	}
+
+	reserved, err = reserve(shardContext, request)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ReserveConcurrencyLimitResponse{Reserved: reserved}, nil
+}
+
+func reserve(
+	shardContext shard.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+) (bool, error) {
+	now := shardContext.GetTimeSource().Now()
+	reserved := false
+	err := shardContext.UpdateConcurrencyLimitReservations(
+		reservationsKey(request.GetNamespaceId(), request.GetKey()),
+		func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool {
+			if _, ok := reservations[request.GetHolderWorkflowId()]; !ok && len(reservations) >= int(request.GetLimit()) {
+				return false
+			}
+			reservations[request.GetHolderWorkflowId()] = &persistencespb.ConcurrencyLimitReservation{
+				RunId:      request.GetHolderRunId(),
+				UpdateTime: timestamppb.New(now),
+			}
+			reserved = true
+			return true
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return reserved, nil
+}
+
+// reservationsKey returns the key of the reservations of a concurrency limit of a namespace in the shard info.
+func reservationsKey(namespaceID string, key string) string {
+	return namespaceID + "/" + key
+}
+
+// releaseStaleReservations verifies the oldest reservations of a concurrency limit held for longer than the grace
+// period. It releases the ones of workflows which are not running, and hands the other ones over to the running run of
+// their workflow. It returns whether any reservation was released.
+func releaseStaleReservations(
+	ctx context.Context,
+	shardContext shard.Context,
+	namespaceID namespace.ID,
+	key string,
+) (bool, error) {
+	now := shardContext.GetTimeSource().Now()
+	staleTime := now.Add(-shardContext.GetConfig().ConcurrencyLimitReservationGracePeriod())
+
+	snapshot := shardContext.GetConcurrencyLimitReservations(key)
+	var workflowIDs []string
+	for workflowID, reservation := range snapshot {
+		if reservation.GetUpdateTime().AsTime().Before(staleTime) {
+			workflowIDs = append(workflowIDs, workflowID)
+		}
+	}
+	if len(workflowIDs) == 0 {
+		return false, nil
+	}
+	sort.Slice(workflowIDs, func(i, j int) bool {
+		return snapshot[workflowIDs[i]].GetUpdateTime().AsTime().Before(snapshot[workflowIDs[j]].GetUpdateTime().AsTime())
+	})
+	if len(workflowIDs) > maxVerifiedReservations {
+		workflowIDs = workflowIDs[:maxVerifiedReservations]
+	}
+
+	runningRunIDs := make(map[string]string, len(workflowIDs))
+	for _, workflowID := range workflowIDs {
+		runID, err := getRunningRunID(ctx, shardContext, namespaceID, workflowID)
+		if err != nil {
+			return false, err
+		}
+		runningRunIDs[workflowID] = runID
+	}
+
+	released := false
+	err := shardContext.UpdateConcurrencyLimitReservations(
+		key,
+		func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool {
+			changed := false
+			for workflowID, runID := range runningRunIDs {
+				if updateReservation(reservations, workflowID, snapshot[workflowID], runID, now) {
+					changed = true
+					if runID == "" {
+						released = true
+					}
+				}
+			}
+			return changed
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+	return released, nil
+}
+
+// updateReservation releases the reservation of a workflow if it is not running, or hands it over to its running run,
+// unless the reservation changed since it was verified. It returns whether the reservation was updated.
+func updateReservation(
+	reservations map[string]*persistencespb.ConcurrencyLimitReservation,
+	workflowID string,
+	verified *persistencespb.ConcurrencyLimitReservation,
+	runningRunID string,
+	now time.Time,
+) bool {
+	reservation, ok := reservations[workflowID]
+	if !ok || !proto.Equal(reservation, verified) {
+		return false
+	}
+	if runningRunID == "" {
+		delete(reservations, workflowID)
+		return true
+	}
+	reservations[workflowID] = &persistencespb.ConcurrencyLimitReservation{
+		RunId:      runningRunID,
+		UpdateTime: timestamppb.New(now),
+	}
+	return true
+}
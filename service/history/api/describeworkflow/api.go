@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -97,6 +98,29 @@ func Invoke(
 	defer func() { workflowLease.GetReleaseFn()(retError) }()
 
 	mutableState := workflowLease.GetMutableState()
+	relocatableAttributes, err := workflow.RelocatableAttributesFetcherProvider(persistenceVisibilityMgr).Fetch(ctx, mutableState)
+	if err != nil {
+		shard.GetLogger().Error(
+			"Failed to fetch relocatable attributes",
+			tag.WorkflowNamespaceID(namespaceID.String()),
+			tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+			tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+			tag.Error(err),
+		)
+		return nil, serviceerror.NewInternal("Failed to fetch memo and search attributes")
+	}
+	return BuildResponse(ctx, mutableState, relocatableAttributes, shard.GetLogger())
+}
+
+// BuildResponse builds the DescribeWorkflowExecution response of a workflow from its mutable state, with the given memo
+// and search attributes.
+func BuildResponse(
+	ctx context.Context,
+	mutableState workflow.MutableState,
+	relocatableAttributes *workflow.RelocatableAttributes,
+	logger log.Logger,
+) (*historyservice.DescribeWorkflowExecutionResponse, error) {
+	var err error
 	executionInfo := mutableState.GetExecutionInfo()
 	executionState := mutableState.GetExecutionState()
 
@@ -185,7 +209,12 @@ func Invoke(
 			return nil, err
 		}
 		if p.State == enumspb.PENDING_ACTIVITY_STATE_SCHEDULED {
-			p.ScheduledTime = ai.ScheduledTime
+			// PendingActivityInfo has no paused state. Paused activities are listed by the
+			// TemporalPausedActivities search attribute of the execution info, and are reported without a
+			// scheduled time since they won't be dispatched until they are unpaused.
+			if !ai.Paused {
+				p.ScheduledTime = ai.ScheduledTime
+			}
 		} else {
 			p.LastStartedTime = ai.StartedTime
 		}
@@ -232,17 +261,6 @@ func Invoke(
 		}
 	}
 
-	relocatableAttributes, err := workflow.RelocatableAttributesFetcherProvider(persistenceVisibilityMgr).Fetch(ctx, mutableState)
-	if err != nil {
-		shard.GetLogger().Error(
-			"Failed to fetch relocatable attributes",
-			tag.WorkflowNamespaceID(namespaceID.String()),
-			tag.WorkflowID(executionInfo.WorkflowId),
-			tag.WorkflowRunID(executionState.RunId),
-			tag.Error(err),
-		)
-		return nil, serviceerror.NewInternal("Failed to fetch memo and search attributes")
-	}
 	result.WorkflowExecutionInfo.Memo = &commonpb.Memo{
 		Fields: clonePayloadMap(relocatableAttributes.Memo.GetFields()),
 	}
@@ -255,9 +273,9 @@ func Invoke(
 	for _, entry := range cbs {
 		callback, err := coll.Data(entry.Key.ID)
 		if err != nil {
-			shard.GetLogger().Error(
+			logger.Error(
 				"failed to load callback data while building describe response",
-				tag.WorkflowNamespaceID(namespaceID.String()),
+				tag.WorkflowNamespaceID(executionInfo.NamespaceId),
 				tag.WorkflowID(executionInfo.WorkflowId),
 				tag.WorkflowRunID(executionState.RunId),
 				tag.Error(err),
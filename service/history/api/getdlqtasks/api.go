@@ -81,6 +81,7 @@ func Invoke(
 				ShardId: task.Payload.ShardId,
 				Blob:    task.Payload.Blob,
 			},
+			Failure: task.Payload.DlqFailure,
 		}
 	}
 
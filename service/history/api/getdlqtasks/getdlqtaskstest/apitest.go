@@ -27,14 +27,18 @@ package getdlqtaskstest
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/service/history/api/getdlqtasks"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -49,6 +53,11 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 	sourceCluster := "test-source-cluster-" + t.Name()
 	targetCluster := "test-target-cluster-" + t.Name()
 	queueType := persistence.QueueTypeHistoryDLQ
+	failure := &commonspb.HistoryDLQTaskFailure{
+		Type:    enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT,
+		Message: "some random error",
+		Time:    timestamppb.New(time.Unix(0, 0)),
+	}
 	_, err := manager.CreateQueue(ctx, &persistence.CreateQueueRequest{
 		QueueKey: persistence.QueueKey{
 			QueueType:     queueType,
@@ -64,6 +73,7 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 		TargetCluster: targetCluster,
 		Task:          inTask,
 		SourceShardID: 1,
+		DLQFailure:    failure,
 	})
 	require.NoError(t, err)
 	res, err := getdlqtasks.Invoke(
@@ -83,6 +93,7 @@ func TestInvoke(t *testing.T, manager persistence.HistoryTaskQueueManager) {
 	require.Equal(t, 1, len(res.DlqTasks))
 	assert.Equal(t, int64(persistence.FirstQueueMessageID), res.DlqTasks[0].Metadata.MessageId)
 	assert.Equal(t, 1, int(res.DlqTasks[0].Payload.ShardId))
+	protoassert.ProtoEqual(t, failure, res.DlqTasks[0].Failure)
 	serializer := serialization.NewTaskSerializer()
 	outTask, err := serializer.DeserializeTask(tasks.CategoryTransfer, res.DlqTasks[0].Payload.Blob)
 	require.NoError(t, err)
@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+type (
+	// PayloadOffload offloads the oversized payloads of a request once history accepted it, and records them in the
+	// mutable state of the run referencing them, so that they are deleted along with the run.
+	PayloadOffload struct {
+		shardContext shard.Context
+		// keys of the payloads offloaded by every attempt of the request
+		keys []string
+	}
+)
+
+func NewPayloadOffload(
+	shardContext shard.Context,
+) *PayloadOffload {
+	return &PayloadOffload{
+		shardContext: shardContext,
+	}
+}
+
+// Size returns the size of payloads once offloaded.
+func (o *PayloadOffload) Size(
+	namespaceEntry *namespace.Namespace,
+	payloads *commonpb.Payloads,
+) int {
+	return o.shardContext.GetPayloadOffloader().Size(namespaceEntry.Name(), payloads)
+}
+
+// Offload replaces the oversized payloads of payloads by references to an offloaded copy.
+func (o *PayloadOffload) Offload(
+	ctx context.Context,
+	namespaceEntry *namespace.Namespace,
+	payloads *commonpb.Payloads,
+) error {
+	keys, err := o.shardContext.GetPayloadOffloader().Offload(ctx, namespaceEntry.ID(), namespaceEntry.Name(), payloads)
+	o.keys = append(o.keys, keys...)
+	return err
+}
+
+// Record records the offloaded payloads in mutableState. eventID is the ID of the event referencing them, or a lower
+// bound of it if the event is buffered.
+func (o *PayloadOffload) Record(
+	mutableState workflow.MutableState,
+	eventID int64,
+) {
+	if len(o.keys) == 0 {
+		return
+	}
+	executionInfo := mutableState.GetExecutionInfo()
+	recorded := make(map[string]struct{}, len(executionInfo.OffloadedPayloads))
+	for _, offloadedPayload := range executionInfo.OffloadedPayloads {
+		recorded[offloadedPayload.GetKey()] = struct{}{}
+	}
+	// payloads offloaded by a previous attempt are recorded again, as the mutable state of that attempt was discarded
+	for _, key := range o.keys {
+		if _, ok := recorded[key]; ok {
+			continue
+		}
+		executionInfo.OffloadedPayloads = append(executionInfo.OffloadedPayloads, &persistencespb.OffloadedPayload{
+			Key:     key,
+			EventId: eventID,
+		})
+	}
+}
+
+// Release deletes the offloaded payloads if the request failed with err and was definitely not persisted.
+func (o *PayloadOffload) Release(
+	ctx context.Context,
+	err error,
+) {
+	if err == nil || len(o.keys) == 0 || isPersistenceOutcomeUnknown(err) {
+		return
+	}
+	if err := o.shardContext.GetPayloadOffloader().Delete(ctx, o.keys); err != nil {
+		o.shardContext.GetLogger().Warn("Failed to delete offloaded payloads of rejected request", tag.Error(err))
+	}
+}
+
+func isPersistenceOutcomeUnknown(err error) bool {
+	var timeoutErr *persistence.TimeoutError
+	var unavailableErr *serviceerror.Unavailable
+	var internalErr *serviceerror.Internal
+	return errors.As(err, &timeoutErr) ||
+		errors.As(err, &unavailableErr) ||
+		errors.As(err, &internalErr) ||
+		common.IsContextDeadlineExceededErr(err) ||
+		common.IsContextCanceledErr(err)
+}
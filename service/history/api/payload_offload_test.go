@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package api_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tests"
+	"go.temporal.io/server/service/history/workflow"
+)
+
+func TestPayloadOffload(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*api.PayloadOffload, *payloadstore.Offloader) {
+		controller := gomock.NewController(t)
+		store, err := payloadstore.NewFilestore(t.TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+		require.NoError(t, err)
+		offloader := payloadstore.NewOffloader(store, dynamicconfig.GetIntPropertyFilteredByNamespace(10))
+		shardContext := shard.NewMockContext(controller)
+		shardContext.EXPECT().GetPayloadOffloader().Return(offloader).AnyTimes()
+		shardContext.EXPECT().GetLogger().Return(log.NewTestLogger()).AnyTimes()
+		return api.NewPayloadOffload(shardContext), offloader
+	}
+
+	t.Run("records payloads offloaded by previous attempts", func(t *testing.T) {
+		t.Parallel()
+		payloadOffload, _ := setup(t)
+		input := payloads.EncodeString(strings.Repeat("large", 10))
+
+		require.NoError(t, payloadOffload.Offload(context.Background(), tests.GlobalNamespaceEntry, input))
+		require.True(t, payloadstore.IsReference(input.Payloads[0]))
+		key := string(input.Payloads[0].Metadata["claimCheckKey"])
+
+		// the retried attempt sees the already offloaded payload
+		require.NoError(t, payloadOffload.Offload(context.Background(), tests.GlobalNamespaceEntry, input))
+		executionInfo := &persistencespb.WorkflowExecutionInfo{}
+		mutableState := workflow.NewMockMutableState(gomock.NewController(t))
+		mutableState.EXPECT().GetExecutionInfo().Return(executionInfo).AnyTimes()
+		payloadOffload.Record(mutableState, 5)
+		payloadOffload.Record(mutableState, 5)
+		require.Equal(t, []*persistencespb.OffloadedPayload{{Key: key, EventId: 5}}, executionInfo.OffloadedPayloads)
+	})
+
+	t.Run("deletes payloads of rejected requests", func(t *testing.T) {
+		t.Parallel()
+		payloadOffload, offloader := setup(t)
+		input := payloads.EncodeString(strings.Repeat("large", 10))
+		require.NoError(t, payloadOffload.Offload(context.Background(), tests.GlobalNamespaceEntry, input))
+
+		payloadOffload.Release(context.Background(), serviceerror.NewInvalidArgument("rejected"))
+		require.ErrorContains(t, offloader.Rehydrate(context.Background(), input), payloadstore.ErrPayloadNotFound.Error())
+	})
+
+	t.Run("keeps payloads of requests which may have been persisted", func(t *testing.T) {
+		t.Parallel()
+		payloadOffload, offloader := setup(t)
+		input := payloads.EncodeString(strings.Repeat("large", 10))
+		require.NoError(t, payloadOffload.Offload(context.Background(), tests.GlobalNamespaceEntry, input))
+
+		payloadOffload.Release(context.Background(), serviceerror.NewUnavailable("timed out"))
+		require.NoError(t, offloader.Rehydrate(context.Background(), input))
+		require.False(t, payloadstore.IsReference(input.Payloads[0]))
+	})
+}
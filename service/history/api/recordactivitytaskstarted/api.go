@@ -90,6 +90,16 @@ func Invoke(
 				return nil, consts.ErrActivityTaskNotFound
 			}
 
+			// A paused activity is rescheduled when it is unpaused, so the task can be dropped.
+			if ai.Paused && ai.StartedEventId == common.EmptyEventID {
+				return nil, consts.ErrActivityTaskNotFound
+			}
+
+			// Same for a suspended workflow, its activities are rescheduled when it is resumed.
+			if mutableState.IsWorkflowExecutionSuspended() && ai.StartedEventId == common.EmptyEventID {
+				return nil, consts.ErrActivityTaskNotFound
+			}
+
 			scheduledEvent, err := mutableState.GetActivityScheduledEvent(ctx, scheduledEventID)
 			if err != nil {
 				return nil, err
@@ -26,13 +26,25 @@ package replication
 
 import (
 	"context"
+	"math"
+	"slices"
 
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/replication"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	backlogScanPageSize = 1000
+	// backlogScanMaxTasks bounds the replication tasks read per shard and cluster when scanning the backlog, so the
+	// status of a cluster which is far behind stays cheap to get.
+	backlogScanMaxTasks = 100000
+)
+
 func GetStatus(
 	ctx context.Context,
 	request *historyservice.GetReplicationStatusRequest,
@@ -54,5 +66,113 @@ func GetStatus(
 	}
 	resp.RemoteClusters = remoteClusters
 	resp.HandoverNamespaces = handoverNamespaces
+
+	if request.GetIncludeBacklog() {
+		for clusterName, status := range remoteClusters {
+			if err := getBacklog(ctx, shard, clusterName, maxReplicationTaskId, status); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return resp, nil
 }
+
+func getBacklog(
+	ctx context.Context,
+	shard shard.Context,
+	clusterName string,
+	maxReplicationTaskID int64,
+	status *historyservice.ShardReplicationStatusPerCluster,
+) error {
+	now := shard.GetTimeSource().Now()
+	executionManager := shard.GetExecutionManager()
+
+	var oldestUnackedTask tasks.Task
+	outstandingTaskCount, outstandingTruncated, err := countTasks(
+		func(pageToken []byte) (*persistence.GetHistoryTasksResponse, error) {
+			if status.AckedTaskId >= maxReplicationTaskID {
+				return &persistence.GetHistoryTasksResponse{}, nil
+			}
+			return executionManager.GetHistoryTasks(ctx, &persistence.GetHistoryTasksRequest{
+				ShardID:             shard.GetShardID(),
+				TaskCategory:        tasks.CategoryReplication,
+				InclusiveMinTaskKey: tasks.NewImmediateKey(status.AckedTaskId + 1),
+				ExclusiveMaxTaskKey: tasks.NewImmediateKey(maxReplicationTaskID + 1),
+				BatchSize:           backlogScanPageSize,
+				NextPageToken:       pageToken,
+			})
+		},
+		func(task tasks.Task) bool {
+			// tasks excluded for the cluster by replication filters are never sent, so they are not outstanding
+			if slices.Contains(tasks.GetReplicationExcludedClusters(task), clusterName) {
+				return false
+			}
+			if oldestUnackedTask == nil {
+				oldestUnackedTask = task
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	dlqAckLevel := shard.GetReplicatorDLQAckLevel(clusterName)
+	dlqSize, dlqTruncated, err := countTasks(
+		func(pageToken []byte) (*persistence.GetHistoryTasksResponse, error) {
+			return executionManager.GetReplicationTasksFromDLQ(ctx, &persistence.GetReplicationTasksFromDLQRequest{
+				GetHistoryTasksRequest: persistence.GetHistoryTasksRequest{
+					ShardID:             shard.GetShardID(),
+					TaskCategory:        tasks.CategoryReplication,
+					InclusiveMinTaskKey: tasks.NewImmediateKey(dlqAckLevel + 1),
+					ExclusiveMaxTaskKey: tasks.NewImmediateKey(math.MaxInt64),
+					BatchSize:           backlogScanPageSize,
+					NextPageToken:       pageToken,
+				},
+				SourceClusterName: clusterName,
+			})
+		},
+		func(tasks.Task) bool { return true },
+	)
+	if err != nil {
+		return err
+	}
+
+	status.OutstandingTaskCount = outstandingTaskCount
+	status.OldestUnackedTaskAge = durationpb.New(0)
+	if oldestUnackedTask != nil {
+		status.OldestUnackedTaskAge = durationpb.New(max(now.Sub(oldestUnackedTask.GetVisibilityTime()), 0))
+	}
+	status.DlqSize = dlqSize
+	status.BacklogTruncated = outstandingTruncated || dlqTruncated
+	return nil
+}
+
+// countTasks pages through tasks and counts the ones matching the predicate, until there are no more tasks or
+// backlogScanMaxTasks tasks have been read.
+func countTasks(
+	getTasks func(pageToken []byte) (*persistence.GetHistoryTasksResponse, error),
+	predicate func(task tasks.Task) bool,
+) (count int64, truncated bool, _ error) {
+	var pageToken []byte
+	scanned := 0
+	for {
+		resp, err := getTasks(pageToken)
+		if err != nil {
+			return 0, false, err
+		}
+		for _, task := range resp.Tasks {
+			if predicate(task) {
+				count++
+			}
+		}
+		scanned += len(resp.Tasks)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return count, false, nil
+		}
+		if scanned >= backlogScanMaxTasks {
+			return count, true, nil
+		}
+	}
+}
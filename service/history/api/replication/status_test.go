@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/api/replication"
+	historyreplication "go.temporal.io/server/service/history/replication"
+	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetStatus_IncludeBacklog(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	now := time.Now()
+	remoteCluster := "remote"
+
+	shardContext := shard.NewMockContext(ctrl)
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetTimeSource().Return(clock.NewEventTimeSource().Update(now)).AnyTimes()
+	shardContext.EXPECT().GetReplicationStatus([]string{remoteCluster}).Return(
+		map[string]*historyservice.ShardReplicationStatusPerCluster{
+			remoteCluster: {
+				AckedTaskId:             10,
+				AckedTaskVisibilityTime: timestamppb.New(now.Add(-time.Hour)),
+			},
+		},
+		nil,
+		nil,
+	)
+	shardContext.EXPECT().GetReplicatorDLQAckLevel(remoteCluster).Return(int64(5))
+
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	shardContext.EXPECT().GetExecutionManager().Return(executionManager).AnyTimes()
+	executionManager.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
+		ShardID:             1,
+		TaskCategory:        tasks.CategoryReplication,
+		InclusiveMinTaskKey: tasks.NewImmediateKey(11),
+		ExclusiveMaxTaskKey: tasks.NewImmediateKey(21),
+		BatchSize:           1000,
+	}).Return(&persistence.GetHistoryTasksResponse{
+		Tasks: []tasks.Task{
+			// excluded from the remote cluster by replication filters, so it is never sent
+			&tasks.HistoryReplicationTask{
+				TaskID:              11,
+				VisibilityTimestamp: now.Add(-time.Hour),
+				ExcludedClusters:    []string{remoteCluster},
+			},
+			&tasks.HistoryReplicationTask{TaskID: 12, VisibilityTimestamp: now.Add(-time.Minute)},
+			&tasks.SyncActivityTask{TaskID: 15, VisibilityTimestamp: now.Add(-time.Second)},
+		},
+	}, nil)
+	executionManager.EXPECT().GetReplicationTasksFromDLQ(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (*persistence.GetHistoryTasksResponse, error) {
+			assert.Equal(t, remoteCluster, request.SourceClusterName)
+			assert.Equal(t, int64(6), request.InclusiveMinTaskKey.TaskID)
+			return &persistence.GetHistoryTasksResponse{
+				Tasks: []tasks.Task{&tasks.HistoryReplicationTask{TaskID: 7}},
+			}, nil
+		},
+	)
+
+	ackManager := historyreplication.NewMockAckManager(ctrl)
+	ackManager.EXPECT().GetMaxTaskInfo().Return(int64(20), now)
+
+	resp, err := replication.GetStatus(
+		context.Background(),
+		&historyservice.GetReplicationStatusRequest{
+			RemoteClusters: []string{remoteCluster},
+			IncludeBacklog: true,
+		},
+		shardContext,
+		ackManager,
+	)
+	require.NoError(t, err)
+	status := resp.RemoteClusters[remoteCluster]
+	assert.Equal(t, int64(10), status.AckedTaskId)
+	assert.Equal(t, int64(2), status.OutstandingTaskCount)
+	assert.Equal(t, time.Minute, status.OldestUnackedTaskAge.AsDuration())
+	assert.Equal(t, int64(1), status.DlqSize)
+	assert.False(t, status.BacklogTruncated)
+}
+
+func TestGetStatus_WithoutBacklog(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	shardContext := shard.NewMockContext(ctrl)
+	shardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	shardContext.EXPECT().GetTimeSource().Return(clock.NewRealTimeSource()).AnyTimes()
+	shardContext.EXPECT().GetReplicationStatus(gomock.Any()).Return(
+		map[string]*historyservice.ShardReplicationStatusPerCluster{
+			"remote": {AckedTaskId: 10},
+		},
+		nil,
+		nil,
+	)
+	ackManager := historyreplication.NewMockAckManager(ctrl)
+	ackManager.EXPECT().GetMaxTaskInfo().Return(int64(20), time.Now())
+
+	resp, err := replication.GetStatus(
+		context.Background(),
+		&historyservice.GetReplicationStatusRequest{},
+		shardContext,
+		ackManager,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.RemoteClusters["remote"].OutstandingTaskCount)
+	assert.Nil(t, resp.RemoteClusters["remote"].OldestUnackedTaskAge)
+}
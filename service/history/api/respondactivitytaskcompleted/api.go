@@ -60,6 +60,9 @@ func Invoke(
 		return nil, err
 	}
 
+	payloadOffload := api.NewPayloadOffload(shard)
+	defer func() { payloadOffload.Release(ctx, retError) }()
+
 	var activityStartedTime time.Time
 	var taskQueue string
 	var workflowTypeName string
@@ -103,6 +106,10 @@ func Invoke(
 				return nil, consts.ErrActivityTaskNotFound
 			}
 
+			if err := payloadOffload.Offload(ctx, namespaceEntry, request.GetResult()); err != nil {
+				return nil, err
+			}
+			payloadOffload.Record(mutableState, mutableState.GetNextEventID())
 			if _, err := mutableState.AddActivityTaskCompletedEvent(scheduledEventID, ai.StartedEventId, request); err != nil {
 				// Unable to add ActivityTaskCompleted event to history
 				return nil, err
@@ -27,14 +27,18 @@ package signalwithstartworkflow
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/enums"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/workflow"
@@ -52,27 +56,6 @@ func Invoke(
 	}
 	namespaceID := namespaceEntry.ID()
 
-	var currentWorkflowLease api.WorkflowLease
-	currentWorkflowLease, err = workflowConsistencyChecker.GetWorkflowLease(
-		ctx,
-		nil,
-		api.BypassMutableStateConsistencyPredicate,
-		definition.NewWorkflowKey(
-			string(namespaceID),
-			signalWithStartRequest.SignalWithStartRequest.WorkflowId,
-			"",
-		),
-		workflow.LockPriorityHigh,
-	)
-	switch err.(type) {
-	case nil:
-		defer func() { currentWorkflowLease.GetReleaseFn()(retError) }()
-	case *serviceerror.NotFound:
-		currentWorkflowLease = nil
-	default:
-		return nil, err
-	}
-
 	// TODO: remove this call in 1.25
 	enums.SetDefaultWorkflowIdConflictPolicy(
 		&signalWithStartRequest.SignalWithStartRequest.WorkflowIdConflictPolicy,
@@ -96,6 +79,40 @@ func Invoke(
 		return nil, err
 	}
 
+	// Reserve the slots of the concurrency limits before taking the lock on the current execution, as in queue mode the
+	// reservation may wait for capacity.
+	runID := uuid.NewString()
+	concurrencyLimits, err := reserveConcurrencyLimits(ctx, shard, namespaceEntry, startRequest, signalWithStartRequest.SignalWithStartRequest, runID)
+	if err != nil {
+		return nil, err
+	}
+	var createdRunID string
+	defer func() { concurrencyLimits.Release(ctx, createdRunID) }()
+
+	var currentWorkflowLease api.WorkflowLease
+	currentWorkflowLease, err = workflowConsistencyChecker.GetWorkflowLease(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			string(namespaceID),
+			signalWithStartRequest.SignalWithStartRequest.WorkflowId,
+			"",
+		),
+		workflow.LockPriorityHigh,
+	)
+	switch err.(type) {
+	case nil:
+		defer func() { currentWorkflowLease.GetReleaseFn()(retError) }()
+	case *serviceerror.NotFound:
+		currentWorkflowLease = nil
+	default:
+		return nil, err
+	}
+
+	payloadOffload := api.NewPayloadOffload(shard)
+	defer func() { payloadOffload.Release(ctx, retError) }()
+
 	runID, started, err := SignalWithStartWorkflow(
 		ctx,
 		shard,
@@ -103,12 +120,55 @@ func Invoke(
 		currentWorkflowLease,
 		startRequest,
 		signalWithStartRequest.SignalWithStartRequest,
+		payloadOffload,
+		runID,
+		concurrencyLimits,
 	)
 	if err != nil {
 		return nil, err
 	}
+	createdRunID = runID
 	return &historyservice.SignalWithStartWorkflowExecutionResponse{
 		RunId:   runID,
 		Started: started,
 	}, nil
 }
+
+// reserveConcurrencyLimits reserves the slots of the concurrency limits for the new run unless the request is expected
+// to signal a running execution. The current execution is read without holding its lock, so if it closes
+// concurrently, the request fails when it is about to start a new run.
+func reserveConcurrencyLimits(
+	ctx context.Context,
+	shard shard.Context,
+	namespaceEntry *namespace.Namespace,
+	startRequest *historyservice.StartWorkflowExecutionRequest,
+	signalWithStartRequest *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	runID string,
+) (*api.ConcurrencyLimitReservation, error) {
+	if !api.HasConcurrencyLimits(shard, namespaceEntry, startRequest.StartRequest) {
+		return nil, nil
+	}
+	resp, err := shard.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     shard.GetShardID(),
+		NamespaceID: namespaceEntry.ID().String(),
+		WorkflowID:  signalWithStartRequest.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+		if resp.Status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING &&
+			signalWithStartRequest.GetWorkflowIdConflictPolicy() != enumspb.WORKFLOW_ID_CONFLICT_POLICY_TERMINATE_EXISTING {
+			return api.SkipConcurrencyLimits(shard, namespaceEntry, startRequest.StartRequest), nil
+		}
+	case *serviceerror.NotFound:
+	default:
+		return nil, err
+	}
+	return api.ReserveConcurrencyLimits(
+		ctx,
+		shard,
+		namespaceEntry,
+		startRequest.StartRequest,
+		runID,
+		metrics.HistorySignalWithStartWorkflowExecutionScope,
+	)
+}
@@ -27,13 +27,14 @@ package signalwithstartworkflow
 import (
 	"context"
 
-	"github.com/google/uuid"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/service/history/api"
@@ -48,6 +49,9 @@ func SignalWithStartWorkflow(
 	currentWorkflowLease api.WorkflowLease,
 	startRequest *historyservice.StartWorkflowExecutionRequest,
 	signalWithStartRequest *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	payloadOffload *api.PayloadOffload,
+	runID string,
+	concurrencyLimits *api.ConcurrencyLimitReservation,
 ) (string, bool, error) {
 	// workflow is running and restart was not requested
 	if currentWorkflowLease != nil &&
@@ -60,6 +64,7 @@ func SignalWithStartWorkflow(
 			shard,
 			currentWorkflowLease,
 			signalWithStartRequest,
+			payloadOffload,
 		); err != nil {
 			return "", false, err
 		}
@@ -73,6 +78,9 @@ func SignalWithStartWorkflow(
 		currentWorkflowLease,
 		startRequest,
 		signalWithStartRequest,
+		payloadOffload,
+		runID,
+		concurrencyLimits,
 	)
 }
 
@@ -83,9 +91,17 @@ func startAndSignalWorkflow(
 	currentWorkflowLease api.WorkflowLease,
 	startRequest *historyservice.StartWorkflowExecutionRequest,
 	signalWithStartRequest *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	payloadOffload *api.PayloadOffload,
+	runID string,
+	concurrencyLimits *api.ConcurrencyLimitReservation,
 ) (string, bool, error) {
 	workflowID := signalWithStartRequest.GetWorkflowId()
-	runID := uuid.New().String()
+	if err := concurrencyLimits.CheckReserved(); err != nil {
+		return "", false, err
+	}
+	if err := payloadOffload.Offload(ctx, namespaceEntry, signalWithStartRequest.GetSignalInput()); err != nil {
+		return "", false, err
+	}
 	// TODO(bergundy): Support eager workflow task
 	newWorkflowLease, err := api.NewWorkflowWithSignal(
 		shard,
@@ -107,6 +123,8 @@ func startAndSignalWorkflow(
 	); err != nil {
 		return "", false, err
 	}
+	payloadOffload.Record(newWorkflowLease.GetMutableState(), common.FirstEventID)
+	concurrencyLimits.Record(newWorkflowLease.GetMutableState())
 
 	workflowMutationFn, err := createWorkflowMutationFunction(
 		currentWorkflowLease,
@@ -265,13 +283,14 @@ func signalWorkflow(
 	shardContext shard.Context,
 	workflowContext api.WorkflowLease,
 	request *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	payloadOffload *api.PayloadOffload,
 ) error {
 	mutableState := workflowContext.GetMutableState()
 	if err := api.ValidateSignal(
 		ctx,
 		shardContext,
 		workflowContext.GetMutableState(),
-		request.GetSignalInput().Size(),
+		payloadOffload.Size(mutableState.GetNamespaceEntry(), request.GetSignalInput()),
 		"SignalWithStartWorkflowExecution",
 	); err != nil {
 		// in-memory mutable state is still clean, release the lock with nil error to prevent
@@ -282,11 +301,20 @@ func signalWorkflow(
 
 	if request.GetRequestId() != "" && mutableState.IsSignalRequested(request.GetRequestId()) {
 		// duplicate signal
+		metrics.RequestIDDedupHitCounter.With(shardContext.GetMetricsHandler()).Record(
+			1,
+			metrics.OperationTag(metrics.HistorySignalWithStartWorkflowExecutionScope),
+			metrics.NamespaceTag(mutableState.GetNamespaceEntry().Name().String()),
+		)
 		// in-memory mutable state is still clean, release the lock with nil error to prevent
 		// clearing and reloading mutable state
 		workflowContext.GetReleaseFn()(nil)
 		return nil
 	}
+	if err := payloadOffload.Offload(ctx, mutableState.GetNamespaceEntry(), request.GetSignalInput()); err != nil {
+		return err
+	}
+	payloadOffload.Record(mutableState, mutableState.GetNextEventID())
 	if request.GetRequestId() != "" {
 		mutableState.AddSignalRequested(request.GetRequestId())
 	}
@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/testing/fakedata"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
@@ -93,8 +94,10 @@ func (s *signalWithStartWorkflowSuite) SetupTest() {
 	s.shardContext.EXPECT().GetLogger().Return(log.NewTestLogger()).AnyTimes()
 	s.shardContext.EXPECT().GetThrottledLogger().Return(log.NewTestLogger()).AnyTimes()
 	s.shardContext.EXPECT().GetTimeSource().Return(clock.NewRealTimeSource()).AnyTimes()
+	s.shardContext.EXPECT().GetPayloadOffloader().Return(nil).AnyTimes()
 
 	s.currentMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+	s.currentMutableState.EXPECT().GetNextEventID().Return(int64(10)).AnyTimes()
 	s.currentMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
 		WorkflowId: s.workflowID,
 	}).AnyTimes()
@@ -124,6 +127,7 @@ func (s *signalWithStartWorkflowSuite) TestSignalWorkflow_WorkflowCloseAttempted
 		s.shardContext,
 		currentWorkflowLease,
 		request,
+		api.NewPayloadOffload(s.shardContext),
 	)
 	s.Error(consts.ErrWorkflowClosing, err)
 }
@@ -139,12 +143,14 @@ func (s *signalWithStartWorkflowSuite) TestSignalWorkflow_Dedup() {
 
 	s.currentMutableState.EXPECT().IsWorkflowCloseAttempted().Return(false)
 	s.currentMutableState.EXPECT().IsSignalRequested(request.GetRequestId()).Return(true)
+	s.shardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler)
 
 	err := signalWorkflow(
 		ctx,
 		s.shardContext,
 		currentWorkflowLease,
 		request,
+		api.NewPayloadOffload(s.shardContext),
 	)
 	s.NoError(err)
 }
@@ -178,6 +184,7 @@ func (s *signalWithStartWorkflowSuite) TestSignalWorkflow_NewWorkflowTask() {
 		s.shardContext,
 		currentWorkflowLease,
 		request,
+		api.NewPayloadOffload(s.shardContext),
 	)
 	s.NoError(err)
 }
@@ -209,6 +216,7 @@ func (s *signalWithStartWorkflowSuite) TestSignalWorkflow_NoNewWorkflowTask() {
 		s.shardContext,
 		currentWorkflowLease,
 		request,
+		api.NewPayloadOffload(s.shardContext),
 	)
 	s.NoError(err)
 }
@@ -29,6 +29,7 @@ import (
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/consts"
@@ -50,6 +51,8 @@ func Invoke(
 	request := req.SignalRequest
 	externalWorkflowExecution := req.ExternalWorkflowExecution
 	childWorkflowOnly := req.GetChildWorkflowOnly()
+	payloadOffload := api.NewPayloadOffload(shard)
+	defer func() { payloadOffload.Release(ctx, retError) }()
 
 	err = api.GetAndUpdateWorkflowWithNew(
 		ctx,
@@ -63,6 +66,11 @@ func Invoke(
 		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
 			mutableState := workflowLease.GetMutableState()
 			if request.GetRequestId() != "" && mutableState.IsSignalRequested(request.GetRequestId()) {
+				metrics.RequestIDDedupHitCounter.With(shard.GetMetricsHandler()).Record(
+					1,
+					metrics.OperationTag(metrics.HistorySignalWorkflowExecutionScope),
+					metrics.NamespaceTag(namespaceEntry.Name().String()),
+				)
 				return &api.UpdateWorkflowAction{
 					Noop:               true,
 					CreateWorkflowTask: false,
@@ -81,7 +89,7 @@ func Invoke(
 				ctx,
 				shard,
 				mutableState,
-				request.GetInput().Size(),
+				payloadOffload.Size(namespaceEntry, request.GetInput()),
 				"SignalWorkflowExecution",
 			); err != nil {
 				releaseFn(nil)
@@ -103,6 +111,10 @@ func Invoke(
 				}
 			}
 
+			if err := payloadOffload.Offload(ctx, namespaceEntry, request.GetInput()); err != nil {
+				return nil, err
+			}
+			payloadOffload.Record(mutableState, mutableState.GetNextEventID())
 			if request.GetRequestId() != "" {
 				mutableState.AddSignalRequested(request.GetRequestId())
 			}
@@ -80,6 +80,7 @@ type Starter struct {
 type creationParams struct {
 	workflowID           string
 	runID                string
+	concurrencyLimits    *api.ConcurrencyLimitReservation
 	workflowLease        api.WorkflowLease
 	workflowTaskInfo     *workflow.WorkflowTaskInfo
 	workflowSnapshot     *persistence.WorkflowSnapshot
@@ -183,7 +184,14 @@ func (s *Starter) Invoke(
 		return nil, err
 	}
 
-	creationParams, err := s.createNewMutableState(ctx, request.GetWorkflowId())
+	runID := uuid.NewString()
+	concurrencyLimits, err := s.reserveConcurrencyLimits(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { concurrencyLimits.Release(ctx, resp.GetRunId()) }()
+
+	creationParams, err := s.createNewMutableState(ctx, request.GetWorkflowId(), runID, concurrencyLimits)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +223,59 @@ func (s *Starter) Invoke(
 	return s.handleConflict(ctx, creationParams, currentWorkflowConditionFailedError)
 }
 
+// reserveConcurrencyLimits reserves the slots of the concurrency limits for the new run if the request is expected to
+// create it. This is done before taking the lock on the current execution, as in queue mode the reservation may wait
+// for capacity. The current execution is read without holding its lock, so if it changes concurrently, a request
+// which was not expected to create a run fails when it is about to create one.
+func (s *Starter) reserveConcurrencyLimits(ctx context.Context, runID string) (*api.ConcurrencyLimitReservation, error) {
+	request := s.request.StartRequest
+	if !api.HasConcurrencyLimits(s.shardContext, s.namespace, request) {
+		return nil, nil
+	}
+	resp, err := s.shardContext.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+		ShardID:     s.shardContext.GetShardID(),
+		NamespaceID: s.namespace.ID().String(),
+		WorkflowID:  request.GetWorkflowId(),
+	})
+	switch err.(type) {
+	case nil:
+		if !s.startsRun(resp) {
+			return api.SkipConcurrencyLimits(s.shardContext, s.namespace, request), nil
+		}
+	case *serviceerror.NotFound:
+	default:
+		return nil, err
+	}
+	return api.ReserveConcurrencyLimits(
+		ctx,
+		s.shardContext,
+		s.namespace,
+		request,
+		runID,
+		metrics.HistoryStartWorkflowExecutionScope,
+	)
+}
+
+// startsRun returns whether the request creates a new run given the current execution, as decided by handleConflict.
+// Retried requests, reused running executions and rejected requests do not.
+func (s *Starter) startsRun(current *persistence.GetCurrentExecutionResponse) bool {
+	request := s.request.StartRequest
+	if current.StartRequestID == request.GetRequestId() {
+		return false
+	}
+	_, err := api.ResolveDuplicateWorkflowID(
+		request.GetWorkflowId(),
+		"",
+		current.RunID,
+		current.State,
+		current.Status,
+		current.StartRequestID,
+		request.GetWorkflowIdReusePolicy(),
+		request.GetWorkflowIdConflictPolicy(),
+	)
+	return err == nil
+}
+
 func (s *Starter) lockCurrentWorkflowExecution(
 	ctx context.Context,
 ) (cache.ReleaseCacheFunc, error) {
@@ -233,8 +294,12 @@ func (s *Starter) lockCurrentWorkflowExecution(
 
 // createNewMutableState creates a new workflow context, and closes its mutable state transaction as snapshot.
 // It returns the creationContext which can later be used to insert into the executions table.
-func (s *Starter) createNewMutableState(ctx context.Context, workflowID string) (*creationParams, error) {
-	runID := uuid.NewString()
+func (s *Starter) createNewMutableState(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	concurrencyLimits *api.ConcurrencyLimitReservation,
+) (*creationParams, error) {
 	workflowLease, err := api.NewWorkflowWithSignal(
 		s.shardContext,
 		s.namespace,
@@ -248,6 +313,7 @@ func (s *Starter) createNewMutableState(ctx context.Context, workflowID string)
 	}
 
 	mutableState := workflowLease.GetMutableState()
+	concurrencyLimits.Record(mutableState)
 	workflowTaskInfo := mutableState.GetStartedWorkflowTask()
 	if s.requestEagerStart() && workflowTaskInfo == nil {
 		return nil, serviceerror.NewInternal("unexpected error: mutable state did not have a started workflow task")
@@ -265,6 +331,7 @@ func (s *Starter) createNewMutableState(ctx context.Context, workflowID string)
 	return &creationParams{
 		workflowID:           workflowID,
 		runID:                runID,
+		concurrencyLimits:    concurrencyLimits,
 		workflowLease:        workflowLease,
 		workflowTaskInfo:     workflowTaskInfo,
 		workflowSnapshot:     workflowSnapshot,
@@ -274,6 +341,22 @@ func (s *Starter) createNewMutableState(ctx context.Context, workflowID string)
 
 // createBrandNew creates a new "brand new" execution in the executions table.
 func (s *Starter) createBrandNew(ctx context.Context, creationParams *creationParams) error {
+	if creationParams.concurrencyLimits.Skipped() {
+		// the creation is expected to fail as the current execution existed when the slots were skipped, it only
+		// succeeds if the workflow was deleted since
+		_, err := s.shardContext.GetCurrentExecution(ctx, &persistence.GetCurrentExecutionRequest{
+			ShardID:     s.shardContext.GetShardID(),
+			NamespaceID: s.namespace.ID().String(),
+			WorkflowID:  creationParams.workflowID,
+		})
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			return creationParams.concurrencyLimits.CheckReserved()
+		default:
+			return err
+		}
+	}
 	return creationParams.workflowLease.GetContext().CreateWorkflowExecution(
 		ctx,
 		s.shardContext,
@@ -327,6 +410,9 @@ func (s *Starter) createAsCurrent(
 	creationParams *creationParams,
 	currentWorkflowConditionFailed *persistence.CurrentWorkflowConditionFailedError,
 ) error {
+	if err := creationParams.concurrencyLimits.CheckReserved(); err != nil {
+		return err
+	}
 	return creationParams.workflowLease.GetContext().CreateWorkflowExecution(
 		ctx,
 		s.shardContext,
@@ -398,6 +484,9 @@ func (s *Starter) resolveDuplicateWorkflowID(
 		),
 		currentExecutionUpdateAction,
 		func() (workflow.Context, workflow.MutableState, error) {
+			if err := creationParams.concurrencyLimits.CheckReserved(); err != nil {
+				return nil, nil, err
+			}
 			workflowLease, err := api.NewWorkflowWithSignal(
 				s.shardContext,
 				s.namespace,
@@ -409,6 +498,7 @@ func (s *Starter) resolveDuplicateWorkflowID(
 				return nil, nil, err
 			}
 			mutableState := workflowLease.GetMutableState()
+			creationParams.concurrencyLimits.Record(mutableState)
 			mutableStateInfo, err = extractMutableStateInfo(mutableState)
 			if err != nil {
 				return nil, nil, err
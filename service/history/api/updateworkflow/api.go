@@ -27,6 +27,7 @@ package updateworkflow
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -45,6 +46,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/worker_versioning"
@@ -151,6 +153,15 @@ func (u *Updater) ApplyRequest(
 	}
 
 	updateID := u.req.GetRequest().GetRequest().GetMeta().GetUpdateId()
+	if _, ok := ms.GetExecutionInfo().GetUpdateInfos()[updateID]; !ok && ms.IsUpdateInDedupWindow(updateID) {
+		// The update was accepted by a previous run of this workflow, which has since continued-as-new or was reset.
+		metrics.RequestIDDedupHitCounter.With(u.shardCtx.GetMetricsHandler()).Record(
+			1,
+			metrics.OperationTag(metrics.HistoryUpdateWorkflowExecutionScope),
+			metrics.NamespaceTag(u.req.GetRequest().GetNamespace()),
+		)
+		return nil, serviceerror.NewAlreadyExist(fmt.Sprintf("Update %s was already accepted by a previous run of the workflow.", updateID))
+	}
 	updateReg := workflowLease.GetContext().UpdateRegistry(ctx, ms)
 	var (
 		alreadyExisted bool
@@ -163,16 +174,28 @@ func (u *Updater) ApplyRequest(
 		return nil, err
 	}
 
-	// If WT is scheduled, but not started, updates will be attached to it, when WT is started.
-	// If WT has already started, new speculative WT will be created when started WT completes.
 	// If update is duplicate, then WT for this update was already created.
-	if alreadyExisted || ms.HasPendingWorkflowTask() {
+	if alreadyExisted {
 		return &api.UpdateWorkflowAction{
 			Noop:               true,
 			CreateWorkflowTask: false,
 		}, nil
 	}
 
+	// If the update was admitted durably, the UpdateAdmitted event must be persisted before the admission is
+	// acknowledged.
+	admittedDurably := u.upd.AdmittedDurably()
+
+	// If WT is scheduled, but not started, updates will be attached to it, when WT is started.
+	// If WT has already started, new speculative WT will be created when started WT completes.
+	// If workflow is suspended, WT for this update will be created when workflow is resumed.
+	if ms.HasPendingWorkflowTask() || ms.IsWorkflowExecutionSuspended() {
+		return &api.UpdateWorkflowAction{
+			Noop:               !admittedDurably,
+			CreateWorkflowTask: false,
+		}, nil
+	}
+
 	// Speculative WT can be created only if there are no events which worker has not yet seen,
 	// i.e. last event in the history is WTCompleted event.
 	// It is guaranteed that WTStarted event is followed by WTCompleted event and history tail might look like:
@@ -181,6 +204,7 @@ func (u *Updater) ApplyRequest(
 	//   --> NextEventID points here
 	// In this case difference between NextEventID and LastWorkflowTaskStartedEventID is 2.
 	// If there are other events after WTCompleted event, then difference is > 2 and speculative WT can't be created.
+	// This is always the case for durably admitted updates.
 	canCreateSpeculativeWT := ms.GetNextEventID() == ms.GetLastWorkflowTaskStartedEventID()+2
 	if !canCreateSpeculativeWT {
 		return &api.UpdateWorkflowAction{
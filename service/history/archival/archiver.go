@@ -42,11 +42,14 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -65,6 +68,14 @@ type (
 		CloseFailoverVersion int64
 		// HistoryURI is the URI of the history archival backend.
 		HistoryURI carchiver.URI
+		// LastFirstEventID is the ID of the first event of the last batch of events, used to verify the archived history.
+		LastFirstEventID int64
+		// VerifyHistory is whether the archived history is read back and compared with the history in primary storage,
+		// in which case archival fails if they don't match.
+		VerifyHistory bool
+		// WorkflowDescription is the final state of the workflow, as returned by DescribeWorkflowExecution. If set, it is
+		// archived with the history as a mutable state snapshot, along with the memo and search attributes below.
+		WorkflowDescription *historyservice.DescribeWorkflowExecutionResponse
 
 		// visibility archival
 		WorkflowTypeName  string
@@ -102,6 +113,7 @@ type (
 		rateLimiter             quotas.RateLimiter
 		searchAttributeProvider searchattribute.Provider
 		visibilityManager       manager.VisibilityManager
+		executionManager        persistence.ExecutionManager
 	}
 )
 
@@ -118,6 +130,7 @@ func NewArchiver(
 	rateLimiter quotas.RateLimiter,
 	searchAttributeProvider searchattribute.Provider,
 	visibilityManger manager.VisibilityManager,
+	executionManager persistence.ExecutionManager,
 ) Archiver {
 	return &archiver{
 		archiverProvider:        archiverProvider,
@@ -126,6 +139,7 @@ func NewArchiver(
 		rateLimiter:             rateLimiter,
 		searchAttributeProvider: searchAttributeProvider,
 		visibilityManager:       visibilityManger,
+		executionManager:        executionManager,
 	}
 }
 
@@ -214,7 +228,7 @@ func (a *archiver) archiveHistory(ctx context.Context, request *Request, logger
 		return err
 	}
 
-	return historyArchiver.Archive(ctx, request.HistoryURI, &carchiver.ArchiveHistoryRequest{
+	archiveRequest := &carchiver.ArchiveHistoryRequest{
 		ShardID:              request.ShardID,
 		NamespaceID:          request.NamespaceID,
 		Namespace:            request.Namespace,
@@ -223,7 +237,59 @@ func (a *archiver) archiveHistory(ctx context.Context, request *Request, logger
 		BranchToken:          request.BranchToken,
 		NextEventID:          request.NextEventID,
 		CloseFailoverVersion: request.CloseFailoverVersion,
+	}
+	if description := request.WorkflowDescription; description != nil {
+		executionInfo := common.CloneProto(description.GetWorkflowExecutionInfo())
+		executionInfo.Memo = nil
+		executionInfo.SearchAttributes = nil
+		archiveRequest.MutableStateSnapshot = &archiverspb.MutableStateSnapshot{
+			FormatVersion:        carchiver.MutableStateSnapshotFormatVersion,
+			NamespaceId:          request.NamespaceID,
+			Namespace:            request.Namespace,
+			WorkflowId:           request.WorkflowID,
+			RunId:                request.RunID,
+			CloseFailoverVersion: request.CloseFailoverVersion,
+			ExecutionInfo:        executionInfo,
+			ExecutionConfig:      description.GetExecutionConfig(),
+			PendingActivities:    description.GetPendingActivities(),
+			PendingChildren:      description.GetPendingChildren(),
+			PendingWorkflowTask:  description.GetPendingWorkflowTask(),
+			Callbacks:            description.GetCallbacks(),
+			SearchAttributes:     request.SearchAttributes,
+			Memo:                 request.Memo,
+		}
+	}
+	err = historyArchiver.Archive(ctx, request.HistoryURI, archiveRequest)
+	if err != nil || !request.VerifyHistory {
+		return err
+	}
+
+	return a.verifyHistory(ctx, historyArchiver, request, logger)
+}
+
+// verifyHistory reads the archived history back, so that the history isn't deleted from primary storage if the archive
+// is missing or incomplete.
+func (a *archiver) verifyHistory(
+	ctx context.Context,
+	historyArchiver carchiver.HistoryArchiver,
+	request *Request,
+	logger log.Logger,
+) error {
+	err := carchiver.VerifyHistory(ctx, a.executionManager, historyArchiver, request.HistoryURI, &carchiver.VerifyHistoryRequest{
+		ShardID:              request.ShardID,
+		NamespaceID:          request.NamespaceID,
+		WorkflowID:           request.WorkflowID,
+		RunID:                request.RunID,
+		BranchToken:          request.BranchToken,
+		LastFirstEventID:     request.LastFirstEventID,
+		NextEventID:          request.NextEventID,
+		CloseFailoverVersion: request.CloseFailoverVersion,
 	})
+	if errors.Is(err, carchiver.ErrArchivedHistoryNotFound) || errors.Is(err, carchiver.ErrArchivedHistoryMismatch) {
+		metrics.ArchiverHistoryVerificationFailure.With(a.metricsHandler).Record(1)
+		logger.Error("archived history failed verification", tag.Error(err))
+	}
+	return err
 }
 
 func (a *archiver) archiveVisibility(ctx context.Context, request *Request, logger log.Logger) (err error) {
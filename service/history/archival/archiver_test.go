@@ -36,11 +36,16 @@ import (
 	"go.uber.org/multierr"
 
 	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/sdk"
@@ -61,6 +66,9 @@ func TestArchiver(t *testing.T) {
 		NameTypeMap          searchattribute.NameTypeMap
 		NameTypeMapErr       error
 		NilHistoryUri        bool
+		VerifyHistory        bool
+		GetHistoryErr        error
+		WorkflowDescription  *historyservice.DescribeWorkflowExecutionResponse
 
 		ExpectArchiveHistory    bool
 		ExpectArchiveVisibility bool
@@ -93,6 +101,40 @@ func TestArchiver(t *testing.T) {
 			ExpectArchiveHistory: true,
 			ExpectedReturnErrors: []string{"example archive history err"},
 		},
+		{
+			Name:    "History archival succeeds with mutable state snapshot",
+			Targets: []Target{TargetHistory},
+			WorkflowDescription: &historyservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+					HistoryLength: 1,
+					SearchAttributes: &common.SearchAttributes{IndexedFields: map[string]*common.Payload{
+						"CustomKeywordField": payload.EncodeString("value"),
+					}},
+				},
+				PendingActivities: []*workflowpb.PendingActivityInfo{{ActivityId: "test-activity-id"}},
+			},
+			SearchAttributes: &common.SearchAttributes{IndexedFields: map[string]*common.Payload{
+				"CustomKeywordField": payload.EncodeString("value"),
+			}},
+
+			ExpectArchiveHistory: true,
+		},
+		{
+			Name:          "History archival succeeds and is verified",
+			Targets:       []Target{TargetHistory},
+			VerifyHistory: true,
+
+			ExpectArchiveHistory: true,
+		},
+		{
+			Name:          "History archival succeeds but the archived history is missing",
+			Targets:       []Target{TargetHistory},
+			VerifyHistory: true,
+			GetHistoryErr: serviceerror.NewNotFound("history not found"),
+
+			ExpectArchiveHistory: true,
+			ExpectedReturnErrors: []string{carchiver.ErrArchivedHistoryNotFound.Error()},
+		},
 		{
 			Name:    "Visibility archival succeeds",
 			Targets: []Target{TargetVisibility},
@@ -198,7 +240,38 @@ func TestArchiver(t *testing.T) {
 
 			if c.ExpectArchiveHistory {
 				archiverProvider.EXPECT().GetHistoryArchiver(gomock.Any(), gomock.Any()).Return(historyArchiver, nil)
-				historyArchiver.EXPECT().Archive(gomock.Any(), historyURI, gomock.Any()).Return(c.ArchiveHistoryErr)
+				historyArchiver.EXPECT().Archive(gomock.Any(), historyURI, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ carchiver.URI, request *carchiver.ArchiveHistoryRequest, _ ...carchiver.ArchiveOption) error {
+						if c.WorkflowDescription == nil {
+							assert.Nil(t, request.MutableStateSnapshot)
+						} else {
+							snapshot := request.MutableStateSnapshot
+							assert.Equal(t, int32(carchiver.MutableStateSnapshotFormatVersion), snapshot.GetFormatVersion())
+							assert.Equal(t, "test-workflow-id", snapshot.GetWorkflowId())
+							assert.Equal(t, "test-run-id", snapshot.GetRunId())
+							assert.Equal(t, int64(1), snapshot.GetExecutionInfo().GetHistoryLength())
+							assert.Nil(t, snapshot.GetExecutionInfo().GetSearchAttributes(),
+								"Search attributes should only be archived in their own field")
+							assert.Equal(t, c.WorkflowDescription.GetPendingActivities(), snapshot.GetPendingActivities())
+							assert.Equal(t, c.SearchAttributes, snapshot.GetSearchAttributes())
+						}
+						return c.ArchiveHistoryErr
+					},
+				)
+			}
+
+			lastEvent := &history.HistoryEvent{EventId: 1}
+			executionManager := persistence.NewMockExecutionManager(controller)
+			if c.VerifyHistory {
+				executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(
+					&persistence.ReadHistoryBranchResponse{HistoryEvents: []*history.HistoryEvent{lastEvent}}, nil,
+				)
+				historyArchiver.EXPECT().Get(gomock.Any(), historyURI, gomock.Any()).Return(
+					&carchiver.GetHistoryResponse{
+						HistoryBatches: []*history.History{{Events: []*history.HistoryEvent{lastEvent}}},
+					},
+					c.GetHistoryErr,
+				)
 			}
 
 			visibilityURI, err := carchiver.NewURI("test:///visibility/archival")
@@ -231,6 +304,7 @@ func TestArchiver(t *testing.T) {
 				fx.Supply(fx.Annotate(metricsHandler, fx.As(new(metrics.Handler)))),
 				fx.Supply(fx.Annotate(searchAttributeProvider, fx.As(new(searchattribute.Provider)))),
 				fx.Supply(fx.Annotate(visibilityManager, fx.As(new(manager.VisibilityManager)))),
+				fx.Supply(fx.Annotate(executionManager, fx.As(new(persistence.ExecutionManager)))),
 				fx.Supply(&configs.Config{
 					ArchivalBackendMaxRPS: func() float64 {
 						return 42.0
@@ -260,10 +334,17 @@ func TestArchiver(t *testing.T) {
 			archiver := <-archivers
 			searchAttributes := c.SearchAttributes
 			_, err = archiver.Archive(ctx, &Request{
-				HistoryURI:       historyURI,
-				VisibilityURI:    visibilityURI,
-				Targets:          c.Targets,
-				SearchAttributes: searchAttributes,
+				NamespaceID:         "test-namespace-id",
+				WorkflowID:          "test-workflow-id",
+				RunID:               "test-run-id",
+				NextEventID:         2,
+				LastFirstEventID:    1,
+				VerifyHistory:       c.VerifyHistory,
+				HistoryURI:          historyURI,
+				VisibilityURI:       visibilityURI,
+				Targets:             c.Targets,
+				SearchAttributes:    searchAttributes,
+				WorkflowDescription: c.WorkflowDescription,
 			})
 
 			if len(c.ExpectedReturnErrors) > 0 {
@@ -93,12 +93,17 @@ func newHostScheduler(params ArchivalQueueFactoryParams) queues.Scheduler {
 	return queues.NewScheduler(
 		params.ClusterMetadata.GetCurrentClusterName(),
 		queues.SchedulerOptions{
-			WorkerCount:             params.Config.ArchivalProcessorSchedulerWorkerCount,
-			ActiveNamespaceWeights:  dynamicconfig.GetMapPropertyFnWithNamespaceFilter(ArchivalTaskPriorities),
-			StandbyNamespaceWeights: dynamicconfig.GetMapPropertyFnWithNamespaceFilter(ArchivalTaskPriorities),
+			WorkerCount:                 params.Config.ArchivalProcessorSchedulerWorkerCount,
+			ActiveNamespaceWeights:      dynamicconfig.GetMapPropertyFnWithNamespaceFilter(ArchivalTaskPriorities),
+			StandbyNamespaceWeights:     dynamicconfig.GetMapPropertyFnWithNamespaceFilter(ArchivalTaskPriorities),
+			EnableEarliestDeadlineFirst: params.Config.TaskSchedulerEnableEarliestDeadlineFirst,
+			DeadlineBudgets:             params.Config.TaskSchedulerDeadlineBudgets,
+			DefaultDeadlineBudget:       params.Config.TaskSchedulerDefaultDeadlineBudget,
 		},
 		params.NamespaceRegistry,
+		params.TimeSource,
 		params.Logger,
+		params.MetricsHandler,
 	)
 }
 
@@ -201,9 +206,12 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 				PollBackoffInterval:  f.Config.ArchivalProcessorPollBackoffInterval,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:          f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:        f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:        f.Config.QueueCriticalSlicesCount,
+				WorkflowPendingTasksCriticalShare:  f.Config.QueueWorkflowPendingTaskCriticalShare,
+				NamespacePendingTasksCriticalShare: f.Config.QueueNamespacePendingTaskCriticalShare,
+				TaskTypeFailingCriticalCount:       f.Config.QueueTaskTypeFailingCriticalCount,
 			},
 			MaxPollRPS:                          f.Config.ArchivalProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.ArchivalProcessorMaxPollInterval,
@@ -34,12 +34,14 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.temporal.io/server/common"
 	carchiver "go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/api/describeworkflow"
 	"go.temporal.io/server/service/history/archival"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
@@ -170,6 +172,7 @@ func (e *archivalQueueTaskExecutor) getArchiveTaskRequest(
 	if err != nil {
 		return nil, err
 	}
+	lastFirstEventID, _ := mutableState.GetLastFirstEventIDTxnID()
 
 	var historyURI, visibilityURI carchiver.URI
 	var targets []archival.Target
@@ -227,6 +230,8 @@ func (e *archivalQueueTaskExecutor) getArchiveTaskRequest(
 		NextEventID:          nextEventID,
 		CloseFailoverVersion: mutableState.LastWriteVersion,
 		HistoryURI:           historyURI,
+		LastFirstEventID:     lastFirstEventID,
+		VerifyHistory:        e.shardContext.GetConfig().ArchivalVerifyHistoryBeforeDelete(namespaceName.String()),
 		VisibilityURI:        visibilityURI,
 		WorkflowTypeName:     executionInfo.GetWorkflowTypeName(),
 		StartTime:            executionInfo.GetStartTime(),
@@ -240,6 +245,14 @@ func (e *archivalQueueTaskExecutor) getArchiveTaskRequest(
 		Targets:              targets,
 		CallerService:        string(primitives.HistoryService),
 	}
+	if e.shardContext.GetConfig().ArchivalMutableStateSnapshot(namespaceName.String()) {
+		description, err := describeworkflow.BuildResponse(ctx, mutableState, workflowAttributes, logger)
+		if err != nil {
+			return nil, err
+		}
+		// The mutable state is released before the request is archived.
+		request.WorkflowDescription = common.CloneProto(description)
+	}
 	return request, nil
 }
 
@@ -263,6 +276,7 @@ func (e *archivalQueueTaskExecutor) addDeletionTask(
 		mutableState,
 		e.shardContext.GetConfig(),
 		e.shardContext.GetArchivalMetadata(),
+		e.metricsHandler,
 	)
 	err = taskGenerator.GenerateDeleteHistoryEventTask(closeTime)
 	if err != nil {
@@ -45,13 +45,16 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	cpersistence "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/plugins/callbacks"
 	"go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/history/archival"
+	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -115,6 +118,12 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				}
 			},
 		},
+		{
+			Name: "mutable state snapshot archived with history",
+			Configure: func(p *params) {
+				p.ArchiveMutableStateSnapshot = true
+			},
+		},
 		{
 			Name: "both history and visibility archival disabled",
 			Configure: func(p *params) {
@@ -364,6 +373,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 			cfg.RetentionTimerJitterDuration = func() time.Duration {
 				return 0
 			}
+			cfg.ArchivalMutableStateSnapshot = dynamicconfig.GetBoolPropertyFnFilteredByNamespace(p.ArchiveMutableStateSnapshot)
 			shardContext.EXPECT().GetConfig().Return(cfg).AnyTimes()
 			mockMetadata := cluster.NewMockMetadata(p.Controller)
 			mockMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
@@ -413,6 +423,7 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				).AnyTimes()
 				mutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
 				mutableState.EXPECT().GetNextEventID().Return(int64(100)).AnyTimes()
+				mutableState.EXPECT().GetLastFirstEventIDTxnID().Return(int64(98), int64(1)).AnyTimes()
 				mutableState.EXPECT().GetLastWriteVersion().Return(
 					p.LastWriteVersionBeforeArchival,
 					p.GetLastWriteVersionBeforeArchivalError,
@@ -442,6 +453,20 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 					Status: 0,
 				}
 				mutableState.EXPECT().GetExecutionState().Return(executionState).AnyTimes()
+				mutableState.EXPECT().GetPendingActivityInfos().Return(nil).AnyTimes()
+				mutableState.EXPECT().GetPendingChildExecutionInfos().Return(nil).AnyTimes()
+				mutableState.EXPECT().GetPendingWorkflowTask().Return(nil).AnyTimes()
+				stateMachineRegistry := hsm.NewRegistry()
+				require.NoError(t, workflow.RegisterStateMachine(stateMachineRegistry))
+				require.NoError(t, callbacks.RegisterStateMachine(stateMachineRegistry))
+				root, err := hsm.NewRoot(
+					stateMachineRegistry,
+					workflow.StateMachineType.ID,
+					mutableState,
+					make(map[int32]*persistence.StateMachineMap),
+				)
+				require.NoError(t, err)
+				mutableState.EXPECT().HSM().Return(root).AnyTimes()
 				if p.ExpectAddTask {
 					mutableState.EXPECT().AddTasks(gomock.Any()).Do(func(ts ...*tasks.DeleteHistoryEventTask) {
 						require.Len(t, ts, 1)
@@ -501,6 +526,14 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 					assert.Equal(t, p.CloseTime, request.CloseTime.AsTime())
 					assert.Equal(t, p.ExecutionDuration, request.ExecutionDuration.AsDuration())
 					assert.ElementsMatch(t, p.ExpectedTargets, request.Targets)
+					assert.Equal(t, int64(98), request.LastFirstEventID)
+					assert.False(t, request.VerifyHistory)
+					if p.ArchiveMutableStateSnapshot {
+						require.NotNil(t, request.WorkflowDescription)
+						assert.Equal(t, int64(99), request.WorkflowDescription.GetWorkflowExecutionInfo().GetHistoryLength())
+					} else {
+						assert.Nil(t, request.WorkflowDescription)
+					}
 
 					return &archival.Response{}, p.ArchiveError
 				})
@@ -595,6 +628,7 @@ type params struct {
 	GetLastWriteVersionBeforeArchivalError error
 	LastWriteVersionAfterArchival          int64
 	GetLastWriteVersionAfterArchivalError  error
+	ArchiveMutableStateSnapshot            bool
 }
 
 // archivalConfig represents the user configuration of archival for the cluster and namespace
@@ -29,6 +29,7 @@ import (
 
 	enumspb "go.temporal.io/api/enums/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
@@ -101,6 +102,14 @@ type Config struct {
 	ShardLingerTimeLimit           dynamicconfig.DurationPropertyFn
 	ShardOwnershipAssertionEnabled dynamicconfig.BoolPropertyFn
 
+	ShardPlacementMode                 dynamicconfig.StringPropertyFn
+	ShardPlacementHostWeights          dynamicconfig.MapPropertyFn
+	ShardPlacementLoadWeights          dynamicconfig.MapPropertyFn
+	ShardPlacementRefreshInterval      dynamicconfig.DurationPropertyFn
+	ShardPlacementRebalanceInterval    dynamicconfig.DurationPropertyFn
+	ShardPlacementMaxMovesPerRebalance dynamicconfig.IntPropertyFn
+	ShardPlacementImbalanceThreshold   dynamicconfig.FloatPropertyFn
+
 	HistoryClientOwnershipCachingEnabled dynamicconfig.BoolPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
@@ -113,6 +122,10 @@ type Config struct {
 	QueueCriticalSlicesCount         dynamicconfig.IntPropertyFn
 	QueuePendingTaskMaxCount         dynamicconfig.IntPropertyFn
 
+	QueueWorkflowPendingTaskCriticalShare  dynamicconfig.FloatPropertyFn
+	QueueNamespacePendingTaskCriticalShare dynamicconfig.FloatPropertyFn
+	QueueTaskTypeFailingCriticalCount      dynamicconfig.IntPropertyFn
+
 	TaskDLQEnabled                 dynamicconfig.BoolPropertyFn
 	TaskDLQUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 	TaskDLQInternalErrors          dynamicconfig.BoolPropertyFn
@@ -125,6 +138,9 @@ type Config struct {
 	TaskSchedulerMaxQPS                      dynamicconfig.IntPropertyFn
 	TaskSchedulerGlobalNamespaceMaxQPS       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	TaskSchedulerNamespaceMaxQPS             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskSchedulerEnableEarliestDeadlineFirst dynamicconfig.BoolPropertyFn
+	TaskSchedulerDeadlineBudgets             dynamicconfig.MapPropertyFn
+	TaskSchedulerDefaultDeadlineBudget       dynamicconfig.DurationPropertyFn
 
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                               dynamicconfig.IntPropertyFn
@@ -139,6 +155,7 @@ type Config struct {
 	TimerProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 	TimerProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
 	TimerProcessorMaxTimeShift                       dynamicconfig.DurationPropertyFn
+	TimerCoalescingWindow                            dynamicconfig.DurationPropertyFnWithNamespaceFilter
 	TimerQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
 	RetentionTimerJitterDuration                     dynamicconfig.DurationPropertyFn
 
@@ -209,6 +226,7 @@ type Config struct {
 	// Size limit related settings
 	BlobSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	BlobSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	PayloadOffloadSizeThreshold               dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitError                        dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MemoSizeLimitWarn                         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	HistorySizeLimitError                     dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -230,6 +248,8 @@ type Config struct {
 	// DefaultActivityRetryOptions specifies the out-of-box retry policy if
 	// none is configured on the Activity by the user.
 	DefaultActivityRetryPolicy dynamicconfig.MapPropertyFnWithNamespaceFilter
+	// ActivityRetryDispatchLead is how long before an activity retry is due its task is sent to matching
+	ActivityRetryDispatchLead dynamicconfig.DurationPropertyFnWithNamespaceFilter
 
 	// DefaultWorkflowRetryPolicy specifies the out-of-box retry policy for
 	// any unset fields on a RetryPolicy configured on a Workflow
@@ -244,6 +264,23 @@ type Config struct {
 	WorkflowTaskCriticalAttempts dynamicconfig.IntPropertyFn
 	WorkflowTaskRetryMaxInterval dynamicconfig.DurationPropertyFn
 
+	// Auto-reset on non-deterministic workflow task failures
+	AutoResetNonDeterministicFailures               dynamicconfig.IntPropertyFnWithNamespaceFilter
+	AutoResetNonDeterministicFailuresByWorkflowType dynamicconfig.MapPropertyFnWithNamespaceFilter
+	AutoResetMaxResetsPerWindow                     dynamicconfig.IntPropertyFnWithNamespaceFilter
+	AutoResetWindow                                 dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	// Dedup window for signal request IDs and update IDs across runs
+	RequestIDDedupWindowSize dynamicconfig.IntPropertyFnWithNamespaceFilter
+	RequestIDDedupWindowTTL  dynamicconfig.DurationPropertyFnWithNamespaceFilter
+
+	MaxConcurrentWorkflowExecutions                  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxConcurrentWorkflowExecutionsByWorkflowType    dynamicconfig.MapPropertyFnWithNamespaceFilter
+	MaxConcurrentWorkflowExecutionsBySearchAttribute dynamicconfig.MapPropertyFnWithNamespaceFilter
+	ConcurrencyLimitMode                             dynamicconfig.StringPropertyFnWithNamespaceFilter
+	ConcurrencyLimitQueueCheckInterval               dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	ConcurrencyLimitReservationGracePeriod           dynamicconfig.DurationPropertyFn
+
 	// ContinueAsNewMinInterval is the minimal interval between continue_as_new to prevent tight continue_as_new loop.
 	ContinueAsNewMinInterval dynamicconfig.DurationPropertyFnWithNamespaceFilter
 
@@ -330,9 +367,12 @@ type Config struct {
 	ArchivalProcessorArchiveDelay                       dynamicconfig.DurationPropertyFn
 	ArchivalBackendMaxRPS                               dynamicconfig.FloatPropertyFn
 	ArchivalQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
+	ArchivalVerifyHistoryBeforeDelete                   dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	ArchivalMutableStateSnapshot                        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	WorkflowExecutionMaxInFlightUpdates dynamicconfig.IntPropertyFnWithNamespaceFilter
 	WorkflowExecutionMaxTotalUpdates    dynamicconfig.IntPropertyFnWithNamespaceFilter
+	EnableDurableUpdateAdmission        dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -408,6 +448,18 @@ func NewConfig(
 		ShardLingerTimeLimit:           dc.GetDurationProperty(dynamicconfig.ShardLingerTimeLimit, 0),
 		ShardOwnershipAssertionEnabled: dc.GetBoolProperty(dynamicconfig.ShardOwnershipAssertionEnabled, false),
 
+		ShardPlacementMode:        dc.GetStringProperty(dynamicconfig.ShardPlacementMode, "hash"),
+		ShardPlacementHostWeights: dc.GetMapProperty(dynamicconfig.ShardPlacementHostWeights, map[string]any{}),
+		ShardPlacementLoadWeights: dc.GetMapProperty(dynamicconfig.ShardPlacementLoadWeights, map[string]any{
+			"requestsPerSecond":          1.0,
+			"taskBacklog":                0.01,
+			"mutableStateLoadsPerSecond": 1.0,
+		}),
+		ShardPlacementRefreshInterval:      dc.GetDurationProperty(dynamicconfig.ShardPlacementRefreshInterval, 10*time.Second),
+		ShardPlacementRebalanceInterval:    dc.GetDurationProperty(dynamicconfig.ShardPlacementRebalanceInterval, 5*time.Minute),
+		ShardPlacementMaxMovesPerRebalance: dc.GetIntProperty(dynamicconfig.ShardPlacementMaxMovesPerRebalance, 1),
+		ShardPlacementImbalanceThreshold:   dc.GetFloat64Property(dynamicconfig.ShardPlacementImbalanceThreshold, 0.2),
+
 		HistoryClientOwnershipCachingEnabled: dc.GetBoolProperty(dynamicconfig.HistoryClientOwnershipCachingEnabled, false),
 
 		StandbyClusterDelay:                  dc.GetDurationProperty(dynamicconfig.StandbyClusterDelay, 5*time.Minute),
@@ -419,6 +471,10 @@ func NewConfig(
 		QueueCriticalSlicesCount:         dc.GetIntProperty(dynamicconfig.QueueCriticalSlicesCount, 50),
 		QueuePendingTaskMaxCount:         dc.GetIntProperty(dynamicconfig.QueuePendingTaskMaxCount, 10000),
 
+		QueueWorkflowPendingTaskCriticalShare:  dc.GetFloat64Property(dynamicconfig.QueueWorkflowPendingTaskCriticalShare, 0.5),
+		QueueNamespacePendingTaskCriticalShare: dc.GetFloat64Property(dynamicconfig.QueueNamespacePendingTaskCriticalShare, 0),
+		QueueTaskTypeFailingCriticalCount:      dc.GetIntProperty(dynamicconfig.QueueTaskTypeFailingCriticalCount, 0),
+
 		TaskDLQEnabled:                 dc.GetBoolProperty(dynamicconfig.HistoryTaskDLQEnabled, true),
 		TaskDLQUnexpectedErrorAttempts: dc.GetIntProperty(dynamicconfig.HistoryTaskDLQUnexpectedErrorAttempts, 100),
 		TaskDLQInternalErrors:          dc.GetBoolProperty(dynamicconfig.HistoryTaskDLQInternalErrors, false),
@@ -431,6 +487,12 @@ func NewConfig(
 		TaskSchedulerMaxQPS:                      dc.GetIntProperty(dynamicconfig.TaskSchedulerMaxQPS, 0),
 		TaskSchedulerNamespaceMaxQPS:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceMaxQPS, 0),
 		TaskSchedulerGlobalNamespaceMaxQPS:       dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerGlobalNamespaceMaxQPS, 0),
+		TaskSchedulerEnableEarliestDeadlineFirst: dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnableEarliestDeadlineFirst, false),
+		TaskSchedulerDeadlineBudgets: dc.GetMapProperty(dynamicconfig.TaskSchedulerDeadlineBudgets, map[string]any{
+			enumsspb.TASK_TYPE_WORKFLOW_TASK_TIMEOUT.String(): "1s",
+			enumsspb.TASK_TYPE_USER_TIMER.String():            "1s",
+		}),
+		TaskSchedulerDefaultDeadlineBudget: dc.GetDurationProperty(dynamicconfig.TaskSchedulerDefaultDeadlineBudget, 10*time.Second),
 
 		TimerTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerProcessorSchedulerWorkerCount:               dc.GetIntProperty(dynamicconfig.TimerProcessorSchedulerWorkerCount, 512),
@@ -444,6 +506,7 @@ func NewConfig(
 		TimerProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TimerProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		TimerProcessorPollBackoffInterval:                dc.GetDurationProperty(dynamicconfig.TimerProcessorPollBackoffInterval, 5*time.Second),
 		TimerProcessorMaxTimeShift:                       dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxTimeShift, 1*time.Second),
+		TimerCoalescingWindow:                            dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.TimerCoalescingWindow, 0),
 		TransferQueueMaxReaderCount:                      dc.GetIntProperty(dynamicconfig.TransferQueueMaxReaderCount, 2),
 		RetentionTimerJitterDuration:                     dc.GetDurationProperty(dynamicconfig.RetentionTimerJitterDuration, 30*time.Minute),
 
@@ -507,6 +570,7 @@ func NewConfig(
 
 		BlobSizeLimitError:                        dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
 		BlobSizeLimitWarn:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.BlobSizeLimitWarn, 512*1024),
+		PayloadOffloadSizeThreshold:               dc.GetIntPropertyFilteredByNamespace(dynamicconfig.PayloadOffloadSizeThreshold, 0),
 		MemoSizeLimitError:                        dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MemoSizeLimitError, 2*1024*1024),
 		MemoSizeLimitWarn:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MemoSizeLimitWarn, 2*1024),
 		NumPendingChildExecutionsLimit:            dc.GetIntPropertyFilteredByNamespace(dynamicconfig.NumPendingChildExecutionsLimitError, 2000),
@@ -529,11 +593,27 @@ func NewConfig(
 		EnableStickyQuery: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableStickyQuery, true),
 
 		DefaultActivityRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultActivityRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
+		ActivityRetryDispatchLead:    dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.ActivityRetryDispatchLead, 0),
 		DefaultWorkflowRetryPolicy:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.DefaultWorkflowRetryPolicy, common.GetDefaultRetryPolicyConfigOptions()),
 		WorkflowTaskHeartbeatTimeout: dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.WorkflowTaskHeartbeatTimeout, time.Minute*30),
 		WorkflowTaskCriticalAttempts: dc.GetIntProperty(dynamicconfig.WorkflowTaskCriticalAttempts, 10),
 		WorkflowTaskRetryMaxInterval: dc.GetDurationProperty(dynamicconfig.WorkflowTaskRetryMaxInterval, time.Minute*10),
 
+		AutoResetNonDeterministicFailures:               dc.GetIntPropertyFilteredByNamespace(dynamicconfig.AutoResetNonDeterministicFailures, 0),
+		AutoResetNonDeterministicFailuresByWorkflowType: dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.AutoResetNonDeterministicFailuresByWorkflowType, map[string]any{}),
+		AutoResetMaxResetsPerWindow:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.AutoResetMaxResetsPerWindow, 3),
+		AutoResetWindow:                                 dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.AutoResetWindow, 24*time.Hour),
+
+		RequestIDDedupWindowSize: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.RequestIDDedupWindowSize, 0),
+		RequestIDDedupWindowTTL:  dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.RequestIDDedupWindowTTL, 24*time.Hour),
+
+		MaxConcurrentWorkflowExecutions:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaxConcurrentWorkflowExecutions, 0),
+		MaxConcurrentWorkflowExecutionsByWorkflowType:    dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MaxConcurrentWorkflowExecutionsByWorkflowType, map[string]any{}),
+		MaxConcurrentWorkflowExecutionsBySearchAttribute: dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MaxConcurrentWorkflowExecutionsBySearchAttribute, map[string]any{}),
+		ConcurrencyLimitMode:                             dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.ConcurrencyLimitMode, "reject"),
+		ConcurrencyLimitQueueCheckInterval:               dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.ConcurrencyLimitQueueCheckInterval, time.Second),
+		ConcurrencyLimitReservationGracePeriod:           dc.GetDurationProperty(dynamicconfig.ConcurrencyLimitReservationGracePeriod, time.Minute),
+
 		ReplicationTaskFetcherParallelism:            dc.GetIntProperty(dynamicconfig.ReplicationTaskFetcherParallelism, 4),
 		ReplicationTaskFetcherAggregationInterval:    dc.GetDurationProperty(dynamicconfig.ReplicationTaskFetcherAggregationInterval, 2*time.Second),
 		ReplicationTaskFetcherTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.ReplicationTaskFetcherTimerJitterCoefficient, 0.15),
@@ -607,10 +687,13 @@ func NewConfig(
 		ArchivalProcessorArchiveDelay:        dc.GetDurationProperty(dynamicconfig.ArchivalProcessorArchiveDelay, 5*time.Minute),
 		ArchivalBackendMaxRPS:                dc.GetFloat64Property(dynamicconfig.ArchivalBackendMaxRPS, 10000.0),
 		ArchivalQueueMaxReaderCount:          dc.GetIntProperty(dynamicconfig.ArchivalQueueMaxReaderCount, 2),
+		ArchivalVerifyHistoryBeforeDelete:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.ArchivalVerifyHistoryBeforeDelete, false),
+		ArchivalMutableStateSnapshot:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.ArchivalMutableStateSnapshot, false),
 
 		// workflow update related
 		WorkflowExecutionMaxInFlightUpdates: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowExecutionMaxInFlightUpdates, 10),
 		WorkflowExecutionMaxTotalUpdates:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowExecutionMaxTotalUpdates, 2000),
+		EnableDurableUpdateAdmission:        dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableDurableUpdateAdmission, false),
 
 		SendRawWorkflowHistory: dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.SendRawWorkflowHistory, false),
 
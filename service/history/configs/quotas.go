@@ -97,8 +97,16 @@ var (
 		"/temporal.server.api.historyservice.v1.HistoryService/GetDLQTasks":                            1,
 		"/temporal.server.api.historyservice.v1.HistoryService/DeleteDLQTasks":                         1,
 		"/temporal.server.api.historyservice.v1.HistoryService/AddTasks":                               1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ListQueueMitigations":                   1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListQueues":                             1,
 		"/temporal.server.api.historyservice.v1.HistoryService/ListTasks":                              1,
+		"/temporal.server.api.historyservice.v1.HistoryService/PauseActivity":                          1,
+		"/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity":                        1,
+		"/temporal.server.api.historyservice.v1.HistoryService/SuspendWorkflowExecution":               1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ResumeWorkflowExecution":                1,
+		"/temporal.server.api.historyservice.v1.HistoryService/RevertQueueMitigation":                  1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ReserveConcurrencyLimit":                1,
+		"/temporal.server.api.historyservice.v1.HistoryService/ReleaseConcurrencyLimit":                1,
 	}
 
 	APIPrioritiesOrdered = []int{OperatorPriority, 1}
@@ -31,6 +31,7 @@ import (
 
 	commonpb "go.temporal.io/api/common/v1"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/metrics"
@@ -173,6 +174,12 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 		return err
 	}
 
+	if !stage.IsProcessed(tasks.DeleteWorkflowExecutionStageHistory) {
+		if err := m.deleteOffloadedPayloads(ctx, ms, currentBranchToken); err != nil {
+			return err
+		}
+	}
+
 	if err := m.shardContext.DeleteWorkflowExecution(
 		ctx,
 		definition.WorkflowKey{
@@ -193,3 +200,93 @@ func (m *DeleteManagerImpl) deleteWorkflowExecutionInternal(
 	metrics.WorkflowCleanupDeleteCount.With(metricsHandler).Record(1)
 	return nil
 }
+
+// deleteOffloadedPayloads schedules the deletion of the payloads offloaded by the workflow run. Payloads referenced
+// by events shared with another run, e.g. a run reset from this one, are kept. Archived histories do not reference the
+// payloads since they are rehydrated before archival, which completes before the run is deleted by retention.
+//
+// The payload store is shared by all clusters, so only the cluster the namespace is active in deletes the payloads.
+// The deletion runs in its own transfer task, without holding the workflow lock while waiting on the payload store.
+func (m *DeleteManagerImpl) deleteOffloadedPayloads(
+	ctx context.Context,
+	ms workflow.MutableState,
+	branchToken []byte,
+) error {
+	if m.shardContext.GetPayloadOffloader() == nil {
+		return nil
+	}
+	offloadedPayloads := ms.GetExecutionInfo().GetOffloadedPayloads()
+	if len(offloadedPayloads) == 0 {
+		return nil
+	}
+	if !ms.GetNamespaceEntry().ActiveInCluster(m.shardContext.GetClusterMetadata().GetCurrentClusterName()) {
+		return nil
+	}
+
+	sharedUntil, err := m.sharedHistoryEnd(ctx, ms, branchToken)
+	if err != nil {
+		return err
+	}
+	var keys []string
+	for _, offloadedPayload := range offloadedPayloads {
+		if offloadedPayload.GetEventId() >= sharedUntil {
+			keys = append(keys, offloadedPayload.GetKey())
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	executionInfo := ms.GetExecutionInfo()
+	return m.shardContext.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+		ShardID: m.shardContext.GetShardID(),
+		// RangeID is set by shardContext
+		NamespaceID: executionInfo.GetNamespaceId(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		Tasks: map[tasks.Category][]tasks.Task{
+			tasks.CategoryTransfer: {&tasks.DeleteOffloadedPayloadsTask{
+				WorkflowKey:         ms.GetWorkflowKey(),
+				VisibilityTimestamp: m.timeSource.Now(),
+				Version:             common.EmptyVersion,
+				Keys:                keys,
+			}},
+		},
+	})
+}
+
+// sharedHistoryEnd returns the ID of the first event of the workflow run which is not shared with another run of its
+// history tree. Like the history nodes, payloads referenced by shared events must outlive the run.
+func (m *DeleteManagerImpl) sharedHistoryEnd(
+	ctx context.Context,
+	ms workflow.MutableState,
+	branchToken []byte,
+) (int64, error) {
+	ownBranches := make(map[string]struct{})
+	for _, versionHistory := range ms.GetExecutionInfo().GetVersionHistories().GetHistories() {
+		branch, err := m.shardContext.GetExecutionManager().GetHistoryBranchUtil().ParseHistoryBranchInfo(versionHistory.GetBranchToken())
+		if err != nil {
+			return 0, err
+		}
+		ownBranches[branch.GetBranchId()] = struct{}{}
+	}
+
+	resp, err := m.shardContext.GetExecutionManager().GetHistoryTreeContainingBranch(ctx, &persistence.GetHistoryTreeContainingBranchRequest{
+		ShardID:     m.shardContext.GetShardID(),
+		BranchToken: branchToken,
+	})
+	if err != nil {
+		return 0, err
+	}
+	sharedUntil := common.FirstEventID
+	for _, branchInfo := range resp.BranchInfos {
+		if _, ok := ownBranches[branchInfo.GetBranchId()]; ok {
+			continue
+		}
+		for _, ancestor := range branchInfo.GetAncestors() {
+			if _, ok := ownBranches[ancestor.GetBranchId()]; ok && ancestor.GetEndNodeId() > sharedUntil {
+				sharedUntil = ancestor.GetEndNodeId()
+			}
+		}
+	}
+	return sharedUntil, nil
+}
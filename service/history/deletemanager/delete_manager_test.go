@@ -26,6 +26,7 @@ package deletemanager
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -34,12 +35,18 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -94,6 +101,7 @@ func (s *deleteManagerWorkflowSuite) SetupTest() {
 	s.mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
 	s.mockShardContext.EXPECT().GetNamespaceRegistry().Return(s.mockNamespaceRegistry).AnyTimes()
 	s.mockShardContext.EXPECT().GetClusterMetadata().Return(s.mockMetadata).AnyTimes()
+	s.mockShardContext.EXPECT().GetPayloadOffloader().Return(nil).AnyTimes()
 
 	s.deleteManager = NewDeleteManager(
 		s.mockShardContext,
@@ -222,3 +230,128 @@ func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_OpenWorkflow()
 	)
 	s.NoError(err)
 }
+
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_DeleteOffloadedPayloads() {
+	// run forked from another run at event 5, the payload of event 3 is shared with it
+	otherTreeBranches := []*persistencespb.HistoryBranch{{
+		TreeId:   "tree",
+		BranchId: "other-branch",
+		Ancestors: []*persistencespb.HistoryBranchRange{
+			{BranchId: "own-branch", BeginNodeId: 1, EndNodeId: 5},
+		},
+	}}
+	keys, deletedKeys := s.deleteOffloadedPayloads(cluster.TestCurrentClusterName, otherTreeBranches)
+	s.Equal([]string{keys[5], keys[8]}, deletedKeys)
+}
+
+func (s *deleteManagerWorkflowSuite) TestDeleteWorkflowExecution_DeleteOffloadedPayloads_StandbyNamespace() {
+	_, deletedKeys := s.deleteOffloadedPayloads(cluster.TestAlternativeClusterName, nil)
+	s.Empty(deletedKeys)
+}
+
+// deleteOffloadedPayloads deletes a closed run which offloaded payloads for events 3, 5 and 8 of its history. It
+// returns the payload keys by event ID, and the keys of the DeleteOffloadedPayloadsTask scheduled by the deletion.
+func (s *deleteManagerWorkflowSuite) deleteOffloadedPayloads(
+	activeClusterName string,
+	otherBranches []*persistencespb.HistoryBranch,
+) (map[int64]string, []string) {
+	ctx := context.Background()
+	we := commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+
+	store, err := payloadstore.NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.NoError(err)
+	offloader := payloadstore.NewOffloader(store, dynamicconfig.GetIntPropertyFilteredByNamespace(10))
+	keysByEventID := make(map[int64]string)
+	var offloadedPayloads []*persistencespb.OffloadedPayload
+	for _, eventID := range []int64{3, 5, 8} {
+		keys, err := offloader.Offload(ctx, tests.NamespaceID, tests.Namespace, payloads.EncodeString(strings.Repeat("large", 10)))
+		s.NoError(err)
+		s.Len(keys, 1)
+		keysByEventID[eventID] = keys[0]
+		offloadedPayloads = append(offloadedPayloads, &persistencespb.OffloadedPayload{Key: keys[0], EventId: eventID})
+	}
+
+	ownBranchID := "own-branch"
+	branchToken, err := persistence.NewHistoryBranch("tree", &ownBranchID, nil)
+	s.NoError(err)
+	namespaceEntry := namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: activeClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+		},
+		tests.Version,
+	)
+
+	mockShardContext := shard.NewMockContext(s.controller)
+	mockExecutionManager := persistence.NewMockExecutionManager(s.controller)
+	mockShardContext.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
+	mockShardContext.EXPECT().GetPayloadOffloader().Return(offloader).AnyTimes()
+	mockShardContext.EXPECT().GetClusterMetadata().Return(s.mockMetadata).AnyTimes()
+	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShardContext.EXPECT().GetShardID().Return(int32(1)).AnyTimes()
+	mockShardContext.EXPECT().GetExecutionManager().Return(mockExecutionManager).AnyTimes()
+	mockExecutionManager.EXPECT().GetHistoryBranchUtil().Return(&persistence.HistoryBranchUtilImpl{}).AnyTimes()
+	deleteManager := NewDeleteManager(
+		mockShardContext,
+		s.mockCache,
+		tests.NewDynamicConfig(),
+		s.mockClock,
+		s.mockVisibilityManager,
+	)
+
+	mockWeCtx := workflow.NewMockContext(s.controller)
+	mockMutableState := workflow.NewMockMutableState(s.controller)
+	mockMutableState.EXPECT().GetCurrentBranchToken().Return(branchToken, nil)
+	mockMutableState.EXPECT().GetNamespaceEntry().Return(namespaceEntry).AnyTimes()
+	mockMutableState.EXPECT().GetWorkflowKey().Return(tests.WorkflowKey).AnyTimes()
+	mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		NamespaceId: tests.NamespaceID.String(),
+		WorkflowId:  tests.WorkflowID,
+		VersionHistories: &historyspb.VersionHistories{
+			Histories: []*historyspb.VersionHistory{{BranchToken: branchToken}},
+		},
+		OffloadedPayloads: offloadedPayloads,
+	}).AnyTimes()
+
+	var deletedKeys []string
+	if activeClusterName == cluster.TestCurrentClusterName {
+		mockExecutionManager.EXPECT().GetHistoryTreeContainingBranch(gomock.Any(), &persistence.GetHistoryTreeContainingBranchRequest{
+			ShardID:     1,
+			BranchToken: branchToken,
+		}).Return(&persistence.GetHistoryTreeContainingBranchResponse{
+			BranchInfos: append([]*persistencespb.HistoryBranch{{TreeId: "tree", BranchId: "own-branch"}}, otherBranches...),
+		}, nil)
+		mockShardContext.EXPECT().AddTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *persistence.AddHistoryTasksRequest) error {
+				s.Equal(tests.NamespaceID.String(), request.NamespaceID)
+				s.Equal(tests.WorkflowID, request.WorkflowID)
+				s.Len(request.Tasks[tasks.CategoryTransfer], 1)
+				task, ok := request.Tasks[tasks.CategoryTransfer][0].(*tasks.DeleteOffloadedPayloadsTask)
+				s.True(ok)
+				s.Equal(tests.WorkflowKey, task.WorkflowKey)
+				deletedKeys = task.Keys
+				return nil
+			},
+		)
+	}
+	stage := tasks.DeleteWorkflowExecutionStageNone
+	mockShardContext.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), &stage).Return(nil)
+	mockWeCtx.EXPECT().Clear()
+
+	err = deleteManager.DeleteWorkflowExecution(
+		ctx,
+		tests.NamespaceID,
+		&we,
+		mockWeCtx,
+		mockMutableState,
+		false,
+		&stage,
+	)
+	s.NoError(err)
+	return keysByEventID, deletedKeys
+}
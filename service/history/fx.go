@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -83,6 +84,7 @@ var Module = fx.Options(
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
+	fx.Provide(PayloadOffloaderProvider),
 	service.PersistenceLazyLoadedServiceResolverModule,
 	fx.Provide(ServiceResolverProvider),
 	fx.Provide(EventNotifierProvider),
@@ -180,6 +182,12 @@ func ConfigProvider(
 	)
 }
 
+// PayloadOffloaderProvider provides the offloader moving oversized payloads to the payload store once history accepted
+// the request carrying them. History deletes the payloads of a workflow along with it.
+func PayloadOffloaderProvider(store payloadstore.Store, serviceConfig *configs.Config) *payloadstore.Offloader {
+	return payloadstore.NewOffloader(store, serviceConfig.PayloadOffloadSizeThreshold)
+}
+
 func ThrottledLoggerRpsFnProvider(serviceConfig *configs.Config) resource.ThrottledLoggerRpsFn {
 	return func() float64 { return float64(serviceConfig.ThrottledLogRPS()) }
 }
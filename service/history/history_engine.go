@@ -26,12 +26,14 @@ package history
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistory"
 	"go.temporal.io/server/service/history/api/listtasks"
 	"go.temporal.io/server/service/history/api/multioperation"
@@ -39,6 +41,7 @@ import (
 	historyspb "go.temporal.io/server/api/history/v1"
 	workflowpb "go.temporal.io/server/api/workflow/v1"
 	"go.temporal.io/server/service/history/api/addtasks"
+	"go.temporal.io/server/service/history/api/concurrencylimit"
 
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -70,6 +73,7 @@ import (
 	"go.temporal.io/server/service/history/api/getworkflowexecutionrawhistoryv2"
 	"go.temporal.io/server/service/history/api/isactivitytaskvalid"
 	"go.temporal.io/server/service/history/api/isworkflowtaskvalid"
+	"go.temporal.io/server/service/history/api/pauseactivity"
 	"go.temporal.io/server/service/history/api/pollupdate"
 	"go.temporal.io/server/service/history/api/queryworkflow"
 	"go.temporal.io/server/service/history/api/reapplyevents"
@@ -86,10 +90,13 @@ import (
 	"go.temporal.io/server/service/history/api/respondactivitytaskcanceled"
 	"go.temporal.io/server/service/history/api/respondactivitytaskcompleted"
 	"go.temporal.io/server/service/history/api/respondactivitytaskfailed"
+	"go.temporal.io/server/service/history/api/resumeworkflow"
 	"go.temporal.io/server/service/history/api/signalwithstartworkflow"
 	"go.temporal.io/server/service/history/api/signalworkflow"
 	"go.temporal.io/server/service/history/api/startworkflow"
+	"go.temporal.io/server/service/history/api/suspendworkflow"
 	"go.temporal.io/server/service/history/api/terminateworkflow"
+	"go.temporal.io/server/service/history/api/unpauseactivity"
 	"go.temporal.io/server/service/history/api/updateworkflow"
 	"go.temporal.io/server/service/history/api/verifychildworkflowcompletionrecorded"
 	"go.temporal.io/server/service/history/configs"
@@ -102,6 +109,7 @@ import (
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -961,3 +969,98 @@ func (e *historyEngineImpl) ListTasks(
 		request,
 	)
 }
+
+func (e *historyEngineImpl) ListQueueMitigations(
+	_ context.Context,
+	request *historyservice.ListQueueMitigationsRequest,
+) (_ *historyservice.ListQueueMitigationsResponse, retError error) {
+	queue, err := e.getMitigatedQueue(request.GetCategory())
+	if err != nil {
+		return nil, err
+	}
+
+	mitigations := queue.Mitigations()
+	response := &historyservice.ListQueueMitigationsResponse{
+		Mitigations: make([]*historyspb.QueueMitigation, 0, len(mitigations)),
+	}
+	for _, mitigation := range mitigations {
+		response.Mitigations = append(response.Mitigations, &historyspb.QueueMitigation{
+			Id:         mitigation.ID,
+			Action:     mitigation.Action,
+			CreateTime: timestamppb.New(mitigation.CreateTime),
+		})
+	}
+	return response, nil
+}
+
+func (e *historyEngineImpl) RevertQueueMitigation(
+	ctx context.Context,
+	request *historyservice.RevertQueueMitigationRequest,
+) (_ *historyservice.RevertQueueMitigationResponse, retError error) {
+	queue, err := e.getMitigatedQueue(request.GetCategory())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := queue.RevertMitigation(ctx, request.GetMitigationId()); err != nil {
+		return nil, err
+	}
+	return &historyservice.RevertQueueMitigationResponse{}, nil
+}
+
+func (e *historyEngineImpl) getMitigatedQueue(
+	categoryID int32,
+) (queues.MitigatedQueue, error) {
+	category, ok := e.taskCategoryRegistry.GetCategoryByID(int(categoryID))
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid task category ID: %v", categoryID))
+	}
+
+	queue, ok := e.queueProcessors[category].(queues.MitigatedQueue)
+	if !ok {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Task queue of category %v doesn't support mitigations", category.Name()))
+	}
+	return queue, nil
+}
+
+func (e *historyEngineImpl) PauseActivity(
+	ctx context.Context,
+	request *historyservice.PauseActivityRequest,
+) (*historyservice.PauseActivityResponse, error) {
+	return pauseactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) UnpauseActivity(
+	ctx context.Context,
+	request *historyservice.UnpauseActivityRequest,
+) (*historyservice.UnpauseActivityResponse, error) {
+	return unpauseactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) SuspendWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.SuspendWorkflowExecutionRequest,
+) (*historyservice.SuspendWorkflowExecutionResponse, error) {
+	return suspendworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) ResumeWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.ResumeWorkflowExecutionRequest,
+) (*historyservice.ResumeWorkflowExecutionResponse, error) {
+	return resumeworkflow.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) ReserveConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReserveConcurrencyLimitRequest,
+) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	return concurrencylimit.Reserve(ctx, e.shardContext, request)
+}
+
+func (e *historyEngineImpl) ReleaseConcurrencyLimit(
+	ctx context.Context,
+	request *historyservice.ReleaseConcurrencyLimitRequest,
+) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	return concurrencylimit.Release(ctx, e.shardContext, request)
+}
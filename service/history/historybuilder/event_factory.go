@@ -698,6 +698,29 @@ func (b *EventFactory) CreateWorkflowPropertiesModifiedEvent(
 	return event
 }
 
+// CreateWorkflowExecutionSuspendedEvent records the suspension of a workflow execution, as a
+// WorkflowPropertiesModifiedExternally event which modifies no property. Such events are only written when a workflow
+// execution is suspended or resumed, which alternate, so each of them toggles the suspended state. Workers may ignore
+// the event.
+func (b *EventFactory) CreateWorkflowExecutionSuspendedEvent() *historypb.HistoryEvent {
+	return b.createWorkflowSuspendedStateModifiedEvent()
+}
+
+// CreateWorkflowExecutionResumedEvent records the resumption of a suspended workflow execution, like
+// CreateWorkflowExecutionSuspendedEvent.
+func (b *EventFactory) CreateWorkflowExecutionResumedEvent() *historypb.HistoryEvent {
+	return b.createWorkflowSuspendedStateModifiedEvent()
+}
+
+func (b *EventFactory) createWorkflowSuspendedStateModifiedEvent() *historypb.HistoryEvent {
+	event := b.createHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, b.timeSource.Now())
+	event.WorkerMayIgnore = true
+	event.Attributes = &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+		WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{},
+	}
+	return event
+}
+
 func (b *EventFactory) CreateSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
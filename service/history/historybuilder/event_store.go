@@ -56,6 +56,8 @@ type EventStore struct {
 
 	// scheduled to started event ID mapping
 	scheduledIDToStartedID map[int64]int64
+	// update ID to position mapping of flushed UpdateAdmitted events
+	flushedUpdateAdmissions map[string]EventPosition
 
 	metricsHandler metrics.Handler
 }
@@ -170,9 +172,30 @@ func (b *EventStore) FlushBufferToCurrentBatch() map[int64]int64 {
 
 	b.memLatestBatch = append(b.memLatestBatch, bufferBatch...)
 
+	// 3rd record the final position of update admissions, which mutable state points to
+	for _, event := range bufferBatch {
+		if event.GetEventType() != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED {
+			continue
+		}
+		if b.flushedUpdateAdmissions == nil {
+			b.flushedUpdateAdmissions = make(map[string]EventPosition)
+		}
+		updateID := event.GetWorkflowExecutionUpdateAdmittedEventAttributes().GetRequest().GetMeta().GetUpdateId()
+		b.flushedUpdateAdmissions[updateID] = EventPosition{
+			EventID:      event.GetEventId(),
+			EventBatchID: b.memLatestBatch[0].GetEventId(),
+		}
+	}
+
 	return b.scheduledIDToStartedID
 }
 
+// FlushedUpdateAdmissions returns the positions assigned to buffered UpdateAdmitted events by the flushes of the
+// current transaction, by update ID.
+func (b *EventStore) FlushedUpdateAdmissions() map[string]EventPosition {
+	return b.flushedUpdateAdmissions
+}
+
 func (b *EventStore) FlushAndCreateNewBatch() {
 	b.assertNotSealed()
 	if len(b.memLatestBatch) == 0 {
@@ -201,6 +224,7 @@ func (b *EventStore) Finish(
 	memBufferBatch := b.dbBufferBatch
 	memBufferBatch = append(memBufferBatch, dbBufferBatch...)
 	scheduledIDToStartedID := b.scheduledIDToStartedID
+	flushedUpdateAdmissions := b.flushedUpdateAdmissions
 
 	b.memEventsBatches = nil
 	b.memBufferBatch = nil
@@ -208,17 +232,19 @@ func (b *EventStore) Finish(
 	b.dbClearBuffer = false
 	b.dbBufferBatch = nil
 	b.scheduledIDToStartedID = nil
+	b.flushedUpdateAdmissions = nil
 
 	if err := b.assignTaskIDs(dbEventsBatches); err != nil {
 		return nil, err
 	}
 
 	return &HistoryMutation{
-		DBEventsBatches:        dbEventsBatches,
-		DBClearBuffer:          dbClearBuffer,
-		DBBufferBatch:          dbBufferBatch,
-		MemBufferBatch:         memBufferBatch,
-		ScheduledIDToStartedID: scheduledIDToStartedID,
+		DBEventsBatches:         dbEventsBatches,
+		DBClearBuffer:           dbClearBuffer,
+		DBBufferBatch:           dbBufferBatch,
+		MemBufferBatch:          memBufferBatch,
+		ScheduledIDToStartedID:  scheduledIDToStartedID,
+		FlushedUpdateAdmissions: flushedUpdateAdmissions,
 	}, nil
 }
 
@@ -493,6 +519,29 @@ func (b *EventStore) HasActivityFinishEvent(
 	return false
 }
 
+func (b *EventStore) lastWorkflowTaskCompletedEvent() *historypb.HistoryEvent {
+	if event := lastWorkflowTaskCompletedEvent(b.memLatestBatch); event != nil {
+		return event
+	}
+	for i := len(b.memEventsBatches) - 1; i >= 0; i-- {
+		if event := lastWorkflowTaskCompletedEvent(b.memEventsBatches[i]); event != nil {
+			return event
+		}
+	}
+	return nil
+}
+
+func lastWorkflowTaskCompletedEvent(
+	events []*historypb.HistoryEvent,
+) *historypb.HistoryEvent {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+			return events[i]
+		}
+	}
+	return nil
+}
+
 func hasActivityFinishEvent(
 	scheduledEventID int64,
 	events []*historypb.HistoryEvent,
@@ -69,6 +69,14 @@ type (
 		MemBufferBatch []*historypb.HistoryEvent
 		// scheduled to started event ID mapping for flushed buffered event
 		ScheduledIDToStartedID map[int64]int64
+		// update ID to position mapping for flushed buffered UpdateAdmitted event
+		FlushedUpdateAdmissions map[string]EventPosition
+	}
+
+	// EventPosition locates an event in history by its ID and the ID of the first event of its batch.
+	EventPosition struct {
+		EventID      int64
+		EventBatchID int64
 	}
 
 	TaskIDGenerator func(number int) ([]int64, error)
@@ -387,6 +395,11 @@ func (b *HistoryBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
 	return event
 }
 
+// HasWorkflowTaskCompletedEvent returns true if a workflow task was completed in the current transaction.
+func (b *HistoryBuilder) HasWorkflowTaskCompletedEvent() bool {
+	return b.EventStore.lastWorkflowTaskCompletedEvent() != nil
+}
+
 func (b *HistoryBuilder) AddWorkflowExecutionUpdateCompletedEvent(
 	acceptedEventID int64,
 	updResp *updatepb.Response,
@@ -575,6 +588,18 @@ func (b *HistoryBuilder) AddWorkflowPropertiesModifiedEvent(
 	return event
 }
 
+func (b *HistoryBuilder) AddWorkflowExecutionSuspendedEvent() *historypb.HistoryEvent {
+	event := b.EventFactory.CreateWorkflowExecutionSuspendedEvent()
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
+func (b *HistoryBuilder) AddWorkflowExecutionResumedEvent() *historypb.HistoryEvent {
+	event := b.EventFactory.CreateWorkflowExecutionResumedEvent()
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
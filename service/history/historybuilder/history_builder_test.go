@@ -718,6 +718,13 @@ func (s *historyBuilderSuite) TestWorkflowTaskCompleted() {
 	}, event)
 }
 
+func (s *historyBuilderSuite) TestHasWorkflowTaskCompletedEvent() {
+	s.False(s.historyBuilder.HasWorkflowTaskCompletedEvent())
+
+	s.historyBuilder.AddWorkflowTaskCompletedEvent(rand.Int63(), rand.Int63(), testIdentity, "", nil, nil, nil)
+	s.True(s.historyBuilder.HasWorkflowTaskCompletedEvent())
+}
+
 func (s *historyBuilderSuite) TestWorkflowTaskFailed() {
 	scheduledEventID := rand.Int63()
 	startedEventID := rand.Int63()
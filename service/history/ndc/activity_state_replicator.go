@@ -149,6 +149,7 @@ func (r *ActivityStateReplicatorImpl) SyncActivityState(
 			LastFailure:        request.LastFailure,
 			LastWorkerIdentity: request.LastWorkerIdentity,
 			VersionHistory:     request.VersionHistory,
+			Paused:             request.Paused,
 		},
 	)
 	if err != nil {
@@ -159,8 +160,10 @@ func (r *ActivityStateReplicatorImpl) SyncActivityState(
 	}
 
 	// passive logic need to explicitly call create timer
-	if _, err := workflow.NewTimerSequence(
+	if _, err := workflow.NewCoalescingTimerSequence(
 		mutableState,
+		r.shardContext.GetConfig(),
+		r.shardContext.GetMetricsHandler(),
 	).CreateNextActivityTimer(); err != nil {
 		return err
 	}
@@ -242,8 +245,10 @@ func (r *ActivityStateReplicatorImpl) SyncActivitiesState(
 	}
 
 	// passive logic need to explicitly call create timer
-	if _, err := workflow.NewTimerSequence(
+	if _, err := workflow.NewCoalescingTimerSequence(
 		mutableState,
+		r.shardContext.GetConfig(),
+		r.shardContext.GetMetricsHandler(),
 	).CreateNextActivityTimer(); err != nil {
 		return err
 	}
@@ -292,6 +297,7 @@ func (r *ActivityStateReplicatorImpl) syncSingleActivityState(
 		activitySyncInfo.GetVersion(),
 		activitySyncInfo.GetAttempt(),
 		timestamp.TimeValue(activitySyncInfo.GetLastHeartbeatTime()),
+		activitySyncInfo.GetPaused(),
 		activityInfo,
 	); !shouldApply {
 		return false, nil
@@ -299,7 +305,9 @@ func (r *ActivityStateReplicatorImpl) syncSingleActivityState(
 
 	// sync activity with empty started ID means activity retry
 	eventTime := timestamp.TimeValue(activitySyncInfo.GetScheduledTime())
-	if activitySyncInfo.StartedEventId == common.EmptyEventID && activitySyncInfo.Attempt > activityInfo.GetAttempt() {
+	// paused activities are not retried until they are unpaused
+	if activitySyncInfo.StartedEventId == common.EmptyEventID && activitySyncInfo.Attempt > activityInfo.GetAttempt() &&
+		!activitySyncInfo.GetPaused() {
 		mutableState.AddTasks(&tasks.ActivityRetryTimerTask{
 			WorkflowKey:         *workflowKey,
 			VisibilityTimestamp: eventTime,
@@ -344,6 +352,7 @@ func (r *ActivityStateReplicatorImpl) testActivity(
 	version int64,
 	attempt int32,
 	lastHeartbeatTime time.Time,
+	paused bool,
 	activityInfo *persistencespb.ActivityInfo,
 ) bool {
 
@@ -357,6 +366,12 @@ func (r *ActivityStateReplicatorImpl) testActivity(
 		return true
 	}
 
+	// activityInfo.Version == version
+	if activityInfo.Paused != paused {
+		// activity was paused or unpaused, the attempts may have been reset when it was unpaused
+		return true
+	}
+
 	// activityInfo.Version == version
 	if activityInfo.Attempt > attempt {
 		// this should not retry, can be caused by failover or reset
@@ -202,6 +202,7 @@ func (s *activityReplicatorStateSuite) TestActivity_LocalVersionLarger() {
 		version,
 		attempt,
 		lastHeartbeatTime,
+		false,
 		localActivityInfo,
 	)
 	s.False(apply)
@@ -220,6 +221,7 @@ func (s *activityReplicatorStateSuite) TestActivity_IncomingVersionLarger() {
 		version,
 		attempt,
 		lastHeartbeatTime,
+		false,
 		localActivityInfo,
 	)
 	s.True(apply)
@@ -238,11 +240,33 @@ func (s *activityReplicatorStateSuite) TestActivity_SameVersion_LocalAttemptLarg
 		version,
 		attempt,
 		lastHeartbeatTime,
+		false,
 		localActivityInfo,
 	)
 	s.False(apply)
 }
 
+func (s *activityReplicatorStateSuite) TestActivity_SameVersion_LocalAttemptLarger_Unpaused() {
+	version := int64(123)
+	attempt := int32(1)
+	lastHeartbeatTime := time.Now()
+	localActivityInfo := &persistencespb.ActivityInfo{
+		Version: version,
+		Attempt: attempt + 1,
+		Paused:  true,
+	}
+
+	// attempts are reset when the activity is unpaused
+	apply := s.nDCActivityStateReplicator.testActivity(
+		version,
+		attempt,
+		lastHeartbeatTime,
+		false,
+		localActivityInfo,
+	)
+	s.True(apply)
+}
+
 func (s *activityReplicatorStateSuite) TestActivity_SameVersion_IncomingAttemptLarger() {
 	version := int64(123)
 	attempt := int32(1)
@@ -256,6 +280,7 @@ func (s *activityReplicatorStateSuite) TestActivity_SameVersion_IncomingAttemptL
 		version,
 		attempt,
 		lastHeartbeatTime,
+		false,
 		localActivityInfo,
 	)
 	s.True(apply)
@@ -275,6 +300,7 @@ func (s *activityReplicatorStateSuite) TestActivity_SameVersion_SameAttempt_Loca
 		version,
 		attempt,
 		lastHeartbeatTime,
+		false,
 		localActivityInfo,
 	)
 	s.False(apply)
@@ -294,6 +320,7 @@ func (s *activityReplicatorStateSuite) TestActivity_SameVersion_SameAttempt_Inco
 		version,
 		attempt,
 		lastHeartbeatTime,
+		false,
 		localActivityInfo,
 	)
 	s.True(apply)
@@ -215,6 +215,12 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	defer func() { resetWorkflow.GetReleaseFn()(retError) }()
 
 	resetMS := resetWorkflow.GetMutableState()
+	// carry over the automatic resets, so that the max resets per window is enforced across runs
+	resetMS.GetExecutionInfo().AutoResetTimes = currentMutableState.GetExecutionInfo().GetAutoResetTimes()
+	// carry over the dedup windows, so that retried signals and updates are not applied again to the new run
+	resetMS.CarryOverRequestIDDedupWindows(currentMutableState.GetExecutionInfo())
+	// carry over the concurrency limits, whose slots are handed over to the reset run when the current run closes
+	resetMS.GetExecutionInfo().ConcurrencyLimitKeys = currentMutableState.GetExecutionInfo().GetConcurrencyLimitKeys()
 	if err := reapplyEventsFn(ctx, resetMS); err != nil {
 		return err
 	}
@@ -189,9 +189,12 @@ func (f *outboundQueueFactory) CreateQueue(
 				PollBackoffInterval:  f.Config.OutboundProcessorPollBackoffInterval,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:          f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:        f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:        f.Config.QueueCriticalSlicesCount,
+				WorkflowPendingTasksCriticalShare:  f.Config.QueueWorkflowPendingTaskCriticalShare,
+				NamespacePendingTasksCriticalShare: f.Config.QueueNamespacePendingTaskCriticalShare,
+				TaskTypeFailingCriticalCount:       f.Config.QueueTaskTypeFailingCriticalCount,
 			},
 			MaxPollRPS:                          f.Config.OutboundProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.OutboundProcessorMaxPollInterval,
@@ -0,0 +1,269 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"fmt"
+	"strings"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	slowLaneMitigationIDPrefixWorkflow  = "workflow:"
+	slowLaneMitigationIDPrefixNamespace = "namespace:"
+	slowLaneMitigationIDPrefixTaskType  = "task-type:"
+)
+
+var (
+	_ Action = (*actionSlowLane)(nil)
+	_ Action = (*actionRevertSlowLane)(nil)
+)
+
+type (
+	// slowLaneMitigation identifies a group of tasks which can be moved
+	// to and from the slow lane reader.
+	slowLaneMitigation struct {
+		id        string
+		predicate tasks.Predicate
+		// hasPendingTasks returns true if a slice with the given stats
+		// has pending tasks in the group
+		hasPendingTasks func(TaskStats) bool
+	}
+
+	// actionSlowLane moves the tasks of a mitigation from all other readers to
+	// the slow lane reader, which is the reader with the lowest priority, so that
+	// they won't block loading and processing of other tasks in the queue.
+	// The moved tasks are unloaded and the slow lane reader is paused for a while
+	// before loading them again.
+	actionSlowLane struct {
+		name             string
+		mitigation       *slowLaneMitigation
+		slowLaneReaderID int64
+	}
+
+	// actionRevertSlowLane moves the tasks of a mitigation from the slow lane
+	// reader back to the default reader.
+	actionRevertSlowLane struct {
+		mitigation       *slowLaneMitigation
+		slowLaneReaderID int64
+	}
+)
+
+func newWorkflowSlowLaneMitigation(
+	namespaceID string,
+	workflowID string,
+) *slowLaneMitigation {
+	workflowKey := definition.NewWorkflowKey(namespaceID, workflowID, "")
+	return &slowLaneMitigation{
+		id: slowLaneMitigationIDPrefixWorkflow + namespaceID + "/" + workflowID,
+		predicate: predicates.And[tasks.Task](
+			tasks.NewNamespacePredicate([]string{namespaceID}),
+			tasks.NewWorkflowIDPredicate([]string{workflowID}),
+		),
+		hasPendingTasks: func(stats TaskStats) bool {
+			return stats.PendingPerWorkflow[workflowKey] > 0
+		},
+	}
+}
+
+func newNamespaceSlowLaneMitigation(
+	namespaceID string,
+) *slowLaneMitigation {
+	return &slowLaneMitigation{
+		id:        slowLaneMitigationIDPrefixNamespace + namespaceID,
+		predicate: tasks.NewNamespacePredicate([]string{namespaceID}),
+		hasPendingTasks: func(stats TaskStats) bool {
+			return stats.PendingPerNamespace[namespaceID] > 0
+		},
+	}
+}
+
+func newTaskTypeSlowLaneMitigation(
+	taskType enumsspb.TaskType,
+) *slowLaneMitigation {
+	return &slowLaneMitigation{
+		id:        slowLaneMitigationIDPrefixTaskType + taskType.String(),
+		predicate: tasks.NewTypePredicate([]enumsspb.TaskType{taskType}),
+		hasPendingTasks: func(stats TaskStats) bool {
+			return stats.FailingPerType[taskType] > 0
+		},
+	}
+}
+
+func parseSlowLaneMitigation(
+	mitigationID string,
+) (*slowLaneMitigation, error) {
+	switch {
+	case strings.HasPrefix(mitigationID, slowLaneMitigationIDPrefixWorkflow):
+		namespaceID, workflowID, ok := strings.Cut(strings.TrimPrefix(mitigationID, slowLaneMitigationIDPrefixWorkflow), "/")
+		if !ok || namespaceID == "" || workflowID == "" {
+			return nil, fmt.Errorf("invalid workflow mitigation ID: %v", mitigationID)
+		}
+		return newWorkflowSlowLaneMitigation(namespaceID, workflowID), nil
+	case strings.HasPrefix(mitigationID, slowLaneMitigationIDPrefixNamespace):
+		namespaceID := strings.TrimPrefix(mitigationID, slowLaneMitigationIDPrefixNamespace)
+		if namespaceID == "" {
+			return nil, fmt.Errorf("invalid namespace mitigation ID: %v", mitigationID)
+		}
+		return newNamespaceSlowLaneMitigation(namespaceID), nil
+	case strings.HasPrefix(mitigationID, slowLaneMitigationIDPrefixTaskType):
+		taskType, err := enumsspb.TaskTypeFromString(strings.TrimPrefix(mitigationID, slowLaneMitigationIDPrefixTaskType))
+		if err != nil {
+			return nil, fmt.Errorf("invalid task type mitigation ID: %w", err)
+		}
+		return newTaskTypeSlowLaneMitigation(taskType), nil
+	default:
+		return nil, fmt.Errorf("unknown mitigation ID: %v", mitigationID)
+	}
+}
+
+// slowLaneReaderID returns the ID of the slow lane reader,
+// which is the default reader if the queue has only one reader.
+func slowLaneReaderID(maxReaderCount int) int64 {
+	return max(DefaultReaderId, DefaultReaderId+int64(maxReaderCount)-1)
+}
+
+func newSlowLaneAction(
+	name string,
+	mitigation *slowLaneMitigation,
+	maxReaderCount int,
+) *actionSlowLane {
+	return &actionSlowLane{
+		name:             name,
+		mitigation:       mitigation,
+		slowLaneReaderID: slowLaneReaderID(maxReaderCount),
+	}
+}
+
+func (a *actionSlowLane) Name() string {
+	return a.name
+}
+
+func (a *actionSlowLane) Run(readerGroup *ReaderGroup) {
+	if a.slowLaneReaderID == DefaultReaderId {
+		return
+	}
+
+	var splitSlices []Slice
+	for readerID, reader := range readerGroup.Readers() {
+		if readerID == a.slowLaneReaderID {
+			continue
+		}
+
+		reader.SplitSlices(func(s Slice) ([]Slice, bool) {
+			if !a.mitigation.hasPendingTasks(s.TaskStats()) {
+				return nil, false
+			}
+
+			split, remain := s.SplitByPredicate(a.mitigation.predicate)
+			split.Clear()
+			splitSlices = append(splitSlices, split)
+			return []Slice{remain}, true
+		})
+	}
+
+	if len(splitSlices) == 0 {
+		return
+	}
+
+	slowLaneReader := readerGroup.GetOrCreateReader(a.slowLaneReaderID)
+	slowLaneReader.MergeSlices(splitSlices...)
+	slowLaneReader.Pause(clearSliceThrottleDuration)
+}
+
+func newRevertSlowLaneAction(
+	mitigation *slowLaneMitigation,
+	maxReaderCount int,
+) *actionRevertSlowLane {
+	return &actionRevertSlowLane{
+		mitigation:       mitigation,
+		slowLaneReaderID: slowLaneReaderID(maxReaderCount),
+	}
+}
+
+func (a *actionRevertSlowLane) Name() string {
+	return "revert-slow-lane"
+}
+
+func (a *actionRevertSlowLane) Run(readerGroup *ReaderGroup) {
+	if a.slowLaneReaderID == DefaultReaderId {
+		return
+	}
+
+	slowLaneReader, ok := readerGroup.ReaderByID(a.slowLaneReaderID)
+	if !ok {
+		return
+	}
+
+	var movedSlices []Slice
+	slowLaneReader.SplitSlices(func(s Slice) ([]Slice, bool) {
+		if !containsPredicate(s.Scope().Predicate, a.mitigation.predicate) {
+			return nil, false
+		}
+
+		movedSlices = append(movedSlices, s)
+		return nil, true
+	})
+
+	if len(movedSlices) == 0 {
+		return
+	}
+
+	readerGroup.GetOrCreateReader(DefaultReaderId).MergeSlices(movedSlices...)
+}
+
+// containsPredicate returns true if all the conjuncts of the given predicate
+// are also conjuncts of the slice predicate, i.e. the slice was split by the
+// given predicate.
+func containsPredicate(
+	slicePredicate tasks.Predicate,
+	predicate tasks.Predicate,
+) bool {
+	sliceConjuncts := conjuncts(slicePredicate)
+	for _, conjunct := range conjuncts(predicate) {
+		found := false
+		for _, sliceConjunct := range sliceConjuncts {
+			if sliceConjunct.Equals(conjunct) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func conjuncts(predicate tasks.Predicate) []tasks.Predicate {
+	if andPredicate, ok := predicate.(*predicates.AndImpl[tasks.Task]); ok {
+		return andPredicate.Predicates
+	}
+	return []tasks.Predicate{predicate}
+}
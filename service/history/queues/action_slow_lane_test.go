@@ -0,0 +1,65 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+func TestContainsPredicate(t *testing.T) {
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	workflowMitigation := newWorkflowSlowLaneMitigation(namespaceID, workflowID)
+	namespaceMitigation := newNamespaceSlowLaneMitigation(namespaceID)
+	taskTypeMitigation := newTaskTypeSlowLaneMitigation(enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK)
+
+	for _, slicePredicate := range []tasks.Predicate{
+		predicates.Universal[tasks.Task](),
+		tasks.NewNamespacePredicate([]string{namespaceID, uuid.New()}),
+		predicates.Not(tasks.NewTypePredicate([]enumsspb.TaskType{enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK})),
+	} {
+		scope := NewScope(NewRandomRange(), slicePredicate)
+
+		for _, mitigation := range []*slowLaneMitigation{workflowMitigation, namespaceMitigation, taskTypeMitigation} {
+			pass, fail := scope.SplitByPredicate(mitigation.predicate)
+			require.True(t, containsPredicate(pass.Predicate, mitigation.predicate), mitigation.id)
+			require.False(t, containsPredicate(fail.Predicate, mitigation.predicate), mitigation.id)
+		}
+
+		require.False(t, containsPredicate(slicePredicate, workflowMitigation.predicate))
+		require.False(t, containsPredicate(slicePredicate, taskTypeMitigation.predicate))
+	}
+
+	scope := NewScope(NewRandomRange(), predicates.Universal[tasks.Task]())
+	pass, _ := scope.SplitByPredicate(namespaceMitigation.predicate)
+	require.False(t, containsPredicate(pass.Predicate, workflowMitigation.predicate))
+}
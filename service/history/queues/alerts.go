@@ -25,6 +25,7 @@
 package queues
 
 import (
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -35,6 +36,9 @@ type (
 		AlertAttributesQueuePendingTaskCount *AlertAttributesQueuePendingTaskCount
 		AlertAttributesReaderStuck           *AlertAttributesReaderStuck
 		AlertAttributesSliceCount            *AlertAttributesSlicesCount
+		AlertAttributesWorkflowPendingTask   *AlertAttributesWorkflowPendingTask
+		AlertAttributesNamespacePendingTask  *AlertAttributesNamespacePendingTask
+		AlertAttributesTaskTypeFailing       *AlertAttributesTaskTypeFailing
 	}
 
 	AlertType int
@@ -53,6 +57,25 @@ type (
 		CurrentSliceCount  int
 		CriticalSliceCount int
 	}
+
+	AlertAttributesWorkflowPendingTask struct {
+		NamespaceID              string
+		WorkflowID               string
+		CurrentPendingTaskCount  int
+		CriticalPendingTaskCount int
+	}
+
+	AlertAttributesNamespacePendingTask struct {
+		NamespaceID              string
+		CurrentPendingTaskCount  int
+		CriticalPendingTaskCount int
+	}
+
+	AlertAttributesTaskTypeFailing struct {
+		TaskType                 enumsspb.TaskType
+		CurrentFailingTaskCount  int
+		CriticalFailingTaskCount int
+	}
 )
 
 const (
@@ -60,4 +83,7 @@ const (
 	AlertTypeQueuePendingTaskCount
 	AlertTypeReaderStuck
 	AlertTypeSliceCount
+	AlertTypeWorkflowPendingTask
+	AlertTypeNamespacePendingTask
+	AlertTypeTaskTypeFailing
 )
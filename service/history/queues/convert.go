@@ -145,6 +145,8 @@ func ToPersistencePredicate(
 		return ToPersistenceTaskTypePredicate(predicate)
 	case *tasks.DestinationPredicate:
 		return ToPersistenceDestinationPredicate(predicate)
+	case *tasks.WorkflowIDPredicate:
+		return ToPersistenceWorkflowIDPredicate(predicate)
 	default:
 		panic(fmt.Sprintf("unknown task predicate type: %T", predicate))
 	}
@@ -170,6 +172,8 @@ func FromPersistencePredicate(
 		return FromPersistenceTaskTypePredicate(predicate.GetTaskTypePredicateAttributes())
 	case enumsspb.PREDICATE_TYPE_DESTINATION:
 		return FromPersistenceDestinationPredicate(predicate.GetDestinationPredicateAttributes())
+	case enumsspb.PREDICATE_TYPE_WORKFLOW_ID:
+		return FromPersistenceWorkflowIDPredicate(predicate.GetWorkflowIdPredicateAttributes())
 	default:
 		panic(fmt.Sprintf("unknown persistence task predicate type: %v", predicate.GetPredicateType()))
 	}
@@ -338,3 +342,22 @@ func FromPersistenceDestinationPredicate(
 ) tasks.Predicate {
 	return tasks.NewDestinationPredicate(attributes.Destinations)
 }
+
+func ToPersistenceWorkflowIDPredicate(
+	workflowIDPredicate *tasks.WorkflowIDPredicate,
+) *persistencespb.Predicate {
+	return &persistencespb.Predicate{
+		PredicateType: enumsspb.PREDICATE_TYPE_WORKFLOW_ID,
+		Attributes: &persistencespb.Predicate_WorkflowIdPredicateAttributes{
+			WorkflowIdPredicateAttributes: &persistencespb.WorkflowIdPredicateAttributes{
+				WorkflowIds: maps.Keys(workflowIDPredicate.WorkflowIDs),
+			},
+		},
+	}
+}
+
+func FromPersistenceWorkflowIDPredicate(
+	attributes *persistencespb.WorkflowIdPredicateAttributes,
+) tasks.Predicate {
+	return tasks.NewWorkflowIDPredicate(attributes.WorkflowIds)
+}
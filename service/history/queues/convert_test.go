@@ -203,6 +203,18 @@ func (s *convertSuite) TestConvertPredicate_TaskType() {
 	}
 }
 
+func (s *convertSuite) TestConvertPredicate_WorkflowID() {
+	testCases := []tasks.Predicate{
+		tasks.NewWorkflowIDPredicate(nil),
+		tasks.NewWorkflowIDPredicate([]string{}),
+		tasks.NewWorkflowIDPredicate([]string{uuid.New(), uuid.New(), uuid.New()}),
+	}
+
+	for _, predicate := range testCases {
+		s.Equal(predicate, FromPersistencePredicate(ToPersistencePredicate(predicate)))
+	}
+}
+
 func (s *convertSuite) TestConvertTaskKey() {
 	key := NewRandomKey()
 	s.Equal(key, FromPersistenceTaskKey(
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 
+	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -77,8 +78,14 @@ func NewDLQWriter(w QueueWriter, m cluster.Metadata, h metrics.Handler, l log.Sn
 	}
 }
 
-// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist.
-func (q *DLQWriter) WriteTaskToDLQ(ctx context.Context, sourceCluster, targetCluster string, task tasks.Task) error {
+// WriteTaskToDLQ writes a task to the DLQ, creating the underlying queue if it doesn't already exist. The failure is
+// optional, and is stored with the task so that DLQ tasks can be retried based on why they failed.
+func (q *DLQWriter) WriteTaskToDLQ(
+	ctx context.Context,
+	sourceCluster, targetCluster string,
+	task tasks.Task,
+	failure *commonspb.HistoryDLQTaskFailure,
+) error {
 	queueKey := persistence.QueueKey{
 		QueueType:     persistence.QueueTypeHistoryDLQ,
 		Category:      task.GetCategory(),
@@ -105,6 +112,7 @@ func (q *DLQWriter) WriteTaskToDLQ(ctx context.Context, sourceCluster, targetClu
 		TargetCluster: queueKey.TargetCluster,
 		Task:          task,
 		SourceShardID: shardID,
+		DLQFailure:    failure,
 	})
 	if err != nil {
 		return fmt.Errorf("%w: %v", ErrSendTaskToDLQ, err)
@@ -32,6 +32,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
@@ -75,6 +77,7 @@ func TestDLQWriter_ErrGetClusterMetadata(t *testing.T) {
 		"source-cluster",
 		"target-cluster",
 		&tasks.WorkflowTask{},
+		nil,
 	)
 	assert.ErrorIs(t, err, queues.ErrGetClusterMetadata)
 	assert.Empty(t, queueWriter.EnqueueTaskRequests)
@@ -110,6 +113,7 @@ func TestDLQWriter_ErrGetNamespaceName(t *testing.T) {
 		"source-cluster",
 		"target-cluster",
 		task,
+		nil,
 	)
 	require.NoError(t, err)
 	require.Len(t, queueWriter.EnqueueTaskRequests, 1)
@@ -152,17 +156,23 @@ func TestDLQWriter_Ok(t *testing.T) {
 			RunID:       tests.RunID,
 		},
 	}
+	failure := &commonspb.HistoryDLQTaskFailure{
+		Type:    enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL,
+		Message: "some random error",
+	}
 	err := writer.WriteTaskToDLQ(
 		context.Background(),
 		"source-cluster",
 		"target-cluster",
 		task,
+		failure,
 	)
 	require.NoError(t, err)
 	require.Len(t, queueWriter.EnqueueTaskRequests, 1)
 	request := queueWriter.EnqueueTaskRequests[0]
 	expectedShardID := tasks.GetShardIDForTask(task, 100)
 	assert.Equal(t, expectedShardID, request.SourceShardID)
+	assert.Equal(t, failure, request.DLQFailure)
 	assert.NotEmpty(t, logger.records)
 	assert.Contains(t, logger.records[0].msg, "Task enqueued to DLQ")
 	assert.Contains(t, logger.records[0].tags, tag.DLQMessageID(0))
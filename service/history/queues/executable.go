@@ -38,7 +38,10 @@ import (
 
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -196,6 +199,7 @@ type (
 		taggedMetricsHandler       metrics.Handler
 		dlqEnabled                 dynamicconfig.BoolPropertyFn
 		terminalFailureCause       error
+		terminalFailureType        enumsspb.DLQTaskFailureType
 		unexpectedErrorAttempts    int
 		maxUnexpectedErrorAttempts dynamicconfig.IntPropertyFn
 		dlqInternalErrors          dynamicconfig.BoolPropertyFn
@@ -373,6 +377,11 @@ func (e *executableImpl) writeToDLQ(ctx context.Context) error {
 		e.clusterMetadata.GetCurrentClusterName(),
 		e.clusterMetadata.GetCurrentClusterName(),
 		e.GetTask(),
+		&commonspb.HistoryDLQTaskFailure{
+			Type:    e.terminalFailureType,
+			Message: e.terminalFailureCause.Error(),
+			Time:    timestamppb.New(start),
+		},
 	)
 	if err != nil {
 		metrics.TaskDLQFailures.With(e.taggedMetricsHandler).Record(1)
@@ -524,6 +533,7 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 				tag.Error(err),
 				tag.ErrorType(err))
 			e.terminalFailureCause = err
+			e.terminalFailureType = enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL
 			metrics.TaskTerminalFailures.With(e.taggedMetricsHandler).Record(1)
 			return fmt.Errorf("%w: %v", ErrTerminalTaskFailure, err)
 		}
@@ -549,6 +559,7 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 			// Keep this message in sync with the log line mentioned in Investigation section of docs/admin/dlq.md
 			e.logger.Error("Marking task as terminally failed, will send to DLQ", tag.Error(err), tag.ErrorType(err))
 			e.terminalFailureCause = err // <- Execute() examines this attribute on the next attempt.
+			e.terminalFailureType = enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL
 			metrics.TaskTerminalFailures.With(e.taggedMetricsHandler).Record(1)
 			return fmt.Errorf("%w: %v", ErrTerminalTaskFailure, err)
 		}
@@ -566,6 +577,7 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 		e.logger.Error("Marking task as terminally failed, will send to DLQ. Maximum number of attempts with unexpected errors",
 			tag.Attempt(int32(e.unexpectedErrorAttempts)), tag.Error(err))
 		e.terminalFailureCause = err // <- Execute() examines this attribute on the next attempt.
+		e.terminalFailureType = enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT
 		metrics.TaskTerminalFailures.With(e.taggedMetricsHandler).Record(1)
 		return fmt.Errorf("%w: %w", ErrTerminalTaskFailure, e.terminalFailureCause)
 	}
@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/service/history/queues"
@@ -405,6 +406,7 @@ func (s *executableSuite) TestExecute_SendToDLQAfterMaxAttempts() {
 	s.ErrorIs(err2, queues.ErrTerminalTaskFailure)
 	s.NoError(executable.Execute())
 	s.Len(queueWriter.EnqueueTaskRequests, 1)
+	s.Equal(enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT, queueWriter.EnqueueTaskRequests[0].DLQFailure.GetType())
 }
 
 func (s *executableSuite) TestExecute_DontSendToDLQAfterMaxAttemptsDLQDisabled() {
@@ -556,6 +558,8 @@ func (s *executableSuite) TestExecute_SendsInternalErrorsToDLQ_WhenEnabled() {
 	s.ErrorIs(err, queues.ErrTerminalTaskFailure)
 	s.NoError(executable.Execute())
 	s.Len(queueWriter.EnqueueTaskRequests, 1)
+	s.Equal(enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL, queueWriter.EnqueueTaskRequests[0].DLQFailure.GetType())
+	s.Equal("injected error", queueWriter.EnqueueTaskRequests[0].DLQFailure.GetMessage())
 }
 
 func (s *executableSuite) TestExecute_DoesntSendInternalErrorsToDLQ_WhenDisabled() {
@@ -56,6 +56,8 @@ func GetActiveTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferActiveTaskResetWorkflow
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferActiveTaskDeleteExecution
+	case *tasks.DeleteOffloadedPayloadsTask:
+		return metrics.TaskTypeTransferActiveTaskDeleteOffloadedPayloads
 	default:
 		return "TransferActive" + task.GetType().String()
 	}
@@ -81,6 +83,8 @@ func GetStandbyTransferTaskTypeTagValue(
 		return metrics.TaskTypeTransferStandbyTaskResetWorkflow
 	case *tasks.DeleteExecutionTask:
 		return metrics.TaskTypeTransferStandbyTaskDeleteExecution
+	case *tasks.DeleteOffloadedPayloadsTask:
+		return metrics.TaskTypeTransferStandbyTaskDeleteOffloadedPayloads
 	default:
 		return "TransferStandby" + task.GetType().String()
 	}
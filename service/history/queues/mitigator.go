@@ -25,8 +25,14 @@
 package queues
 
 import (
+	"context"
 	"sync"
+	"time"
 
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/maps"
+
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -39,6 +45,26 @@ type (
 	// Mitigator generates and runs an Action for resolving the given Alert
 	Mitigator interface {
 		Mitigate(Alert)
+		// Mitigations returns the mitigations which moved tasks to the slow lane reader
+		// since the queue was loaded and haven't been reverted.
+		Mitigations() []Mitigation
+		// Revert moves the tasks of a mitigation from the slow lane reader back to
+		// the default reader. It's allowed to revert a mitigation applied before
+		// the queue was loaded.
+		Revert(mitigationID string) error
+	}
+
+	// MitigatedQueue is a Queue whose Mitigator actions can be listed and reverted
+	MitigatedQueue interface {
+		Queue
+		Mitigations() []Mitigation
+		RevertMitigation(ctx context.Context, mitigationID string) error
+	}
+
+	Mitigation struct {
+		ID         string
+		Action     string
+		CreateTime time.Time
 	}
 
 	actionRunner func(Action, *ReaderGroup, metrics.Handler, log.Logger)
@@ -50,8 +76,11 @@ type (
 		monitor        Monitor
 		logger         log.Logger
 		metricsHandler metrics.Handler
+		timeSource     clock.TimeSource
 		maxReaderCount dynamicconfig.IntPropertyFn
 
+		mitigations map[string]Mitigation
+
 		// this is for overriding the behavior in unit tests
 		// since we don't really want to run the action in Mitigator unit tests
 		actionRunner actionRunner
@@ -64,6 +93,7 @@ func newMitigator(
 	monitor Monitor,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	timeSource clock.TimeSource,
 	maxReaderCount dynamicconfig.IntPropertyFn,
 	grouper Grouper,
 ) *mitigatorImpl {
@@ -72,8 +102,11 @@ func newMitigator(
 		monitor:        monitor,
 		logger:         logger,
 		metricsHandler: metricsHandler,
+		timeSource:     timeSource,
 		maxReaderCount: maxReaderCount,
 
+		mitigations: make(map[string]Mitigation),
+
 		actionRunner: runAction,
 		grouper:      grouper,
 	}
@@ -84,6 +117,7 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 	defer m.Unlock()
 
 	var action Action
+	var mitigation *slowLaneMitigation
 	switch alert.AlertType {
 	case AlertTypeQueuePendingTaskCount:
 		action = newQueuePendingTaskAction(
@@ -102,21 +136,78 @@ func (m *mitigatorImpl) Mitigate(alert Alert) {
 			alert.AlertAttributesSliceCount,
 			m.monitor,
 		)
+	case AlertTypeWorkflowPendingTask:
+		mitigation = newWorkflowSlowLaneMitigation(
+			alert.AlertAttributesWorkflowPendingTask.NamespaceID,
+			alert.AlertAttributesWorkflowPendingTask.WorkflowID,
+		)
+		action = newSlowLaneAction("workflow-slow-lane", mitigation, m.maxReaderCount())
+	case AlertTypeNamespacePendingTask:
+		mitigation = newNamespaceSlowLaneMitigation(
+			alert.AlertAttributesNamespacePendingTask.NamespaceID,
+		)
+		action = newSlowLaneAction("namespace-slow-lane", mitigation, m.maxReaderCount())
+	case AlertTypeTaskTypeFailing:
+		mitigation = newTaskTypeSlowLaneMitigation(
+			alert.AlertAttributesTaskTypeFailing.TaskType,
+		)
+		action = newSlowLaneAction("task-type-slow-lane", mitigation, m.maxReaderCount())
 	default:
 		m.logger.Error("Unknown queue alert type", tag.QueueAlert(alert))
 		return
 	}
 
+	logger := log.With(m.logger, tag.QueueAlert(alert))
 	m.actionRunner(
 		action,
 		m.readerGroup,
 		m.metricsHandler,
-		log.With(m.logger, tag.QueueAlert(alert)),
+		logger,
 	)
 
+	if mitigation != nil {
+		if _, ok := m.mitigations[mitigation.id]; !ok {
+			m.mitigations[mitigation.id] = Mitigation{
+				ID:         mitigation.id,
+				Action:     action.Name(),
+				CreateTime: m.timeSource.Now(),
+			}
+		}
+		logger.Info("Queue mitigation applied", tag.NewStringTag("queue-mitigation", mitigation.id))
+	}
+
 	m.monitor.ResolveAlert(alert.AlertType)
 }
 
+func (m *mitigatorImpl) Mitigations() []Mitigation {
+	m.Lock()
+	defer m.Unlock()
+
+	return maps.Values(m.mitigations)
+}
+
+func (m *mitigatorImpl) Revert(mitigationID string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	mitigation, err := parseSlowLaneMitigation(mitigationID)
+	if err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	logger := log.With(m.logger, tag.NewStringTag("queue-mitigation", mitigation.id))
+	m.actionRunner(
+		newRevertSlowLaneAction(mitigation, m.maxReaderCount()),
+		m.readerGroup,
+		m.metricsHandler,
+		logger,
+	)
+
+	delete(m.mitigations, mitigation.id)
+	logger.Info("Queue mitigation reverted")
+	return nil
+}
+
 func runAction(
 	action Action,
 	readerGroup *ReaderGroup,
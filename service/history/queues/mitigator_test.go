@@ -27,13 +27,18 @@ package queues
 import (
 	"testing"
 
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/service/history/tasks"
 )
 
 type (
@@ -72,6 +77,7 @@ func (s *mitigatorSuite) SetupTest() {
 		s.monitor,
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
+		s.mockTimeSource,
 		dynamicconfig.GetIntPropertyFn(3),
 		GrouperNamespaceID{},
 	)
@@ -112,6 +118,40 @@ func (s *mitigatorSuite) TestMitigate_ActionMatchAlert() {
 			},
 			expectedAction: &actionSliceCount{},
 		},
+		{
+			alert: Alert{
+				AlertType: AlertTypeWorkflowPendingTask,
+				AlertAttributesWorkflowPendingTask: &AlertAttributesWorkflowPendingTask{
+					NamespaceID:              uuid.New(),
+					WorkflowID:               uuid.New(),
+					CurrentPendingTaskCount:  1000,
+					CriticalPendingTaskCount: 500,
+				},
+			},
+			expectedAction: &actionSlowLane{},
+		},
+		{
+			alert: Alert{
+				AlertType: AlertTypeNamespacePendingTask,
+				AlertAttributesNamespacePendingTask: &AlertAttributesNamespacePendingTask{
+					NamespaceID:              uuid.New(),
+					CurrentPendingTaskCount:  1000,
+					CriticalPendingTaskCount: 500,
+				},
+			},
+			expectedAction: &actionSlowLane{},
+		},
+		{
+			alert: Alert{
+				AlertType: AlertTypeTaskTypeFailing,
+				AlertAttributesTaskTypeFailing: &AlertAttributesTaskTypeFailing{
+					TaskType:                 enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+					CurrentFailingTaskCount:  1000,
+					CriticalFailingTaskCount: 500,
+				},
+			},
+			expectedAction: &actionSlowLane{},
+		},
 	}
 
 	var actualAction Action
@@ -151,6 +191,69 @@ func (s *mitigatorSuite) TestMitigate_ResolveAlert() {
 	s.Equal(alert.AlertType, s.monitor.resolvedAlertType)
 }
 
+func (s *mitigatorSuite) TestMitigations_Revert() {
+	var actualAction Action
+	s.mitigator.actionRunner = func(
+		action Action,
+		_ *ReaderGroup,
+		_ metrics.Handler,
+		_ log.Logger,
+	) {
+		actualAction = action
+	}
+
+	namespaceID := uuid.New()
+	workflowID := "workflow/" + uuid.New()
+	s.mitigator.Mitigate(Alert{
+		AlertType: AlertTypeWorkflowPendingTask,
+		AlertAttributesWorkflowPendingTask: &AlertAttributesWorkflowPendingTask{
+			NamespaceID:              namespaceID,
+			WorkflowID:               workflowID,
+			CurrentPendingTaskCount:  1000,
+			CriticalPendingTaskCount: 500,
+		},
+	})
+	s.mitigator.Mitigate(Alert{
+		AlertType: AlertTypeTaskTypeFailing,
+		AlertAttributesTaskTypeFailing: &AlertAttributesTaskTypeFailing{
+			TaskType:                 enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			CurrentFailingTaskCount:  1000,
+			CriticalFailingTaskCount: 500,
+		},
+	})
+
+	workflowMitigationID := "workflow:" + namespaceID + "/" + workflowID
+	s.ElementsMatch([]Mitigation{
+		{
+			ID:         workflowMitigationID,
+			Action:     "workflow-slow-lane",
+			CreateTime: s.mockTimeSource.Now(),
+		},
+		{
+			ID:         "task-type:TransferActivityTask",
+			Action:     "task-type-slow-lane",
+			CreateTime: s.mockTimeSource.Now(),
+		},
+	}, s.mitigator.Mitigations())
+
+	s.NoError(s.mitigator.Revert(workflowMitigationID))
+	s.IsType(&actionRevertSlowLane{}, actualAction)
+	s.True(actualAction.(*actionRevertSlowLane).mitigation.predicate.Equals(predicates.And[tasks.Task](
+		tasks.NewNamespacePredicate([]string{namespaceID}),
+		tasks.NewWorkflowIDPredicate([]string{workflowID}),
+	)))
+	s.Len(s.mitigator.Mitigations(), 1)
+
+	// mitigations applied before the queue was loaded can also be reverted
+	s.NoError(s.mitigator.Revert("namespace:" + namespaceID))
+	s.Len(s.mitigator.Mitigations(), 1)
+
+	for _, invalidID := range []string{"", "workflow:" + namespaceID, "namespace:", "task-type:Unknown", "shard:1"} {
+		var invalidArgErr *serviceerror.InvalidArgument
+		s.ErrorAs(s.mitigator.Revert(invalidID), &invalidArgErr)
+	}
+}
+
 func (m *testMonitor) ResolveAlert(alertType AlertType) {
 	m.resolvedAlertType = alertType
 }
@@ -57,6 +57,8 @@ type (
 		GetSliceCount(readerID int64) int
 		SetSliceCount(readerID int64, count int)
 
+		SetPendingTaskStats(stats TaskStats)
+
 		RemoveSlice(slice Slice)
 		RemoveReader(readerID int64)
 
@@ -70,6 +72,11 @@ type (
 		PendingTasksCriticalCount   dynamicconfig.IntPropertyFn
 		ReaderStuckCriticalAttempts dynamicconfig.IntPropertyFn
 		SliceCountCriticalThreshold dynamicconfig.IntPropertyFn
+		// WorkflowPendingTasksCriticalShare and NamespacePendingTasksCriticalShare
+		// are fractions of PendingTasksCriticalCount
+		WorkflowPendingTasksCriticalShare  dynamicconfig.FloatPropertyFn
+		NamespacePendingTasksCriticalShare dynamicconfig.FloatPropertyFn
+		TaskTypeFailingCriticalCount       dynamicconfig.IntPropertyFn
 	}
 
 	monitorImpl struct {
@@ -256,6 +263,56 @@ func (m *monitorImpl) SetSliceCount(readerID int64, count int) {
 	}
 }
 
+// SetPendingTaskStats checks the distribution of pending tasks across workflows,
+// namespaces and task types, for tasks not already moved to the slow lane reader.
+func (m *monitorImpl) SetPendingTaskStats(stats TaskStats) {
+	m.Lock()
+	defer m.Unlock()
+
+	criticalTotalTasks := m.options.PendingTasksCriticalCount()
+
+	var monopolizedNamespaceID string
+	criticalWorkflowTasks := int(float64(criticalTotalTasks) * m.options.WorkflowPendingTasksCriticalShare())
+	if workflowKey, count := maxCount(stats.PendingPerWorkflow); criticalWorkflowTasks > 0 && count > criticalWorkflowTasks {
+		monopolizedNamespaceID = workflowKey.NamespaceID
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeWorkflowPendingTask,
+			AlertAttributesWorkflowPendingTask: &AlertAttributesWorkflowPendingTask{
+				NamespaceID:              workflowKey.NamespaceID,
+				WorkflowID:               workflowKey.WorkflowID,
+				CurrentPendingTaskCount:  count,
+				CriticalPendingTaskCount: criticalWorkflowTasks,
+			},
+		})
+	}
+
+	criticalNamespaceTasks := int(float64(criticalTotalTasks) * m.options.NamespacePendingTasksCriticalShare())
+	if namespaceID, count := maxCount(stats.PendingPerNamespace); criticalNamespaceTasks > 0 && count > criticalNamespaceTasks &&
+		// moving the monopolizing workflow may be enough to bring the namespace back to its share
+		namespaceID != monopolizedNamespaceID {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeNamespacePendingTask,
+			AlertAttributesNamespacePendingTask: &AlertAttributesNamespacePendingTask{
+				NamespaceID:              namespaceID,
+				CurrentPendingTaskCount:  count,
+				CriticalPendingTaskCount: criticalNamespaceTasks,
+			},
+		})
+	}
+
+	criticalFailingTasks := m.options.TaskTypeFailingCriticalCount()
+	if taskType, count := maxCount(stats.FailingPerType); criticalFailingTasks > 0 && count > criticalFailingTasks {
+		m.sendAlertLocked(&Alert{
+			AlertType: AlertTypeTaskTypeFailing,
+			AlertAttributesTaskTypeFailing: &AlertAttributesTaskTypeFailing{
+				TaskType:                 taskType,
+				CurrentFailingTaskCount:  count,
+				CriticalFailingTaskCount: criticalFailingTasks,
+			},
+		})
+	}
+}
+
 func (m *monitorImpl) RemoveSlice(slice Slice) {
 	m.Lock()
 	defer m.Unlock()
@@ -349,3 +406,15 @@ func (m *monitorImpl) isClosed() bool {
 		return false
 	}
 }
+
+func maxCount[K comparable](counts map[K]int) (K, int) {
+	var maxKey K
+	maxCount := 0
+	for key, count := range counts {
+		if count > maxCount {
+			maxKey = key
+			maxCount = count
+		}
+	}
+	return maxKey, maxCount
+}
@@ -29,10 +29,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -62,9 +65,12 @@ func (s *monitorSuite) SetupTest() {
 		tasks.CategoryTypeScheduled,
 		s.mockTimeSource,
 		&MonitorOptions{
-			PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-			ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-			SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+			PendingTasksCriticalCount:          dynamicconfig.GetIntPropertyFn(1000),
+			ReaderStuckCriticalAttempts:        dynamicconfig.GetIntPropertyFn(5),
+			SliceCountCriticalThreshold:        dynamicconfig.GetIntPropertyFn(50),
+			WorkflowPendingTasksCriticalShare:  dynamicconfig.GetFloatPropertyFn(0.2),
+			NamespacePendingTasksCriticalShare: dynamicconfig.GetFloatPropertyFn(0.5),
+			TaskTypeFailingCriticalCount:       dynamicconfig.GetIntPropertyFn(100),
 		},
 	)
 	s.alertCh = s.monitor.AlertCh()
@@ -212,6 +218,64 @@ func (s *monitorSuite) TestSliceCount() {
 	}, *alert)
 }
 
+func (s *monitorSuite) TestPendingTaskStats_Alerts() {
+	namespaceID := uuid.New()
+	otherNamespaceID := uuid.New()
+	workflowKey := definition.NewWorkflowKey(namespaceID, uuid.New(), "")
+
+	// workflow share is 200 tasks, namespace share is 500 tasks
+	s.monitor.SetPendingTaskStats(TaskStats{
+		PendingPerNamespace: map[string]int{namespaceID: 200, otherNamespaceID: 500},
+		PendingPerWorkflow:  map[definition.WorkflowKey]int{workflowKey: 200},
+		FailingPerType:      map[enumsspb.TaskType]int{enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK: 100},
+	})
+	select {
+	case <-s.alertCh:
+		s.Fail("should not trigger alert")
+	default:
+	}
+
+	s.monitor.SetPendingTaskStats(TaskStats{
+		PendingPerNamespace: map[string]int{namespaceID: 600, otherNamespaceID: 501},
+		PendingPerWorkflow:  map[definition.WorkflowKey]int{workflowKey: 300},
+		FailingPerType:      map[enumsspb.TaskType]int{enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK: 101},
+	})
+	s.Equal(Alert{
+		AlertType: AlertTypeWorkflowPendingTask,
+		AlertAttributesWorkflowPendingTask: &AlertAttributesWorkflowPendingTask{
+			NamespaceID:              workflowKey.NamespaceID,
+			WorkflowID:               workflowKey.WorkflowID,
+			CurrentPendingTaskCount:  300,
+			CriticalPendingTaskCount: 200,
+		},
+	}, *<-s.alertCh)
+	s.Equal(Alert{
+		AlertType: AlertTypeTaskTypeFailing,
+		AlertAttributesTaskTypeFailing: &AlertAttributesTaskTypeFailing{
+			TaskType:                 enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			CurrentFailingTaskCount:  101,
+			CriticalFailingTaskCount: 100,
+		},
+	}, *<-s.alertCh)
+	select {
+	case <-s.alertCh:
+		s.Fail("namespace of the monopolizing workflow should not trigger alert")
+	default:
+	}
+
+	s.monitor.SetPendingTaskStats(TaskStats{
+		PendingPerNamespace: map[string]int{namespaceID: 100, otherNamespaceID: 501},
+	})
+	s.Equal(Alert{
+		AlertType: AlertTypeNamespacePendingTask,
+		AlertAttributesNamespacePendingTask: &AlertAttributesNamespacePendingTask{
+			NamespaceID:              otherNamespaceID,
+			CurrentPendingTaskCount:  501,
+			CriticalPendingTaskCount: 500,
+		},
+	}, *<-s.alertCh)
+}
+
 func (s *monitorSuite) TestResolveAlert() {
 	sliceCount := s.monitor.options.SliceCountCriticalThreshold() * 2
 
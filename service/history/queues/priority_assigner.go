@@ -51,6 +51,7 @@ func (a *priorityAssignerImpl) Assign(executable Executable) tasks.Priority {
 	switch taskType {
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT,
 		enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION,
+		enumsspb.TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS,
 		enumsspb.TASK_TYPE_VISIBILITY_DELETE_EXECUTION,
 		enumsspb.TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION,
 		enumsspb.TASK_TYPE_UNSPECIFIED:
@@ -88,6 +88,7 @@ func (s *priorityAssignerSuite) TestAssign_LowPriorityTaskTypes() {
 	for _, taskType := range []enumsspb.TaskType{
 		enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT,
 		enumsspb.TASK_TYPE_TRANSFER_DELETE_EXECUTION,
+		enumsspb.TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS,
 		enumsspb.TASK_TYPE_VISIBILITY_DELETE_EXECUTION,
 		enumsspb.TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION,
 		enumsspb.TASK_TYPE_UNSPECIFIED,
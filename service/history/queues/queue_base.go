@@ -29,10 +29,13 @@ import (
 	"sync"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
@@ -68,6 +71,10 @@ const (
 	forceNewSliceDuration = 5 * time.Minute
 )
 
+var (
+	errQueueShutdown = serviceerror.NewUnavailable("queue is shutting down")
+)
+
 type (
 	queueState struct {
 		readerScopes                 map[int64][]Scope
@@ -105,7 +112,13 @@ type (
 		checkpointRetrier backoff.Retrier
 		checkpointTimer   *time.Timer
 
-		alertCh <-chan *Alert
+		alertCh            <-chan *Alert
+		mitigationRevertCh chan mitigationRevertRequest
+	}
+
+	mitigationRevertRequest struct {
+		mitigationID string
+		errCh        chan error
 	}
 
 	Options struct {
@@ -200,7 +213,7 @@ func newQueueBase(
 		exclusiveDeletionHighWatermark = tasks.MinKey(exclusiveDeletionHighWatermark, scopes[0].Range.InclusiveMin)
 	}
 
-	mitigator := newMitigator(readerGroup, monitor, logger, metricsHandler, options.MaxReaderCount, grouper)
+	mitigator := newMitigator(readerGroup, monitor, logger, metricsHandler, shard.GetTimeSource(), options.MaxReaderCount, grouper)
 
 	return &queueBase{
 		shard: shard,
@@ -237,7 +250,8 @@ func newQueueBase(
 			backoff.SystemClock,
 		),
 
-		alertCh: monitor.AlertCh(),
+		alertCh:            monitor.AlertCh(),
+		mitigationRevertCh: make(chan mitigationRevertRequest),
 	}
 }
 
@@ -268,6 +282,37 @@ func (p *queueBase) FailoverNamespace(
 	p.rescheduler.Reschedule(namespaceID)
 }
 
+func (p *queueBase) Mitigations() []Mitigation {
+	return p.mitigator.Mitigations()
+}
+
+// RevertMitigation reverts a mitigation on the event loop of the queue,
+// so that it's not run concurrently with other actions or checkpoints.
+func (p *queueBase) RevertMitigation(
+	ctx context.Context,
+	mitigationID string,
+) error {
+	request := mitigationRevertRequest{
+		mitigationID: mitigationID,
+		errCh:        make(chan error, 1),
+	}
+
+	select {
+	case p.mitigationRevertCh <- request:
+	case <-p.shutdownCh:
+		return errQueueShutdown
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-request.errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (p *queueBase) processNewRange() {
 	newMaxKey := p.shard.GetQueueExclusiveHighReadWatermark(p.category)
 
@@ -304,6 +349,8 @@ func (p *queueBase) checkpoint() {
 		tasksCompleted += r.ShrinkSlices()
 	})
 
+	p.setPendingTaskStats()
+
 	// Run slicePredicateAction to move slices with non-universal predicate to non-default reader
 	// so that upon shard reload, task loading for those slices won't block other slices in the default reader.
 	runAction(
@@ -439,6 +486,51 @@ func (p *queueBase) handleAlert(alert *Alert) {
 	p.notifyReaders()
 }
 
+func (p *queueBase) handleMitigationRevert(request mitigationRevertRequest) {
+	err := p.mitigator.Revert(request.mitigationID)
+	request.errCh <- err
+	if err != nil {
+		return
+	}
+
+	p.checkpoint()
+	p.notifyReaders()
+}
+
+// setPendingTaskStats reports the pending tasks of all readers but the slow lane reader to the monitor,
+// tasks already moved to the slow lane reader won't trigger another mitigation.
+func (p *queueBase) setPendingTaskStats() {
+	slowLaneReaderID := slowLaneReaderID(p.mitigator.maxReaderCount())
+	if slowLaneReaderID == DefaultReaderId {
+		return
+	}
+
+	stats := TaskStats{
+		PendingPerNamespace: make(map[string]int),
+		PendingPerWorkflow:  make(map[definition.WorkflowKey]int),
+		FailingPerType:      make(map[enumsspb.TaskType]int),
+	}
+	p.readerGroup.ForEach(func(readerID int64, r Reader) {
+		if readerID == slowLaneReaderID {
+			return
+		}
+
+		r.WalkSlices(func(s Slice) {
+			sliceStats := s.TaskStats()
+			for namespaceID, count := range sliceStats.PendingPerNamespace {
+				stats.PendingPerNamespace[namespaceID] += count
+			}
+			for workflowKey, count := range sliceStats.PendingPerWorkflow {
+				stats.PendingPerWorkflow[workflowKey] += count
+			}
+			for taskType, count := range sliceStats.FailingPerType {
+				stats.FailingPerType[taskType] += count
+			}
+		})
+	})
+	p.monitor.SetPendingTaskStats(stats)
+}
+
 func (p *queueBase) notifyReaders() {
 	p.readerGroup.ForEach(func(_ int64, r Reader) {
 		r.Notify()
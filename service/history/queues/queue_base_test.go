@@ -80,9 +80,12 @@ var testQueueOptions = &Options{
 		PollBackoffInterval:  dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond),
 	},
 	MonitorOptions: MonitorOptions{
-		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-		ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-		SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+		PendingTasksCriticalCount:          dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts:        dynamicconfig.GetIntPropertyFn(5),
+		SliceCountCriticalThreshold:        dynamicconfig.GetIntPropertyFn(50),
+		WorkflowPendingTasksCriticalShare:  dynamicconfig.GetFloatPropertyFn(0.5),
+		NamespacePendingTasksCriticalShare: dynamicconfig.GetFloatPropertyFn(0.8),
+		TaskTypeFailingCriticalCount:       dynamicconfig.GetIntPropertyFn(100),
 	},
 	MaxPollRPS:                          dynamicconfig.GetIntPropertyFn(20),
 	MaxPollInterval:                     dynamicconfig.GetDurationPropertyFn(time.Minute * 5),
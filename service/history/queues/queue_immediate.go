@@ -40,7 +40,7 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
-var _ Queue = (*immediateQueue)(nil)
+var _ MitigatedQueue = (*immediateQueue)(nil)
 
 type (
 	immediateQueue struct {
@@ -173,6 +173,8 @@ func (p *immediateQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case request := <-p.mitigationRevertCh:
+			p.handleMitigationRevert(request)
 		}
 	}
 }
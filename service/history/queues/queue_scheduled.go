@@ -43,7 +43,7 @@ import (
 	"go.temporal.io/server/service/history/tasks"
 )
 
-var _ Queue = (*scheduledQueue)(nil)
+var _ MitigatedQueue = (*scheduledQueue)(nil)
 
 type (
 	scheduledQueue struct {
@@ -219,6 +219,8 @@ func (p *scheduledQueue) processEventLoop() {
 			p.checkpoint()
 		case alert := <-p.alertCh:
 			p.handleAlert(alert)
+		case request := <-p.mitigationRevertCh:
+			p.handleMitigationRevert(request)
 		}
 	}
 }
@@ -108,7 +108,9 @@ func (s *scheduledQueueSuite) SetupTest() {
 			StandbyNamespaceWeights: s.mockShard.GetConfig().TimerProcessorSchedulerStandbyRoundRobinWeights,
 		},
 		s.mockShard.GetNamespaceRegistry(),
+		s.mockShard.GetTimeSource(),
 		logger,
+		metrics.NoopMetricsHandler,
 	)
 	scheduler = NewRateLimitedScheduler(
 		scheduler,
@@ -92,9 +92,12 @@ func (s *readerSuite) SetupTest() {
 		)
 	})
 	s.monitor = newMonitor(tasks.CategoryTypeScheduled, clock.NewRealTimeSource(), &MonitorOptions{
-		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
-		ReaderStuckCriticalAttempts: dynamicconfig.GetIntPropertyFn(5),
-		SliceCountCriticalThreshold: dynamicconfig.GetIntPropertyFn(50),
+		PendingTasksCriticalCount:          dynamicconfig.GetIntPropertyFn(1000),
+		ReaderStuckCriticalAttempts:        dynamicconfig.GetIntPropertyFn(5),
+		SliceCountCriticalThreshold:        dynamicconfig.GetIntPropertyFn(50),
+		WorkflowPendingTasksCriticalShare:  dynamicconfig.GetFloatPropertyFn(0.5),
+		NamespacePendingTasksCriticalShare: dynamicconfig.GetFloatPropertyFn(0.8),
+		TaskTypeFailingCriticalCount:       dynamicconfig.GetIntPropertyFn(100),
 	})
 }
 
@@ -27,12 +27,15 @@
 package queues
 
 import (
+	"time"
+
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/configs"
@@ -73,6 +76,12 @@ type (
 		WorkerCount             dynamicconfig.IntPropertyFn
 		ActiveNamespaceWeights  dynamicconfig.MapPropertyFnWithNamespaceFilter
 		StandbyNamespaceWeights dynamicconfig.MapPropertyFnWithNamespaceFilter
+
+		// Optional, if enabled, tasks within the same task channel are dispatched
+		// in the order of their visibility time plus the deadline budget of the task type
+		EnableEarliestDeadlineFirst dynamicconfig.BoolPropertyFn
+		DeadlineBudgets             dynamicconfig.MapPropertyFn
+		DefaultDeadlineBudget       dynamicconfig.DurationPropertyFn
 	}
 
 	RateLimitedSchedulerOptions struct {
@@ -100,7 +109,9 @@ func NewScheduler(
 	currentClusterName string,
 	options SchedulerOptions,
 	namespaceRegistry namespace.Registry,
+	timeSource clock.TimeSource,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) Scheduler {
 	var scheduler tasks.Scheduler[Executable]
 
@@ -140,19 +151,44 @@ func NewScheduler(
 		WorkerCount: options.WorkerCount,
 	}
 
-	scheduler = tasks.NewInterleavedWeightedRoundRobinScheduler(
-		tasks.InterleavedWeightedRoundRobinSchedulerOptions[Executable, TaskChannelKey]{
-			TaskChannelKeyFn:      taskChannelKeyFn,
-			ChannelWeightFn:       channelWeightFn,
-			ChannelWeightUpdateCh: channelWeightUpdateCh,
-		},
-		tasks.Scheduler[Executable](tasks.NewFIFOScheduler[Executable](
-			fifoSchedulerOptions,
-			logger,
-		)),
+	fifoScheduler := tasks.NewFIFOScheduler[Executable](
+		fifoSchedulerOptions,
 		logger,
 	)
 
+	if options.EnableEarliestDeadlineFirst != nil && options.EnableEarliestDeadlineFirst() {
+		taskDeadlineFn := func(e Executable) time.Time {
+			return e.GetVisibilityTime().Add(taskDeadlineBudget(e, options, logger))
+		}
+		taskMetricsTagsFn := func(e Executable) []metrics.Tag {
+			return append(EstimateTaskMetricTag(e, namespaceRegistry, currentClusterName), metrics.TaskPriorityTag(e.GetPriority().String()))
+		}
+
+		scheduler = tasks.NewEarliestDeadlineFirstScheduler(
+			tasks.EarliestDeadlineFirstSchedulerOptions[Executable, TaskChannelKey]{
+				TaskChannelKeyFn:      taskChannelKeyFn,
+				ChannelWeightFn:       channelWeightFn,
+				TaskDeadlineFn:        taskDeadlineFn,
+				ChannelWeightUpdateCh: channelWeightUpdateCh,
+			},
+			tasks.Scheduler[Executable](fifoScheduler),
+			timeSource,
+			taskMetricsTagsFn,
+			logger,
+			metricsHandler,
+		)
+	} else {
+		scheduler = tasks.NewInterleavedWeightedRoundRobinScheduler(
+			tasks.InterleavedWeightedRoundRobinSchedulerOptions[Executable, TaskChannelKey]{
+				TaskChannelKeyFn:      taskChannelKeyFn,
+				ChannelWeightFn:       channelWeightFn,
+				ChannelWeightUpdateCh: channelWeightUpdateCh,
+			},
+			tasks.Scheduler[Executable](fifoScheduler),
+			logger,
+		)
+	}
+
 	return &schedulerImpl{
 		Scheduler:             scheduler,
 		namespaceRegistry:     namespaceRegistry,
@@ -193,6 +229,37 @@ func (s *schedulerImpl) TaskChannelKeyFn() TaskChannelKeyFn {
 	return s.taskChannelKeyFn
 }
 
+func taskDeadlineBudget(
+	e Executable,
+	options SchedulerOptions,
+	logger log.Logger,
+) time.Duration {
+	defaultBudget := options.DefaultDeadlineBudget()
+
+	value, ok := options.DeadlineBudgets()[e.GetType().String()]
+	if !ok {
+		return defaultBudget
+	}
+
+	switch value := value.(type) {
+	case string:
+		budget, err := timestamp.ParseDurationDefaultSeconds(value)
+		if err == nil {
+			return budget
+		}
+	case int:
+		return time.Duration(value) * time.Second
+	case float64:
+		return time.Duration(value * float64(time.Second))
+	}
+
+	logger.Error("Invalid task deadline budget, fallback to default budget",
+		tag.TaskType(e.GetType()),
+		tag.Value(value),
+	)
+	return defaultBudget
+}
+
 // CommonSchedulerWrapper is an adapter that converts a common [task.Scheduler] to a [Scheduler] with an injectable
 // TaskChannelKeyFn.
 type CommonSchedulerWrapper struct {
@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+func TestTaskDeadlineBudget(t *testing.T) {
+	controller := gomock.NewController(t)
+	options := SchedulerOptions{
+		DeadlineBudgets: dynamicconfig.GetMapPropertyFn(map[string]any{
+			enumsspb.TASK_TYPE_USER_TIMER.String():            "2s",
+			enumsspb.TASK_TYPE_WORKFLOW_TASK_TIMEOUT.String(): 3,
+			enumsspb.TASK_TYPE_ACTIVITY_TIMEOUT.String():      "invalid",
+		}),
+		DefaultDeadlineBudget: dynamicconfig.GetDurationPropertyFn(10 * time.Second),
+	}
+
+	testCases := []struct {
+		taskType       enumsspb.TaskType
+		expectedBudget time.Duration
+	}{
+		{taskType: enumsspb.TASK_TYPE_USER_TIMER, expectedBudget: 2 * time.Second},
+		{taskType: enumsspb.TASK_TYPE_WORKFLOW_TASK_TIMEOUT, expectedBudget: 3 * time.Second},
+		{taskType: enumsspb.TASK_TYPE_ACTIVITY_TIMEOUT, expectedBudget: 10 * time.Second},
+		{taskType: enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK, expectedBudget: 10 * time.Second},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.taskType.String(), func(t *testing.T) {
+			mockExecutable := NewMockExecutable(controller)
+			mockExecutable.EXPECT().GetType().Return(tc.taskType).AnyTimes()
+
+			require.Equal(t, tc.expectedBudget, taskDeadlineBudget(mockExecutable, options, log.NewNoopLogger()))
+		})
+	}
+}
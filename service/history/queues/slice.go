@@ -27,6 +27,8 @@ package queues
 import (
 	"fmt"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/service/history/tasks"
 	"golang.org/x/exp/maps"
 )
@@ -58,6 +60,13 @@ type (
 
 	TaskStats struct {
 		PendingPerKey map[any]int
+		// PendingPerNamespace and PendingPerWorkflow count pending tasks by namespace
+		// and by workflow (the RunID of the key is always empty).
+		PendingPerNamespace map[string]int
+		PendingPerWorkflow  map[definition.WorkflowKey]int
+		// FailingPerType counts pending tasks which have been attempted
+		// at least failingTaskMinAttempts times by task type.
+		FailingPerType map[enumsspb.TaskType]int
 	}
 
 	SliceImpl struct {
@@ -416,9 +425,9 @@ func (s *SliceImpl) MoreTasks() bool {
 func (s *SliceImpl) TaskStats() TaskStats {
 	s.stateSanityCheck()
 
-	return TaskStats{
-		PendingPerKey: s.executableTracker.pendingPerKey,
-	}
+	stats := s.executableTracker.taskStats()
+	stats.PendingPerKey = s.executableTracker.pendingPerKey
+	return stats
 }
 
 func (s *SliceImpl) Clear() {
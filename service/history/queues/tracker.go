@@ -27,10 +27,18 @@ package queues
 import (
 	"fmt"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/definition"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/tasks"
 )
 
+const (
+	// failingTaskMinAttempts is the number of attempts after which
+	// a pending task is considered failing in TaskStats
+	failingTaskMinAttempts = 5
+)
+
 type (
 	// TODO: make task tracking a standalone component
 	// currently it's used as a implementation detail in SliceImpl
@@ -129,6 +137,26 @@ func (t *executableTracker) shrink() (tasks.Key, int) {
 	return minPendingTaskKey, tasksCompleted
 }
 
+func (t *executableTracker) taskStats() TaskStats {
+	stats := TaskStats{
+		PendingPerNamespace: make(map[string]int),
+		PendingPerWorkflow:  make(map[definition.WorkflowKey]int),
+		FailingPerType:      make(map[enumsspb.TaskType]int),
+	}
+	for _, executable := range t.pendingExecutables {
+		if executable.State() == ctasks.TaskStateAcked {
+			continue
+		}
+
+		stats.PendingPerNamespace[executable.GetNamespaceID()]++
+		stats.PendingPerWorkflow[definition.NewWorkflowKey(executable.GetNamespaceID(), executable.GetWorkflowID(), "")]++
+		if executable.Attempt() >= failingTaskMinAttempts {
+			stats.FailingPerType[executable.GetType()]++
+		}
+	}
+	return stats
+}
+
 func (t *executableTracker) clear() {
 	for _, executable := range t.pendingExecutables {
 		executable.Cancel()
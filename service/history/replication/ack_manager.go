@@ -29,6 +29,7 @@ package replication
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -317,8 +318,9 @@ func (p *ackMgrImpl) getTasks(
 			skippedTaskCount++
 			continue
 		}
-		// If namespace doesn't exist on polling cluster, there is no reason to send the task.
-		if !ns.IsOnCluster(pollingCluster) {
+		// If namespace doesn't exist on polling cluster, or the replication filters of the namespace exclude the
+		// workflow from the polling cluster, there is no reason to send the task.
+		if !ns.IsOnCluster(pollingCluster) || slices.Contains(tasks.GetReplicationExcludedClusters(task), pollingCluster) {
 			lastTaskID = task.GetTaskID()
 			skippedTaskCount++
 			continue
@@ -471,7 +471,9 @@ func (s *ackManagerSuite) TestGetTasks_FilterNamespace() {
 		NextPageToken:       []byte{22, 3, 83}, // previous token
 	}).Return(tasksResponse2, nil)
 
-	tasksResponse3 := s.getHistoryTasksResponse(1)
+	tasksResponse3 := s.getHistoryTasksResponse(2)
+	// 1 of 2 task is for a workflow that replication filters exclude from poll cluster.
+	tasksResponse3.Tasks[0].(*tasks.HistoryReplicationTask).ExcludedClusters = []string{cluster.TestCurrentClusterName}
 	s.mockExecutionMgr.EXPECT().GetHistoryTasks(gomock.Any(), &persistence.GetHistoryTasksRequest{
 		ShardID:             s.mockShard.GetShardID(),
 		TaskCategory:        tasks.CategoryReplication,
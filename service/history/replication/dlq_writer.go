@@ -148,7 +148,8 @@ func (d *DLQWriterAdapter) WriteTaskToDLQ(
 	if err != nil {
 		return err
 	}
-	return d.dlqWriter.WriteTaskToDLQ(ctx, request.SourceCluster, d.currentClusterName, task)
+	// Replication DLQ tasks aren't retried automatically, so there's no need to record the failure.
+	return d.dlqWriter.WriteTaskToDLQ(ctx, request.SourceCluster, d.currentClusterName, task, nil)
 }
 
 // This is a helper function to make it easier to change the DLQWriteRequest format in the future.
@@ -95,6 +95,7 @@ func NewExecutableActivityStateTask(
 			LastWorkerIdentity: task.LastWorkerIdentity,
 			BaseExecutionInfo:  task.BaseExecutionInfo,
 			VersionHistory:     task.VersionHistory,
+			Paused:             task.Paused,
 		},
 
 		batchable: true,
@@ -110,6 +111,7 @@ func NewExecutableActivityStateTask(
 			LastFailure:        task.LastFailure,
 			LastWorkerIdentity: task.LastWorkerIdentity,
 			VersionHistory:     task.VersionHistory,
+			Paused:             task.Paused,
 		}),
 	}
 }
@@ -28,6 +28,7 @@ package replication
 
 import (
 	"context"
+	"slices"
 
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
@@ -112,6 +113,11 @@ func (c *SourceTaskConverterImpl) Convert(
 		return nil, nil
 	}
 
+	// the replication filters of the namespace exclude the workflow from the client cluster
+	if slices.Contains(tasks.GetReplicationExcludedClusters(task), c.clientClusterName) {
+		return nil, nil
+	}
+
 	clientShardID := common.WorkflowIDToHistoryShard(task.GetNamespaceID(), task.GetWorkflowID(), c.clientClusterShardCount)
 	if clientShardID != c.clientShardKey.ShardID {
 		return nil, nil
@@ -188,6 +194,7 @@ func convertActivityStateReplicationTask(
 						LastWorkerIdentity: activityInfo.RetryLastWorkerIdentity,
 						BaseExecutionInfo:  persistence.CopyBaseWorkflowInfo(mutableState.GetBaseWorkflowInfo()),
 						VersionHistory:     versionhistory.CopyVersionHistory(currentVersionHistory),
+						Paused:             activityInfo.Paused,
 					},
 				},
 				VisibilityTime: timestamppb.New(taskInfo.VisibilityTimestamp),
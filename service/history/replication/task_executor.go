@@ -159,6 +159,7 @@ func (e *taskExecutorImpl) handleActivityTask(
 		LastFailure:        attr.LastFailure,
 		LastWorkerIdentity: attr.LastWorkerIdentity,
 		VersionHistory:     attr.GetVersionHistory(),
+		Paused:             attr.Paused,
 	}
 	ctx, cancel := e.newTaskContext(ctx, attr.NamespaceId)
 	defer cancel()
@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/searchattribute"
@@ -76,6 +77,8 @@ type (
 		GetSearchAttributesProvider() searchattribute.Provider
 		GetSearchAttributesMapperProvider() searchattribute.MapperProvider
 		GetArchivalMetadata() archiver.ArchivalMetadata
+		// GetPayloadOffloader returns the offloader moving oversized payloads to the payload store.
+		GetPayloadOffloader() *payloadstore.Offloader
 
 		GetEngine(ctx context.Context) (Engine, error)
 
@@ -94,6 +97,9 @@ type (
 		GetReplicatorDLQAckLevel(sourceCluster string) int64
 		UpdateReplicatorDLQAckLevel(sourCluster string, ackLevel int64) error
 
+		GetConcurrencyLimitReservations(key string) map[string]*persistencespb.ConcurrencyLimitReservation
+		UpdateConcurrencyLimitReservations(key string, updateFn func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool) error
+
 		UpdateRemoteClusterInfo(cluster string, ackTaskID int64, ackTimestamp time.Time)
 		UpdateRemoteReaderInfo(readerID int64, ackTaskID int64, ackTimestamp time.Time) error
 
@@ -131,5 +137,7 @@ type (
 
 		IsValid() bool
 		FinishStop()
+
+		loadCounters() shardLoadCounters
 	}
 )
@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/resource"
@@ -71,6 +72,7 @@ type (
 		MetricsHandler              metrics.Handler
 		NamespaceRegistry           namespace.Registry
 		PayloadSerializer           serialization.Serializer
+		PayloadOffloader            *payloadstore.Offloader
 		PersistenceExecutionManager persistence.ExecutionManager
 		PersistenceShardManager     persistence.ShardManager
 		SaMapperProvider            searchattribute.MapperProvider
@@ -118,6 +120,7 @@ func (c *contextFactoryImpl) CreateContext(
 		c.SaMapperProvider,
 		c.ClusterMetadata,
 		c.ArchivalMetadata,
+		c.PayloadOffloader,
 		c.HostInfoProvider,
 		c.TaskCategoryRegistry,
 		c.EventsCache,
@@ -64,6 +64,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -129,6 +130,7 @@ type (
 		saMapperProvider        searchattribute.MapperProvider
 		clusterMetadata         cluster.Metadata
 		archivalMetadata        archiver.ArchivalMetadata
+		payloadOffloader        *payloadstore.Offloader
 		hostInfoProvider        membership.HostInfoProvider
 		taskCategoryRegistry    tasks.TaskCategoryRegistry
 
@@ -149,11 +151,19 @@ type (
 		state      contextState
 		stopReason stopReason
 
+		// shardInfoWriteLock serializes the writes of shardInfo, so that a snapshot of it never overwrites a more recent
+		// one. It must be acquired before rwLock and ioSemaphore.
+		shardInfoWriteLock sync.Mutex
+		// number of updates of the concurrency limit reservations persisted, protected by shardInfoWriteLock
+		persistedConcurrencyLimitReservationsVersion int64
+
 		// All following fields are protected by rwLock, and only valid if state >= Acquiring:
 		rwLock                        sync.RWMutex
 		lastUpdated                   time.Time
 		tasksCompletedSinceLastUpdate int
 		shardInfo                     *persistencespb.ShardInfo
+		// number of updates of the concurrency limit reservations in shardInfo
+		concurrencyLimitReservationsVersion int64
 
 		// All methods of the taskKeyManager, except the completionFn returned by
 		// setAndTrackTaskKeys, must be invoked within rwLock.
@@ -167,6 +177,10 @@ type (
 		handoverNamespaces      map[namespace.Name]*namespaceHandOverInfo // keyed on namespace name
 		acquireShardRetryPolicy backoff.RetryPolicy
 
+		// counters of the load of the shard, used by the load-aware shard placement
+		requestCount          atomic.Int64
+		mutableStateLoadCount atomic.Int64
+
 		stateMachineRegistry *hsm.Registry
 	}
 
@@ -277,6 +291,7 @@ func (s *ContextImpl) GetPingChecks() []common.PingCheck {
 func (s *ContextImpl) GetEngine(
 	ctx context.Context,
 ) (Engine, error) {
+	s.requestCount.Add(1)
 	return s.engineFuture.Get(ctx)
 }
 
@@ -846,6 +861,7 @@ func (s *ContextImpl) GetWorkflowExecution(
 		return nil, err
 	}
 
+	s.mutableStateLoadCount.Add(1)
 	resp, err := s.executionManager.GetWorkflowExecution(ctx, request)
 	if err = s.handleReadError(err); err != nil {
 		// also return resp, for RebuildMutableState API
@@ -1253,13 +1269,39 @@ func (s *ContextImpl) updateShardInfo(
 
 	s.lastUpdated = now
 	s.tasksCompletedSinceLastUpdate = 0
+	s.wUnlock()
 
-	updatedShardInfo := trimShardInfo(s.clusterMetadata.GetAllClusterInfo(), copyShardInfo(s.shardInfo))
+	if err := s.writeShardInfo(); err != nil {
+		s.wLock()
+		defer s.wUnlock()
+		// revert lastUpdated time so that operation can be retried
+		s.lastUpdated = util.MaxTime(previousLastUpdate, s.lastUpdated)
+		return err
+	}
+
+	return nil
+}
+
+func (s *ContextImpl) writeShardInfo() error {
+	s.shardInfoWriteLock.Lock()
+	defer s.shardInfoWriteLock.Unlock()
+
+	return s.writeShardInfoLocked()
+}
+
+// writeShardInfoLocked persists a snapshot of the shard info, it must be called with shardInfoWriteLock held.
+func (s *ContextImpl) writeShardInfoLocked() error {
+	s.rLock()
+	if err := s.errorByState(); err != nil {
+		s.rUnlock()
+		return err
+	}
 	request := &persistence.UpdateShardRequest{
-		ShardInfo:       updatedShardInfo,
+		ShardInfo:       trimShardInfo(s.clusterMetadata.GetAllClusterInfo(), copyShardInfo(s.shardInfo)),
 		PreviousRangeID: s.shardInfo.GetRangeId(),
 	}
-	s.wUnlock()
+	concurrencyLimitReservationsVersion := s.concurrencyLimitReservationsVersion
+	s.rUnlock()
 
 	if err := s.ioSemaphoreAcquire(s.lifecycleCtx); err != nil {
 		return err
@@ -1269,18 +1311,72 @@ func (s *ContextImpl) updateShardInfo(
 	ctx, cancel := s.newIOContext()
 	defer cancel()
 
-	err := s.persistenceShardManager.UpdateShard(ctx, request)
-	if err != nil {
-		s.wLock()
-		defer s.wUnlock()
-		// revert lastUpdated time so that operation can be retried
-		s.lastUpdated = util.MaxTime(previousLastUpdate, s.lastUpdated)
-		return s.handleWriteErrorLocked(request.PreviousRangeID, err)
+	if err := s.persistenceShardManager.UpdateShard(ctx, request); err != nil {
+		return s.handleWriteError(request.PreviousRangeID, err)
 	}
-
+	s.persistedConcurrencyLimitReservationsVersion = concurrencyLimitReservationsVersion
 	return nil
 }
 
+// GetConcurrencyLimitReservations returns a copy of the reservations of a concurrency limit owned by the shard, keyed by
+// workflow ID.
+func (s *ContextImpl) GetConcurrencyLimitReservations(
+	key string,
+) map[string]*persistencespb.ConcurrencyLimitReservation {
+	s.rLock()
+	defer s.rUnlock()
+
+	reservations := s.shardInfo.GetConcurrencyLimitReservations()[key].GetReservations()
+	result := make(map[string]*persistencespb.ConcurrencyLimitReservation, len(reservations))
+	for workflowID, reservation := range reservations {
+		result[workflowID] = common.CloneProto(reservation)
+	}
+	return result
+}
+
+// UpdateConcurrencyLimitReservations applies updateFn, which returns whether it changed them, to the reservations of a
+// concurrency limit owned by the shard. It returns once the reservations are persisted, along with the updates made
+// concurrently and the ones which failed to be persisted earlier. Unlike the other updates of the shard info, which
+// can be lost with the shard, they are persisted right away since callers rely on them.
+func (s *ContextImpl) UpdateConcurrencyLimitReservations(
+	key string,
+	updateFn func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool,
+) error {
+	s.wLock()
+	if err := s.errorByState(); err != nil {
+		s.wUnlock()
+		return err
+	}
+	if s.shardInfo.ConcurrencyLimitReservations == nil {
+		s.shardInfo.ConcurrencyLimitReservations = make(map[string]*persistencespb.ConcurrencyLimitReservations)
+	}
+	reservations, ok := s.shardInfo.ConcurrencyLimitReservations[key]
+	if !ok {
+		reservations = &persistencespb.ConcurrencyLimitReservations{}
+	}
+	if reservations.Reservations == nil {
+		reservations.Reservations = make(map[string]*persistencespb.ConcurrencyLimitReservation)
+	}
+	if updateFn(reservations.Reservations) {
+		s.concurrencyLimitReservationsVersion++
+	}
+	if len(reservations.Reservations) == 0 {
+		delete(s.shardInfo.ConcurrencyLimitReservations, key)
+	} else {
+		s.shardInfo.ConcurrencyLimitReservations[key] = reservations
+	}
+	version := s.concurrencyLimitReservationsVersion
+	s.wUnlock()
+
+	s.shardInfoWriteLock.Lock()
+	defer s.shardInfoWriteLock.Unlock()
+
+	if s.persistedConcurrencyLimitReservationsVersion >= version {
+		return nil
+	}
+	return s.writeShardInfoLocked()
+}
+
 // Take the shard lock and update queue metrics
 func (s *ContextImpl) emitShardInfoMetricsLogs() {
 	s.rLock()
@@ -1335,6 +1431,34 @@ Loop:
 	}
 }
 
+// loadCounters returns the number of requests and mutable state loads since the shard context was created, and the
+// approximate number of outstanding tasks in the immediate queues of the shard.
+func (s *ContextImpl) loadCounters() shardLoadCounters {
+	counters := shardLoadCounters{
+		requests:          s.requestCount.Load(),
+		mutableStateLoads: s.mutableStateLoadCount.Load(),
+	}
+
+	s.rLock()
+	defer s.rUnlock()
+
+	if s.shardInfo == nil {
+		return counters
+	}
+	for categoryID, queueState := range s.shardInfo.QueueStates {
+		category, ok := s.taskCategoryRegistry.GetCategoryByID(int(categoryID))
+		if !ok || category.Type() != tasks.CategoryTypeImmediate {
+			continue
+		}
+		minTaskKey := getMinTaskKey(queueState)
+		if minTaskKey == nil {
+			continue
+		}
+		counters.taskBacklog += max(s.taskKeyManager.getExclusiveReaderHighWatermark(category).TaskID-minTaskKey.TaskID, 0)
+	}
+	return counters
+}
+
 func (s *ContextImpl) SetCurrentTime(cluster string, currentTime time.Time) {
 	s.wLock()
 	defer s.wUnlock()
@@ -2050,6 +2174,7 @@ func newContext(
 	saMapperProvider searchattribute.MapperProvider,
 	clusterMetadata cluster.Metadata,
 	archivalMetadata archiver.ArchivalMetadata,
+	payloadOffloader *payloadstore.Offloader,
 	hostInfoProvider membership.HostInfoProvider,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	eventsCache events.Cache,
@@ -2091,6 +2216,7 @@ func newContext(
 		saMapperProvider:        saMapperProvider,
 		clusterMetadata:         clusterMetadata,
 		archivalMetadata:        archivalMetadata,
+		payloadOffloader:        payloadOffloader,
 		hostInfoProvider:        hostInfoProvider,
 		taskCategoryRegistry:    taskCategoryRegistry,
 		handoverNamespaces:      make(map[namespace.Name]*namespaceHandOverInfo),
@@ -2134,14 +2260,20 @@ func copyShardInfo(shardInfo *persistencespb.ShardInfo) *persistencespb.ShardInf
 		queueStates[k] = queueState
 	}
 
+	concurrencyLimitReservations := make(map[string]*persistencespb.ConcurrencyLimitReservations, len(shardInfo.ConcurrencyLimitReservations))
+	for k, v := range shardInfo.ConcurrencyLimitReservations {
+		concurrencyLimitReservations[k] = common.CloneProto(v)
+	}
+
 	return &persistencespb.ShardInfo{
-		ShardId:                shardInfo.ShardId,
-		Owner:                  shardInfo.Owner,
-		RangeId:                shardInfo.RangeId,
-		StolenSinceRenew:       shardInfo.StolenSinceRenew,
-		ReplicationDlqAckLevel: maps.Clone(shardInfo.ReplicationDlqAckLevel),
-		UpdateTime:             shardInfo.UpdateTime,
-		QueueStates:            queueStates,
+		ShardId:                      shardInfo.ShardId,
+		Owner:                        shardInfo.Owner,
+		RangeId:                      shardInfo.RangeId,
+		StolenSinceRenew:             shardInfo.StolenSinceRenew,
+		ReplicationDlqAckLevel:       maps.Clone(shardInfo.ReplicationDlqAckLevel),
+		UpdateTime:                   shardInfo.UpdateTime,
+		QueueStates:                  queueStates,
+		ConcurrencyLimitReservations: concurrencyLimitReservations,
 	}
 }
 
@@ -2185,6 +2317,10 @@ func (s *ContextImpl) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return s.archivalMetadata
 }
 
+func (s *ContextImpl) GetPayloadOffloader() *payloadstore.Offloader {
+	return s.payloadOffloader
+}
+
 func (s *ContextImpl) StateMachineRegistry() *hsm.Registry {
 	return s.stateMachineRegistry
 }
@@ -47,6 +47,7 @@ import (
 	log "go.temporal.io/server/common/log"
 	metrics "go.temporal.io/server/common/metrics"
 	namespace "go.temporal.io/server/common/namespace"
+	payloadstore "go.temporal.io/server/common/payloadstore"
 	persistence "go.temporal.io/server/common/persistence"
 	serialization "go.temporal.io/server/common/persistence/serialization"
 	searchattribute "go.temporal.io/server/common/searchattribute"
@@ -238,6 +239,20 @@ func (mr *MockContextMockRecorder) GetArchivalMetadata() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivalMetadata", reflect.TypeOf((*MockContext)(nil).GetArchivalMetadata))
 }
 
+// GetPayloadOffloader mocks base method.
+func (m *MockContext) GetPayloadOffloader() *payloadstore.Offloader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayloadOffloader")
+	ret0, _ := ret[0].(*payloadstore.Offloader)
+	return ret0
+}
+
+// GetPayloadOffloader indicates an expected call of GetPayloadOffloader.
+func (mr *MockContextMockRecorder) GetPayloadOffloader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadOffloader", reflect.TypeOf((*MockContext)(nil).GetPayloadOffloader))
+}
+
 // GetClusterMetadata mocks base method.
 func (m *MockContext) GetClusterMetadata() cluster.Metadata {
 	m.ctrl.T.Helper()
@@ -252,6 +267,20 @@ func (mr *MockContextMockRecorder) GetClusterMetadata() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterMetadata", reflect.TypeOf((*MockContext)(nil).GetClusterMetadata))
 }
 
+// GetConcurrencyLimitReservations mocks base method.
+func (m *MockContext) GetConcurrencyLimitReservations(key string) map[string]*v13.ConcurrencyLimitReservation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConcurrencyLimitReservations", key)
+	ret0, _ := ret[0].(map[string]*v13.ConcurrencyLimitReservation)
+	return ret0
+}
+
+// GetConcurrencyLimitReservations indicates an expected call of GetConcurrencyLimitReservations.
+func (mr *MockContextMockRecorder) GetConcurrencyLimitReservations(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConcurrencyLimitReservations", reflect.TypeOf((*MockContext)(nil).GetConcurrencyLimitReservations), key)
+}
+
 // GetConfig mocks base method.
 func (m *MockContext) GetConfig() *configs.Config {
 	m.ctrl.T.Helper()
@@ -677,6 +706,20 @@ func (mr *MockContextMockRecorder) UnloadForOwnershipLost() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadForOwnershipLost", reflect.TypeOf((*MockContext)(nil).UnloadForOwnershipLost))
 }
 
+// UpdateConcurrencyLimitReservations mocks base method.
+func (m *MockContext) UpdateConcurrencyLimitReservations(key string, updateFn func(map[string]*v13.ConcurrencyLimitReservation) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConcurrencyLimitReservations", key, updateFn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConcurrencyLimitReservations indicates an expected call of UpdateConcurrencyLimitReservations.
+func (mr *MockContextMockRecorder) UpdateConcurrencyLimitReservations(key, updateFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConcurrencyLimitReservations", reflect.TypeOf((*MockContext)(nil).UpdateConcurrencyLimitReservations), key, updateFn)
+}
+
 // UpdateHandoverNamespace mocks base method.
 func (m *MockContext) UpdateHandoverNamespace(ns *namespace.Namespace, deletedFromDb bool) {
 	m.ctrl.T.Helper()
@@ -952,6 +995,20 @@ func (mr *MockControllableContextMockRecorder) GetArchivalMetadata() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivalMetadata", reflect.TypeOf((*MockControllableContext)(nil).GetArchivalMetadata))
 }
 
+// GetPayloadOffloader mocks base method.
+func (m *MockControllableContext) GetPayloadOffloader() *payloadstore.Offloader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayloadOffloader")
+	ret0, _ := ret[0].(*payloadstore.Offloader)
+	return ret0
+}
+
+// GetPayloadOffloader indicates an expected call of GetPayloadOffloader.
+func (mr *MockControllableContextMockRecorder) GetPayloadOffloader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayloadOffloader", reflect.TypeOf((*MockControllableContext)(nil).GetPayloadOffloader))
+}
+
 // GetClusterMetadata mocks base method.
 func (m *MockControllableContext) GetClusterMetadata() cluster.Metadata {
 	m.ctrl.T.Helper()
@@ -966,6 +1023,20 @@ func (mr *MockControllableContextMockRecorder) GetClusterMetadata() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterMetadata", reflect.TypeOf((*MockControllableContext)(nil).GetClusterMetadata))
 }
 
+// GetConcurrencyLimitReservations mocks base method.
+func (m *MockControllableContext) GetConcurrencyLimitReservations(key string) map[string]*v13.ConcurrencyLimitReservation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConcurrencyLimitReservations", key)
+	ret0, _ := ret[0].(map[string]*v13.ConcurrencyLimitReservation)
+	return ret0
+}
+
+// GetConcurrencyLimitReservations indicates an expected call of GetConcurrencyLimitReservations.
+func (mr *MockControllableContextMockRecorder) GetConcurrencyLimitReservations(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConcurrencyLimitReservations", reflect.TypeOf((*MockControllableContext)(nil).GetConcurrencyLimitReservations), key)
+}
+
 // GetConfig mocks base method.
 func (m *MockControllableContext) GetConfig() *configs.Config {
 	m.ctrl.T.Helper()
@@ -1337,6 +1408,20 @@ func (mr *MockControllableContextMockRecorder) IsValid() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsValid", reflect.TypeOf((*MockControllableContext)(nil).IsValid))
 }
 
+// loadCounters mocks base method.
+func (m *MockControllableContext) loadCounters() shardLoadCounters {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "loadCounters")
+	ret0, _ := ret[0].(shardLoadCounters)
+	return ret0
+}
+
+// loadCounters indicates an expected call of loadCounters.
+func (mr *MockControllableContextMockRecorder) loadCounters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "loadCounters", reflect.TypeOf((*MockControllableContext)(nil).loadCounters))
+}
+
 // NewVectorClock mocks base method.
 func (m *MockControllableContext) NewVectorClock() (*v11.VectorClock, error) {
 	m.ctrl.T.Helper()
@@ -1419,6 +1504,20 @@ func (mr *MockControllableContextMockRecorder) UnloadForOwnershipLost() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnloadForOwnershipLost", reflect.TypeOf((*MockControllableContext)(nil).UnloadForOwnershipLost))
 }
 
+// UpdateConcurrencyLimitReservations mocks base method.
+func (m *MockControllableContext) UpdateConcurrencyLimitReservations(key string, updateFn func(map[string]*v13.ConcurrencyLimitReservation) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConcurrencyLimitReservations", key, updateFn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConcurrencyLimitReservations indicates an expected call of UpdateConcurrencyLimitReservations.
+func (mr *MockControllableContextMockRecorder) UpdateConcurrencyLimitReservations(key, updateFn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConcurrencyLimitReservations", reflect.TypeOf((*MockControllableContext)(nil).UpdateConcurrencyLimitReservations), key, updateFn)
+}
+
 // UpdateHandoverNamespace mocks base method.
 func (m *MockControllableContext) UpdateHandoverNamespace(ns *namespace.Namespace, deletedFromDb bool) {
 	m.ctrl.T.Helper()
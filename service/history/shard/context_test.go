@@ -710,6 +710,46 @@ func (s *contextSuite) TestShardStopReasonCloseShard() {
 	s.False(s.mockShard.stoppedForOwnershipLost())
 }
 
+func (s *contextSuite) TestUpdateConcurrencyLimitReservations() {
+	s.mockShard.state = contextStateAcquired
+
+	var persisted *persistencespb.ShardInfo
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			persisted = request.ShardInfo
+			return nil
+		},
+	).Times(1)
+	err := s.mockShard.UpdateConcurrencyLimitReservations("namespace", func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool {
+		reservations["workflow-id"] = &persistencespb.ConcurrencyLimitReservation{RunId: "run-id"}
+		return true
+	})
+	s.NoError(err)
+	s.Equal("run-id", persisted.GetConcurrencyLimitReservations()["namespace"].GetReservations()["workflow-id"].GetRunId())
+	s.Equal("run-id", s.mockShard.GetConcurrencyLimitReservations("namespace")["workflow-id"].GetRunId())
+
+	// Unchanged reservations are not persisted again
+	err = s.mockShard.UpdateConcurrencyLimitReservations("namespace", func(map[string]*persistencespb.ConcurrencyLimitReservation) bool {
+		return false
+	})
+	s.NoError(err)
+
+	// Empty reservations are removed
+	s.mockShardManager.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			persisted = request.ShardInfo
+			return nil
+		},
+	).Times(1)
+	err = s.mockShard.UpdateConcurrencyLimitReservations("namespace", func(reservations map[string]*persistencespb.ConcurrencyLimitReservation) bool {
+		delete(reservations, "workflow-id")
+		return true
+	})
+	s.NoError(err)
+	s.Empty(persisted.GetConcurrencyLimitReservations())
+	s.Empty(s.mockShard.GetConcurrencyLimitReservations("namespace"))
+}
+
 func (s *contextSuite) TestUpdateShardInfo_CallbackIsInvoked_EvenWhenNotPersisted() {
 	s.mockShard.state = contextStateAcquired
 
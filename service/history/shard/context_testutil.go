@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resourcetest"
@@ -207,6 +208,11 @@ func (s *ContextTest) SetHistoryClientForTesting(client historyservice.HistorySe
 	s.historyClient = client
 }
 
+// SetPayloadOffloaderForTesting sets the payload offloader. Only used by tests.
+func (s *ContextTest) SetPayloadOffloaderForTesting(offloader *payloadstore.Offloader) {
+	s.payloadOffloader = offloader
+}
+
 // SetStateMachineRegistry sets the state machine registry on this shard.
 func (s *ContextTest) SetStateMachineRegistry(reg *hsm.Registry) {
 	s.stateMachineRegistry = reg
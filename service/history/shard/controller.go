@@ -25,6 +25,7 @@
 package shard
 
 import (
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/namespace"
 )
@@ -39,6 +40,7 @@ type (
 		GetShardByNamespaceWorkflow(namespaceID namespace.ID, workflowID string) (Context, error)
 		CloseShardByID(shardID int32)
 		ShardIDs() []int32
+		ShardLoads() []*adminservice.ShardLoad
 		Start()
 		Stop()
 	}
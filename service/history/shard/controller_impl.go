@@ -36,7 +36,9 @@ import (
 	"golang.org/x/sync/semaphore"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -44,6 +46,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/resource"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 )
@@ -71,6 +74,7 @@ type (
 		contextFactory       ContextFactory
 		contextTaggedLogger  log.Logger
 		hostInfoProvider     membership.HostInfoProvider
+		loadTracker          *shardLoadTracker
 		ownership            *ownership
 		status               int32
 		taggedMetricsHandler metrics.Handler
@@ -94,17 +98,30 @@ func ControllerProvider(
 	metricsHandler metrics.Handler,
 	hostInfoProvider membership.HostInfoProvider,
 	contextFactory ContextFactory,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	historyClient resource.HistoryClient,
+	timeSource clock.TimeSource,
 ) *ControllerImpl {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	contextTaggedLogger := log.With(logger, tag.ComponentShardController, tag.Address(hostIdentity))
 	taggedMetricsHandler := metricsHandler.WithTags(metrics.OperationTag(metrics.HistoryShardControllerScope))
 
+	placements := newShardPlacements(
+		clusterMetadataManager,
+		config,
+		historyClient,
+		historyServiceResolver,
+		hostInfoProvider,
+		contextTaggedLogger,
+		timeSource,
+	)
 	ownership := newOwnership(
 		config,
 		historyServiceResolver,
 		hostInfoProvider,
 		contextTaggedLogger,
 		taggedMetricsHandler,
+		placements,
 	)
 
 	c := &ControllerImpl{
@@ -113,6 +130,7 @@ func ControllerProvider(
 		contextTaggedLogger:     contextTaggedLogger,
 		historyShards:           make(map[int32]ControllableContext),
 		hostInfoProvider:        hostInfoProvider,
+		loadTracker:             newShardLoadTracker(config, timeSource),
 		ownership:               ownership,
 		taggedMetricsHandler:    taggedMetricsHandler,
 		shardCountSubscriptions: map[*shardCountSubscription]struct{}{},
@@ -223,6 +241,23 @@ func (c *ControllerImpl) ShardIDs() []int32 {
 	return ids
 }
 
+// ShardLoads returns the load of the shards owned by this controller, as of the last sample. Shards acquired since
+// then are not included.
+func (c *ControllerImpl) ShardLoads() []*adminservice.ShardLoad {
+	return c.loadTracker.shardLoads()
+}
+
+func (c *ControllerImpl) sampleShardLoads() {
+	c.RLock()
+	shards := make([]ControllableContext, 0, len(c.historyShards))
+	for _, shard := range c.historyShards {
+		shards = append(shards, shard)
+	}
+	c.RUnlock()
+
+	c.loadTracker.sample(shards)
+}
+
 func (c *ControllerImpl) shardRemoveAndStop(shard ControllableContext) {
 	startTime := time.Now().UTC()
 	defer func() {
@@ -32,6 +32,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	adminservice "go.temporal.io/server/api/adminservice/v1"
 	common "go.temporal.io/server/common"
 	namespace "go.temporal.io/server/common/namespace"
 )
@@ -129,6 +130,20 @@ func (mr *MockControllerMockRecorder) ShardIDs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardIDs", reflect.TypeOf((*MockController)(nil).ShardIDs))
 }
 
+// ShardLoads mocks base method.
+func (m *MockController) ShardLoads() []*adminservice.ShardLoad {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardLoads")
+	ret0, _ := ret[0].([]*adminservice.ShardLoad)
+	return ret0
+}
+
+// ShardLoads indicates an expected call of ShardLoads.
+func (mr *MockControllerMockRecorder) ShardLoads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardLoads", reflect.TypeOf((*MockController)(nil).ShardLoads))
+}
+
 // Start mocks base method.
 func (m *MockController) Start() {
 	m.ctrl.T.Helper()
@@ -121,6 +121,9 @@ func NewTestController(
 		metricsTestHandler,
 		resource.GetHostInfoProvider(),
 		contextFactory,
+		resource.GetClusterMetadataManager(),
+		resource.GetHistoryClient(),
+		resource.GetTimeSource(),
 	)
 }
 
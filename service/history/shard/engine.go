@@ -111,6 +111,14 @@ type (
 		GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *historyservice.GetWorkflowExecutionRawHistoryV2Request) (*historyservice.GetWorkflowExecutionRawHistoryV2Response, error)
 		AddTasks(ctx context.Context, request *historyservice.AddTasksRequest) (*historyservice.AddTasksResponse, error)
 		ListTasks(ctx context.Context, request *historyservice.ListTasksRequest) (*historyservice.ListTasksResponse, error)
+		ListQueueMitigations(ctx context.Context, request *historyservice.ListQueueMitigationsRequest) (*historyservice.ListQueueMitigationsResponse, error)
+		RevertQueueMitigation(ctx context.Context, request *historyservice.RevertQueueMitigationRequest) (*historyservice.RevertQueueMitigationResponse, error)
+		PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error)
+		UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error)
+		SuspendWorkflowExecution(ctx context.Context, request *historyservice.SuspendWorkflowExecutionRequest) (*historyservice.SuspendWorkflowExecutionResponse, error)
+		ResumeWorkflowExecution(ctx context.Context, request *historyservice.ResumeWorkflowExecutionRequest) (*historyservice.ResumeWorkflowExecutionResponse, error)
+		ReserveConcurrencyLimit(ctx context.Context, request *historyservice.ReserveConcurrencyLimitRequest) (*historyservice.ReserveConcurrencyLimitResponse, error)
+		ReleaseConcurrencyLimit(ctx context.Context, request *historyservice.ReleaseConcurrencyLimitRequest) (*historyservice.ReleaseConcurrencyLimitResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTasks(tasks map[tasks.Category][]tasks.Task)
@@ -382,6 +382,21 @@ func (mr *MockEngineMockRecorder) IsWorkflowTaskValid(ctx, request interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTaskValid", reflect.TypeOf((*MockEngine)(nil).IsWorkflowTaskValid), ctx, request)
 }
 
+// ListQueueMitigations mocks base method.
+func (m *MockEngine) ListQueueMitigations(ctx context.Context, request *historyservice.ListQueueMitigationsRequest) (*historyservice.ListQueueMitigationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueueMitigations", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ListQueueMitigationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueueMitigations indicates an expected call of ListQueueMitigations.
+func (mr *MockEngineMockRecorder) ListQueueMitigations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueueMitigations", reflect.TypeOf((*MockEngine)(nil).ListQueueMitigations), ctx, request)
+}
+
 // ListTasks mocks base method.
 func (m *MockEngine) ListTasks(ctx context.Context, request *historyservice.ListTasksRequest) (*historyservice.ListTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -436,6 +451,21 @@ func (mr *MockEngineMockRecorder) NotifyNewTasks(tasks interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyNewTasks", reflect.TypeOf((*MockEngine)(nil).NotifyNewTasks), tasks)
 }
 
+// PauseActivity mocks base method.
+func (m *MockEngine) PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ctx, request)
+	ret0, _ := ret[0].(*historyservice.PauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockEngineMockRecorder) PauseActivity(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockEngine)(nil).PauseActivity), ctx, request)
+}
+
 // PollMutableState mocks base method.
 func (m *MockEngine) PollMutableState(ctx context.Context, request *historyservice.PollMutableStateRequest) (*historyservice.PollMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -598,6 +628,21 @@ func (mr *MockEngineMockRecorder) RefreshWorkflowTasks(ctx, namespaceUUID, execu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockEngine)(nil).RefreshWorkflowTasks), ctx, namespaceUUID, execution)
 }
 
+// ReleaseConcurrencyLimit mocks base method.
+func (m *MockEngine) ReleaseConcurrencyLimit(ctx context.Context, request *historyservice.ReleaseConcurrencyLimitRequest) (*historyservice.ReleaseConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseConcurrencyLimit", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ReleaseConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseConcurrencyLimit indicates an expected call of ReleaseConcurrencyLimit.
+func (mr *MockEngineMockRecorder) ReleaseConcurrencyLimit(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseConcurrencyLimit", reflect.TypeOf((*MockEngine)(nil).ReleaseConcurrencyLimit), ctx, request)
+}
+
 // RemoveSignalMutableState mocks base method.
 func (m *MockEngine) RemoveSignalMutableState(ctx context.Context, request *historyservice.RemoveSignalMutableStateRequest) (*historyservice.RemoveSignalMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -670,6 +715,21 @@ func (mr *MockEngineMockRecorder) RequestCancelWorkflowExecution(ctx, request in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestCancelWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).RequestCancelWorkflowExecution), ctx, request)
 }
 
+// ReserveConcurrencyLimit mocks base method.
+func (m *MockEngine) ReserveConcurrencyLimit(ctx context.Context, request *historyservice.ReserveConcurrencyLimitRequest) (*historyservice.ReserveConcurrencyLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveConcurrencyLimit", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ReserveConcurrencyLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveConcurrencyLimit indicates an expected call of ReserveConcurrencyLimit.
+func (mr *MockEngineMockRecorder) ReserveConcurrencyLimit(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveConcurrencyLimit", reflect.TypeOf((*MockEngine)(nil).ReserveConcurrencyLimit), ctx, request)
+}
+
 // ResetStickyTaskQueue mocks base method.
 func (m *MockEngine) ResetStickyTaskQueue(ctx context.Context, resetRequest *historyservice.ResetStickyTaskQueueRequest) (*historyservice.ResetStickyTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -774,6 +834,36 @@ func (mr *MockEngineMockRecorder) RespondWorkflowTaskFailed(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondWorkflowTaskFailed", reflect.TypeOf((*MockEngine)(nil).RespondWorkflowTaskFailed), ctx, request)
 }
 
+// ResumeWorkflowExecution mocks base method.
+func (m *MockEngine) ResumeWorkflowExecution(ctx context.Context, request *historyservice.ResumeWorkflowExecutionRequest) (*historyservice.ResumeWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ResumeWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeWorkflowExecution indicates an expected call of ResumeWorkflowExecution.
+func (mr *MockEngineMockRecorder) ResumeWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).ResumeWorkflowExecution), ctx, request)
+}
+
+// RevertQueueMitigation mocks base method.
+func (m *MockEngine) RevertQueueMitigation(ctx context.Context, request *historyservice.RevertQueueMitigationRequest) (*historyservice.RevertQueueMitigationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertQueueMitigation", ctx, request)
+	ret0, _ := ret[0].(*historyservice.RevertQueueMitigationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertQueueMitigation indicates an expected call of RevertQueueMitigation.
+func (mr *MockEngineMockRecorder) RevertQueueMitigation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertQueueMitigation", reflect.TypeOf((*MockEngine)(nil).RevertQueueMitigation), ctx, request)
+}
+
 // ScheduleWorkflowTask mocks base method.
 func (m *MockEngine) ScheduleWorkflowTask(ctx context.Context, request *historyservice.ScheduleWorkflowTaskRequest) error {
 	m.ctrl.T.Helper()
@@ -872,6 +962,21 @@ func (mr *MockEngineMockRecorder) SubscribeReplicationNotification() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeReplicationNotification", reflect.TypeOf((*MockEngine)(nil).SubscribeReplicationNotification))
 }
 
+// SuspendWorkflowExecution mocks base method.
+func (m *MockEngine) SuspendWorkflowExecution(ctx context.Context, request *historyservice.SuspendWorkflowExecutionRequest) (*historyservice.SuspendWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*historyservice.SuspendWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendWorkflowExecution indicates an expected call of SuspendWorkflowExecution.
+func (mr *MockEngineMockRecorder) SuspendWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).SuspendWorkflowExecution), ctx, request)
+}
+
 // SyncActivities mocks base method.
 func (m *MockEngine) SyncActivities(ctx context.Context, request *historyservice.SyncActivitiesRequest) error {
 	m.ctrl.T.Helper()
@@ -929,6 +1034,21 @@ func (mr *MockEngineMockRecorder) TerminateWorkflowExecution(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).TerminateWorkflowExecution), ctx, request)
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockEngine) UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ctx, request)
+	ret0, _ := ret[0].(*historyservice.UnpauseActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockEngineMockRecorder) UnpauseActivity(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockEngine)(nil).UnpauseActivity), ctx, request)
+}
+
 // UnsubscribeReplicationNotification mocks base method.
 func (m *MockEngine) UnsubscribeReplicationNotification(arg0 string) {
 	m.ctrl.T.Helper()
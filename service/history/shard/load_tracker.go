@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/number"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	shardLoadRequestsPerSecond          = "requestsPerSecond"
+	shardLoadTaskBacklog                = "taskBacklog"
+	shardLoadMutableStateLoadsPerSecond = "mutableStateLoadsPerSecond"
+)
+
+type (
+	// shardLoadCounters are the cumulative counters of a shard context which make up its load.
+	shardLoadCounters struct {
+		requests          int64
+		mutableStateLoads int64
+		taskBacklog       int64
+	}
+
+	shardLoadSample struct {
+		counters   shardLoadCounters
+		sampleTime time.Time
+	}
+
+	// shardLoadTracker turns the cumulative counters of the shards owned by the controller into rates, by sampling
+	// them periodically.
+	shardLoadTracker struct {
+		config     *configs.Config
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		samples map[ControllableContext]shardLoadSample
+		loads   map[int32]*adminservice.ShardLoad
+	}
+)
+
+func newShardLoadTracker(
+	config *configs.Config,
+	timeSource clock.TimeSource,
+) *shardLoadTracker {
+	return &shardLoadTracker{
+		config:     config,
+		timeSource: timeSource,
+		samples:    make(map[ControllableContext]shardLoadSample),
+		loads:      make(map[int32]*adminservice.ShardLoad),
+	}
+}
+
+// sample records the counters of the given shards, and updates the load of each shard which was also present in the
+// previous sample. Shards which are not given anymore are forgotten.
+func (t *shardLoadTracker) sample(shards []ControllableContext) {
+	now := t.timeSource.Now()
+	weights := t.config.ShardPlacementLoadWeights()
+
+	t.Lock()
+	defer t.Unlock()
+
+	samples := make(map[ControllableContext]shardLoadSample, len(shards))
+	loads := make(map[int32]*adminservice.ShardLoad, len(shards))
+	for _, shard := range shards {
+		current := shardLoadSample{
+			counters:   shard.loadCounters(),
+			sampleTime: now,
+		}
+		samples[shard] = current
+
+		previous, ok := t.samples[shard]
+		if !ok {
+			continue
+		}
+		elapsed := current.sampleTime.Sub(previous.sampleTime).Seconds()
+		if elapsed <= 0 {
+			continue
+		}
+		load := &adminservice.ShardLoad{
+			ShardId:                    shard.GetShardID(),
+			RequestsPerSecond:          float64(current.counters.requests-previous.counters.requests) / elapsed,
+			TaskBacklog:                current.counters.taskBacklog,
+			MutableStateLoadsPerSecond: float64(current.counters.mutableStateLoads-previous.counters.mutableStateLoads) / elapsed,
+		}
+		load.Load = weightedShardLoad(load, weights)
+		loads[load.ShardId] = load
+	}
+	t.samples = samples
+	t.loads = loads
+}
+
+// shardLoads returns the loads computed by the last sample.
+func (t *shardLoadTracker) shardLoads() []*adminservice.ShardLoad {
+	t.Lock()
+	defer t.Unlock()
+
+	loads := make([]*adminservice.ShardLoad, 0, len(t.loads))
+	for _, load := range t.loads {
+		loads = append(loads, load)
+	}
+	return loads
+}
+
+func weightedShardLoad(
+	load *adminservice.ShardLoad,
+	weights map[string]any,
+) float64 {
+	return load.RequestsPerSecond*number.NewNumber(weights[shardLoadRequestsPerSecond]).GetFloatOrDefault(0) +
+		float64(load.TaskBacklog)*number.NewNumber(weights[shardLoadTaskBacklog]).GetFloatOrDefault(0) +
+		load.MutableStateLoadsPerSecond*number.NewNumber(weights[shardLoadMutableStateLoadsPerSecond]).GetFloatOrDefault(0)
+}
@@ -28,7 +28,7 @@ import (
 	"context"
 	"time"
 
-	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
@@ -47,8 +47,8 @@ type (
 	// Upon receiving membership update events, it calls the controller's
 	// acquireShards method, which acquires or closes shards as needed.
 	// The controller calls its verifyOwnership method when asked to
-	// acquire a shard, to check that membership believes this host should
-	// own the shard.
+	// acquire a shard, to check that membership, or the shard placement in the
+	// load-aware placement mode, believes this host should own the shard.
 	ownership struct {
 		acquireCh              chan struct{}
 		config                 *configs.Config
@@ -58,6 +58,7 @@ type (
 		logger                 log.Logger
 		membershipUpdateCh     chan *membership.ChangedEvent
 		metricsHandler         metrics.Handler
+		placements             *shardPlacements
 	}
 )
 
@@ -67,6 +68,7 @@ func newOwnership(
 	hostInfoProvider membership.HostInfoProvider,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	placements *shardPlacements,
 ) *ownership {
 	hostIdentity := hostInfoProvider.HostInfo().Identity()
 	logger = log.With(logger, tag.ComponentShardController, tag.Address(hostIdentity))
@@ -78,6 +80,7 @@ func newOwnership(
 		logger:                 logger,
 		membershipUpdateCh:     make(chan *membership.ChangedEvent, 1),
 		metricsHandler:         metricsHandler,
+		placements:             placements,
 	}
 }
 
@@ -92,6 +95,11 @@ func (o *ownership) start(controller *ControllerImpl) {
 		return nil
 	})
 
+	o.goros.Go(func(ctx context.Context) error {
+		o.placementLoop(ctx, controller)
+		return nil
+	})
+
 	if err := o.historyServiceResolver.AddListener(
 		shardControllerMembershipUpdateListenerName,
 		o.membershipUpdateCh,
@@ -142,6 +150,37 @@ func (o *ownership) acquireLoop(ctx context.Context, controller *ControllerImpl)
 	}
 }
 
+func (o *ownership) placementLoop(ctx context.Context, controller *ControllerImpl) {
+	refreshTicker := time.NewTicker(o.config.ShardPlacementRefreshInterval())
+	defer refreshTicker.Stop()
+	rebalanceTicker := time.NewTicker(o.config.ShardPlacementRebalanceInterval())
+	defer rebalanceTicker.Stop()
+
+	ctx = headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-refreshTicker.C:
+			controller.sampleShardLoads()
+
+			changed, err := o.placements.refresh(ctx)
+			if err != nil {
+				o.logger.Warn("Unable to refresh shard placements", tag.Error(err))
+				continue
+			}
+			if changed {
+				o.scheduleAcquire()
+			}
+		case <-rebalanceTicker.C:
+			if err := o.placements.rebalance(ctx); err != nil {
+				o.logger.Warn("Unable to rebalance shard placements", tag.Error(err))
+			}
+		}
+	}
+}
+
 func (o *ownership) stop() {
 	if err := o.historyServiceResolver.RemoveListener(
 		shardControllerMembershipUpdateListenerName,
@@ -154,10 +193,10 @@ func (o *ownership) stop() {
 }
 
 // verifyOwnership checks if the shard should be owned by this host's shard
-// controller. If membership lists another host as the owner, it returns a
-// ShardOwnershipLost error with the correct owner.
+// controller. If membership or the shard placement lists another host as the
+// owner, it returns a ShardOwnershipLost error with the correct owner.
 func (o *ownership) verifyOwnership(shardID int32) error {
-	ownerInfo, err := o.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+	ownerInfo, err := o.placements.lookup(shardID)
 	if err != nil {
 		return err
 	}
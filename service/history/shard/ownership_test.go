@@ -79,6 +79,9 @@ func (s *ownershipSuite) newController(contextFactory ContextFactory) *Controlle
 		s.resource.GetMetricsHandler(),
 		s.resource.GetHostInfoProvider(),
 		contextFactory,
+		s.resource.GetClusterMetadataManager(),
+		s.resource.GetHistoryClient(),
+		s.resource.GetTimeSource(),
 	)
 }
 
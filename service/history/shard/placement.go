@@ -0,0 +1,319 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync/atomic"
+
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/number"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/service/history/configs"
+)
+
+const (
+	// ShardPlacementModeHash places each shard on the host membership assigns it to.
+	ShardPlacementModeHash = "hash"
+	// ShardPlacementModeLoadAware places shards according to the placements stored in the cluster metadata, which
+	// are pinned by operators or computed from the load of the shards.
+	ShardPlacementModeLoadAware = "load-aware"
+
+	// shardPlacementRebalancerKey is the membership key of the host which rebalances shards
+	shardPlacementRebalancerKey = "shard-placement-rebalancer"
+)
+
+type (
+	// shardPlacements keeps the placements of the history shards stored in the cluster metadata, and moves shards
+	// away from overloaded hosts in the load-aware shard placement mode.
+	shardPlacements struct {
+		clusterMetadataManager persistence.ClusterMetadataManager
+		config                 *configs.Config
+		historyClient          historyservice.HistoryServiceClient
+		historyServiceResolver membership.ServiceResolver
+		hostInfoProvider       membership.HostInfoProvider
+		logger                 log.Logger
+		timeSource             clock.TimeSource
+
+		placements atomic.Pointer[map[int32]*persistencespb.ShardPlacement]
+	}
+
+	shardMove struct {
+		shardID int32
+		from    string
+		to      string
+	}
+
+	hostLoad struct {
+		identity string
+		weight   float64
+		load     float64
+		shards   []*adminservice.ShardLoad
+	}
+)
+
+func newShardPlacements(
+	clusterMetadataManager persistence.ClusterMetadataManager,
+	config *configs.Config,
+	historyClient historyservice.HistoryServiceClient,
+	historyServiceResolver membership.ServiceResolver,
+	hostInfoProvider membership.HostInfoProvider,
+	logger log.Logger,
+	timeSource clock.TimeSource,
+) *shardPlacements {
+	p := &shardPlacements{
+		clusterMetadataManager: clusterMetadataManager,
+		config:                 config,
+		historyClient:          historyClient,
+		historyServiceResolver: historyServiceResolver,
+		hostInfoProvider:       hostInfoProvider,
+		logger:                 logger,
+		timeSource:             timeSource,
+	}
+	p.placements.Store(&map[int32]*persistencespb.ShardPlacement{})
+	return p
+}
+
+// lookup returns the host which should own the shard. In the load-aware mode, this is the host the shard is placed on
+// if that host is available, otherwise it is the host membership assigns the shard to.
+func (p *shardPlacements) lookup(shardID int32) (membership.HostInfo, error) {
+	hashOwner, err := p.historyServiceResolver.Lookup(convert.Int32ToString(shardID))
+	if err != nil {
+		return nil, err
+	}
+	if p.config.ShardPlacementMode() != ShardPlacementModeLoadAware {
+		return hashOwner, nil
+	}
+
+	placement, ok := (*p.placements.Load())[shardID]
+	if !ok || placement.GetOwner() == hashOwner.Identity() {
+		return hashOwner, nil
+	}
+	for _, member := range p.historyServiceResolver.AvailableMembers() {
+		if member.Identity() == placement.GetOwner() {
+			return member, nil
+		}
+	}
+	return hashOwner, nil
+}
+
+// refresh reloads the placements from the cluster metadata, and returns whether they changed.
+func (p *shardPlacements) refresh(ctx context.Context) (bool, error) {
+	placements := map[int32]*persistencespb.ShardPlacement{}
+	if p.config.ShardPlacementMode() == ShardPlacementModeLoadAware {
+		var err error
+		if placements, err = persistence.GetShardPlacements(ctx, p.clusterMetadataManager); err != nil {
+			return false, err
+		}
+	}
+
+	previous := p.placements.Swap(&placements)
+	return !maps.EqualFunc(*previous, placements, func(a, b *persistencespb.ShardPlacement) bool {
+		return proto.Equal(a, b)
+	}), nil
+}
+
+// rebalance moves shards from the hosts whose load per weight exceeds the average by more than the imbalance
+// threshold to the least loaded hosts. Only the host membership assigns the rebalancer key to rebalances, and it
+// moves at most ShardPlacementMaxMovesPerRebalance shards at a time.
+func (p *shardPlacements) rebalance(ctx context.Context) error {
+	if p.config.ShardPlacementMode() != ShardPlacementModeLoadAware {
+		return nil
+	}
+	rebalancer, err := p.historyServiceResolver.Lookup(shardPlacementRebalancerKey)
+	if err != nil {
+		return err
+	}
+	if rebalancer.Identity() != p.hostInfoProvider.HostInfo().Identity() {
+		return nil
+	}
+
+	members := p.historyServiceResolver.AvailableMembers()
+	shardLoads := make(map[string][]*adminservice.ShardLoad, len(members))
+	for _, member := range members {
+		resp, err := p.historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{
+			HostAddress: member.GetAddress(),
+		})
+		if err != nil {
+			// the loads of all hosts are needed to compare them
+			return err
+		}
+		shardLoads[member.Identity()] = resp.GetShardLoads()
+	}
+	hostWeights := p.config.ShardPlacementHostWeights()
+
+	var moves []shardMove
+	if err := persistence.UpdateShardPlacements(ctx, p.clusterMetadataManager, func(
+		placements map[int32]*persistencespb.ShardPlacement,
+	) error {
+		pinned := make(map[int32]struct{})
+		for shardID, placement := range placements {
+			if placement.GetPinned() {
+				pinned[shardID] = struct{}{}
+				continue
+			}
+			// the shard moved back to its hash owner when its host left
+			if _, ok := shardLoads[placement.GetOwner()]; !ok {
+				delete(placements, shardID)
+			}
+		}
+
+		hosts := make([]*hostLoad, 0, len(shardLoads))
+		for identity, loads := range shardLoads {
+			hosts = append(hosts, &hostLoad{
+				identity: identity,
+				weight:   number.NewNumber(hostWeights[identity]).GetFloatOrDefault(1),
+				shards:   loads,
+			})
+		}
+		moves = planShardMoves(
+			hosts,
+			pinned,
+			p.config.ShardPlacementImbalanceThreshold(),
+			p.config.ShardPlacementMaxMovesPerRebalance(),
+		)
+
+		for _, move := range moves {
+			hashOwner, err := p.historyServiceResolver.Lookup(convert.Int32ToString(move.shardID))
+			if err != nil {
+				return err
+			}
+			if hashOwner.Identity() == move.to {
+				delete(placements, move.shardID)
+				continue
+			}
+			placements[move.shardID] = &persistencespb.ShardPlacement{
+				Owner:      move.to,
+				UpdateTime: timestamppb.New(p.timeSource.Now()),
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, move := range moves {
+		p.logger.Info("Moving shard to less loaded host",
+			tag.ShardID(move.shardID),
+			tag.NewStringTag("from", move.from),
+			tag.NewStringTag("to", move.to),
+		)
+	}
+	return nil
+}
+
+// planShardMoves repeatedly moves a shard from the host with the highest load per weight to the host with the lowest
+// one, as long as the former exceeds the average by more than the imbalance threshold and the move lowers the highest
+// load per weight of the two hosts. Pinned shards and hosts with a weight of 0 or less are never moved.
+func planShardMoves(
+	hosts []*hostLoad,
+	pinned map[int32]struct{},
+	imbalanceThreshold float64,
+	maxMoves int,
+) []shardMove {
+	var totalLoad, totalWeight float64
+	candidates := make([]*hostLoad, 0, len(hosts))
+	for _, host := range hosts {
+		if host.weight <= 0 {
+			continue
+		}
+		host := &hostLoad{
+			identity: host.identity,
+			weight:   host.weight,
+			shards:   slices.Clone(host.shards),
+		}
+		for _, shard := range host.shards {
+			host.load += shard.GetLoad()
+		}
+		totalLoad += host.load
+		totalWeight += host.weight
+		candidates = append(candidates, host)
+	}
+	if len(candidates) < 2 || totalLoad <= 0 {
+		return nil
+	}
+	// sort to break ties deterministically
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].identity < candidates[j].identity
+	})
+	average := totalLoad / totalWeight
+
+	var moves []shardMove
+	for len(moves) < maxMoves {
+		from, to := candidates[0], candidates[0]
+		for _, host := range candidates[1:] {
+			if host.load/host.weight > from.load/from.weight {
+				from = host
+			}
+			if host.load/host.weight < to.load/to.weight {
+				to = host
+			}
+		}
+		peak := from.load / from.weight
+		if peak <= average*(1+imbalanceThreshold) {
+			break
+		}
+
+		move := -1
+		for i, shard := range from.shards {
+			if _, ok := pinned[shard.GetShardId()]; ok || shard.GetLoad() <= 0 {
+				continue
+			}
+			movedPeak := max((from.load-shard.GetLoad())/from.weight, (to.load+shard.GetLoad())/to.weight)
+			if movedPeak < peak {
+				peak = movedPeak
+				move = i
+			}
+		}
+		if move < 0 {
+			break
+		}
+
+		shard := from.shards[move]
+		from.shards = slices.Delete(from.shards, move, move+1)
+		from.load -= shard.GetLoad()
+		to.shards = append(to.shards, shard)
+		to.load += shard.GetLoad()
+		moves = append(moves, shardMove{
+			shardID: shard.GetShardId(),
+			from:    from.identity,
+			to:      to.identity,
+		})
+	}
+	return moves
+}
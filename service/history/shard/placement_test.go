@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/tests"
+)
+
+type (
+	placementSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		resource   *resourcetest.Test
+		config     *configs.Config
+
+		placements *shardPlacements
+	}
+)
+
+func TestPlacementSuite(t *testing.T) {
+	s := new(placementSuite)
+	suite.Run(t, s)
+}
+
+func (s *placementSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.resource = resourcetest.NewTest(s.controller, primitives.HistoryService)
+	s.config = tests.NewDynamicConfig()
+	s.config.ShardPlacementMode = dynamicconfig.GetStringPropertyFn(ShardPlacementModeLoadAware)
+
+	s.placements = newShardPlacements(
+		s.resource.GetClusterMetadataManager(),
+		s.config,
+		s.resource.GetHistoryClient(),
+		s.resource.GetHistoryServiceResolver(),
+		s.resource.GetHostInfoProvider(),
+		log.NewTestLogger(),
+		s.resource.GetTimeSource(),
+	)
+}
+
+func (s *placementSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *placementSuite) TestLookup_PlacedOnAvailableHost() {
+	hashOwner := membership.NewHostInfoFromAddress("host-1")
+	placedOwner := membership.NewHostInfoFromAddress("host-2")
+	s.placements.placements.Store(&map[int32]*persistencespb.ShardPlacement{
+		1: {Owner: placedOwner.Identity()},
+	})
+
+	s.resource.HistoryServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(hashOwner, nil)
+	s.resource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{hashOwner, placedOwner})
+
+	owner, err := s.placements.lookup(1)
+	s.NoError(err)
+	s.Equal(placedOwner.Identity(), owner.Identity())
+}
+
+func (s *placementSuite) TestLookup_PlacedOnUnavailableHost() {
+	hashOwner := membership.NewHostInfoFromAddress("host-1")
+	s.placements.placements.Store(&map[int32]*persistencespb.ShardPlacement{
+		1: {Owner: "host-2"},
+	})
+
+	s.resource.HistoryServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(hashOwner, nil)
+	s.resource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{hashOwner})
+
+	owner, err := s.placements.lookup(1)
+	s.NoError(err)
+	s.Equal(hashOwner.Identity(), owner.Identity())
+}
+
+func (s *placementSuite) TestLookup_HashMode() {
+	s.config.ShardPlacementMode = dynamicconfig.GetStringPropertyFn(ShardPlacementModeHash)
+	hashOwner := membership.NewHostInfoFromAddress("host-1")
+	s.placements.placements.Store(&map[int32]*persistencespb.ShardPlacement{
+		1: {Owner: "host-2"},
+	})
+
+	s.resource.HistoryServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(hashOwner, nil)
+
+	owner, err := s.placements.lookup(1)
+	s.NoError(err)
+	s.Equal(hashOwner.Identity(), owner.Identity())
+}
+
+func (s *placementSuite) TestPlanShardMoves_Balanced() {
+	hosts := []*hostLoad{
+		{identity: "host-1", weight: 1, shards: []*adminservice.ShardLoad{{ShardId: 1, Load: 10}}},
+		{identity: "host-2", weight: 1, shards: []*adminservice.ShardLoad{{ShardId: 2, Load: 11}}},
+	}
+	s.Empty(planShardMoves(hosts, nil, 0.2, 10))
+}
+
+func (s *placementSuite) TestPlanShardMoves_MovesFromOverloadedHost() {
+	hosts := []*hostLoad{
+		{identity: "host-1", weight: 1, shards: []*adminservice.ShardLoad{
+			{ShardId: 1, Load: 35},
+			{ShardId: 2, Load: 45},
+			{ShardId: 3, Load: 20},
+		}},
+		{identity: "host-2", weight: 1, shards: []*adminservice.ShardLoad{{ShardId: 4, Load: 10}}},
+	}
+	moves := planShardMoves(hosts, nil, 0.2, 10)
+	s.Equal([]shardMove{{shardID: 2, from: "host-1", to: "host-2"}}, moves)
+	// the input is not modified
+	s.Len(hosts[0].shards, 3)
+}
+
+func (s *placementSuite) TestPlanShardMoves_MaxMoves() {
+	hosts := []*hostLoad{
+		{identity: "host-1", weight: 1, shards: []*adminservice.ShardLoad{
+			{ShardId: 1, Load: 10},
+			{ShardId: 2, Load: 10},
+			{ShardId: 3, Load: 10},
+			{ShardId: 4, Load: 10},
+		}},
+		{identity: "host-2", weight: 1},
+	}
+	s.Len(planShardMoves(hosts, nil, 0.2, 1), 1)
+	s.Len(planShardMoves(hosts, nil, 0.2, 10), 2)
+}
+
+func (s *placementSuite) TestPlanShardMoves_HostWeights() {
+	hosts := []*hostLoad{
+		{identity: "host-1", weight: 3, shards: []*adminservice.ShardLoad{
+			{ShardId: 1, Load: 10},
+			{ShardId: 2, Load: 10},
+			{ShardId: 3, Load: 10},
+		}},
+		{identity: "host-2", weight: 1, shards: []*adminservice.ShardLoad{{ShardId: 4, Load: 10}}},
+	}
+	s.Empty(planShardMoves(hosts, nil, 0.2, 10))
+}
+
+func (s *placementSuite) TestPlanShardMoves_SkipsPinnedShards() {
+	hosts := []*hostLoad{
+		{identity: "host-1", weight: 1, shards: []*adminservice.ShardLoad{
+			{ShardId: 1, Load: 10},
+			{ShardId: 2, Load: 10},
+		}},
+		{identity: "host-2", weight: 1},
+	}
+	pinned := map[int32]struct{}{1: {}, 2: {}}
+	s.Empty(planShardMoves(hosts, pinned, 0.2, 10))
+}
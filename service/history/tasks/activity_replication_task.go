@@ -40,6 +40,7 @@ type (
 		TaskID              int64
 		Version             int64
 		ScheduledEventID    int64
+		ExcludedClusters    []string
 	}
 )
 
@@ -0,0 +1,82 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/definition"
+)
+
+var _ Task = (*DeleteOffloadedPayloadsTask)(nil)
+
+type (
+	// DeleteOffloadedPayloadsTask deletes payloads offloaded by a workflow run from the payload store. It is created
+	// when the run is deleted and executed without the workflow lock, as the run no longer exists by then.
+	DeleteOffloadedPayloadsTask struct {
+		definition.WorkflowKey
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+		Keys                []string
+	}
+)
+
+func (a *DeleteOffloadedPayloadsTask) GetKey() Key {
+	return NewImmediateKey(a.TaskID)
+}
+
+func (a *DeleteOffloadedPayloadsTask) GetVersion() int64 {
+	return a.Version
+}
+
+func (a *DeleteOffloadedPayloadsTask) SetVersion(version int64) {
+	a.Version = version
+}
+
+func (a *DeleteOffloadedPayloadsTask) GetTaskID() int64 {
+	return a.TaskID
+}
+
+func (a *DeleteOffloadedPayloadsTask) SetTaskID(id int64) {
+	a.TaskID = id
+}
+
+func (a *DeleteOffloadedPayloadsTask) GetVisibilityTime() time.Time {
+	return a.VisibilityTimestamp
+}
+
+func (a *DeleteOffloadedPayloadsTask) SetVisibilityTime(timestamp time.Time) {
+	a.VisibilityTimestamp = timestamp
+}
+
+func (a *DeleteOffloadedPayloadsTask) GetCategory() Category {
+	return CategoryTransfer
+}
+
+func (a *DeleteOffloadedPayloadsTask) GetType() enumsspb.TaskType {
+	return enumsspb.TASK_TYPE_TRANSFER_DELETE_OFFLOADED_PAYLOADS
+}
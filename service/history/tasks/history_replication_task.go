@@ -42,6 +42,7 @@ type (
 		NextEventID         int64
 		Version             int64
 		NewRunID            string
+		ExcludedClusters    []string
 
 		// deprecated
 		BranchToken       []byte
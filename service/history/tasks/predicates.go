@@ -38,6 +38,7 @@ type (
 var (
 	_ Predicate = (*NamespacePredicate)(nil)
 	_ Predicate = (*TypePredicate)(nil)
+	_ Predicate = (*WorkflowIDPredicate)(nil)
 )
 
 type (
@@ -56,6 +57,10 @@ type (
 	TypePredicate struct {
 		Types map[enumsspb.TaskType]struct{}
 	}
+
+	WorkflowIDPredicate struct {
+		WorkflowIDs map[string]struct{}
+	}
 )
 
 func NewNamespacePredicate(
@@ -174,6 +179,33 @@ func (t *TypePredicate) Equals(predicate Predicate) bool {
 	return maps.Equal(t.Types, typePrediate.Types)
 }
 
+func NewWorkflowIDPredicate(
+	workflowIDs []string,
+) *WorkflowIDPredicate {
+	workflowIDMap := make(map[string]struct{}, len(workflowIDs))
+	for _, id := range workflowIDs {
+		workflowIDMap[id] = struct{}{}
+	}
+
+	return &WorkflowIDPredicate{
+		WorkflowIDs: workflowIDMap,
+	}
+}
+
+func (w *WorkflowIDPredicate) Test(task Task) bool {
+	_, ok := w.WorkflowIDs[task.GetWorkflowID()]
+	return ok
+}
+
+func (w *WorkflowIDPredicate) Equals(predicate Predicate) bool {
+	workflowIDPredicate, ok := predicate.(*WorkflowIDPredicate)
+	if !ok {
+		return false
+	}
+
+	return maps.Equal(w.WorkflowIDs, workflowIDPredicate.WorkflowIDs)
+}
+
 func AndPredicates(a Predicate, b Predicate) Predicate {
 	switch a := a.(type) {
 	case *NamespacePredicate:
@@ -206,6 +238,16 @@ func AndPredicates(a Predicate, b Predicate) Predicate {
 				Destinations: intersection,
 			}
 		}
+	case *WorkflowIDPredicate:
+		if b, ok := b.(*WorkflowIDPredicate); ok {
+			intersection := intersect(a.WorkflowIDs, b.WorkflowIDs)
+			if len(intersection) == 0 {
+				return predicates.Empty[Task]()
+			}
+			return &WorkflowIDPredicate{
+				WorkflowIDs: intersection,
+			}
+		}
 	}
 
 	return predicates.And(a, b)
@@ -231,6 +273,12 @@ func OrPredicates(a Predicate, b Predicate) Predicate {
 				Destinations: union(a.Destinations, b.Destinations),
 			}
 		}
+	case *WorkflowIDPredicate:
+		if b, ok := b.(*WorkflowIDPredicate); ok {
+			return &WorkflowIDPredicate{
+				WorkflowIDs: union(a.WorkflowIDs, b.WorkflowIDs),
+			}
+		}
 	}
 
 	return predicates.Or(a, b)
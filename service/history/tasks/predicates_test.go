@@ -216,6 +216,41 @@ func (s *predicatesSuite) TestStateMachineTaskTypePredicate_Equals() {
 	s.False(p.Equals(predicates.Universal[Task]()))
 }
 
+func (s *predicatesSuite) TestWorkflowIDPredicate_Test() {
+	workflowIDs := []string{uuid.New(), uuid.New()}
+
+	p := NewWorkflowIDPredicate(workflowIDs)
+	for _, id := range workflowIDs {
+		mockTask := NewMockTask(s.controller)
+		mockTask.EXPECT().GetWorkflowID().Return(id).Times(1)
+		s.True(p.Test(mockTask))
+	}
+
+	mockTask := NewMockTask(s.controller)
+	mockTask.EXPECT().GetWorkflowID().Return(uuid.New()).Times(1)
+	s.False(p.Test(mockTask))
+}
+
+func (s *predicatesSuite) TestWorkflowIDPredicate_Equals() {
+	workflowIDs := []string{uuid.New(), uuid.New()}
+
+	p := NewWorkflowIDPredicate(workflowIDs)
+
+	s.True(p.Equals(p))
+	s.True(p.Equals(NewWorkflowIDPredicate(workflowIDs)))
+	rand.Shuffle(
+		len(workflowIDs),
+		func(i, j int) {
+			workflowIDs[i], workflowIDs[j] = workflowIDs[j], workflowIDs[i]
+		},
+	)
+	s.True(p.Equals(NewWorkflowIDPredicate(workflowIDs)))
+
+	s.False(p.Equals(NewWorkflowIDPredicate([]string{uuid.New(), uuid.New()})))
+	s.False(p.Equals(NewNamespacePredicate(workflowIDs)))
+	s.False(p.Equals(predicates.Universal[Task]()))
+}
+
 func (s *predicatesSuite) TestAndPredicates() {
 	testCases := []struct {
 		predicateA     Predicate
@@ -83,6 +83,8 @@ func GetTransferTaskEventID(
 		eventID = common.FirstEventID
 	case *DeleteExecutionTask:
 		eventID = common.FirstEventID
+	case *DeleteOffloadedPayloadsTask:
+		eventID = common.FirstEventID
 	case *CancelExecutionTask:
 		eventID = task.InitiatedEventID
 	case *SignalExecutionTask:
@@ -128,3 +130,35 @@ func GetTimerTaskEventID(
 	}
 	return eventID
 }
+
+// GetReplicationExcludedClusters returns the clusters a replication task is not sent to because of the
+// replication filters of its namespace.
+func GetReplicationExcludedClusters(
+	replicationTask Task,
+) []string {
+	switch task := replicationTask.(type) {
+	case *HistoryReplicationTask:
+		return task.ExcludedClusters
+	case *SyncActivityTask:
+		return task.ExcludedClusters
+	case *SyncWorkflowStateTask:
+		return task.ExcludedClusters
+	default:
+		return nil
+	}
+}
+
+// SetReplicationExcludedClusters sets the clusters a replication task is not sent to.
+func SetReplicationExcludedClusters(
+	replicationTask Task,
+	excludedClusters []string,
+) {
+	switch task := replicationTask.(type) {
+	case *HistoryReplicationTask:
+		task.ExcludedClusters = excludedClusters
+	case *SyncActivityTask:
+		task.ExcludedClusters = excludedClusters
+	case *SyncWorkflowStateTask:
+		task.ExcludedClusters = excludedClusters
+	}
+}
@@ -26,10 +26,13 @@ package history
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/pborman/uuid"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -191,43 +194,38 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 		return consts.ErrWorkflowExecutionNotFound
 	}
 
-	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shardContext.GetTimeSource().Now()
 	timerFired := false
-
-Loop:
-	for _, timerSequenceID := range timerSequence.LoadAndSortUserTimers() {
-		timerInfo, ok := mutableState.GetUserTimerInfoByEventID(timerSequenceID.EventID)
-		if !ok {
-			errString := fmt.Sprintf("failed to find in user timer event ID: %v", timerSequenceID.EventID)
-			t.logger.Error(errString)
-			return serviceerror.NewInternal(errString)
-		}
-
-		if !queues.IsTimeExpired(referenceTime, timerSequenceID.Timestamp) {
-			// Timer sequence IDs are sorted; once we encounter a timer whose
-			// sequence ID has not expired, all subsequent timers will not have
-			// expired.
-			break Loop
-		}
-
-		if !mutableState.IsWorkflowExecutionRunning() {
+	// user timers are recreated when the workflow is resumed
+	if !mutableState.IsWorkflowExecutionSuspended() {
+		timerFired, err = t.fireExpiredUserTimers(mutableState, referenceTime)
+		if errors.Is(err, consts.ErrWorkflowCompleted) {
 			release(nil) // so mutable state is not unloaded from cache
-			return consts.ErrWorkflowCompleted
+			return err
+		} else if err != nil {
+			return err
 		}
+	}
 
-		if _, err := mutableState.AddTimerFiredEvent(timerInfo.GetTimerId()); err != nil {
+	// activity timeouts may have been coalesced into this task, see workflow.NewCoalescingTimerSequence
+	activityTimedOut := false
+	activityTimerUpdated := false
+	if mutableState.IsWorkflowExecutionRunning() {
+		activityTimedOut, activityTimerUpdated, err = t.timeoutExpiredActivities(mutableState, referenceTime)
+		if err != nil {
 			return err
 		}
-		timerFired = true
 	}
 
-	if !timerFired {
+	if !timerFired && !activityTimerUpdated {
 		release(nil) // so mutable state is not unloaded from cache
+		if mutableState.IsWorkflowExecutionSuspended() {
+			return nil
+		}
 		return errNoTimerFired
 	}
 
-	return t.updateWorkflowExecution(ctx, weContext, mutableState, timerFired)
+	return t.updateWorkflowExecution(ctx, weContext, mutableState, timerFired || activityTimedOut)
 }
 
 func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
@@ -251,10 +249,8 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 		return nil
 	}
 
-	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shardContext.GetTimeSource().Now()
 	updateMutableState := false
-	scheduleWorkflowTask := false
 
 	// Need to clear activity heartbeat timer task mask for new activity timer task creation.
 	// NOTE: LastHeartbeatTimeoutVisibilityInSeconds is for deduping heartbeat timer creation as it's possible
@@ -271,8 +267,74 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 		updateMutableState = true
 	}
 
+	scheduleWorkflowTask, activityTimerUpdated, err := t.timeoutExpiredActivities(mutableState, referenceTime)
+	if err != nil {
+		return err
+	}
+	updateMutableState = updateMutableState || activityTimerUpdated
+
+	// user timers may have been coalesced into this task, see workflow.NewCoalescingTimerSequence
+	if !mutableState.IsWorkflowExecutionSuspended() {
+		timerFired, err := t.fireExpiredUserTimers(mutableState, referenceTime)
+		if err != nil {
+			return err
+		}
+		updateMutableState = updateMutableState || timerFired
+		scheduleWorkflowTask = scheduleWorkflowTask || timerFired
+	}
+
+	if !updateMutableState {
+		return nil
+	}
+	return t.updateWorkflowExecution(ctx, weContext, mutableState, scheduleWorkflowTask)
+}
+
+// fireExpiredUserTimers adds a timer fired event for every user timer expired at the reference time.
+func (t *timerQueueActiveTaskExecutor) fireExpiredUserTimers(
+	mutableState workflow.MutableState,
+	referenceTime time.Time,
+) (bool, error) {
+	timerFired := false
+
 Loop:
-	for _, timerSequenceID := range timerSequence.LoadAndSortActivityTimers() {
+	for _, timerSequenceID := range t.getTimerSequence(mutableState).LoadAndSortUserTimers() {
+		timerInfo, ok := mutableState.GetUserTimerInfoByEventID(timerSequenceID.EventID)
+		if !ok {
+			errString := fmt.Sprintf("failed to find in user timer event ID: %v", timerSequenceID.EventID)
+			t.logger.Error(errString)
+			return false, serviceerror.NewInternal(errString)
+		}
+
+		if !queues.IsTimeExpired(referenceTime, timerSequenceID.Timestamp) {
+			// Timer sequence IDs are sorted; once we encounter a timer whose
+			// sequence ID has not expired, all subsequent timers will not have
+			// expired.
+			break Loop
+		}
+
+		if !mutableState.IsWorkflowExecutionRunning() {
+			return false, consts.ErrWorkflowCompleted
+		}
+
+		if _, err := mutableState.AddTimerFiredEvent(timerInfo.GetTimerId()); err != nil {
+			return false, err
+		}
+		timerFired = true
+	}
+	return timerFired, nil
+}
+
+// timeoutExpiredActivities retries or times out every activity with a timeout expired at the reference time. It
+// returns whether an activity timed out, and whether mutable state was updated.
+func (t *timerQueueActiveTaskExecutor) timeoutExpiredActivities(
+	mutableState workflow.MutableState,
+	referenceTime time.Time,
+) (bool, bool, error) {
+	updateMutableState := false
+	activityTimedOut := false
+
+Loop:
+	for _, timerSequenceID := range t.getTimerSequence(mutableState).LoadAndSortActivityTimers() {
 		activityInfo, ok := mutableState.GetActivityInfo(timerSequenceID.EventID)
 		if !ok || timerSequenceID.Attempt < activityInfo.Attempt {
 			// handle 2 cases:
@@ -292,9 +354,9 @@ Loop:
 
 		failureMsg := fmt.Sprintf("activity %v timeout", timerSequenceID.TimerType.String())
 		timeoutFailure := failure.NewTimeoutFailure(failureMsg, timerSequenceID.TimerType)
-		var retryState enumspb.RetryState
-		if retryState, err = mutableState.RetryActivity(activityInfo, timeoutFailure); err != nil {
-			return err
+		retryState, err := mutableState.RetryActivity(activityInfo, timeoutFailure)
+		if err != nil {
+			return false, false, err
 		} else if retryState == enumspb.RETRY_STATE_IN_PROGRESS {
 			updateMutableState = true
 			continue Loop
@@ -318,16 +380,12 @@ Loop:
 			timeoutFailure,
 			retryState,
 		); err != nil {
-			return err
+			return false, false, err
 		}
 		updateMutableState = true
-		scheduleWorkflowTask = true
-	}
-
-	if !updateMutableState {
-		return nil
+		activityTimedOut = true
 	}
-	return t.updateWorkflowExecution(ctx, weContext, mutableState, scheduleWorkflowTask)
+	return activityTimedOut, updateMutableState, nil
 }
 
 func (t *timerQueueActiveTaskExecutor) executeWorkflowTaskTimeoutTask(
@@ -505,6 +563,18 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if activityInfo.Paused {
+		// the activity is rescheduled when it is unpaused
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
+
+	if mutableState.IsWorkflowExecutionSuspended() {
+		// the activity is rescheduled when the workflow is resumed
+		release(nil) // release(nil) so mutable state is not unloaded from cache
+		return nil
+	}
+
 	taskQueue := &taskqueuepb.TaskQueue{
 		Name: activityInfo.TaskQueue,
 		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
@@ -512,6 +582,11 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	scheduleToStartTimeout := timestamp.DurationValue(activityInfo.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	useWfBuildId := activityInfo.GetUseWorkflowBuildId() != nil
+	var visibilityTime *timestamppb.Timestamp
+	if activityInfo.GetScheduledTime().AsTime().After(t.shardContext.GetTimeSource().Now()) {
+		// the retry timer fired ahead of the retry time, see ActivityRetryDispatchLead
+		visibilityTime = activityInfo.GetScheduledTime()
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	release(nil) // release earlier as we don't need the lock anymore
@@ -527,6 +602,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		ScheduleToStartTimeout: durationpb.New(scheduleToStartTimeout),
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), task.TaskID),
 		VersionDirective:       directive,
+		VisibilityTime:         visibilityTime,
 	})
 	if err != nil {
 		return err
@@ -50,6 +50,7 @@ import (
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -988,6 +989,180 @@ func (s *timerQueueActiveTaskExecutorSuite) TestProcessActivityTimeout_Heartbeat
 	s.NoError(resp.ExecutionErr)
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestProcessUserTimerTimeout_CoalescedActivityTimeout() {
+	s.mockShard.GetConfig().TimerCoalescingWindow = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(2 * time.Second)
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(200 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	timerID := "timer"
+	timerTimeout := 2 * time.Second
+	timerEvent, _ := addTimerStartedEvent(mutableState, event.GetEventId(), timerID, timerTimeout)
+	activityTimeout := 3 * time.Second
+	scheduledEvent, _ := addActivityTaskScheduledEvent(
+		mutableState,
+		event.GetEventId(),
+		"activity",
+		"activity type",
+		"taskqueue",
+		nil,
+		activityTimeout,
+		activityTimeout,
+		activityTimeout,
+		activityTimeout,
+	)
+
+	timerSequence := workflow.NewCoalescingTimerSequence(mutableState, s.mockShard.GetConfig(), metrics.NoopMetricsHandler)
+	mutableState.InsertTasks[tasks.CategoryTimer] = nil
+	modified, err := timerSequence.CreateNextUserTimer()
+	s.NoError(err)
+	s.True(modified)
+	modified, err = timerSequence.CreateNextActivityTimer()
+	s.NoError(err)
+	s.False(modified) // the activity timeout is handled by the user timer task
+	s.Len(mutableState.InsertTasks[tasks.CategoryTimer], 1)
+	task := mutableState.InsertTasks[tasks.CategoryTimer][0]
+
+	timerTask := &tasks.UserTimerTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version:             s.version,
+		TaskID:              int64(100),
+		VisibilityTimestamp: task.(*tasks.UserTimerTask).VisibilityTimestamp,
+		EventID:             timerEvent.EventId,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, scheduledEvent.GetEventId(), scheduledEvent.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(tests.UpdateWorkflowExecutionResponse, nil)
+
+	s.timeSource.Update(s.now.Add(2 * activityTimeout))
+	resp := s.timerQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(timerTask))
+	s.NoError(resp.ExecutionErr)
+
+	updatedMutableState := s.getMutableStateFromCache(s.namespaceID, execution.GetWorkflowId(), execution.GetRunId())
+	_, ok := updatedMutableState.GetUserTimerInfo(timerID)
+	s.False(ok)
+	_, ok = updatedMutableState.GetActivityInfo(scheduledEvent.GetEventId())
+	s.False(ok)
+}
+
+func (s *timerQueueActiveTaskExecutorSuite) TestProcessActivityTimeout_CoalescedUserTimer() {
+	s.mockShard.GetConfig().TimerCoalescingWindow = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(2 * time.Second)
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(200 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	activityTimeout := 2 * time.Second
+	scheduledEvent, _ := addActivityTaskScheduledEvent(
+		mutableState,
+		event.GetEventId(),
+		"activity",
+		"activity type",
+		"taskqueue",
+		nil,
+		activityTimeout,
+		activityTimeout,
+		activityTimeout,
+		activityTimeout,
+	)
+	timerID := "timer"
+	timerTimeout := 3 * time.Second
+	addTimerStartedEvent(mutableState, event.GetEventId(), timerID, timerTimeout)
+
+	timerSequence := workflow.NewCoalescingTimerSequence(mutableState, s.mockShard.GetConfig(), metrics.NoopMetricsHandler)
+	mutableState.InsertTasks[tasks.CategoryTimer] = nil
+	modified, err := timerSequence.CreateNextActivityTimer()
+	s.NoError(err)
+	s.True(modified)
+	modified, err = timerSequence.CreateNextUserTimer()
+	s.NoError(err)
+	s.False(modified) // the user timer is handled by the activity timeout task
+	s.Len(mutableState.InsertTasks[tasks.CategoryTimer], 1)
+	task := mutableState.InsertTasks[tasks.CategoryTimer][0].(*tasks.ActivityTimeoutTask)
+
+	timerTask := &tasks.ActivityTimeoutTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Attempt:             1,
+		Version:             s.version,
+		TaskID:              int64(100),
+		TimeoutType:         task.TimeoutType,
+		VisibilityTimestamp: task.VisibilityTimestamp,
+		EventID:             scheduledEvent.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, scheduledEvent.GetEventId(), scheduledEvent.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockExecutionMgr.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(tests.UpdateWorkflowExecutionResponse, nil)
+
+	s.timeSource.Update(s.now.Add(2 * timerTimeout))
+	resp := s.timerQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(timerTask))
+	s.NoError(resp.ExecutionErr)
+
+	updatedMutableState := s.getMutableStateFromCache(s.namespaceID, execution.GetWorkflowId(), execution.GetRunId())
+	_, ok := updatedMutableState.GetActivityInfo(scheduledEvent.GetEventId())
+	s.False(ok)
+	_, ok = updatedMutableState.GetUserTimerInfo(timerID)
+	s.False(ok)
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowTaskTimeout_Fire() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -1281,6 +1456,101 @@ func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 	s.NoError(resp.ExecutionErr)
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_FireAheadOfRetryTime() {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType: &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: taskQueueName,
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				WorkflowRunTimeout:  durationpb.New(200 * time.Second),
+				WorkflowTaskTimeout: durationpb.New(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+
+	activityID := "activity"
+	activityType := "activity type"
+	timerTimeout := 2 * time.Second
+	scheduledEvent, activityInfo := addActivityTaskScheduledEventWithRetry(
+		mutableState,
+		event.GetEventId(),
+		activityID,
+		activityType,
+		taskQueueName,
+		nil,
+		timerTimeout,
+		timerTimeout,
+		timerTimeout,
+		timerTimeout,
+		&commonpb.RetryPolicy{
+			InitialInterval:        durationpb.New(1 * time.Second),
+			BackoffCoefficient:     1.2,
+			MaximumInterval:        durationpb.New(5 * time.Second),
+			MaximumAttempts:        5,
+			NonRetryableErrorTypes: []string{"（╯' - ')╯ ┻━┻ "},
+		},
+	)
+	activityInfo.Attempt = 1
+	// the timer fires ahead of the retry time, matching holds the task until then
+	activityInfo.ScheduledTime = timestamppb.New(s.now.Add(10 * time.Second))
+
+	timerTask := &tasks.ActivityRetryTimerTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		Version:             s.version,
+		TaskID:              int64(100),
+		VisibilityTimestamp: s.now,
+		EventID:             activityInfo.ScheduledEventId,
+		Attempt:             activityInfo.Attempt,
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, scheduledEvent.GetEventId(), scheduledEvent.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockMatchingClient.EXPECT().AddActivityTask(
+		gomock.Any(),
+		pm.Eq(&matchingservice.AddActivityTaskRequest{
+			NamespaceId: s.namespaceID.String(),
+			Execution:   execution,
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: activityInfo.TaskQueue,
+				Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+			},
+			ScheduledEventId:       activityInfo.ScheduledEventId,
+			ScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout,
+			Clock:                  vclock.NewVectorClock(s.mockClusterMetadata.GetClusterID(), s.mockShard.GetShardID(), timerTask.TaskID),
+			VersionDirective:       worker_versioning.MakeUseAssignmentRulesDirective(),
+			VisibilityTime:         activityInfo.ScheduledTime,
+		}),
+		gomock.Any(),
+	).Return(&matchingservice.AddActivityTaskResponse{}, nil)
+
+	resp := s.timerQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(timerTask))
+	s.NoError(resp.ExecutionErr)
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) TestActivityRetryTimer_Noop() {
 	execution := &commonpb.WorkflowExecution{
 		WorkflowId: "some random workflow ID",
@@ -77,12 +77,17 @@ func NewTimerQueueFactory(
 			HostScheduler: queues.NewScheduler(
 				params.ClusterMetadata.GetCurrentClusterName(),
 				queues.SchedulerOptions{
-					WorkerCount:             params.Config.TimerProcessorSchedulerWorkerCount,
-					ActiveNamespaceWeights:  params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
-					StandbyNamespaceWeights: params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
+					WorkerCount:                 params.Config.TimerProcessorSchedulerWorkerCount,
+					ActiveNamespaceWeights:      params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
+					StandbyNamespaceWeights:     params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
+					EnableEarliestDeadlineFirst: params.Config.TaskSchedulerEnableEarliestDeadlineFirst,
+					DeadlineBudgets:             params.Config.TaskSchedulerDeadlineBudgets,
+					DefaultDeadlineBudget:       params.Config.TaskSchedulerDefaultDeadlineBudget,
 				},
 				params.NamespaceRegistry,
+				params.TimeSource,
 				params.Logger,
+				params.MetricsHandler,
 			),
 			HostPriorityAssigner: queues.NewPriorityAssigner(),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
@@ -236,9 +241,12 @@ func (f *timerQueueFactory) CreateQueue(
 				PollBackoffInterval:  f.Config.TimerProcessorPollBackoffInterval,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:          f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:        f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:        f.Config.QueueCriticalSlicesCount,
+				WorkflowPendingTasksCriticalShare:  f.Config.QueueWorkflowPendingTaskCriticalShare,
+				NamespacePendingTasksCriticalShare: f.Config.QueueNamespacePendingTaskCriticalShare,
+				TaskTypeFailingCriticalCount:       f.Config.QueueTaskTypeFailingCriticalCount,
 			},
 			MaxPollRPS:                          f.Config.TimerProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.TimerProcessorMaxPollInterval,
@@ -155,6 +155,10 @@ func (t *timerQueueStandbyTaskExecutor) executeUserTimerTimeoutTask(
 			// Since the user timers are already sorted, then if there is one timer which is not expired,
 			// all user timers after that timer are not expired.
 		}
+		// activity timeouts may have been coalesced into this task, see workflow.NewCoalescingTimerSequence
+		if t.coalescedTimerExpired(mutableState, timerSequence.LoadAndSortActivityTimers(), timerTask) {
+			return getHistoryResendInfo(mutableState)
+		}
 		// If there is no user timer expired, then we are good.
 		return nil, nil
 	}
@@ -211,6 +215,10 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityTimeoutTask(
 			// Since the activity timers are already sorted, then if there is one timer which is not expired,
 			// all activity timers after that timer are not expired.
 		}
+		// user timers may have been coalesced into this task, see workflow.NewCoalescingTimerSequence
+		if t.coalescedTimerExpired(mutableState, timerSequence.LoadAndSortUserTimers(), timerTask) {
+			return getHistoryResendInfo(mutableState)
+		}
 
 		// for reason to update mutable state & generate a new activity task,
 		// see comments at the beginning of this function.
@@ -463,10 +471,30 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowExecutionTimeoutTask(
 	)
 }
 
+// coalescedTimerExpired returns whether the first of the given timers, which are of another kind than the timer task,
+// is expired at the visibility time of the task while timer coalescing is enabled.
+//
+// The standby side cannot tell which task a timer was coalesced into, but it can rely on the invariant maintained by
+// workflow.NewCoalescingTimerSequence: a coalescing task is never visible before any of the timers it covers, since
+// its visibility time is the latest timestamp among them. So a timer of the other kind that has not expired at the
+// visibility time of this task cannot be covered by it. One that has expired may be covered by it, or may have a task
+// of its own; either way the active side is going to handle it, so waiting for its history is safe and at worst
+// delays acknowledging this task until the other timer is processed.
+func (t *timerQueueStandbyTaskExecutor) coalescedTimerExpired(
+	mutableState workflow.MutableState,
+	timerSequenceIDs []workflow.TimerSequenceID,
+	timerTask tasks.Task,
+) bool {
+	if len(timerSequenceIDs) == 0 || t.config.TimerCoalescingWindow(mutableState.GetNamespaceEntry().Name().String()) <= 0 {
+		return false
+	}
+	return queues.IsTimeExpired(timerTask.GetVisibilityTime(), timerSequenceIDs[0].Timestamp)
+}
+
 func (t *timerQueueStandbyTaskExecutor) getTimerSequence(
 	mutableState workflow.MutableState,
 ) workflow.TimerSequence {
-	return workflow.NewTimerSequence(mutableState)
+	return workflow.NewCoalescingTimerSequence(mutableState, t.config, t.metricHandler)
 }
 
 func (t *timerQueueStandbyTaskExecutor) processTimer(
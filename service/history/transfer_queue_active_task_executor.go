@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
@@ -55,6 +56,7 @@ import (
 	"go.temporal.io/server/common/sdk"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/worker_versioning"
+	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/ndc"
@@ -157,6 +159,8 @@ func (t *transferQueueActiveTaskExecutor) Execute(
 		err = t.processResetWorkflow(ctx, task)
 	case *tasks.DeleteExecutionTask:
 		err = t.processDeleteExecutionTask(ctx, task)
+	case *tasks.DeleteOffloadedPayloadsTask:
+		err = t.processDeleteOffloadedPayloadsTask(ctx, task)
 	default:
 		err = errUnknownTransferTask
 	}
@@ -212,6 +216,18 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 		return consts.ErrWorkflowCompleted
 	}
 
+	if ai.Paused {
+		// the activity is rescheduled when it is unpaused
+		release(nil) // release(nil) so that the mutable state is not unloaded from cache
+		return nil
+	}
+
+	if mutableState.IsWorkflowExecutionSuspended() {
+		// the activity is rescheduled when the workflow is resumed
+		release(nil) // release(nil) so that the mutable state is not unloaded from cache
+		return nil
+	}
+
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 
@@ -253,6 +269,12 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		return err
 	}
 
+	if mutableState.IsWorkflowExecutionSuspended() {
+		// the workflow task is rescheduled when the workflow is resumed
+		release(nil) // release(nil) so that the mutable state is not unloaded from cache
+		return nil
+	}
+
 	// Task queue from transfer task (not current one from mutable state) must be used here.
 	// If current task queue becomes sticky since this transfer task was created,
 	// it can't be used here, because timeout timer was not created for it,
@@ -368,6 +390,7 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 
 	namespaceName := mutableState.GetNamespaceEntry().Name()
 	children := copyChildWorkflowInfos(mutableState.GetPendingChildExecutionInfos())
+	concurrencyLimitKeys := slices.Clone(executionInfo.ConcurrencyLimitKeys)
 
 	// NOTE: do not access anything related mutable state after this lock release.
 	// Release lock immediately since mutable state is not needed
@@ -410,6 +433,19 @@ func (t *transferQueueActiveTaskExecutor) processCloseExecution(
 		return err
 	}
 
+	// Release the slots of the concurrency limits held by the workflow, unless it continued as a new run
+	err = api.ReleaseConcurrencyLimits(
+		ctx,
+		t.shardContext,
+		namespace.ID(task.GetNamespaceID()),
+		task.GetWorkflowID(),
+		task.GetRunID(),
+		concurrencyLimitKeys,
+	)
+	if err != nil {
+		return err
+	}
+
 	if task.DeleteAfterClose {
 		err = t.deleteExecution(
 			ctx,
@@ -970,10 +1006,27 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 	logger = log.With(logger, tag.WorkflowNamespace(namespaceEntry.Name().String()))
 
 	reason, resetPoint := workflow.FindAutoResetPoint(t.shardContext.GetTimeSource(), namespaceEntry.VerifyBinaryChecksum, executionInfo.AutoResetPoints)
+	nonDeterministicAutoReset := false
+	if resetPoint == nil && currentMutableState.IsWorkflowExecutionRunning() {
+		reason, resetPoint = workflow.FindNonDeterministicAutoResetPoint(
+			t.shardContext.GetTimeSource(),
+			t.config,
+			namespaceEntry.Name().String(),
+			executionInfo,
+		)
+		nonDeterministicAutoReset = resetPoint != nil
+	}
 	if resetPoint == nil {
 		logger.Warn("Auto-Reset is skipped, because reset point is not found.")
 		return nil
 	}
+	if nonDeterministicAutoReset {
+		logger = log.With(logger, tag.BuildId(executionInfo.NonDeterministicFailureBuildId))
+		if workflow.IsAutoResetLimitReached(t.shardContext.GetTimeSource(), t.config, namespaceEntry.Name().String(), executionInfo) {
+			logger.Warn("Auto-Reset is skipped, because max automatic resets within the window is reached.")
+			return nil
+		}
+	}
 	logger = log.With(
 		logger,
 		tag.WorkflowResetBaseRunID(resetPoint.GetRunId()),
@@ -1009,9 +1062,14 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 		}
 	}
 
+	if nonDeterministicAutoReset {
+		// recorded on the current run, the reset carries it over to the new run
+		workflow.AddAutoResetTime(t.shardContext.GetTimeSource(), t.config, namespaceEntry.Name().String(), executionInfo)
+	}
+
 	// NOTE: reset need to go through history which may take a longer time,
 	// so it's using its own timeout
-	return t.resetWorkflow(
+	if err := t.resetWorkflow(
 		ctx,
 		task,
 		reason,
@@ -1020,7 +1078,18 @@ func (t *transferQueueActiveTaskExecutor) processResetWorkflow(
 		currentContext,
 		currentMutableState,
 		logger,
-	)
+	); err != nil {
+		return err
+	}
+	if nonDeterministicAutoReset {
+		metrics.AutoResetNonDeterministicErrorCounter.With(t.metricHandler).Record(
+			1,
+			metrics.OperationTag(metrics.OperationTransferQueueProcessorScope),
+			metrics.NamespaceTag(namespaceEntry.Name().String()),
+		)
+		logger.Info("Auto-Reset workflow for non-deterministic workflow task failures")
+	}
+	return nil
 }
 
 func (t *transferQueueActiveTaskExecutor) recordChildExecutionStarted(
@@ -58,13 +58,16 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/payloadstore"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -2391,6 +2394,31 @@ func (s *transferQueueActiveTaskExecutorSuite) TestProcessorStartChildExecution_
 	s.Nil(resp.ExecutionErr)
 }
 
+func (s *transferQueueActiveTaskExecutorSuite) TestProcessDeleteOffloadedPayloadsTask() {
+	store, err := payloadstore.NewFilestore(s.T().TempDir(), &config.FilestoreArchiver{FileMode: "0666", DirMode: "0766"})
+	s.NoError(err)
+	offloader := payloadstore.NewOffloader(store, dynamicconfig.GetIntPropertyFilteredByNamespace(1))
+	s.mockShard.SetPayloadOffloaderForTesting(offloader)
+
+	references := payloads.EncodeString("offloaded")
+	keys, err := offloader.Offload(context.Background(), tests.NamespaceID, tests.Namespace, references)
+	s.NoError(err)
+	s.Len(keys, 1)
+
+	transferTask := &tasks.DeleteOffloadedPayloadsTask{
+		WorkflowKey:         tests.WorkflowKey,
+		VisibilityTimestamp: time.Now().UTC(),
+		TaskID:              int64(59),
+		Version:             common.EmptyVersion,
+		Keys:                keys,
+	}
+
+	// the run is already deleted, so the task must not need its mutable state
+	resp := s.transferQueueActiveTaskExecutor.Execute(context.Background(), s.newTaskExecutable(transferTask))
+	s.NoError(resp.ExecutionErr)
+	s.ErrorContains(offloader.Rehydrate(context.Background(), references), payloadstore.ErrPayloadNotFound.Error())
+}
+
 func (s *transferQueueActiveTaskExecutorSuite) createAddActivityTaskRequest(
 	task *tasks.ActivityTask,
 	ai *persistencespb.ActivityInfo,
@@ -79,12 +79,17 @@ func NewTransferQueueFactory(
 			HostScheduler: queues.NewScheduler(
 				params.ClusterMetadata.GetCurrentClusterName(),
 				queues.SchedulerOptions{
-					WorkerCount:             params.Config.TransferProcessorSchedulerWorkerCount,
-					ActiveNamespaceWeights:  params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
-					StandbyNamespaceWeights: params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
+					WorkerCount:                 params.Config.TransferProcessorSchedulerWorkerCount,
+					ActiveNamespaceWeights:      params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
+					StandbyNamespaceWeights:     params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
+					EnableEarliestDeadlineFirst: params.Config.TaskSchedulerEnableEarliestDeadlineFirst,
+					DeadlineBudgets:             params.Config.TaskSchedulerDeadlineBudgets,
+					DefaultDeadlineBudget:       params.Config.TaskSchedulerDefaultDeadlineBudget,
 				},
 				params.NamespaceRegistry,
+				params.TimeSource,
 				params.Logger,
+				params.MetricsHandler,
 			),
 			HostPriorityAssigner: queues.NewPriorityAssigner(),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
@@ -228,9 +233,12 @@ func (f *transferQueueFactory) CreateQueue(
 				PollBackoffInterval:  f.Config.TransferProcessorPollBackoffInterval,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:          f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:        f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:        f.Config.QueueCriticalSlicesCount,
+				WorkflowPendingTasksCriticalShare:  f.Config.QueueWorkflowPendingTaskCriticalShare,
+				NamespacePendingTasksCriticalShare: f.Config.QueueNamespacePendingTaskCriticalShare,
+				TaskTypeFailingCriticalCount:       f.Config.QueueTaskTypeFailingCriticalCount,
 			},
 			MaxPollRPS:                          f.Config.TransferProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.TransferProcessorMaxPollInterval,
@@ -130,6 +130,9 @@ func (t *transferQueueStandbyTaskExecutor) Execute(
 		err = t.processCloseExecution(ctx, task)
 	case *tasks.DeleteExecutionTask:
 		err = t.processDeleteExecutionTask(ctx, task, false)
+	case *tasks.DeleteOffloadedPayloadsTask:
+		// the payload store is shared by all clusters, so whichever cluster processes the task deletes the payloads
+		err = t.processDeleteOffloadedPayloadsTask(ctx, task)
 	default:
 		err = errUnknownTransferTask
 	}
@@ -221,6 +221,19 @@ func (t *transferQueueTaskExecutorBase) processDeleteExecutionTask(
 	return t.deleteExecution(ctx, task, false, ensureNoPendingCloseTask, &task.ProcessStage)
 }
 
+// processDeleteOffloadedPayloadsTask deletes the payloads offloaded by a deleted workflow run. The run is gone, so
+// unlike other transfer tasks this one neither loads the mutable state nor holds the workflow lock while it waits on
+// the payload store.
+func (t *transferQueueTaskExecutorBase) processDeleteOffloadedPayloadsTask(
+	ctx context.Context,
+	task *tasks.DeleteOffloadedPayloadsTask,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, taskTimeout)
+	defer cancel()
+
+	return t.shardContext.GetPayloadOffloader().Delete(ctx, task.Keys)
+}
+
 func (t *transferQueueTaskExecutorBase) deleteExecution(
 	ctx context.Context,
 	task tasks.Task,
@@ -65,12 +65,17 @@ func NewVisibilityQueueFactory(
 			HostScheduler: queues.NewScheduler(
 				params.ClusterMetadata.GetCurrentClusterName(),
 				queues.SchedulerOptions{
-					WorkerCount:             params.Config.VisibilityProcessorSchedulerWorkerCount,
-					ActiveNamespaceWeights:  params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
-					StandbyNamespaceWeights: params.Config.VisibilityProcessorSchedulerStandbyRoundRobinWeights,
+					WorkerCount:                 params.Config.VisibilityProcessorSchedulerWorkerCount,
+					ActiveNamespaceWeights:      params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
+					StandbyNamespaceWeights:     params.Config.VisibilityProcessorSchedulerStandbyRoundRobinWeights,
+					EnableEarliestDeadlineFirst: params.Config.TaskSchedulerEnableEarliestDeadlineFirst,
+					DeadlineBudgets:             params.Config.TaskSchedulerDeadlineBudgets,
+					DefaultDeadlineBudget:       params.Config.TaskSchedulerDefaultDeadlineBudget,
 				},
 				params.NamespaceRegistry,
+				params.TimeSource,
 				params.Logger,
+				params.MetricsHandler,
 			),
 			HostPriorityAssigner: queues.NewPriorityAssigner(),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
@@ -157,9 +162,12 @@ func (f *visibilityQueueFactory) CreateQueue(
 				PollBackoffInterval:  f.Config.VisibilityProcessorPollBackoffInterval,
 			},
 			MonitorOptions: queues.MonitorOptions{
-				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
-				ReaderStuckCriticalAttempts: f.Config.QueueReaderStuckCriticalAttempts,
-				SliceCountCriticalThreshold: f.Config.QueueCriticalSlicesCount,
+				PendingTasksCriticalCount:          f.Config.QueuePendingTaskCriticalCount,
+				ReaderStuckCriticalAttempts:        f.Config.QueueReaderStuckCriticalAttempts,
+				SliceCountCriticalThreshold:        f.Config.QueueCriticalSlicesCount,
+				WorkflowPendingTasksCriticalShare:  f.Config.QueueWorkflowPendingTaskCriticalShare,
+				NamespacePendingTasksCriticalShare: f.Config.QueueNamespacePendingTaskCriticalShare,
+				TaskTypeFailingCriticalCount:       f.Config.QueueTaskTypeFailingCriticalCount,
 			},
 			MaxPollRPS:                          f.Config.VisibilityProcessorMaxPollRPS,
 			MaxPollInterval:                     f.Config.VisibilityProcessorMaxPollInterval,
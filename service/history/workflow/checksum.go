@@ -28,6 +28,7 @@ import (
 	"fmt"
 
 	checksumspb "go.temporal.io/server/api/checksum/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/checksum"
 	"go.temporal.io/server/common/util"
@@ -107,3 +108,59 @@ func newMutableStateChecksumPayload(ms MutableState) *checksumspb.MutableStateCh
 	payload.PendingReqCancelInitiatedEventIds = requestCancelIDs
 	return payload
 }
+
+// GenerateReplicatedMutableStateChecksum generates the checksum of a persisted mutable state, leaving out the
+// fields which are local to a cluster: the sticky task queue, the in-flight workflow task and the branch tokens.
+// The checksums of a workflow execution in sync between clusters are equal.
+func GenerateReplicatedMutableStateChecksum(state *persistencespb.WorkflowMutableState) (*persistencespb.Checksum, error) {
+	executionInfo := state.GetExecutionInfo()
+	payload := &checksumspb.MutableStateChecksumPayload{
+		CancelRequested:            executionInfo.GetCancelRequested(),
+		State:                      state.GetExecutionState().GetState(),
+		LastFirstEventId:           executionInfo.GetLastFirstEventId(),
+		NextEventId:                state.GetNextEventId(),
+		LastProcessedEventId:       executionInfo.GetLastWorkflowTaskStartedEventId(),
+		ActivityCount:              executionInfo.GetActivityCount(),
+		ChildExecutionCount:        executionInfo.GetChildExecutionCount(),
+		UserTimerCount:             executionInfo.GetUserTimerCount(),
+		RequestCancelExternalCount: executionInfo.GetRequestCancelExternalCount(),
+		SignalExternalCount:        executionInfo.GetSignalExternalCount(),
+		SignalCount:                executionInfo.GetSignalCount(),
+	}
+
+	if versionHistories := executionInfo.GetVersionHistories(); versionHistories != nil {
+		histories := make([]*historyspb.VersionHistory, 0, len(versionHistories.GetHistories()))
+		for _, versionHistory := range versionHistories.GetHistories() {
+			histories = append(histories, &historyspb.VersionHistory{Items: versionHistory.GetItems()})
+		}
+		payload.VersionHistories = &historyspb.VersionHistories{
+			CurrentVersionHistoryIndex: versionHistories.GetCurrentVersionHistoryIndex(),
+			Histories:                  histories,
+		}
+	}
+
+	pendingTimerIDs := make([]int64, 0, len(state.GetTimerInfos()))
+	for _, ti := range state.GetTimerInfos() {
+		pendingTimerIDs = append(pendingTimerIDs, ti.GetStartedEventId())
+	}
+	util.SortSlice(pendingTimerIDs)
+	payload.PendingTimerStartedEventIds = pendingTimerIDs
+
+	pendingActivityIDs := maps.Keys(state.GetActivityInfos())
+	util.SortSlice(pendingActivityIDs)
+	payload.PendingActivityScheduledEventIds = pendingActivityIDs
+
+	pendingChildIDs := maps.Keys(state.GetChildExecutionInfos())
+	util.SortSlice(pendingChildIDs)
+	payload.PendingChildInitiatedEventIds = pendingChildIDs
+
+	signalIDs := maps.Keys(state.GetSignalInfos())
+	util.SortSlice(signalIDs)
+	payload.PendingSignalInitiatedEventIds = signalIDs
+
+	requestCancelIDs := maps.Keys(state.GetRequestCancelInfos())
+	util.SortSlice(requestCancelIDs)
+	payload.PendingReqCancelInitiatedEventIds = requestCancelIDs
+
+	return checksum.GenerateCRC32(payload, mutableStateChecksumPayloadV1)
+}
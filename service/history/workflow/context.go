@@ -936,6 +936,11 @@ func (c *ContextImpl) UpdateRegistry(ctx context.Context, ms MutableState) updat
 					return c.config.WorkflowExecutionMaxTotalUpdates(nsIDStr)
 				},
 			),
+			update.WithDurableAdmission(
+				func() bool {
+					return c.config.EnableDurableUpdateAdmission(ms.GetNamespaceEntry().Name().String())
+				},
+			),
 		)
 	}
 	return c.updateRegistry
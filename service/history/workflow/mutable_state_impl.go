@@ -165,6 +165,9 @@ type (
 		// Running approximate total size of mutable state fields (except buffered events) when written to DB in bytes.
 		// Buffered events are added to this value when calling GetApproximatePersistedSize.
 		approximateSize int
+		// Indexes of the signal request ID and update ID dedup windows, built the first time an entry is added.
+		signalRequestIDDedupWindow *requestIDDedupWindow
+		updateIDDedupWindow        *requestIDDedupWindow
 		// Buffer events from DB
 		bufferEventsInDB []*historypb.HistoryEvent
 		// Indicates the workflow state in DB, can be used to calculate
@@ -186,6 +189,8 @@ type (
 		// The flag will be unset whenever workflow task successfully completed, timedout or failed
 		// due to cause other than UnhandledCommand.
 		workflowCloseAttempted bool
+		// A flag indicating if a workflow task failed with a non-deterministic error in the current transaction.
+		nonDeterministicFailureRecorded bool
 
 		InsertTasks map[tasks.Category][]tasks.Task
 
@@ -485,6 +490,9 @@ func NewMutableStateInChain(
 
 	// carry over necessary fields from current mutable state
 	newMutableState.executionInfo.WorkflowExecutionTimerTaskStatus = currentMutableState.GetExecutionInfo().WorkflowExecutionTimerTaskStatus
+	newMutableState.CarryOverRequestIDDedupWindows(currentMutableState.GetExecutionInfo())
+	// the slots of the concurrency limits are handed over to the new run when the current run closes
+	newMutableState.executionInfo.ConcurrencyLimitKeys = slices.Clone(currentMutableState.GetExecutionInfo().GetConcurrencyLimitKeys())
 
 	// TODO: Today other information like autoResetPoints, previousRunID, firstRunID, etc.
 	// are carried over in AddWorkflowExecutionStartedEventWithOptions. Ideally all information
@@ -678,7 +686,8 @@ func (ms *MutableStateImpl) FlushBufferedEvents() {
 	if ms.HasStartedWorkflowTask() {
 		return
 	}
-	ms.updatePendingEventIDs(ms.hBuilder.FlushBufferToCurrentBatch())
+	scheduledIDToStartedID := ms.hBuilder.FlushBufferToCurrentBatch()
+	ms.updatePendingEventIDs(scheduledIDToStartedID, ms.hBuilder.FlushedUpdateAdmissions())
 }
 
 func (ms *MutableStateImpl) UpdateCurrentVersion(
@@ -1425,6 +1434,8 @@ func (ms *MutableStateImpl) UpdateActivityInfo(
 	ai.Attempt = incomingActivityInfo.GetAttempt()
 	ai.RetryLastWorkerIdentity = incomingActivityInfo.GetLastWorkerIdentity()
 	ai.RetryLastFailure = incomingActivityInfo.LastFailure
+	pausedChanged := ai.Paused != incomingActivityInfo.GetPaused()
+	ai.Paused = incomingActivityInfo.GetPaused()
 
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = TimerTaskStatusNone
@@ -1432,6 +1443,9 @@ func (ms *MutableStateImpl) UpdateActivityInfo(
 
 	ms.updateActivityInfos[ai.ScheduledEventId] = ai
 	ms.approximateSize += ai.Size()
+	if pausedChanged {
+		return ms.updatePausedActivitiesSearchAttribute()
+	}
 	return nil
 }
 
@@ -1475,7 +1489,9 @@ func (ms *MutableStateImpl) DeleteActivity(
 	scheduledEventID int64,
 ) error {
 
+	paused := false
 	if activityInfo, ok := ms.pendingActivityInfoIDs[scheduledEventID]; ok {
+		paused = activityInfo.Paused
 		delete(ms.pendingActivityInfoIDs, scheduledEventID)
 		delete(ms.pendingActivityTimerHeartbeats, scheduledEventID)
 		ms.approximateSize -= activityInfo.Size() + int64SizeBytes
@@ -1502,6 +1518,10 @@ func (ms *MutableStateImpl) DeleteActivity(
 	delete(ms.updateActivityInfos, scheduledEventID)
 	delete(ms.syncActivityTasks, scheduledEventID)
 	ms.deleteActivityInfos[scheduledEventID] = struct{}{}
+
+	if paused {
+		return ms.updatePausedActivitiesSearchAttribute()
+	}
 	return nil
 }
 
@@ -1680,6 +1700,12 @@ func (ms *MutableStateImpl) GetMostRecentWorkerVersionStamp() *commonpb.WorkerVe
 	return ms.executionInfo.MostRecentWorkerVersionStamp
 }
 
+// keepBufferedEvents returns true if buffered events must not be flushed to history when the transaction is closed:
+// a started workflow task has not completed yet, or the workflow is suspended.
+func (ms *MutableStateImpl) keepBufferedEvents() bool {
+	return ms.HasStartedWorkflowTask() || (ms.IsWorkflowExecutionSuspended() && ms.IsWorkflowExecutionRunning())
+}
+
 func (ms *MutableStateImpl) HasBufferedEvents() bool {
 	return ms.hBuilder.HasBufferEvents()
 }
@@ -1726,6 +1752,12 @@ func (ms *MutableStateImpl) IsCancelRequested() bool {
 	return ms.executionInfo.CancelRequested
 }
 
+// IsWorkflowExecutionSuspended returns true if the workflow execution is suspended. See
+// AddWorkflowExecutionSuspendedEvent.
+func (ms *MutableStateImpl) IsWorkflowExecutionSuspended() bool {
+	return ms.executionInfo.Suspended
+}
+
 func (ms *MutableStateImpl) IsWorkflowCloseAttempted() bool {
 	return ms.workflowCloseAttempted
 }
@@ -1737,7 +1769,28 @@ func (ms *MutableStateImpl) IsSignalRequested(
 	if _, ok := ms.pendingSignalRequestedIDs[requestID]; ok {
 		return true
 	}
-	return false
+	return isInRequestIDDedupWindow(
+		ms.timeSource,
+		ms.config,
+		ms.namespaceEntry.Name().String(),
+		ms.executionInfo.SignalRequestIdDedupWindow,
+		requestID,
+	)
+}
+
+// IsUpdateInDedupWindow returns true if an update with the given ID was accepted by this run, or by a previous run
+// of the workflow it was carried over from, within the update ID dedup window.
+func (ms *MutableStateImpl) IsUpdateInDedupWindow(
+	updateID string,
+) bool {
+
+	return isInRequestIDDedupWindow(
+		ms.timeSource,
+		ms.config,
+		ms.namespaceEntry.Name().String(),
+		ms.executionInfo.UpdateIdDedupWindow,
+		updateID,
+	)
 }
 
 func (ms *MutableStateImpl) IsWorkflowPendingOnWorkflowTaskBackoff() bool {
@@ -1753,7 +1806,7 @@ func (ms *MutableStateImpl) IsWorkflowPendingOnWorkflowTaskBackoff() bool {
 // persistence + size of buffered events in history builder if they will not be flushed
 func (ms *MutableStateImpl) GetApproximatePersistedSize() int {
 	// include buffered events in the size if they will not be flushed
-	if ms.BufferSizeAcceptable() && ms.HasStartedWorkflowTask() {
+	if ms.BufferSizeAcceptable() && ms.keepBufferedEvents() {
 		return ms.approximateSize + ms.hBuilder.SizeInBytesOfBufferedEvents()
 	}
 	return ms.approximateSize
@@ -1772,6 +1825,65 @@ func (ms *MutableStateImpl) AddSignalRequested(
 	ms.pendingSignalRequestedIDs[requestID] = struct{}{}
 	ms.updateSignalRequestedIDs[requestID] = struct{}{}
 	ms.approximateSize += len(requestID)
+
+	// unlike the requested IDs above, which are removed once the signal is delivered,
+	// the dedup window remembers the request ID across runs until it expires
+	ms.addToRequestIDDedupWindow(
+		&ms.signalRequestIDDedupWindow,
+		&ms.executionInfo.SignalRequestIdDedupWindow,
+		requestID,
+		ms.timeSource.Now(),
+	)
+}
+
+// CarryOverRequestIDDedupWindows merges the unexpired entries of the signal request ID and update ID dedup windows
+// of a previous run, which continued-as-new or was reset to this one, into the dedup windows of this run.
+// NOTE: signal request IDs are not part of the history events, so only state based replication carries them to the
+// standby clusters; update IDs are recorded again from the accepted events.
+func (ms *MutableStateImpl) CarryOverRequestIDDedupWindows(
+	previousExecutionInfo *persistencespb.WorkflowExecutionInfo,
+) {
+
+	for requestID, acceptTime := range previousExecutionInfo.GetSignalRequestIdDedupWindow() {
+		ms.addToRequestIDDedupWindow(
+			&ms.signalRequestIDDedupWindow,
+			&ms.executionInfo.SignalRequestIdDedupWindow,
+			requestID,
+			acceptTime.AsTime(),
+		)
+	}
+	for updateID, acceptTime := range previousExecutionInfo.GetUpdateIdDedupWindow() {
+		ms.addToRequestIDDedupWindow(
+			&ms.updateIDDedupWindow,
+			&ms.executionInfo.UpdateIdDedupWindow,
+			updateID,
+			acceptTime.AsTime(),
+		)
+	}
+}
+
+func (ms *MutableStateImpl) addToRequestIDDedupWindow(
+	window **requestIDDedupWindow,
+	entries *map[string]*timestamppb.Timestamp,
+	requestID string,
+	acceptTime time.Time,
+) {
+	namespaceName := ms.namespaceEntry.Name().String()
+	maxSize := ms.config.RequestIDDedupWindowSize(namespaceName)
+	if requestID == "" || maxSize <= 0 {
+		return
+	}
+	if *window == nil {
+		*window = newRequestIDDedupWindow(*entries)
+		*entries = (*window).entries
+	}
+	ms.approximateSize += (*window).add(
+		requestID,
+		acceptTime,
+		ms.timeSource.Now(),
+		ms.config.RequestIDDedupWindowTTL(namespaceName),
+		maxSize,
+	)
 }
 
 func (ms *MutableStateImpl) DeleteSignalRequested(
@@ -2312,6 +2424,11 @@ func (ms *MutableStateImpl) addResetPointFromCompletion(
 	return true
 }
 
+func (ms *MutableStateImpl) clearNonDeterministicFailures() {
+	ms.executionInfo.NonDeterministicFailureCount = 0
+	ms.executionInfo.NonDeterministicFailureBuildId = ""
+}
+
 func (ms *MutableStateImpl) UpdateBuildIdAssignment(buildId string) error {
 	ms.executionInfo.AssignedBuildId = buildId
 	limit := ms.config.SearchAttributesSizeOfValueLimit(ms.namespaceEntry.Name().String())
@@ -3485,6 +3602,93 @@ func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedEvent(
 	}
 }
 
+// AddWorkflowExecutionSuspendedEvent suspends a running workflow execution. While the workflow is suspended, the task
+// generator defers workflow tasks, user timers and activity tasks, and new events are kept in the buffer.
+func (ms *MutableStateImpl) AddWorkflowExecutionSuspendedEvent(
+	reason string,
+	identity string,
+) (*historypb.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowSuspended
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if ms.executionInfo.Suspended || ms.HasStartedWorkflowTask() {
+		ms.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(ms.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ms.executionInfo.Suspended),
+		)
+		return nil, ms.createInternalServerError(opTag)
+	}
+
+	event := ms.hBuilder.AddWorkflowExecutionSuspendedEvent()
+	// the suspended event itself must not stay in the buffer
+	ms.FlushBufferedEvents()
+	if err := ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+		return nil, err
+	}
+	// reason and identity are kept by this cluster only, the event doesn't carry them
+	ms.approximateSize -= ms.executionInfo.Size()
+	ms.executionInfo.SuspendReason = reason
+	ms.executionInfo.SuspendIdentity = identity
+	ms.approximateSize += ms.executionInfo.Size()
+	return event, nil
+}
+
+// AddWorkflowExecutionResumedEvent resumes a suspended workflow execution and flushes the events buffered while it
+// was suspended. The tasks deferred while the workflow was suspended are not regenerated here, this is done by
+// TaskRefresher.RefreshDeferredTasks.
+func (ms *MutableStateImpl) AddWorkflowExecutionResumedEvent() (*historypb.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowResumed
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if !ms.executionInfo.Suspended {
+		ms.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(ms.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.Bool(ms.executionInfo.Suspended),
+		)
+		return nil, ms.createInternalServerError(opTag)
+	}
+
+	event := ms.hBuilder.AddWorkflowExecutionResumedEvent()
+	if err := ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+		return nil, err
+	}
+	ms.FlushBufferedEvents()
+	return event, nil
+}
+
+// ApplyWorkflowPropertiesModifiedExternallyEvent applies the suspension or resumption of the workflow execution recorded
+// by a WorkflowPropertiesModifiedExternally event which modifies no property. Suspensions and resumptions alternate, so
+// the event toggles the suspended state. Modifications of workflow properties are not supported.
+func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedExternallyEvent(
+	event *historypb.HistoryEvent,
+) error {
+	attributes := event.GetWorkflowPropertiesModifiedExternallyEventAttributes()
+	if attributes == nil {
+		return serviceerror.NewInternal("wrong event type in call to ApplyWorkflowPropertiesModifiedExternallyEvent")
+	}
+	if attributes.GetNewTaskQueue() != "" ||
+		attributes.GetNewWorkflowTaskTimeout() != nil ||
+		attributes.GetNewWorkflowRunTimeout() != nil ||
+		attributes.GetNewWorkflowExecutionTimeout() != nil ||
+		attributes.GetUpsertedMemo() != nil {
+		return serviceerror.NewUnimplemented("Workflow property modification not implemented")
+	}
+	ms.approximateSize -= ms.executionInfo.Size()
+	ms.executionInfo.Suspended = !ms.executionInfo.Suspended
+	ms.executionInfo.SuspendReason = ""
+	ms.executionInfo.SuspendIdentity = ""
+	ms.approximateSize += ms.executionInfo.Size()
+	return nil
+}
+
 func (ms *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	targetNamespace namespace.Name,
@@ -3800,18 +4004,70 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionUpdateAdmittedEvent(event *his
 			},
 		},
 	}
-	if _, ok := ms.executionInfo.UpdateInfos[updateID]; ok {
-		return serviceerror.NewInternal(fmt.Sprintf("Update ID %s is already present in registry", updateID))
+	if ui, ok := ms.executionInfo.UpdateInfos[updateID]; ok {
+		if ui.GetRequest() == nil {
+			return serviceerror.NewInternal(fmt.Sprintf("Update ID %s is already present in registry", updateID))
+		}
+		// the update is admitted again, e.g. when its UpdateAdmitted event is reapplied
+		sizeBefore := ui.Size()
+		ui.Value = request
+		ms.approximateSize += ui.Size() - sizeBefore
+	} else {
+		ui := updatespb.UpdateInfo{Value: request}
+		ms.executionInfo.UpdateInfos[updateID] = &ui
+		ms.executionInfo.UpdateCount++
+		ms.approximateSize += ui.Size() + len(updateID)
+	}
+	if event.EventId != common.BufferedEventID {
+		ms.writeEventToCache(event)
 	}
-	ui := updatespb.UpdateInfo{Value: request}
-	ms.executionInfo.UpdateInfos[updateID] = &ui
-	ms.executionInfo.UpdateCount++
-	sizeDelta := ui.Size() + len(updateID)
-	ms.approximateSize += sizeDelta
-	ms.writeEventToCache(event)
 	return nil
 }
 
+// RejectWorkflowExecutionUpdateAdmission removes the admission of an update which was written to history, so that the
+// update ID can be admitted again. No event is written since workers do not expect one for an update they rejected.
+// The rejection is implied by history instead: see RemoveUnacceptedUpdateAdmissions.
+func (ms *MutableStateImpl) RejectWorkflowExecutionUpdateAdmission(
+	updateID string,
+) error {
+	if err := ms.checkMutability(tag.WorkflowActionUpdateRejected); err != nil {
+		return err
+	}
+	if !ms.hBuilder.HasWorkflowTaskCompletedEvent() {
+		return serviceerror.NewInternal("durably admitted update can only be rejected when a workflow task is completed")
+	}
+	ms.removeWorkflowExecutionUpdateAdmission(updateID)
+	return nil
+}
+
+// RemoveUnacceptedUpdateAdmissions removes the admissions of updates which were written to history before the workflow
+// task started at workflowTaskStartedEventID, and which are not accepted once all events of the batch completing that
+// workflow task are applied. Such updates were delivered to the worker by the workflow task, and every update delivered
+// by a workflow task is either accepted or rejected by the time it completes. It is used when events are applied, e.g.
+// on passive clusters or when mutable state is rebuilt, to restore the rejections which are not written to history.
+func (ms *MutableStateImpl) RemoveUnacceptedUpdateAdmissions(
+	workflowTaskStartedEventID int64,
+) {
+	for updateID, ui := range ms.executionInfo.GetUpdateInfos() {
+		eventID := ui.GetRequest().GetHistoryPointer().GetEventId()
+		if eventID != common.EmptyEventID && eventID != common.BufferedEventID && eventID < workflowTaskStartedEventID {
+			ms.removeWorkflowExecutionUpdateAdmission(updateID)
+		}
+	}
+}
+
+func (ms *MutableStateImpl) removeWorkflowExecutionUpdateAdmission(
+	updateID string,
+) {
+	ui, ok := ms.executionInfo.GetUpdateInfos()[updateID]
+	if !ok || ui.GetRequest() == nil {
+		// only admissions can be rejected, accepted updates are completed instead
+		return
+	}
+	delete(ms.executionInfo.UpdateInfos, updateID)
+	ms.approximateSize -= ui.Size() + len(updateID)
+}
+
 func (ms *MutableStateImpl) AddWorkflowExecutionUpdateAcceptedEvent(
 	protocolInstanceID string,
 	acceptedRequestMessageId string,
@@ -3857,6 +4113,13 @@ func (ms *MutableStateImpl) ApplyWorkflowExecutionUpdateAcceptedEvent(
 		sizeDelta = ui.Size() + len(updateID)
 	}
 	ms.approximateSize += sizeDelta
+	// recorded when the event is applied, so that the dedup window is rebuilt on the standby clusters as well
+	ms.addToRequestIDDedupWindow(
+		&ms.updateIDDedupWindow,
+		&ms.executionInfo.UpdateIdDedupWindow,
+		updateID,
+		event.GetEventTime().AsTime(),
+	)
 	ms.writeEventToCache(event)
 	return nil
 }
@@ -4584,8 +4847,11 @@ func (ms *MutableStateImpl) RetryActivity(
 		return state, nil
 	}
 	nextAttempt := ai.Attempt + 1
-	if err := ms.taskGenerator.GenerateActivityRetryTasks(ai.ScheduledEventId, activityVisitor.NextScheduledTime(), nextAttempt); err != nil {
-		return enumspb.RETRY_STATE_INTERNAL_SERVER_ERROR, err
+	// a paused activity records the failed attempt, but its next attempt is only scheduled once it is unpaused
+	if !ai.Paused {
+		if err := ms.taskGenerator.GenerateActivityRetryTasks(ai.ScheduledEventId, activityVisitor.NextScheduledTime(), nextAttempt); err != nil {
+			return enumspb.RETRY_STATE_INTERNAL_SERVER_ERROR, err
+		}
 	}
 	// we need to store activity info size since pendingActivityInfoIDs holds pointers to activity
 	// info and if prev found it points to the same activity info as ai, so updating ai will cause
@@ -4606,6 +4872,109 @@ func (ms *MutableStateImpl) RetryActivity(
 	return enumspb.RETRY_STATE_IN_PROGRESS, nil
 }
 
+// PauseActivity marks a pending activity as paused. A paused activity is not dispatched to workers and its
+// failed or timed out attempts are not retried until the activity is unpaused. The paused state is not written to
+// history, it is replicated along with the rest of the activity state.
+func (ms *MutableStateImpl) PauseActivity(
+	ai *persistencespb.ActivityInfo,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskPaused
+	if err := ms.checkMutability(opTag); err != nil {
+		return err
+	}
+	if ai.Paused {
+		return nil
+	}
+	if _, ok := ms.pendingActivityInfoIDs[ai.ScheduledEventId]; !ok {
+		return ErrMissingActivityInfo
+	}
+
+	ms.approximateSize -= ai.Size()
+	ai.Paused = true
+	ai.Version = ms.GetCurrentVersion()
+	ms.approximateSize += ai.Size()
+	ms.updateActivityInfos[ai.ScheduledEventId] = ai
+	ms.syncActivityTasks[ai.ScheduledEventId] = struct{}{}
+	return ms.updatePausedActivitiesSearchAttribute()
+}
+
+// UnpauseActivity clears the paused mark of a pending activity. If no attempt of the activity is in flight, the
+// activity is rescheduled immediately, from its first attempt if resetAttempts is set.
+func (ms *MutableStateImpl) UnpauseActivity(
+	ai *persistencespb.ActivityInfo,
+	resetAttempts bool,
+) error {
+
+	opTag := tag.WorkflowActionActivityTaskUnpaused
+	if err := ms.checkMutability(opTag); err != nil {
+		return err
+	}
+	if !ai.Paused {
+		return nil
+	}
+	if resetAttempts && ai.StartedEventId != common.EmptyEventID {
+		return serviceerror.NewFailedPrecondition("cannot reset attempts of an activity with an attempt in flight")
+	}
+	if _, ok := ms.pendingActivityInfoIDs[ai.ScheduledEventId]; !ok {
+		return ErrMissingActivityInfo
+	}
+
+	ms.approximateSize -= ai.Size()
+	ai.Paused = false
+	ai.Version = ms.GetCurrentVersion()
+	if ai.StartedEventId == common.EmptyEventID {
+		if resetAttempts {
+			ai.Attempt = 1
+		}
+		now := ms.timeSource.Now().In(time.UTC)
+		ai.ScheduledTime = timestamppb.New(now)
+		ai.TimerTaskStatus &^= TimerTaskStatusCreatedScheduleToStart
+		if err := ms.taskGenerator.GenerateActivityRetryTasks(ai.ScheduledEventId, now, ai.Attempt); err != nil {
+			return err
+		}
+	}
+	ms.approximateSize += ai.Size()
+	ms.updateActivityInfos[ai.ScheduledEventId] = ai
+	ms.syncActivityTasks[ai.ScheduledEventId] = struct{}{}
+	return ms.updatePausedActivitiesSearchAttribute()
+}
+
+// updatePausedActivitiesSearchAttribute recomputes the TemporalPausedActivities search attribute from the pending
+// activities and upserts visibility if it changed.
+func (ms *MutableStateImpl) updatePausedActivitiesSearchAttribute() error {
+	var pausedActivityIDs []string
+	for _, ai := range ms.pendingActivityInfoIDs {
+		if ai.Paused {
+			pausedActivityIDs = append(pausedActivityIDs, ai.ActivityId)
+		}
+	}
+
+	searchAttributes := ms.executionInfo.SearchAttributes
+	if len(pausedActivityIDs) == 0 {
+		if _, ok := searchAttributes[searchattribute.TemporalPausedActivities]; !ok {
+			return nil
+		}
+		delete(searchAttributes, searchattribute.TemporalPausedActivities)
+		return ms.taskGenerator.GenerateUpsertVisibilityTask()
+	}
+
+	slices.Sort(pausedActivityIDs)
+	saPayload, err := searchattribute.EncodeValue(pausedActivityIDs, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	if err != nil {
+		return err
+	}
+	if proto.Equal(searchAttributes[searchattribute.TemporalPausedActivities], saPayload) {
+		return nil
+	}
+	if searchAttributes == nil {
+		searchAttributes = make(map[string]*commonpb.Payload, 1)
+		ms.executionInfo.SearchAttributes = searchAttributes
+	}
+	searchAttributes[searchattribute.TemporalPausedActivities] = saPayload
+	return ms.taskGenerator.GenerateUpsertVisibilityTask()
+}
+
 func (ms *MutableStateImpl) truncateRetryableActivityFailure(
 	activityFailure *failurepb.Failure,
 ) *failurepb.Failure {
@@ -5031,6 +5400,8 @@ func (ms *MutableStateImpl) cleanupTransaction(
 	ms.updateSignalRequestedIDs = make(map[string]struct{})
 	ms.deleteSignalRequestedIDs = make(map[string]struct{})
 
+	ms.nonDeterministicFailureRecorded = false
+
 	ms.stateInDB = ms.executionState.State
 	ms.nextEventIDInDB = ms.GetNextEventID()
 	// ms.dbRecordVersion remains the same
@@ -5058,7 +5429,7 @@ func (ms *MutableStateImpl) prepareEventsAndReplicationTasks(
 		return nil, nil, false, err
 	}
 
-	historyMutation, err := ms.hBuilder.Finish(!ms.HasStartedWorkflowTask())
+	historyMutation, err := ms.hBuilder.Finish(!ms.keepBufferedEvents())
 	if err != nil {
 		return nil, nil, false, err
 	}
@@ -5068,7 +5439,7 @@ func (ms *MutableStateImpl) prepareEventsAndReplicationTasks(
 	newBufferBatch := historyMutation.DBBufferBatch
 	clearBuffer := historyMutation.DBClearBuffer
 	newEventsBatches := historyMutation.DBEventsBatches
-	ms.updatePendingEventIDs(historyMutation.ScheduledIDToStartedID)
+	ms.updatePendingEventIDs(historyMutation.ScheduledIDToStartedID, historyMutation.FlushedUpdateAdmissions)
 
 	workflowEventsSeq := make([]*persistence.WorkflowEvents, len(newEventsBatches))
 	historyNodeTxnIDs, err := ms.shard.GenerateTaskIDs(len(newEventsBatches))
@@ -5096,6 +5467,10 @@ func (ms *MutableStateImpl) prepareEventsAndReplicationTasks(
 		return nil, nil, false, err
 	}
 
+	if transactionPolicy == TransactionPolicyActive {
+		ms.updateReplicationExcludedClusters()
+	}
+
 	for _, workflowEvents := range workflowEventsSeq {
 		if err := ms.eventsToReplicationTask(transactionPolicy, workflowEvents.Events); err != nil {
 			return nil, nil, false, err
@@ -5106,6 +5481,11 @@ func (ms *MutableStateImpl) prepareEventsAndReplicationTasks(
 		ms.InsertTasks[tasks.CategoryReplication],
 		ms.syncActivityToReplicationTask(transactionPolicy)...,
 	)
+	if excludedClusters := ms.executionInfo.ReplicationExcludedClusters; len(excludedClusters) != 0 {
+		for _, task := range ms.InsertTasks[tasks.CategoryReplication] {
+			tasks.SetReplicationExcludedClusters(task, excludedClusters)
+		}
+	}
 
 	if transactionPolicy == TransactionPolicyPassive &&
 		len(ms.InsertTasks[tasks.CategoryReplication]) > 0 {
@@ -5160,6 +5540,7 @@ func (ms *MutableStateImpl) syncActivityToReplicationTask(
 
 func (ms *MutableStateImpl) updatePendingEventIDs(
 	scheduledIDToStartedID map[int64]int64,
+	flushedUpdateAdmissions map[string]historybuilder.EventPosition,
 ) {
 	for scheduledEventID, startedEventID := range scheduledIDToStartedID {
 		if activityInfo, ok := ms.GetActivityInfo(scheduledEventID); ok {
@@ -5173,6 +5554,13 @@ func (ms *MutableStateImpl) updatePendingEventIDs(
 			continue
 		}
 	}
+	// update admissions added while a workflow task was started point to a buffered event until it is flushed
+	for updateID, position := range flushedUpdateAdmissions {
+		if pointer := ms.executionInfo.GetUpdateInfos()[updateID].GetRequest().GetHistoryPointer(); pointer != nil {
+			pointer.EventId = position.EventID
+			pointer.EventBatchId = position.EventBatchID
+		}
+	}
 }
 
 func (ms *MutableStateImpl) updateWithLastWriteEvent(
@@ -5398,6 +5786,13 @@ func (ms *MutableStateImpl) closeTransactionHandleBufferedEventsLimit(
 		return nil
 	}
 
+	if ms.IsWorkflowExecutionSuspended() && !ms.HasStartedWorkflowTask() {
+		// events of a suspended workflow are written to history once the buffer is full,
+		// the workflow sees them when it is resumed
+		ms.FlushBufferedEvents()
+		return nil
+	}
+
 	// Handling buffered events size issue
 	if workflowTask := ms.GetStartedWorkflowTask(); workflowTask != nil {
 		// we have a workflow task on the fly with a lower version, fail it
@@ -5454,6 +5849,49 @@ func (ms *MutableStateImpl) closeTransactionHandleWorkflowReset(
 			tag.WorkflowEventID(pt.GetFirstWorkflowTaskCompletedId()),
 			tag.WorkflowBinaryChecksum(pt.GetBinaryChecksum()),
 		)
+		return nil
+	}
+
+	// compare with the auto-reset policy for non-deterministic workflow task failures
+	if _, pt := FindNonDeterministicAutoResetPoint(
+		ms.timeSource,
+		ms.config,
+		namespaceEntry.Name().String(),
+		ms.executionInfo,
+	); pt != nil {
+		if IsAutoResetLimitReached(ms.timeSource, ms.config, namespaceEntry.Name().String(), ms.executionInfo) {
+			// the reset point is found on every transaction until the workflow makes progress, only report the
+			// skipped reset for the workflow task failure which reached the auto-reset threshold
+			if !ms.nonDeterministicFailureRecorded || !IsAutoResetThresholdReached(
+				ms.config,
+				namespaceEntry.Name().String(),
+				ms.executionInfo,
+			) {
+				return nil
+			}
+			metrics.AutoResetLimitExceededCounter.With(ms.metricsHandler).Record(
+				1,
+				metrics.NamespaceTag(namespaceEntry.Name().String()),
+			)
+			ms.logWarn("Auto-Reset is skipped, because max automatic resets within the window is reached",
+				tag.WorkflowNamespace(namespaceEntry.Name().String()),
+				tag.WorkflowID(ms.executionInfo.WorkflowId),
+				tag.WorkflowRunID(ms.executionState.RunId),
+				tag.BuildId(ms.executionInfo.NonDeterministicFailureBuildId),
+			)
+			return nil
+		}
+		if err := ms.taskGenerator.GenerateWorkflowResetTasks(); err != nil {
+			return err
+		}
+		ms.logInfo("Auto-Reset task is scheduled for non-deterministic workflow task failures",
+			tag.WorkflowNamespace(namespaceEntry.Name().String()),
+			tag.WorkflowID(ms.executionInfo.WorkflowId),
+			tag.WorkflowRunID(ms.executionState.RunId),
+			tag.WorkflowResetBaseRunID(pt.GetRunId()),
+			tag.WorkflowEventID(pt.GetFirstWorkflowTaskCompletedId()),
+			tag.BuildId(ms.executionInfo.NonDeterministicFailureBuildId),
+		)
 	}
 	return nil
 }
@@ -5521,7 +5959,22 @@ func (ms *MutableStateImpl) closeTransactionCollapseVisibilityTasks() {
 }
 
 func (ms *MutableStateImpl) generateReplicationTask() bool {
-	return len(ms.namespaceEntry.ClusterNames()) > 1
+	// no replication task is needed when the replication filters exclude the workflow from all the standby clusters
+	return len(ms.namespaceEntry.ClusterNames())-len(ms.executionInfo.ReplicationExcludedClusters) > 1
+}
+
+// updateReplicationExcludedClusters evaluates the replication filters of the namespace against the workflow, and
+// marks it as not replicated to the clusters the filters exclude it from.
+func (ms *MutableStateImpl) updateReplicationExcludedClusters() {
+	if len(ms.namespaceEntry.ReplicationFilters()) == 0 && len(ms.executionInfo.ReplicationExcludedClusters) == 0 {
+		return
+	}
+	ms.executionInfo.ReplicationExcludedClusters = ms.namespaceEntry.ReplicationExcludedClusters(
+		ms.clusterMetadata.GetCurrentClusterName(),
+		ms.executionInfo.WorkflowTypeName,
+		ms.executionInfo.TaskQueue,
+		ms.executionInfo.SearchAttributes,
+	)
 }
 
 func (ms *MutableStateImpl) checkMutability(
@@ -45,15 +45,19 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.temporal.io/server/api/clock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	updatespb "go.temporal.io/server/api/update/v1"
 	"go.temporal.io/server/common"
+	commonclock "go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
@@ -973,6 +977,90 @@ func (s *mutableStateSuite) TestUpdateInfos() {
 		"expected 1 completed update + 2 accepted in mutation")
 }
 
+func (s *mutableStateSuite) TestUpdateAdmission_RejectAndRetry() {
+	newMutableState := func() *MutableStateImpl {
+		dbState := s.buildWorkflowMutableState()
+		mutableState, err := NewMutableStateFromDB(
+			s.mockShard,
+			NewMapEventCache(s.T(), map[events.EventKey]*historypb.HistoryEvent{}),
+			s.logger,
+			tests.LocalNamespaceEntry,
+			dbState,
+			123,
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(mutableState.UpdateCurrentVersion(dbState.ExecutionInfo.VersionHistories.Histories[0].Items[0].Version, false))
+		return mutableState
+	}
+	s.mutableState = newMutableState()
+
+	updateID := s.T().Name() + "-update-id"
+	request := &updatepb.Request{
+		Meta:  &updatepb.Meta{UpdateId: updateID},
+		Input: &updatepb.Input{Name: "update-handler"},
+	}
+	historyPointer := func(ms *MutableStateImpl) *updatespb.RequestInfo_HistoryPointer {
+		return ms.GetExecutionInfo().GetUpdateInfos()[updateID].GetRequest().GetHistoryPointer()
+	}
+
+	// the workflow task is started, so the admission is buffered until the workflow task completes
+	admittedEvent, err := s.mutableState.AddWorkflowExecutionUpdateAdmittedEvent(request, enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_UNSPECIFIED)
+	s.Require().NoError(err)
+	s.Equal(common.BufferedEventID, admittedEvent.GetEventId())
+	_, err = s.mutableState.AddWorkflowTaskCompletedEvent(
+		s.mutableState.GetStartedWorkflowTask(),
+		&workflowservice.RespondWorkflowTaskCompletedRequest{Identity: "some random identity"},
+		WorkflowTaskCompletionLimits{MaxResetPoints: 10, MaxSearchAttributeValueSize: 1024},
+	)
+	s.Require().NoError(err)
+	s.mutableState.FlushBufferedEvents()
+	s.NotEqual(common.BufferedEventID, admittedEvent.GetEventId())
+	s.Equal(admittedEvent.GetEventId(), historyPointer(s.mutableState).GetEventId(), "admission should point to the flushed event")
+
+	// the update is sent to the worker with the next workflow task, which rejects it
+	wt, err := s.mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+	s.Require().NoError(err)
+	_, wt, err = s.mutableState.AddWorkflowTaskStartedEvent(
+		wt.ScheduledEventID,
+		uuid.New(),
+		&taskqueuepb.TaskQueue{Name: "task-queue"},
+		"worker-identity",
+		nil,
+	)
+	s.Require().NoError(err)
+	completedEvent, err := s.mutableState.AddWorkflowTaskCompletedEvent(
+		wt,
+		&workflowservice.RespondWorkflowTaskCompletedRequest{Identity: "some random identity"},
+		WorkflowTaskCompletionLimits{MaxResetPoints: 10, MaxSearchAttributeValueSize: 1024},
+	)
+	s.Require().NoError(err)
+	nextEventID := s.mutableState.GetNextEventID()
+	s.Require().NoError(s.mutableState.RejectWorkflowExecutionUpdateAdmission(updateID))
+	s.NotContains(s.mutableState.GetExecutionInfo().GetUpdateInfos(), updateID)
+	s.Equal(nextEventID, s.mutableState.GetNextEventID(), "rejection of an admitted update should not write an event")
+	s.Empty(completedEvent.GetWorkflowTaskCompletedEventAttributes().ProtoReflect().GetUnknown(), "rejection of an admitted update should not modify the completed event")
+
+	readmittedEvent, err := s.mutableState.AddWorkflowExecutionUpdateAdmittedEvent(request, enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_UNSPECIFIED)
+	s.Require().NoError(err, "rejected update should be admitted again")
+	s.Equal(readmittedEvent.GetEventId(), historyPointer(s.mutableState).GetEventId())
+
+	// a passive mutable state replicating the same events ends up with the same admission
+	replicatedMutableState := newMutableState()
+	s.Require().NoError(replicatedMutableState.ApplyWorkflowExecutionUpdateAdmittedEvent(admittedEvent, admittedEvent.GetEventId()))
+	s.Require().NoError(replicatedMutableState.ApplyWorkflowTaskCompletedEvent(completedEvent))
+	s.Contains(replicatedMutableState.GetExecutionInfo().GetUpdateInfos(), updateID)
+	replicatedMutableState.RemoveUnacceptedUpdateAdmissions(wt.StartedEventID)
+	s.NotContains(replicatedMutableState.GetExecutionInfo().GetUpdateInfos(), updateID)
+	s.Require().NoError(replicatedMutableState.ApplyWorkflowExecutionUpdateAdmittedEvent(readmittedEvent, readmittedEvent.GetEventId()))
+	s.Equal(readmittedEvent.GetEventId(), historyPointer(replicatedMutableState).GetEventId())
+	replicatedMutableState.RemoveUnacceptedUpdateAdmissions(wt.StartedEventID)
+	s.Contains(replicatedMutableState.GetExecutionInfo().GetUpdateInfos(), updateID, "update admitted after the workflow task started was not delivered by it")
+
+	// applying the admission again, e.g. when it is reapplied, is a no-op
+	s.Require().NoError(replicatedMutableState.ApplyWorkflowExecutionUpdateAdmittedEvent(readmittedEvent, readmittedEvent.GetEventId()))
+	s.Equal(s.mutableState.GetExecutionInfo().GetUpdateCount(), replicatedMutableState.GetExecutionInfo().GetUpdateCount())
+}
+
 func (s *mutableStateSuite) TestApplyActivityTaskStartedEvent() {
 	state := s.buildWorkflowMutableState()
 
@@ -1213,6 +1301,456 @@ func (s *mutableStateSuite) TestRetryActivity_TruncateRetryableFailure() {
 	s.Equal(activityFailure.GetMessage(), activityInfo.RetryLastFailure.Cause.GetMessage())
 }
 
+func (s *mutableStateSuite) TestPauseActivity_RetryAndUnpause() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	// scheduling, starting & completing workflow task is omitted here
+
+	workflowTaskCompletedEventID := int64(4)
+	_, activityInfo, err := s.mutableState.AddActivityTaskScheduledEvent(
+		workflowTaskCompletedEventID,
+		&commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:   "5",
+			ActivityType: &commonpb.ActivityType{Name: "activity-type"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+			RetryPolicy: &commonpb.RetryPolicy{
+				InitialInterval: timestamp.DurationFromSeconds(1),
+			},
+		},
+		false,
+	)
+	s.NoError(err)
+
+	_, err = s.mutableState.AddActivityTaskStartedEvent(
+		activityInfo,
+		activityInfo.ScheduledEventId,
+		uuid.New(),
+		"worker-identity",
+		nil)
+	s.NoError(err)
+
+	nextEventID := s.mutableState.GetNextEventID()
+	s.NoError(s.mutableState.PauseActivity(activityInfo))
+	s.True(activityInfo.Paused)
+	s.Equal(nextEventID, s.mutableState.GetNextEventID(), "pausing an activity should not write an event")
+	s.Equal([]string{"5"}, s.getPausedActivitiesFromMutableState())
+	s.Equal(s.mutableState.GetCurrentVersion(), activityInfo.Version)
+	s.Contains(s.mutableState.syncActivityTasks, activityInfo.ScheduledEventId)
+
+	// the in-flight attempt fails, but its retry is held back while the activity is paused
+	retryState, err := s.mutableState.RetryActivity(activityInfo, &failurepb.Failure{Message: "downstream unavailable"})
+	s.NoError(err)
+	s.Equal(enumspb.RETRY_STATE_IN_PROGRESS, retryState)
+	s.Equal(int32(2), activityInfo.Attempt)
+	s.Equal(0, s.countActivityRetryTimerTasks())
+
+	s.NoError(s.mutableState.UnpauseActivity(activityInfo, false))
+	s.False(activityInfo.Paused)
+	s.Equal(int32(2), activityInfo.Attempt)
+	s.Equal(1, s.countActivityRetryTimerTasks())
+	s.Empty(s.getPausedActivitiesFromMutableState())
+
+	// unpausing with attempts reset restarts the retry policy from the first attempt
+	s.NoError(s.mutableState.PauseActivity(activityInfo))
+	s.NoError(s.mutableState.UnpauseActivity(activityInfo, true))
+	s.Equal(int32(1), activityInfo.Attempt)
+	s.Equal(2, s.countActivityRetryTimerTasks())
+}
+
+func (s *mutableStateSuite) TestUpdateActivityInfo_Paused() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	workflowTaskCompletedEventID := int64(4)
+	_, activityInfo, err := s.mutableState.AddActivityTaskScheduledEvent(
+		workflowTaskCompletedEventID,
+		&commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:   "5",
+			ActivityType: &commonpb.ActivityType{Name: "activity-type"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+		},
+		false,
+	)
+	s.NoError(err)
+
+	// the paused state is replicated with the activity state
+	syncActivityInfo := &historyservice.ActivitySyncInfo{
+		Version:          activityInfo.Version,
+		ScheduledEventId: activityInfo.ScheduledEventId,
+		ScheduledTime:    activityInfo.ScheduledTime,
+		StartedEventId:   common.EmptyEventID,
+		Attempt:          activityInfo.Attempt,
+		Paused:           true,
+	}
+	s.NoError(s.mutableState.UpdateActivityInfo(syncActivityInfo, false))
+	s.True(activityInfo.Paused)
+	s.Equal([]string{"5"}, s.getPausedActivitiesFromMutableState())
+
+	syncActivityInfo.Paused = false
+	s.NoError(s.mutableState.UpdateActivityInfo(syncActivityInfo, false))
+	s.False(activityInfo.Paused)
+	s.Empty(s.getPausedActivitiesFromMutableState())
+}
+
+func (s *mutableStateSuite) TestSuspendPauseAndResume_PausedActivityIsNotDispatched() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	_, err := s.mutableState.AddWorkflowExecutionSuspendedEvent("maintenance", "operator")
+	s.NoError(err)
+
+	// activities scheduled while the workflow is suspended are dispatched once it is resumed
+	workflowTaskCompletedEventID := int64(4)
+	scheduledEvents := make(map[string]*historypb.HistoryEvent, 2)
+	for _, activityID := range []string{"5", "6"} {
+		event, _, err := s.mutableState.AddActivityTaskScheduledEvent(
+			workflowTaskCompletedEventID,
+			&commandpb.ScheduleActivityTaskCommandAttributes{
+				ActivityId:   activityID,
+				ActivityType: &commonpb.ActivityType{Name: "activity-type"},
+				TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+			},
+			false,
+		)
+		s.NoError(err)
+		scheduledEvents[activityID] = event
+	}
+	s.Equal(0, s.countActivityTransferTasks())
+
+	pausedActivityInfo, ok := s.mutableState.GetActivityByActivityID("5")
+	s.True(ok)
+	s.NoError(s.mutableState.PauseActivity(pausedActivityInfo))
+
+	_, err = s.mutableState.AddWorkflowExecutionResumedEvent()
+	s.NoError(err)
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(s.mutableState.GetExecutionInfo().GetVersionHistories())
+	s.NoError(err)
+	s.NoError(versionhistory.AddOrUpdateVersionHistoryItem(versionHistory, &historyspb.VersionHistoryItem{
+		EventId: s.mutableState.GetNextEventID() - 1,
+		Version: s.mutableState.GetCurrentVersion(),
+	}))
+	s.mockEventsCache.EXPECT().GetEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(scheduledEvents["6"], nil)
+	s.NoError(NewTaskRefresher(
+		s.mockShard,
+		s.mockConfig,
+		s.mockShard.GetNamespaceRegistry(),
+		s.logger,
+	).RefreshDeferredTasks(context.Background(), s.mutableState))
+
+	// only the activity which is not paused is dispatched
+	var dispatched []int64
+	for _, task := range s.mutableState.InsertTasks[tasks.CategoryTransfer] {
+		if activityTask, ok := task.(*tasks.ActivityTask); ok {
+			dispatched = append(dispatched, activityTask.ScheduledEventID)
+		}
+	}
+	s.Equal([]int64{scheduledEvents["6"].GetEventId()}, dispatched)
+	s.Equal(0, s.countActivityRetryTimerTasks())
+
+	// the paused activity is dispatched once it is unpaused
+	s.NoError(s.mutableState.UnpauseActivity(pausedActivityInfo, false))
+	s.Equal(1, s.countActivityRetryTimerTasks())
+}
+
+func (s *mutableStateSuite) TestUnpauseActivity_ResetAttemptsInFlight() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	workflowTaskCompletedEventID := int64(4)
+	_, activityInfo, err := s.mutableState.AddActivityTaskScheduledEvent(
+		workflowTaskCompletedEventID,
+		&commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:   "5",
+			ActivityType: &commonpb.ActivityType{Name: "activity-type"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+		},
+		false,
+	)
+	s.NoError(err)
+
+	_, err = s.mutableState.AddActivityTaskStartedEvent(
+		activityInfo,
+		activityInfo.ScheduledEventId,
+		uuid.New(),
+		"worker-identity",
+		nil)
+	s.NoError(err)
+
+	s.NoError(s.mutableState.PauseActivity(activityInfo))
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(s.mutableState.UnpauseActivity(activityInfo, true), &failedPrecondition)
+	s.True(activityInfo.Paused)
+
+	// completing a paused activity removes it from the search attribute
+	s.NoError(s.mutableState.DeleteActivity(activityInfo.ScheduledEventId))
+	s.Empty(s.getPausedActivitiesFromMutableState())
+}
+
+func (s *mutableStateSuite) TestSuspendAndResumeWorkflowExecution() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	suspendedEvent, err := s.mutableState.AddWorkflowExecutionSuspendedEvent("maintenance", "operator")
+	s.NoError(err)
+	event := suspendedEvent
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.GetEventType())
+	s.True(event.GetWorkerMayIgnore())
+	s.Empty(event.GetWorkflowPropertiesModifiedExternallyEventAttributes().ProtoReflect().GetUnknown())
+	s.Nil(event.GetWorkflowPropertiesModifiedExternallyEventAttributes().GetUpsertedMemo())
+	s.True(s.mutableState.IsWorkflowExecutionSuspended())
+	s.Equal("maintenance", s.mutableState.GetExecutionInfo().SuspendReason)
+	s.Equal("operator", s.mutableState.GetExecutionInfo().SuspendIdentity)
+
+	_, err = s.mutableState.AddWorkflowExecutionSuspendedEvent("maintenance", "operator")
+	s.Error(err)
+
+	// activities scheduled while the workflow is suspended are not dispatched
+	workflowTaskCompletedEventID := int64(4)
+	_, _, err = s.mutableState.AddActivityTaskScheduledEvent(
+		workflowTaskCompletedEventID,
+		&commandpb.ScheduleActivityTaskCommandAttributes{
+			ActivityId:   "5",
+			ActivityType: &commonpb.ActivityType{Name: "activity-type"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+		},
+		false,
+	)
+	s.NoError(err)
+	s.Equal(0, s.countActivityTransferTasks())
+
+	// signals are buffered until the workflow is resumed
+	_, err = s.mutableState.AddWorkflowExecutionSignaled("signal", nil, "sender", nil, true)
+	s.NoError(err)
+	s.True(s.mutableState.HasBufferedEvents())
+
+	event, err = s.mutableState.AddWorkflowExecutionResumedEvent()
+	s.NoError(err)
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, event.GetEventType())
+	s.False(s.mutableState.IsWorkflowExecutionSuspended())
+	s.Empty(s.mutableState.GetExecutionInfo().SuspendReason)
+	s.False(s.mutableState.HasBufferedEvents())
+
+	_, err = s.mutableState.AddWorkflowExecutionResumedEvent()
+	s.Error(err)
+
+	// replicated or replayed events toggle the suspended state, which survives a JSON round trip of the events
+	for _, event := range []*historypb.HistoryEvent{suspendedEvent, event} {
+		data, err := protojson.Marshal(event)
+		s.NoError(err)
+		deserialized := &historypb.HistoryEvent{}
+		s.NoError(protojson.Unmarshal(data, deserialized))
+		s.NoError(s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(deserialized))
+		s.Equal(event == suspendedEvent, s.mutableState.IsWorkflowExecutionSuspended())
+		s.Empty(s.mutableState.GetExecutionInfo().SuspendReason, "reason is kept by the suspending cluster only")
+	}
+
+	// modifications of workflow properties are not supported
+	s.Error(s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(&historypb.HistoryEvent{
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+				NewTaskQueue: "task-queue",
+			},
+		},
+	}))
+}
+
+func (s *mutableStateSuite) TestNonDeterministicAutoReset() {
+	version := int64(12)
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	s.mutableState = TestGlobalMutableState(
+		s.mockShard,
+		s.mockEventsCache,
+		s.logger,
+		version,
+		workflowID,
+		runID,
+	)
+	s.prepareTransientWorkflowTaskCompletionFirstBatchApplied(version, workflowID, runID)
+	versionHistory, err := versionhistory.GetCurrentVersionHistory(s.mutableState.GetExecutionInfo().GetVersionHistories())
+	s.NoError(err)
+	s.NoError(versionhistory.AddOrUpdateVersionHistoryItem(versionHistory, &historyspb.VersionHistoryItem{
+		EventId: s.mutableState.GetNextEventID() - 1,
+		Version: version,
+	}))
+
+	namespaceName := s.mutableState.GetNamespaceEntry().Name().String()
+	s.mockConfig.AutoResetNonDeterministicFailuresByWorkflowType = func(namespace string) map[string]any {
+		return map[string]any{"workflow-type": 2}
+	}
+	s.mockConfig.AutoResetMaxResetsPerWindow = func(namespace string) int { return 1 }
+	s.mockConfig.AutoResetWindow = func(namespace string) time.Duration { return time.Hour }
+
+	executionInfo := s.mutableState.GetExecutionInfo()
+	executionInfo.WorkflowTypeName = "workflow-type"
+	executionInfo.AutoResetPoints = &workflowpb.ResetPoints{
+		Points: []*workflowpb.ResetPointInfo{
+			{BuildId: "good", FirstWorkflowTaskCompletedId: 4, Resettable: true},
+			{BuildId: "bad", FirstWorkflowTaskCompletedId: 10, Resettable: true},
+		},
+	}
+
+	failWorkflowTask := func(cause enumspb.WorkflowTaskFailedCause, buildID string) {
+		var err error
+		wt := s.mutableState.GetPendingWorkflowTask()
+		if wt == nil {
+			wt, err = s.mutableState.AddWorkflowTaskScheduledEvent(false, enumsspb.WORKFLOW_TASK_TYPE_NORMAL)
+			s.NoError(err)
+		}
+		if wt.StartedEventID == common.EmptyEventID {
+			_, wt, err = s.mutableState.AddWorkflowTaskStartedEvent(
+				wt.ScheduledEventID,
+				uuid.New(),
+				&taskqueuepb.TaskQueue{Name: "task-queue"},
+				"worker-identity",
+				nil,
+			)
+			s.NoError(err)
+		}
+		_, err = s.mutableState.AddWorkflowTaskFailedEvent(
+			wt,
+			cause,
+			failure.NewServerFailure("workflow task failure", false),
+			"worker-identity",
+			&commonpb.WorkerVersionStamp{BuildId: buildID},
+			"",
+			"",
+			"",
+			0,
+		)
+		s.NoError(err)
+	}
+
+	failWorkflowTask(enumspb.WORKFLOW_TASK_FAILED_CAUSE_NON_DETERMINISTIC_ERROR, "bad")
+	s.Equal(int32(1), executionInfo.NonDeterministicFailureCount)
+	_, resetPoint := FindNonDeterministicAutoResetPoint(s.mutableState.timeSource, s.mockConfig, namespaceName, executionInfo)
+	s.Nil(resetPoint)
+
+	failWorkflowTask(enumspb.WORKFLOW_TASK_FAILED_CAUSE_NON_DETERMINISTIC_ERROR, "bad")
+	s.Equal(int32(2), executionInfo.NonDeterministicFailureCount)
+	s.Equal("bad", executionInfo.NonDeterministicFailureBuildId)
+	_, resetPoint = FindNonDeterministicAutoResetPoint(s.mutableState.timeSource, s.mockConfig, namespaceName, executionInfo)
+	s.NotNil(resetPoint)
+	s.Equal("good", resetPoint.GetBuildId())
+
+	s.False(IsAutoResetLimitReached(s.mutableState.timeSource, s.mockConfig, namespaceName, executionInfo))
+	AddAutoResetTime(s.mutableState.timeSource, s.mockConfig, namespaceName, executionInfo)
+	s.True(IsAutoResetLimitReached(s.mutableState.timeSource, s.mockConfig, namespaceName, executionInfo))
+
+	// a reset skipped because of the limit is reported for the failure reaching the threshold only
+	s.mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(tests.GlobalNamespaceEntry, nil).AnyTimes()
+	limitExceeded := func() int64 {
+		for _, counter := range s.testScope.Snapshot().Counters() {
+			if counter.Name() == "test.auto_reset_limit_exceeded" {
+				return counter.Value()
+			}
+		}
+		return 0
+	}
+	s.NoError(s.mutableState.closeTransactionHandleWorkflowReset(TransactionPolicyActive))
+	s.Equal(int64(1), limitExceeded())
+	// cleared by cleanupTransaction
+	s.mutableState.nonDeterministicFailureRecorded = false
+	s.NoError(s.mutableState.closeTransactionHandleWorkflowReset(TransactionPolicyActive))
+	s.Equal(int64(1), limitExceeded())
+	failWorkflowTask(enumspb.WORKFLOW_TASK_FAILED_CAUSE_NON_DETERMINISTIC_ERROR, "bad")
+	s.Equal(int32(3), executionInfo.NonDeterministicFailureCount)
+	s.NoError(s.mutableState.closeTransactionHandleWorkflowReset(TransactionPolicyActive))
+	s.Equal(int64(1), limitExceeded())
+
+	// a failure with another cause breaks the sequence
+	failWorkflowTask(enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE, "bad")
+	s.Zero(executionInfo.NonDeterministicFailureCount)
+	s.Empty(executionInfo.NonDeterministicFailureBuildId)
+}
+
+func (s *mutableStateSuite) TestRequestIDDedupWindow() {
+	timeSource := commonclock.NewEventTimeSource().Update(time.Now())
+	s.mutableState.timeSource = timeSource
+	s.mockConfig.RequestIDDedupWindowSize = func(namespace string) int { return 2 }
+	s.mockConfig.RequestIDDedupWindowTTL = func(namespace string) time.Duration { return time.Hour }
+
+	s.mutableState.AddSignalRequested("request-1")
+	timeSource.Advance(time.Second)
+	s.mutableState.AddSignalRequested("request-2")
+	timeSource.Advance(time.Second)
+
+	// delivered signals are still deduplicated
+	s.mutableState.DeleteSignalRequested("request-1")
+	s.mutableState.DeleteSignalRequested("request-2")
+	s.True(s.mutableState.IsSignalRequested("request-1"))
+	s.True(s.mutableState.IsSignalRequested("request-2"))
+
+	// the oldest request ID is evicted once the window is full
+	s.mutableState.AddSignalRequested("request-3")
+	s.False(s.mutableState.IsSignalRequested("request-1"))
+	s.True(s.mutableState.IsSignalRequested("request-2"))
+	s.True(s.mutableState.IsSignalRequested("request-3"))
+	s.Len(s.mutableState.GetExecutionInfo().SignalRequestIdDedupWindow, 2)
+
+	s.mutableState.GetExecutionInfo().UpdateIdDedupWindow = map[string]*timestamppb.Timestamp{
+		"update-1": timestamppb.New(timeSource.Now()),
+	}
+	s.True(s.mutableState.IsUpdateInDedupWindow("update-1"))
+	s.False(s.mutableState.IsUpdateInDedupWindow("update-2"))
+
+	// the window is carried over to the next run
+	newNextMutableState := func() *MutableStateImpl {
+		ms := NewMutableState(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, tests.WorkflowID, tests.RunID, timeSource.Now())
+		ms.timeSource = timeSource
+		return ms
+	}
+	nextMutableState := newNextMutableState()
+	nextMutableState.CarryOverRequestIDDedupWindows(s.mutableState.GetExecutionInfo())
+	s.Len(nextMutableState.GetExecutionInfo().SignalRequestIdDedupWindow, 2)
+	s.Len(nextMutableState.GetExecutionInfo().UpdateIdDedupWindow, 1)
+	s.True(nextMutableState.IsSignalRequested("request-3"))
+	s.True(nextMutableState.IsUpdateInDedupWindow("update-1"))
+
+	// expired entries are neither deduplicated nor carried over
+	timeSource.Advance(time.Hour)
+	s.mutableState.DeleteSignalRequested("request-3")
+	s.False(s.mutableState.IsSignalRequested("request-3"))
+	s.False(s.mutableState.IsUpdateInDedupWindow("update-1"))
+	nextMutableState = newNextMutableState()
+	nextMutableState.CarryOverRequestIDDedupWindows(s.mutableState.GetExecutionInfo())
+	s.Empty(nextMutableState.GetExecutionInfo().SignalRequestIdDedupWindow)
+	s.Empty(nextMutableState.GetExecutionInfo().UpdateIdDedupWindow)
+
+	// a zero size disables the window
+	s.mockConfig.RequestIDDedupWindowSize = func(namespace string) int { return 0 }
+	s.mutableState.AddSignalRequested("request-4")
+	s.mutableState.DeleteSignalRequested("request-4")
+	s.False(s.mutableState.IsSignalRequested("request-4"))
+}
+
+func (s *mutableStateSuite) TestReplicationExcludedClusters() {
+	s.mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mutableState.namespaceEntry = namespace.NewGlobalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+		&persistencespb.NamespaceConfig{},
+		&persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters:          []string{cluster.TestCurrentClusterName, cluster.TestAlternativeClusterName},
+			ReplicationFilters: []*persistencespb.ReplicationFilter{
+				{WorkflowTypes: []string{"filtered-type"}},
+			},
+		},
+		tests.Version,
+	)
+	executionInfo := s.mutableState.GetExecutionInfo()
+	s.True(s.mutableState.generateReplicationTask())
+
+	// a workflow excluded from all the standby clusters does not generate replication tasks
+	executionInfo.WorkflowTypeName = "filtered-type"
+	s.mutableState.updateReplicationExcludedClusters()
+	s.Equal([]string{cluster.TestAlternativeClusterName}, executionInfo.ReplicationExcludedClusters)
+	s.False(s.mutableState.generateReplicationTask())
+
+	executionInfo.WorkflowTypeName = "other-type"
+	s.mutableState.updateReplicationExcludedClusters()
+	s.Empty(executionInfo.ReplicationExcludedClusters)
+	s.True(s.mutableState.generateReplicationTask())
+}
+
 func (s *mutableStateSuite) TestUpdateBuildIdsSearchAttribute() {
 	versioned := func(buildId string) *commonpb.WorkerVersionStamp {
 		return &commonpb.WorkerVersionStamp{BuildId: buildId, UseVersioning: true}
@@ -1399,6 +1937,38 @@ func (s *mutableStateSuite) getBuildIdsFromMutableState() []string {
 	return buildIDs
 }
 
+func (s *mutableStateSuite) getPausedActivitiesFromMutableState() []string {
+	payload, found := s.mutableState.executionInfo.SearchAttributes[searchattribute.TemporalPausedActivities]
+	if !found {
+		return nil
+	}
+	decoded, err := searchattribute.DecodeValue(payload, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, true)
+	s.NoError(err)
+	activityIDs, ok := decoded.([]string)
+	s.True(ok)
+	return activityIDs
+}
+
+func (s *mutableStateSuite) countActivityRetryTimerTasks() int {
+	count := 0
+	for _, task := range s.mutableState.InsertTasks[tasks.CategoryTimer] {
+		if _, ok := task.(*tasks.ActivityRetryTimerTask); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *mutableStateSuite) countActivityTransferTasks() int {
+	count := 0
+	for _, task := range s.mutableState.InsertTasks[tasks.CategoryTransfer] {
+		if _, ok := task.(*tasks.ActivityTask); ok {
+			count++
+		}
+	}
+	return count
+}
+
 // return reset points minus a few fields that are hard to check for equality
 func (s *mutableStateSuite) cleanedResetPoints() *workflowpb.ResetPoints {
 	out := common.CloneProto(s.mutableState.executionInfo.GetAutoResetPoints())
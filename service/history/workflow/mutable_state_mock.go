@@ -626,6 +626,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionCanceledEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionCanceledEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionCanceledEvent), arg0, arg1)
 }
 
+// AddWorkflowExecutionResumedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionResumedEvent() (*v13.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionResumedEvent")
+	ret0, _ := ret[0].(*v13.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionResumedEvent indicates an expected call of AddWorkflowExecutionResumedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionResumedEvent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionResumedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionResumedEvent))
+}
+
 // AddWorkflowExecutionSignaled mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionSignaled(signalName string, input *v10.Payloads, identity string, header *v10.Header, skipGenerateWorkflowTask bool) (*v13.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -686,6 +701,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionStartedEventWithOpti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionStartedEventWithOptions", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionStartedEventWithOptions), arg0, arg1, arg2, arg3, arg4)
 }
 
+// AddWorkflowExecutionSuspendedEvent mocks base method.
+func (m *MockMutableState) AddWorkflowExecutionSuspendedEvent(reason, identity string) (*v13.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionSuspendedEvent", reason, identity)
+	ret0, _ := ret[0].(*v13.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionSuspendedEvent indicates an expected call of AddWorkflowExecutionSuspendedEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowExecutionSuspendedEvent(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionSuspendedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowExecutionSuspendedEvent), reason, identity)
+}
+
 // AddWorkflowExecutionTerminatedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowExecutionTerminatedEvent(firstEventID int64, reason string, details *v10.Payloads, identity string, deleteAfterTerminate bool) (*v13.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1415,6 +1445,20 @@ func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedEvent(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedEvent), arg0)
 }
 
+// ApplyWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 *v13.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyWorkflowPropertiesModifiedExternallyEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyWorkflowPropertiesModifiedExternallyEvent indicates an expected call of ApplyWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // ApplyWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) ApplyWorkflowTaskCompletedEvent(arg0 *v13.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -1487,6 +1531,18 @@ func (mr *MockMutableStateMockRecorder) ApplyWorkflowTaskTimedOutEvent(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowTaskTimedOutEvent), arg0)
 }
 
+// CarryOverRequestIDDedupWindows mocks base method.
+func (m *MockMutableState) CarryOverRequestIDDedupWindows(previousExecutionInfo *v112.WorkflowExecutionInfo) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CarryOverRequestIDDedupWindows", previousExecutionInfo)
+}
+
+// CarryOverRequestIDDedupWindows indicates an expected call of CarryOverRequestIDDedupWindows.
+func (mr *MockMutableStateMockRecorder) CarryOverRequestIDDedupWindows(previousExecutionInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CarryOverRequestIDDedupWindows", reflect.TypeOf((*MockMutableState)(nil).CarryOverRequestIDDedupWindows), previousExecutionInfo)
+}
+
 // CheckResettable mocks base method.
 func (m *MockMutableState) CheckResettable() error {
 	m.ctrl.T.Helper()
@@ -2607,6 +2663,20 @@ func (mr *MockMutableStateMockRecorder) IsTransientWorkflowTask() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTransientWorkflowTask", reflect.TypeOf((*MockMutableState)(nil).IsTransientWorkflowTask))
 }
 
+// IsUpdateInDedupWindow mocks base method.
+func (m *MockMutableState) IsUpdateInDedupWindow(updateID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUpdateInDedupWindow", updateID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsUpdateInDedupWindow indicates an expected call of IsUpdateInDedupWindow.
+func (mr *MockMutableStateMockRecorder) IsUpdateInDedupWindow(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUpdateInDedupWindow", reflect.TypeOf((*MockMutableState)(nil).IsUpdateInDedupWindow), updateID)
+}
+
 // IsWorkflowCloseAttempted mocks base method.
 func (m *MockMutableState) IsWorkflowCloseAttempted() bool {
 	m.ctrl.T.Helper()
@@ -2635,6 +2705,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// IsWorkflowExecutionSuspended mocks base method.
+func (m *MockMutableState) IsWorkflowExecutionSuspended() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionSuspended")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionSuspended indicates an expected call of IsWorkflowExecutionSuspended.
+func (mr *MockMutableStateMockRecorder) IsWorkflowExecutionSuspended() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionSuspended", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowExecutionSuspended))
+}
+
 // IsWorkflowPendingOnWorkflowTaskBackoff mocks base method.
 func (m *MockMutableState) IsWorkflowPendingOnWorkflowTaskBackoff() bool {
 	m.ctrl.T.Helper()
@@ -2649,6 +2733,20 @@ func (mr *MockMutableStateMockRecorder) IsWorkflowPendingOnWorkflowTaskBackoff()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowPendingOnWorkflowTaskBackoff", reflect.TypeOf((*MockMutableState)(nil).IsWorkflowPendingOnWorkflowTaskBackoff))
 }
 
+// PauseActivity mocks base method.
+func (m *MockMutableState) PauseActivity(ai *v112.ActivityInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseActivity", ai)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseActivity indicates an expected call of PauseActivity.
+func (mr *MockMutableStateMockRecorder) PauseActivity(ai interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseActivity", reflect.TypeOf((*MockMutableState)(nil).PauseActivity), ai)
+}
+
 // PopTasks mocks base method.
 func (m *MockMutableState) PopTasks() map[tasks.Category][]tasks.Task {
 	m.ctrl.T.Helper()
@@ -2663,6 +2761,20 @@ func (mr *MockMutableStateMockRecorder) PopTasks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopTasks", reflect.TypeOf((*MockMutableState)(nil).PopTasks))
 }
 
+// RejectWorkflowExecutionUpdateAdmission mocks base method.
+func (m *MockMutableState) RejectWorkflowExecutionUpdateAdmission(updateID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectWorkflowExecutionUpdateAdmission", updateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectWorkflowExecutionUpdateAdmission indicates an expected call of RejectWorkflowExecutionUpdateAdmission.
+func (mr *MockMutableStateMockRecorder) RejectWorkflowExecutionUpdateAdmission(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectWorkflowExecutionUpdateAdmission", reflect.TypeOf((*MockMutableState)(nil).RejectWorkflowExecutionUpdateAdmission), updateID)
+}
+
 // RejectWorkflowExecutionUpdate mocks base method.
 func (m *MockMutableState) RejectWorkflowExecutionUpdate(protocolInstanceID string, updRejection *v15.Rejection) error {
 	m.ctrl.T.Helper()
@@ -2677,6 +2789,18 @@ func (mr *MockMutableStateMockRecorder) RejectWorkflowExecutionUpdate(protocolIn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectWorkflowExecutionUpdate", reflect.TypeOf((*MockMutableState)(nil).RejectWorkflowExecutionUpdate), protocolInstanceID, updRejection)
 }
 
+// RemoveUnacceptedUpdateAdmissions mocks base method.
+func (m *MockMutableState) RemoveUnacceptedUpdateAdmissions(workflowTaskStartedEventID int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveUnacceptedUpdateAdmissions", workflowTaskStartedEventID)
+}
+
+// RemoveUnacceptedUpdateAdmissions indicates an expected call of RemoveUnacceptedUpdateAdmissions.
+func (mr *MockMutableStateMockRecorder) RemoveUnacceptedUpdateAdmissions(workflowTaskStartedEventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUnacceptedUpdateAdmissions", reflect.TypeOf((*MockMutableState)(nil).RemoveUnacceptedUpdateAdmissions), workflowTaskStartedEventID)
+}
+
 // RemoveSpeculativeWorkflowTaskTimeoutTask mocks base method.
 func (m *MockMutableState) RemoveSpeculativeWorkflowTaskTimeoutTask() {
 	m.ctrl.T.Helper()
@@ -2824,6 +2948,20 @@ func (mr *MockMutableStateMockRecorder) TaskQueueScheduleToStartTimeout(name int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskQueueScheduleToStartTimeout", reflect.TypeOf((*MockMutableState)(nil).TaskQueueScheduleToStartTimeout), name)
 }
 
+// UnpauseActivity mocks base method.
+func (m *MockMutableState) UnpauseActivity(ai *v112.ActivityInfo, resetAttempts bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseActivity", ai, resetAttempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseActivity indicates an expected call of UnpauseActivity.
+func (mr *MockMutableStateMockRecorder) UnpauseActivity(ai, resetAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseActivity", reflect.TypeOf((*MockMutableState)(nil).UnpauseActivity), ai, resetAttempts)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockMutableState) UpdateActivity(arg0 *v112.ActivityInfo) error {
 	m.ctrl.T.Helper()
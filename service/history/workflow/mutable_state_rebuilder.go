@@ -39,6 +39,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
@@ -164,6 +165,7 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 	}
 	executionInfo.LastEventTaskId = lastEvent.GetTaskId()
 
+	completedWorkflowTaskStartedEventID := common.EmptyEventID
 	for _, event := range history {
 		switch event.GetEventType() {
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
@@ -271,6 +273,7 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 			); err != nil {
 				return nil, err
 			}
+			completedWorkflowTaskStartedEventID = event.GetWorkflowTaskCompletedEventAttributes().GetStartedEventId()
 
 		case enumspb.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
 			if err := b.mutableState.ApplyWorkflowTaskTimedOutEvent(
@@ -670,15 +673,37 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 				return nil, err
 			}
 
-		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY,
-			enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
-			return nil, serviceerror.NewUnimplemented("Workflow/activity property modification not implemented")
+		case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
+			wasSuspended := b.mutableState.IsWorkflowExecutionSuspended()
+			if err := b.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+				return nil, err
+			}
+			if wasSuspended && !b.mutableState.IsWorkflowExecutionSuspended() {
+				// tasks deferred during suspension need to be generated now
+				if err := NewTaskRefresher(
+					b.shard,
+					b.shard.GetConfig(),
+					b.namespaceRegistry,
+					b.logger,
+				).RefreshDeferredTasks(ctx, b.mutableState); err != nil {
+					return nil, err
+				}
+			}
+
+		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY:
+			return nil, serviceerror.NewUnimplemented("Activity property modification not implemented")
 
 		default:
 			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown event type: %v", event.GetEventType()))
 		}
 	}
 
+	// Updates rejected by the workflow task are not written to history, their admissions are removed once the updates
+	// accepted by the workflow task are applied.
+	if completedWorkflowTaskStartedEventID != common.EmptyEventID {
+		b.mutableState.RemoveUnacceptedUpdateAdmissions(completedWorkflowTaskStartedEventID)
+	}
+
 	// The length of newRunHistory can be zero in resend case
 	if len(newRunHistory) == 0 {
 		return nil, nil
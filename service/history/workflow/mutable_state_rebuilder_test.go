@@ -1207,7 +1207,10 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowTaskCompleted() {
 			StartedEventId:   startedEventID,
 		}},
 	}
-	s.mockMutableState.EXPECT().ApplyWorkflowTaskCompletedEvent(protomock.Eq(event)).Return(nil)
+	gomock.InOrder(
+		s.mockMutableState.EXPECT().ApplyWorkflowTaskCompletedEvent(protomock.Eq(event)).Return(nil),
+		s.mockMutableState.EXPECT().RemoveUnacceptedUpdateAdmissions(startedEventID),
+	)
 	s.mockUpdateVersion(event)
 	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
 
@@ -2133,5 +2136,6 @@ func (p *testTaskGeneratorProvider) NewTaskGenerator(
 		mutableState,
 		shardContext.GetConfig(),
 		shardContext.GetArchivalMetadata(),
+		shardContext.GetMetricsHandler(),
 	)
 }
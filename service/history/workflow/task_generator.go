@@ -38,6 +38,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -110,6 +111,7 @@ type (
 		mutableState      MutableState
 		config            *configs.Config
 		archivalMetadata  archiver.ArchivalMetadata
+		metricsHandler    metrics.Handler
 	}
 )
 
@@ -122,12 +124,14 @@ func NewTaskGenerator(
 	mutableState MutableState,
 	config *configs.Config,
 	archivalMetadata archiver.ArchivalMetadata,
+	metricsHandler metrics.Handler,
 ) *TaskGeneratorImpl {
 	return &TaskGeneratorImpl{
 		namespaceRegistry: namespaceRegistry,
 		mutableState:      mutableState,
 		config:            config,
 		archivalMetadata:  archivalMetadata,
+		metricsHandler:    metricsHandler,
 	}
 }
 
@@ -447,6 +451,10 @@ func (r *TaskGeneratorImpl) GenerateScheduleWorkflowTaskTasks(
 		return serviceerror.NewInternal(fmt.Sprintf("it could be a bug, GenerateScheduleSpeculativeWorkflowTaskTasks must be called for speculative workflow task: %v", workflowTaskScheduledEventID))
 	}
 
+	if r.deferWhileSuspended() {
+		return nil
+	}
+
 	if r.mutableState.IsStickyTaskQueueSet() {
 		scheduleToStartTimeout := timestamp.DurationValue(r.mutableState.GetExecutionInfo().StickyScheduleToStartTimeout)
 		wttt := &tasks.WorkflowTaskTimeoutTask{
@@ -563,6 +571,10 @@ func (r *TaskGeneratorImpl) GenerateActivityTasks(
 		return serviceerror.NewInternal(fmt.Sprintf("it could be a bug, cannot get pending activity: %v", activityScheduledEventID))
 	}
 
+	if r.deferWhileSuspended() {
+		return nil
+	}
+
 	r.mutableState.AddTasks(&tasks.ActivityTask{
 		// TaskID, VisibilityTimestamp is set by shard
 		WorkflowKey:      r.mutableState.GetWorkflowKey(),
@@ -575,11 +587,17 @@ func (r *TaskGeneratorImpl) GenerateActivityTasks(
 }
 
 func (r *TaskGeneratorImpl) GenerateActivityRetryTasks(eventID int64, visibilityTimestamp time.Time, nextAttempt int32) error {
+	if r.deferWhileSuspended() {
+		return nil
+	}
+
+	// the timer may fire ahead of the retry time, matching holds the task until then
+	dispatchLead := r.config.ActivityRetryDispatchLead(r.mutableState.GetNamespaceEntry().Name().String())
 	r.mutableState.AddTasks(&tasks.ActivityRetryTimerTask{
 		// TaskID is set by shard
 		WorkflowKey:         r.mutableState.GetWorkflowKey(),
 		Version:             r.mutableState.GetCurrentVersion(),
-		VisibilityTimestamp: visibilityTimestamp,
+		VisibilityTimestamp: visibilityTimestamp.Add(-dispatchLead),
 		EventID:             eventID,
 		Attempt:             nextAttempt,
 	})
@@ -715,6 +733,10 @@ func (r *TaskGeneratorImpl) GenerateActivityTimerTasks() error {
 }
 
 func (r *TaskGeneratorImpl) GenerateUserTimerTasks() error {
+	if r.deferWhileSuspended() {
+		return nil
+	}
+
 	_, err := r.getTimerSequence().CreateNextUserTimer()
 	return err
 }
@@ -787,8 +809,14 @@ func (r *TaskGeneratorImpl) GenerateMigrationTasks() ([]tasks.Task, int64, error
 
 }
 
+// deferWhileSuspended returns true if task generation has to be skipped because the workflow is suspended. The
+// skipped tasks are generated by TaskRefresher.RefreshDeferredTasks when the workflow is resumed.
+func (r *TaskGeneratorImpl) deferWhileSuspended() bool {
+	return r.mutableState.IsWorkflowExecutionSuspended()
+}
+
 func (r *TaskGeneratorImpl) getTimerSequence() TimerSequence {
-	return NewTimerSequence(r.mutableState)
+	return NewCoalescingTimerSequence(r.mutableState, r.config, r.metricsHandler)
 }
 
 func (r *TaskGeneratorImpl) getTargetNamespaceID(
@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/plugins/callbacks"
 	"go.temporal.io/server/service/history/configs"
@@ -273,7 +274,7 @@ func TestTaskGeneratorImpl_GenerateWorkflowCloseTasks(t *testing.T) {
 				return cfg
 			}).AnyTimes()
 
-			taskGenerator := NewTaskGenerator(namespaceRegistry, mutableState, cfg, archivalMetadata)
+			taskGenerator := NewTaskGenerator(namespaceRegistry, mutableState, cfg, archivalMetadata, metrics.NoopMetricsHandler)
 			err := taskGenerator.GenerateWorkflowCloseTasks(p.CloseEventTime, p.DeleteAfterClose)
 			require.NoError(t, err)
 
@@ -389,7 +390,7 @@ func TestTaskGenerator_GenerateDirtySubStateMachineTasks(t *testing.T) {
 		genTasks = append(genTasks, ts...)
 	}).AnyTimes()
 
-	taskGenerator := NewTaskGenerator(namespaceRegistry, mutableState, cfg, archivalMetadata)
+	taskGenerator := NewTaskGenerator(namespaceRegistry, mutableState, cfg, archivalMetadata, metrics.NoopMetricsHandler)
 	err = taskGenerator.GenerateDirtySubStateMachineTasks(reg)
 	require.NoError(t, err)
 
@@ -606,6 +607,7 @@ func TestTaskGenerator_GenerateWorkflowStartTasks(t *testing.T) {
 				mockMutableState,
 				mockShard.GetConfig(),
 				mockShard.GetArchivalMetadata(),
+				mockShard.GetMetricsHandler(),
 			)
 
 			actualExecutionTimerTaskStatus, err := taskGenerator.GenerateWorkflowStartTasks(&history.HistoryEvent{
@@ -44,6 +44,9 @@ import (
 type (
 	TaskRefresher interface {
 		RefreshTasks(ctx context.Context, mutableState MutableState) error
+		// RefreshDeferredTasks regenerates the workflow task, activity and user timer tasks which the task generator
+		// deferred while the workflow execution was suspended.
+		RefreshDeferredTasks(ctx context.Context, mutableState MutableState) error
 	}
 
 	TaskRefresherImpl struct {
@@ -151,6 +154,36 @@ func (r *TaskRefresherImpl) RefreshTasks(
 	return r.refreshTasksForWorkflowSearchAttr(mutableState, taskGenerator)
 }
 
+func (r *TaskRefresherImpl) RefreshDeferredTasks(
+	ctx context.Context,
+	mutableState MutableState,
+) error {
+
+	taskGenerator := taskGeneratorProvider.NewTaskGenerator(
+		r.shard,
+		mutableState,
+	)
+
+	if err := r.refreshWorkflowTaskTasks(
+		mutableState,
+		taskGenerator,
+	); err != nil {
+		return err
+	}
+
+	if err := r.refreshTasksForActivity(
+		ctx,
+		mutableState,
+		taskGenerator,
+	); err != nil {
+		return err
+	}
+
+	return r.refreshTasksForTimer(
+		mutableState,
+	)
+}
+
 func (r *TaskRefresherImpl) refreshTasksForWorkflowStart(
 	ctx context.Context,
 	mutableState MutableState,
@@ -299,6 +332,11 @@ Loop:
 			continue Loop
 		}
 
+		// paused activities are dispatched once they are unpaused
+		if activityInfo.Paused {
+			continue Loop
+		}
+
 		scheduleEvent, err := mutableState.GetActivityScheduledEvent(ctx, activityInfo.ScheduledEventId)
 		if err != nil {
 			return err
@@ -311,8 +349,10 @@ Loop:
 		}
 	}
 
-	if _, err := NewTimerSequence(
+	if _, err := NewCoalescingTimerSequence(
 		mutableState,
+		r.config,
+		r.shard.GetMetricsHandler(),
 	).CreateNextActivityTimer(); err != nil {
 		return err
 	}
@@ -342,8 +382,10 @@ func (r *TaskRefresherImpl) refreshTasksForTimer(
 		}
 	}
 
-	if _, err := NewTimerSequence(
+	if _, err := NewCoalescingTimerSequence(
 		mutableState,
+		r.config,
+		r.shard.GetMetricsHandler(),
 	).CreateNextUserTimer(); err != nil {
 		return err
 	}
@@ -58,6 +58,20 @@ func (m *MockTaskRefresher) EXPECT() *MockTaskRefresherMockRecorder {
 	return m.recorder
 }
 
+// RefreshDeferredTasks mocks base method.
+func (m *MockTaskRefresher) RefreshDeferredTasks(ctx context.Context, mutableState MutableState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshDeferredTasks", ctx, mutableState)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshDeferredTasks indicates an expected call of RefreshDeferredTasks.
+func (mr *MockTaskRefresherMockRecorder) RefreshDeferredTasks(ctx, mutableState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshDeferredTasks", reflect.TypeOf((*MockTaskRefresher)(nil).RefreshDeferredTasks), ctx, mutableState)
+}
+
 // RefreshTasks mocks base method.
 func (m *MockTaskRefresher) RefreshTasks(ctx context.Context, mutableState MutableState) error {
 	m.ctrl.T.Helper()
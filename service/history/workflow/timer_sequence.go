@@ -36,7 +36,10 @@ import (
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -77,7 +80,9 @@ type (
 	}
 
 	timerSequenceImpl struct {
-		mutableState MutableState
+		mutableState   MutableState
+		config         *configs.Config
+		metricsHandler metrics.Handler
 	}
 )
 
@@ -91,6 +96,20 @@ func NewTimerSequence(
 	}
 }
 
+// NewCoalescingTimerSequence creates a timer sequence which, when creating the next timer task, lets the user timers
+// and activity timeouts due within the configured coalescing window share that task.
+func NewCoalescingTimerSequence(
+	mutableState MutableState,
+	config *configs.Config,
+	metricsHandler metrics.Handler,
+) *timerSequenceImpl {
+	return &timerSequenceImpl{
+		mutableState:   mutableState,
+		config:         config,
+		metricsHandler: metricsHandler,
+	}
+}
+
 func (t *timerSequenceImpl) CreateNextUserTimer() (bool, error) {
 
 	sequenceIDs := t.LoadAndSortUserTimers()
@@ -121,10 +140,14 @@ func (t *timerSequenceImpl) CreateNextUserTimer() (bool, error) {
 	if err := t.mutableState.UpdateUserTimer(timerInfo); err != nil {
 		return false, err
 	}
+	visibilityTimestamp, err := t.coalesceTimers(firstTimerTask.Timestamp)
+	if err != nil {
+		return false, err
+	}
 	t.mutableState.AddTasks(&tasks.UserTimerTask{
 		// TaskID is set by shard
 		WorkflowKey:         t.mutableState.GetWorkflowKey(),
-		VisibilityTimestamp: firstTimerTask.Timestamp,
+		VisibilityTimestamp: visibilityTimestamp,
 		EventID:             firstTimerTask.EventID,
 		Version:             t.mutableState.GetCurrentVersion(),
 	})
@@ -168,10 +191,19 @@ func (t *timerSequenceImpl) CreateNextActivityTimer() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+
+	visibilityTimestamp := firstTimerTask.Timestamp
+	// heartbeat timer tasks must fire at the heartbeat timeout recorded in the activity info, see
+	// UpdateActivityWithTimerHeartbeat
+	if firstTimerTask.TimerType != enumspb.TIMEOUT_TYPE_HEARTBEAT {
+		if visibilityTimestamp, err = t.coalesceTimers(firstTimerTask.Timestamp); err != nil {
+			return false, err
+		}
+	}
 	t.mutableState.AddTasks(&tasks.ActivityTimeoutTask{
 		// TaskID is set by shard
 		WorkflowKey:         t.mutableState.GetWorkflowKey(),
-		VisibilityTimestamp: firstTimerTask.Timestamp,
+		VisibilityTimestamp: visibilityTimestamp,
 		TimeoutType:         firstTimerTask.TimerType,
 		EventID:             firstTimerTask.EventID,
 		Attempt:             firstTimerTask.Attempt,
@@ -180,6 +212,77 @@ func (t *timerSequenceImpl) CreateNextActivityTimer() (bool, error) {
 	return true, nil
 }
 
+// coalesceTimers marks the user timers and activity timeouts due within the coalescing window after the given timer
+// as created, so that they are handled by the timer task about to be created, and returns the visibility timestamp of
+// that task. Heartbeat timeouts are never coalesced since they move with every heartbeat.
+func (t *timerSequenceImpl) coalesceTimers(
+	firstTimestamp time.Time,
+) (time.Time, error) {
+
+	if t.config == nil {
+		return firstTimestamp, nil
+	}
+	namespaceName := t.mutableState.GetNamespaceEntry().Name().String()
+	coalescingWindow := t.config.TimerCoalescingWindow(namespaceName)
+	if coalescingWindow <= 0 {
+		return firstTimestamp, nil
+	}
+
+	windowEnd := firstTimestamp.Add(coalescingWindow)
+	workflowRunExpirationTime := timestamp.TimeValue(t.mutableState.GetExecutionInfo().WorkflowRunExpirationTime)
+	if !workflowRunExpirationTime.IsZero() && workflowRunExpirationTime.Before(windowEnd) {
+		windowEnd = workflowRunExpirationTime
+	}
+
+	visibilityTimestamp := firstTimestamp
+	coalesced := 0
+	for _, sequenceID := range t.LoadAndSortUserTimers() {
+		if sequenceID.Timestamp.After(windowEnd) {
+			break
+		}
+		if sequenceID.TimerCreated {
+			continue
+		}
+		timerInfo, ok := t.mutableState.GetUserTimerInfoByEventID(sequenceID.EventID)
+		if !ok {
+			return time.Time{}, serviceerror.NewInternal(fmt.Sprintf("unable to load timer info %v", sequenceID.EventID))
+		}
+		timerInfo.TaskStatus = TimerTaskStatusCreated
+		if err := t.mutableState.UpdateUserTimer(timerInfo); err != nil {
+			return time.Time{}, err
+		}
+		visibilityTimestamp = util.MaxTime(visibilityTimestamp, sequenceID.Timestamp)
+		coalesced++
+	}
+
+	for _, sequenceID := range t.LoadAndSortActivityTimers() {
+		if sequenceID.Timestamp.After(windowEnd) {
+			break
+		}
+		if sequenceID.TimerCreated || sequenceID.TimerType == enumspb.TIMEOUT_TYPE_HEARTBEAT {
+			continue
+		}
+		activityInfo, ok := t.mutableState.GetActivityInfo(sequenceID.EventID)
+		if !ok {
+			return time.Time{}, serviceerror.NewInternal(fmt.Sprintf("unable to load activity info %v", sequenceID.EventID))
+		}
+		activityInfo.TimerTaskStatus |= timerTypeToTimerMask(sequenceID.TimerType)
+		if err := t.mutableState.UpdateActivity(activityInfo); err != nil {
+			return time.Time{}, err
+		}
+		visibilityTimestamp = util.MaxTime(visibilityTimestamp, sequenceID.Timestamp)
+		coalesced++
+	}
+
+	if coalesced > 0 {
+		metrics.TimerTasksCoalescedCounter.With(t.metricsHandler).Record(
+			int64(coalesced),
+			metrics.NamespaceTag(namespaceName),
+		)
+	}
+	return visibilityTimestamp, nil
+}
+
 func (t *timerSequenceImpl) LoadAndSortUserTimers() []TimerSequenceID {
 
 	pendingTimers := t.mutableState.GetPendingTimerInfos()
@@ -264,6 +367,16 @@ func (t *timerSequenceImpl) getActivityScheduleToStartTimeout(
 		return nil
 	}
 
+	// activity is paused and will not be dispatched until it is unpaused
+	if activityInfo.Paused {
+		return nil
+	}
+
+	// activity is not dispatched until the workflow is resumed
+	if t.mutableState.IsWorkflowExecutionSuspended() {
+		return nil
+	}
+
 	scheduleToStartDuration := timestamp.DurationValue(activityInfo.ScheduleToStartTimeout)
 	if scheduleToStartDuration == 0 {
 		return nil
@@ -32,11 +32,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/service/history/tasks"
@@ -81,6 +84,7 @@ func (s *timerSequenceSuite) SetupTest() {
 		tests.RunID,
 	)
 	s.mockMutableState.EXPECT().GetWorkflowKey().Return(s.workflowKey).AnyTimes()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionSuspended().Return(false).AnyTimes()
 	s.timerSequence = NewTimerSequence(s.mockMutableState)
 }
 
@@ -242,6 +246,70 @@ func (s *timerSequenceSuite) TestCreateNextUserTimer_NotCreated_NoWorkflowExpiry
 	s.True(modified)
 }
 
+func (s *timerSequenceSuite) TestCreateNextUserTimer_Coalesced() {
+	now := time.Now().UTC()
+	currentVersion := int64(999)
+	config := tests.NewDynamicConfig()
+	config.TimerCoalescingWindow = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(time.Second)
+	s.mockMutableState.EXPECT().GetNamespaceEntry().Return(tests.GlobalNamespaceEntry).AnyTimes()
+	timerSequence := NewCoalescingTimerSequence(s.mockMutableState, config, metrics.NoopMetricsHandler)
+
+	newTimerInfo := func(timerID string, startedEventID int64, expiry time.Duration) *persistencespb.TimerInfo {
+		return &persistencespb.TimerInfo{
+			Version:        123,
+			TimerId:        timerID,
+			StartedEventId: startedEventID,
+			ExpiryTime:     timestamppb.New(now.Add(expiry)),
+			TaskStatus:     TimerTaskStatusNone,
+		}
+	}
+	firstTimerInfo := newTimerInfo("first", 456, time.Second)
+	coalescedTimerInfo := newTimerInfo("coalesced", 457, 1500*time.Millisecond)
+	laterTimerInfo := newTimerInfo("later", 458, 5*time.Second)
+	activityInfo := &persistencespb.ActivityInfo{
+		Version:                123,
+		ScheduledEventId:       234,
+		ScheduledTime:          timestamppb.New(now),
+		StartedEventId:         common.EmptyEventID,
+		ActivityId:             "some random activity ID",
+		ScheduleToStartTimeout: durationpb.New(1200 * time.Millisecond),
+		ScheduleToCloseTimeout: timestamp.DurationFromSeconds(1000),
+		TimerTaskStatus:        TimerTaskStatusNone,
+		Attempt:                1,
+	}
+
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{}).AnyTimes()
+	s.mockMutableState.EXPECT().GetPendingTimerInfos().Return(map[string]*persistencespb.TimerInfo{
+		firstTimerInfo.TimerId:     firstTimerInfo,
+		coalescedTimerInfo.TimerId: coalescedTimerInfo,
+		laterTimerInfo.TimerId:     laterTimerInfo,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetPendingActivityInfos().Return(map[int64]*persistencespb.ActivityInfo{
+		activityInfo.ScheduledEventId: activityInfo,
+	}).AnyTimes()
+	s.mockMutableState.EXPECT().GetUserTimerInfoByEventID(firstTimerInfo.StartedEventId).Return(firstTimerInfo, true)
+	s.mockMutableState.EXPECT().GetUserTimerInfoByEventID(coalescedTimerInfo.StartedEventId).Return(coalescedTimerInfo, true)
+	s.mockMutableState.EXPECT().GetActivityInfo(activityInfo.ScheduledEventId).Return(activityInfo, true)
+	s.mockMutableState.EXPECT().UpdateUserTimer(gomock.Any()).Return(nil).Times(2)
+	s.mockMutableState.EXPECT().UpdateActivity(gomock.Any()).Return(nil)
+	s.mockMutableState.EXPECT().GetCurrentVersion().Return(currentVersion)
+	s.mockMutableState.EXPECT().AddTasks(&tasks.UserTimerTask{
+		// TaskID is set by shard
+		WorkflowKey:         s.workflowKey,
+		VisibilityTimestamp: coalescedTimerInfo.ExpiryTime.AsTime(),
+		EventID:             firstTimerInfo.GetStartedEventId(),
+		Version:             currentVersion,
+	})
+
+	modified, err := timerSequence.CreateNextUserTimer()
+	s.NoError(err)
+	s.True(modified)
+	s.Equal(int64(TimerTaskStatusCreated), firstTimerInfo.TaskStatus)
+	s.Equal(int64(TimerTaskStatusCreated), coalescedTimerInfo.TaskStatus)
+	s.Equal(int64(TimerTaskStatusNone), laterTimerInfo.TaskStatus)
+	s.Equal(int32(TimerTaskStatusCreatedScheduleToStart), activityInfo.TimerTaskStatus)
+}
+
 func (s *timerSequenceSuite) TestCreateNextActivityTimer_AlreadyCreated_AfterWorkflowExpiry() {
 	now := time.Now().UTC()
 	activityInfo := &persistencespb.ActivityInfo{
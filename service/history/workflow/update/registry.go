@@ -95,13 +95,14 @@ type (
 	}
 
 	registry struct {
-		mu              sync.RWMutex
-		updates         map[string]*Update
-		getStoreFn      func() Store
-		instrumentation instrumentation
-		maxInFlight     func() int
-		maxTotal        func() int
-		completedCount  int
+		mu               sync.RWMutex
+		updates          map[string]*Update
+		getStoreFn       func() Store
+		instrumentation  instrumentation
+		maxInFlight      func() int
+		maxTotal         func() int
+		durableAdmission func() bool
+		completedCount   int
 	}
 
 	Option func(*registry)
@@ -122,6 +123,16 @@ func WithTotalLimit(f func() int) Option {
 	}
 }
 
+// WithDurableAdmission provides an optional switch to persist the admission of
+// an update with an UpdateAdmitted event before the admission is acknowledged.
+// Durably admitted updates are reloaded from the Store and redelivered to the
+// worker after the registry is lost, e.g. when the shard moves to another host.
+func WithDurableAdmission(f func() bool) Option {
+	return func(r *registry) {
+		r.durableAdmission = f
+	}
+}
+
 // WithLogger sets the log.Logger to be used by an UpdateRegistry and its
 // Updates.
 func WithLogger(l log.Logger) Option {
@@ -153,11 +164,12 @@ func NewRegistry(
 	opts ...Option,
 ) Registry {
 	r := &registry{
-		updates:         make(map[string]*Update),
-		getStoreFn:      getStoreFn,
-		instrumentation: noopInstrumentation,
-		maxInFlight:     func() int { return math.MaxInt },
-		maxTotal:        func() int { return math.MaxInt },
+		updates:          make(map[string]*Update),
+		getStoreFn:       getStoreFn,
+		instrumentation:  noopInstrumentation,
+		maxInFlight:      func() int { return math.MaxInt },
+		maxTotal:         func() int { return math.MaxInt },
+		durableAdmission: func() bool { return false },
 	}
 	for _, opt := range opts {
 		opt(r)
@@ -203,7 +215,12 @@ func (r *registry) FindOrCreate(ctx context.Context, id string) (*Update, bool,
 	if err := r.admit(ctx); err != nil {
 		return nil, false, err
 	}
-	upd := New(id, r.remover(id), withInstrumentation(&r.instrumentation))
+	upd := New(
+		id,
+		r.remover(id),
+		withInstrumentation(&r.instrumentation),
+		withDurableAdmission(r.durableAdmission),
+	)
 	r.updates[id] = upd
 	return upd, false, nil
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
 	protocolpb "go.temporal.io/api/protocol/v1"
 	"go.temporal.io/api/serviceerror"
 	updatepb "go.temporal.io/api/update/v1"
@@ -656,3 +657,81 @@ func TestCancelIncomplete(t *testing.T) {
 	require.Nil(t, status.Outcome.GetFailure())
 	require.NotNil(t, status.Outcome.GetSuccess())
 }
+
+func TestDurableAdmission(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx              = context.Background()
+		updateID         = t.Name() + "-update-id"
+		admittedRequest  *updatepb.Request
+		rejectedUpdateID string
+		evStore          = mockEventStore{
+			Controller: effect.Immediate(ctx),
+			AddWorkflowExecutionUpdateAdmittedEventFunc: func(
+				request *updatepb.Request,
+				origin enumspb.UpdateAdmittedEventOrigin,
+			) (*historypb.HistoryEvent, error) {
+				admittedRequest = request
+				return &historypb.HistoryEvent{}, nil
+			},
+			RejectWorkflowExecutionUpdateAdmissionFunc: func(updateID string) error {
+				rejectedUpdateID = updateID
+				return nil
+			},
+		}
+		reg = update.NewRegistry(
+			func() update.Store { return emptyUpdateStore },
+			update.WithDurableAdmission(func() bool { return true }),
+		)
+		req = &updatepb.Request{
+			Meta:  &updatepb.Meta{UpdateId: updateID},
+			Input: &updatepb.Input{Name: t.Name() + "-update-func"},
+		}
+	)
+
+	upd, _, err := reg.FindOrCreate(ctx, updateID)
+	require.NoError(t, err)
+	require.NoError(t, upd.Admit(ctx, req, evStore))
+	require.Equal(t, req, admittedRequest, "admission should be written to an UpdateAdmitted event")
+	require.True(t, upd.AdmittedDurably())
+
+	require.True(t, reg.HasOutgoingMessages(false))
+	msgs := reg.Send(ctx, false, testSequencingEventID, evStore)
+	require.Empty(t, msgs, "request should be delivered with the UpdateAdmitted event")
+
+	rejectedIDs, err := reg.RejectUnprocessed(ctx, evStore)
+	require.NoError(t, err)
+	require.Equal(t, []string{updateID}, rejectedIDs)
+	require.Equal(t, updateID, rejectedUpdateID, "admission of rejected update should be undone")
+}
+
+func TestDurableAdmissionReload(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx      = context.Background()
+		updateID = t.Name() + "-update-id"
+		store    = mockUpdateStore{
+			VisitUpdatesFunc: func(visitor func(updID string, updInfo *updatespb.UpdateInfo)) {
+				visitor(updateID, &updatespb.UpdateInfo{
+					Value: &updatespb.UpdateInfo_Request{
+						Request: &updatespb.RequestInfo{
+							Location: &updatespb.RequestInfo_HistoryPointer_{
+								HistoryPointer: &updatespb.RequestInfo_HistoryPointer{EventId: 5},
+							},
+						},
+					},
+				})
+			},
+		}
+		reg = update.NewRegistry(func() update.Store { return store })
+	)
+
+	upd, found := reg.Find(ctx, updateID)
+	require.True(t, found, "durably admitted update should be reloaded from the store")
+	require.True(t, upd.AdmittedDurably())
+	require.True(t, reg.HasOutgoingMessages(false), "reloaded update should be redelivered to the worker")
+
+	status, err := upd.WaitLifecycleStage(ctx, enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ADMITTED, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ADMITTED, status.Stage)
+}
@@ -68,6 +68,19 @@ type (
 			resp *updatepb.Response,
 		) (*historypb.HistoryEvent, error)
 
+		// AddWorkflowExecutionUpdateAdmittedEvent writes an update admitted
+		// event. The data may not be durable when this function returns.
+		AddWorkflowExecutionUpdateAdmittedEvent(
+			request *updatepb.Request,
+			origin enumspb.UpdateAdmittedEventOrigin,
+		) (*historypb.HistoryEvent, error)
+
+		// RejectWorkflowExecutionUpdateAdmission undoes an UpdateAdmitted
+		// event without writing an event of its own, so that the update is not
+		// admitted again when the registry is reloaded from the store. The data
+		// may not be durable when this function returns.
+		RejectWorkflowExecutionUpdateAdmission(updateID string) error
+
 		// CanAddEvent returns true if an event can be added to the EventStore.
 		CanAddEvent() bool
 	}
@@ -90,6 +103,11 @@ type (
 		acceptedEventID int64
 		onComplete      func()
 		instrumentation *instrumentation
+		// durableAdmission indicates whether newly admitted updates are written
+		// to an UpdateAdmitted event before the admission is acknowledged.
+		durableAdmission func() bool
+		// admittedDurably is true when an UpdateAdmitted event exists for the update.
+		admittedDurably bool
 
 		// these fields might be accessed while not holding the workflow lock
 		accepted future.Future[*failurepb.Failure]
@@ -103,12 +121,13 @@ type (
 // onComplete callback when it completes.
 func New(id string, opts ...updateOpt) *Update {
 	upd := &Update{
-		id:              id,
-		state:           stateCreated,
-		onComplete:      func() {},
-		instrumentation: &noopInstrumentation,
-		accepted:        future.NewFuture[*failurepb.Failure](),
-		outcome:         future.NewFuture[*updatepb.Outcome](),
+		id:               id,
+		state:            stateCreated,
+		onComplete:       func() {},
+		instrumentation:  &noopInstrumentation,
+		durableAdmission: func() bool { return false },
+		accepted:         future.NewFuture[*failurepb.Failure](),
+		outcome:          future.NewFuture[*updatepb.Outcome](),
 	}
 	for _, opt := range opts {
 		opt(upd)
@@ -128,6 +147,12 @@ func withInstrumentation(i *instrumentation) updateOpt {
 	}
 }
 
+func withDurableAdmission(f func() bool) updateOpt {
+	return func(u *Update) {
+		u.durableAdmission = f
+	}
+}
+
 func newAdmitted(id string, request *anypb.Any, opts ...updateOpt) *Update {
 	upd := &Update{
 		id:              id,
@@ -138,6 +163,8 @@ func newAdmitted(id string, request *anypb.Any, opts ...updateOpt) *Update {
 		accepted:        future.NewFuture[*failurepb.Failure](),
 		outcome:         future.NewFuture[*updatepb.Outcome](),
 	}
+	// an admitted update without request payload derives from an UpdateAdmitted event
+	upd.admittedDurably = request == nil
 	for _, opt := range opts {
 		opt(upd)
 	}
@@ -280,10 +307,23 @@ func (u *Update) Admit(
 	if err != nil {
 		return invalidArgf("unable to marshal request: %v", err)
 	}
-	u.request = reqAny
+	if u.durableAdmission() {
+		// The request is persisted with the UpdateAdmitted event, which is also how it is communicated to the
+		// worker. This way the update survives the registry being reloaded from the store, e.g. after the shard
+		// moved to another host.
+		if _, err := eventStore.AddWorkflowExecutionUpdateAdmittedEvent(req, enumspb.UPDATE_ADMITTED_EVENT_ORIGIN_UNSPECIFIED); err != nil {
+			return err
+		}
+		u.admittedDurably = true
+	} else {
+		u.request = reqAny
+	}
 	u.setState(stateProvisionallyAdmitted)
 	eventStore.OnAfterCommit(func(context.Context) { u.setState(stateAdmitted) })
-	eventStore.OnAfterRollback(func(context.Context) { u.setState(stateCreated) })
+	eventStore.OnAfterRollback(func(context.Context) {
+		u.admittedDurably = false
+		u.setState(stateCreated)
+	})
 	return nil
 }
 
@@ -383,6 +423,12 @@ func (u *Update) Send(
 	}
 }
 
+// AdmittedDurably returns true if the admission of the update is recorded by an
+// UpdateAdmitted event, see WithDurableAdmission.
+func (u *Update) AdmittedDurably() bool {
+	return u.admittedDurably
+}
+
 // isSent checks if update was sent to worker.
 func (u *Update) isSent() bool {
 	return u.state.Matches(stateSet(stateProvisionallySent | stateSent))
@@ -482,6 +528,14 @@ func (u *Update) reject(
 	rejectionFailure *failurepb.Failure,
 	eventStore EventStore,
 ) error {
+	// Workers do not expect an UpdateRejected event for an update they rejected, so a durable admission is undone
+	// without one. Once the workflow is closed nothing can be written, and nothing is needed since updates of a closed
+	// workflow are rejected whenever they are reloaded.
+	if u.admittedDurably && eventStore.CanAddEvent() {
+		if err := eventStore.RejectWorkflowExecutionUpdateAdmission(u.id); err != nil {
+			return err
+		}
+	}
 	u.setState(stateProvisionallyCompleted)
 	eventStore.OnAfterCommit(func(context.Context) {
 		u.request = nil
@@ -76,6 +76,13 @@ type mockEventStore struct {
 		resp *updatepb.Response,
 	) (*historypb.HistoryEvent, error)
 
+	AddWorkflowExecutionUpdateAdmittedEventFunc func(
+		request *updatepb.Request,
+		origin enumspb.UpdateAdmittedEventOrigin,
+	) (*historypb.HistoryEvent, error)
+
+	RejectWorkflowExecutionUpdateAdmissionFunc func(updateID string) error
+
 	CanAddEventFunc func() bool
 }
 
@@ -101,6 +108,23 @@ func (m mockEventStore) AddWorkflowExecutionUpdateCompletedEvent(
 	return &historypb.HistoryEvent{}, nil
 }
 
+func (m mockEventStore) AddWorkflowExecutionUpdateAdmittedEvent(
+	request *updatepb.Request,
+	origin enumspb.UpdateAdmittedEventOrigin,
+) (*historypb.HistoryEvent, error) {
+	if m.AddWorkflowExecutionUpdateAdmittedEventFunc != nil {
+		return m.AddWorkflowExecutionUpdateAdmittedEventFunc(request, origin)
+	}
+	return &historypb.HistoryEvent{}, nil
+}
+
+func (m mockEventStore) RejectWorkflowExecutionUpdateAdmission(updateID string) error {
+	if m.RejectWorkflowExecutionUpdateAdmissionFunc != nil {
+		return m.RejectWorkflowExecutionUpdateAdmissionFunc(updateID)
+	}
+	return nil
+}
+
 func (m mockEventStore) CanAddEvent() bool {
 	if m.CanAddEventFunc != nil {
 		return m.CanAddEventFunc()
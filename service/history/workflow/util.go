@@ -25,16 +25,24 @@
 package workflow
 
 import (
+	"fmt"
+	"time"
+
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/internal/effect"
+	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 )
 
@@ -179,6 +187,219 @@ func FindAutoResetPoint(
 	return "", nil
 }
 
+// FindNonDeterministicAutoResetPoint returns the reset point to automatically reset a workflow to once its workflow
+// tasks failed the configured number of consecutive times with a non-deterministic error on the same build, i.e. the
+// last reset point created by another, known-good, build.
+func FindNonDeterministicAutoResetPoint(
+	timeSource clock.TimeSource,
+	config *configs.Config,
+	namespaceName string,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) (string, *workflowpb.ResetPointInfo) {
+	threshold := autoResetNonDeterministicFailures(config, namespaceName, executionInfo.GetWorkflowTypeName())
+	failures := executionInfo.GetNonDeterministicFailureCount()
+	if threshold <= 0 || int(failures) < threshold {
+		return "", nil
+	}
+	badBuildID := executionInfo.GetNonDeterministicFailureBuildId()
+
+	now := timeSource.Now()
+	points := executionInfo.GetAutoResetPoints().GetPoints()
+	for i := len(points) - 1; i >= 0; i-- {
+		p := points[i]
+		if !p.GetResettable() || autoResetBuildID(p.GetBuildId(), p.GetBinaryChecksum()) == badBuildID {
+			continue
+		}
+		expireTime := timestamp.TimeValue(p.GetExpireTime())
+		if !expireTime.IsZero() && now.After(expireTime) {
+			// reset point has expired and we may already deleted the history
+			continue
+		}
+		reason := fmt.Sprintf(
+			"auto-reset: workflow task failed %d consecutive times with a non-deterministic error on build %q",
+			failures,
+			badBuildID,
+		)
+		return reason, p
+	}
+	return "", nil
+}
+
+// IsAutoResetThresholdReached returns true if the consecutive non-deterministic failures of the workflow just reached
+// the auto-reset threshold.
+func IsAutoResetThresholdReached(
+	config *configs.Config,
+	namespaceName string,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) bool {
+	threshold := autoResetNonDeterministicFailures(config, namespaceName, executionInfo.GetWorkflowTypeName())
+	return threshold > 0 && int(executionInfo.GetNonDeterministicFailureCount()) == threshold
+}
+
+// IsAutoResetLimitReached returns true if the workflow was already automatically reset the max number of times within
+// the auto-reset window.
+func IsAutoResetLimitReached(
+	timeSource clock.TimeSource,
+	config *configs.Config,
+	namespaceName string,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) bool {
+	windowStart := timeSource.Now().Add(-config.AutoResetWindow(namespaceName))
+	resets := 0
+	for _, resetTime := range executionInfo.GetAutoResetTimes() {
+		if resetTime.AsTime().After(windowStart) {
+			resets++
+		}
+	}
+	return resets >= config.AutoResetMaxResetsPerWindow(namespaceName)
+}
+
+// AddAutoResetTime records an automatic reset of the workflow, dropping the reset times which are outside the
+// auto-reset window.
+func AddAutoResetTime(
+	timeSource clock.TimeSource,
+	config *configs.Config,
+	namespaceName string,
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+) {
+	now := timeSource.Now()
+	windowStart := now.Add(-config.AutoResetWindow(namespaceName))
+	resetTimes := make([]*timestamppb.Timestamp, 0, len(executionInfo.AutoResetTimes)+1)
+	for _, resetTime := range executionInfo.AutoResetTimes {
+		if resetTime.AsTime().After(windowStart) {
+			resetTimes = append(resetTimes, resetTime)
+		}
+	}
+	executionInfo.AutoResetTimes = append(resetTimes, timestamppb.New(now))
+}
+
+func autoResetNonDeterministicFailures(
+	config *configs.Config,
+	namespaceName string,
+	workflowType string,
+) int {
+	if value, ok := config.AutoResetNonDeterministicFailuresByWorkflowType(namespaceName)[workflowType]; ok {
+		switch value := value.(type) {
+		case int:
+			return value
+		case float64:
+			return int(value)
+		}
+	}
+	return config.AutoResetNonDeterministicFailures(namespaceName)
+}
+
+// autoResetBuildID identifies the build of a worker for the auto-reset policy: the build ID of versioned workers, or
+// the binary checksum of the others.
+func autoResetBuildID(
+	buildID string,
+	binaryChecksum string,
+) string {
+	if buildID != "" {
+		return buildID
+	}
+	return binaryChecksum
+}
+
+type (
+	// requestIDDedupWindow indexes the entries of a persisted dedup window by accept time, so that the expired and
+	// the oldest entries are evicted from the top of a heap instead of scanning the window on every add.
+	requestIDDedupWindow struct {
+		entries map[string]*timestamppb.Timestamp
+		// the heap is not updated when an entry is replaced, its stale items are skipped when they reach the top
+		order collection.Queue[requestIDDedupWindowItem]
+	}
+
+	requestIDDedupWindowItem struct {
+		requestID  string
+		acceptTime time.Time
+	}
+)
+
+func newRequestIDDedupWindow(
+	entries map[string]*timestamppb.Timestamp,
+) *requestIDDedupWindow {
+	if entries == nil {
+		entries = make(map[string]*timestamppb.Timestamp)
+	}
+	items := make([]requestIDDedupWindowItem, 0, len(entries))
+	for requestID, acceptTime := range entries {
+		items = append(items, requestIDDedupWindowItem{requestID: requestID, acceptTime: acceptTime.AsTime()})
+	}
+	return &requestIDDedupWindow{
+		entries: entries,
+		order: collection.NewPriorityQueueWithItems(
+			func(this requestIDDedupWindowItem, other requestIDDedupWindowItem) bool {
+				return this.acceptTime.Before(other.acceptTime)
+			},
+			items,
+		),
+	}
+}
+
+// add records the request ID as accepted at the given time, evicting the expired entries and, if the window is full,
+// the oldest ones. It returns the change of the approximate size of the window.
+func (w *requestIDDedupWindow) add(
+	requestID string,
+	acceptTime time.Time,
+	now time.Time,
+	ttl time.Duration,
+	maxSize int,
+) int {
+	sizeDelta := 0
+	if prev, ok := w.entries[requestID]; ok {
+		if !prev.AsTime().Before(acceptTime) {
+			return 0
+		}
+		delete(w.entries, requestID)
+		sizeDelta -= requestIDDedupWindowEntrySize(requestID, prev)
+	}
+	for !w.order.IsEmpty() {
+		top := w.order.Peek()
+		entry, ok := w.entries[top.requestID]
+		if ok && entry.AsTime().Equal(top.acceptTime) && now.Sub(top.acceptTime) < ttl && len(w.entries) < maxSize {
+			break
+		}
+		w.order.Remove()
+		if ok && entry.AsTime().Equal(top.acceptTime) {
+			delete(w.entries, top.requestID)
+			sizeDelta -= requestIDDedupWindowEntrySize(top.requestID, entry)
+		}
+	}
+	if now.Sub(acceptTime) >= ttl {
+		return sizeDelta
+	}
+	entry := timestamppb.New(acceptTime)
+	w.entries[requestID] = entry
+	w.order.Add(requestIDDedupWindowItem{requestID: requestID, acceptTime: acceptTime})
+	return sizeDelta + requestIDDedupWindowEntrySize(requestID, entry)
+}
+
+func requestIDDedupWindowEntrySize(
+	requestID string,
+	acceptTime *timestamppb.Timestamp,
+) int {
+	return len(requestID) + proto.Size(acceptTime)
+}
+
+// isInRequestIDDedupWindow returns true if the request ID is in the dedup window and has not expired.
+func isInRequestIDDedupWindow(
+	timeSource clock.TimeSource,
+	config *configs.Config,
+	namespaceName string,
+	window map[string]*timestamppb.Timestamp,
+	requestID string,
+) bool {
+	if requestID == "" || config.RequestIDDedupWindowSize(namespaceName) <= 0 {
+		return false
+	}
+	acceptTime, ok := window[requestID]
+	if !ok {
+		return false
+	}
+	return timeSource.Now().Sub(acceptTime.AsTime()) < config.RequestIDDedupWindowTTL(namespaceName)
+}
+
 func WithEffects(effects effect.Controller, ms MutableState) MutableStateWithEffects {
 	return MutableStateWithEffects{
 		MutableState: ms,
@@ -311,7 +311,8 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskScheduledEventAsHeartbeat(
 		m.ms.executionInfo.WorkflowTaskAttempt = 1
 		workflowTaskType = enumsspb.WORKFLOW_TASK_TYPE_NORMAL
 		createWorkflowTaskScheduledEvent = true
-		m.ms.updatePendingEventIDs(m.ms.hBuilder.FlushBufferToCurrentBatch())
+		scheduledIDToStartedID := m.ms.hBuilder.FlushBufferToCurrentBatch()
+		m.ms.updatePendingEventIDs(scheduledIDToStartedID, m.ms.hBuilder.FlushedUpdateAdmissions())
 	}
 	if m.ms.IsTransientWorkflowTask() {
 		lastWriteVersion, err := m.ms.GetLastWriteVersion()
@@ -671,6 +672,19 @@ func (m *workflowTaskStateMachine) AddWorkflowTaskFailedEvent(
 		m.ms.workflowCloseAttempted = true
 	}
 
+	// Track consecutive non-deterministic failures on the same build for the auto-reset policy.
+	if cause == enumspb.WORKFLOW_TASK_FAILED_CAUSE_NON_DETERMINISTIC_ERROR {
+		buildID := autoResetBuildID(versioningStamp.GetBuildId(), binaryChecksum)
+		if m.ms.executionInfo.NonDeterministicFailureBuildId != buildID {
+			m.ms.executionInfo.NonDeterministicFailureBuildId = buildID
+			m.ms.executionInfo.NonDeterministicFailureCount = 0
+		}
+		m.ms.executionInfo.NonDeterministicFailureCount++
+		m.ms.nonDeterministicFailureRecorded = true
+	} else {
+		m.ms.clearNonDeterministicFailures()
+	}
+
 	// Attempt counter was incremented directly in mutable state. Current WT attempt counter needs to be updated.
 	workflowTask.Attempt = m.ms.GetExecutionInfo().GetWorkflowTaskAttempt()
 
@@ -937,6 +951,7 @@ func (m *workflowTaskStateMachine) afterAddWorkflowTaskCompletedEvent(
 	attrs := event.GetWorkflowTaskCompletedEventAttributes()
 	m.ms.executionInfo.LastWorkflowTaskStartedEventId = attrs.GetStartedEventId()
 	m.ms.executionInfo.MostRecentWorkerVersionStamp = attrs.GetWorkerVersion()
+	m.ms.clearNonDeterministicFailures()
 	addedResetPoint := m.ms.addResetPointFromCompletion(
 		attrs.GetBinaryChecksum(),
 		attrs.GetWorkerVersion().GetBuildId(),
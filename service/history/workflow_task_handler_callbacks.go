@@ -228,6 +228,12 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				return nil, serviceerror.NewNotFound("Workflow task not found.")
 			}
 
+			// A workflow task of a suspended workflow is rescheduled when the workflow is resumed,
+			// so it is OK to drop the task.
+			if mutableState.IsWorkflowExecutionSuspended() && workflowTask.StartedEventID == common.EmptyEventID {
+				return nil, serviceerror.NewNotFound("Workflow task not found.")
+			}
+
 			workflowKey = mutableState.GetWorkflowKey()
 			updateAction := &api.UpdateWorkflowAction{}
 
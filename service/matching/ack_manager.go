@@ -147,6 +147,14 @@ func (m *ackManager) completeTask(taskID int64) int64 {
 	}
 }
 
+// isOutstanding returns true if the task was added and is not completed yet.
+func (m *ackManager) isOutstanding(taskID int64) bool {
+	m.RLock()
+	defer m.RUnlock()
+	acked, found := m.outstandingTasks.Get(taskID)
+	return found && !acked.(bool)
+}
+
 func (m *ackManager) getBacklogCountHint() int64 {
 	return m.backlogCounter.Load()
 }
@@ -26,16 +26,22 @@ package matching
 
 import (
 	"bytes"
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/future"
@@ -69,9 +75,21 @@ type (
 		SpoolTask(taskInfo *persistencespb.TaskInfo) error
 		BacklogCountHint() int64
 		BacklogStatus() *taskqueuepb.TaskQueueStatus
+		// ListTasks returns a page of backlog tasks that are accepted by the given filter.
+		ListTasks(ctx context.Context, pageSize int, nextPageToken []byte, filter backlogTaskFilterFunc) ([]*persistencespb.AllocatedTaskInfo, []byte, error)
+		// DeleteTasks deletes the given backlog tasks. afterDelete, if not nil, is called for each
+		// task found in the backlog once its deletion is persisted.
+		DeleteTasks(ctx context.Context, taskIDs []int64, afterDelete func(*persistencespb.AllocatedTaskInfo) error) ([]*taskqueuespb.BacklogTaskDeletionResult, error)
 		String() string
 	}
 
+	// backlogTaskFilterFunc returns true if the task should be included in a backlog listing.
+	backlogTaskFilterFunc func(context.Context, *persistencespb.AllocatedTaskInfo) (bool, error)
+
+	backlogPageToken struct {
+		LastTaskID int64
+	}
+
 	backlogManagerImpl struct {
 		pqMgr               physicalTaskQueueManager
 		db                  *taskQueueDB
@@ -214,6 +232,197 @@ func (c *backlogManagerImpl) BacklogStatus() *taskqueuepb.TaskQueueStatus {
 	}
 }
 
+func (c *backlogManagerImpl) ListTasks(
+	ctx context.Context,
+	pageSize int,
+	nextPageToken []byte,
+	filter backlogTaskFilterFunc,
+) ([]*persistencespb.AllocatedTaskInfo, []byte, error) {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return nil, nil, err
+	}
+	minTaskID := c.taskAckManager.getAckLevel() + 1
+	if len(nextPageToken) > 0 {
+		var token backlogPageToken
+		if err := json.Unmarshal(nextPageToken, &token); err != nil {
+			return nil, nil, serviceerror.NewInvalidArgument("invalid next page token")
+		}
+		minTaskID = max(minTaskID, token.LastTaskID+1)
+	}
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	if minTaskID > maxReadLevel {
+		return nil, nil, nil
+	}
+
+	// Each page scans a fixed number of tasks from persistence, so a page can be empty when
+	// the filter rejects everything that was scanned.
+	resp, err := c.db.GetTasks(ctx, minTaskID, maxReadLevel+1, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for _, task := range resp.Tasks {
+		if c.db.IsTaskDeleted(task.GetTaskId()) {
+			continue
+		}
+		if filter != nil {
+			include, err := filter(ctx, task)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !include {
+				continue
+			}
+		}
+		tasks = append(tasks, task)
+	}
+	if len(resp.Tasks) < pageSize {
+		return tasks, nil, nil
+	}
+	token, err := json.Marshal(backlogPageToken{LastTaskID: resp.Tasks[len(resp.Tasks)-1].GetTaskId()})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, token, nil
+}
+
+// DeleteTasks deletes tasks from the backlog by persisting tombstones for them and returns the outcome for every
+// distinct requested task ID. Tasks that the task reader has loaded already are left alone and reported as in flight,
+// since they may be matched with a poller at any moment. Tombstones are persisted before afterDelete runs, so a task
+// can never be dispatched from both this backlog and wherever afterDelete puts it. If afterDelete fails for a task,
+// that task is written back to the backlog under a new task ID and reported as failed. An error is returned if a task
+// could not be written back, since such a task is not in the backlog anymore.
+func (c *backlogManagerImpl) DeleteTasks(
+	ctx context.Context,
+	taskIDs []int64,
+	afterDelete func(*persistencespb.AllocatedTaskInfo) error,
+) ([]*taskqueuespb.BacklogTaskDeletionResult, error) {
+	if err := c.WaitUntilInitialized(ctx); err != nil {
+		return nil, err
+	}
+	taskIDs = slices.Clone(taskIDs)
+	slices.Sort(taskIDs)
+	taskIDs = slices.Compact(taskIDs)
+	if maxTombstones := c.config.MaxBacklogTaskTombstones(); c.db.DeletedTaskCount()+len(taskIDs) > maxTombstones {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"cannot delete %d tasks: task queue already has %d deleted tasks pending cleanup (limit %d)",
+			len(taskIDs), c.db.DeletedTaskCount(), maxTombstones))
+	}
+
+	ackLevel := c.taskAckManager.getAckLevel()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	results := make([]*taskqueuespb.BacklogTaskDeletionResult, 0, len(taskIDs))
+	var found []*persistencespb.AllocatedTaskInfo
+	for _, taskID := range taskIDs {
+		if taskID <= ackLevel || taskID > maxReadLevel || c.db.IsTaskDeleted(taskID) {
+			results = append(results, newBacklogTaskDeletionResult(taskID, enumsspb.BACKLOG_TASK_DELETION_STATUS_NOT_FOUND, nil))
+			continue
+		}
+		resp, err := c.db.GetTasks(ctx, taskID, taskID+1, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Tasks) == 0 {
+			results = append(results, newBacklogTaskDeletionResult(taskID, enumsspb.BACKLOG_TASK_DELETION_STATUS_NOT_FOUND, nil))
+			continue
+		}
+		found = append(found, resp.Tasks[0])
+	}
+
+	deleting, err := c.tombstoneUnloadedTasks(ctx, found, &results)
+	c.signalIfFatal(err)
+	if err != nil {
+		return nil, err
+	}
+
+	var restoreErrs []error
+	deleted := 0
+	for _, task := range deleting {
+		if afterDelete != nil {
+			if err := afterDelete(task); err != nil {
+				result := newBacklogTaskDeletionResult(task.GetTaskId(), enumsspb.BACKLOG_TASK_DELETION_STATUS_FAILED, err)
+				if restoreErr := c.restoreDeletedTask(task); restoreErr != nil {
+					restoreErrs = append(restoreErrs, fmt.Errorf("task %d: %w", task.GetTaskId(), restoreErr))
+					result.Error = fmt.Sprintf("%v; writing the task back to the backlog failed: %v", err, restoreErr)
+				}
+				results = append(results, result)
+				continue
+			}
+		}
+		results = append(results, newBacklogTaskDeletionResult(task.GetTaskId(), enumsspb.BACKLOG_TASK_DELETION_STATUS_DELETED, nil))
+		deleted++
+	}
+	if deleted > 0 {
+		c.logger.Info("Deleted tasks from backlog", tag.Counter(deleted), tag.AckLevel(ackLevel))
+	}
+	slices.SortFunc(results, func(a, b *taskqueuespb.BacklogTaskDeletionResult) int {
+		return cmp.Compare(a.GetTaskId(), b.GetTaskId())
+	})
+	if err := errors.Join(restoreErrs...); err != nil {
+		return results, serviceerror.NewInternal(fmt.Sprintf("failed to write tasks back to the backlog after moving them failed: %v", err))
+	}
+	return results, nil
+}
+
+// tombstoneUnloadedTasks persists tombstones for the given tasks that the task reader has not loaded yet and returns
+// them. Results for the other tasks are appended to results. The task reader's loadLock is held while the tombstones
+// are persisted, so that the reader cannot load a task between the check and the write.
+func (c *backlogManagerImpl) tombstoneUnloadedTasks(
+	ctx context.Context,
+	tasks []*persistencespb.AllocatedTaskInfo,
+	results *[]*taskqueuespb.BacklogTaskDeletionResult,
+) ([]*persistencespb.AllocatedTaskInfo, error) {
+	c.taskReader.loadLock.Lock()
+	defer c.taskReader.loadLock.Unlock()
+
+	readLevel := c.taskAckManager.getReadLevel()
+	var unloaded []*persistencespb.AllocatedTaskInfo
+	var unloadedIDs []int64
+	for _, task := range tasks {
+		switch {
+		case task.GetTaskId() > readLevel:
+			unloaded = append(unloaded, task)
+			unloadedIDs = append(unloadedIDs, task.GetTaskId())
+		case c.taskAckManager.isOutstanding(task.GetTaskId()):
+			*results = append(*results, newBacklogTaskDeletionResult(task.GetTaskId(), enumsspb.BACKLOG_TASK_DELETION_STATUS_IN_FLIGHT, nil))
+		default:
+			// completed after it was read above
+			*results = append(*results, newBacklogTaskDeletionResult(task.GetTaskId(), enumsspb.BACKLOG_TASK_DELETION_STATUS_NOT_FOUND, nil))
+		}
+	}
+	if len(unloaded) == 0 {
+		return nil, nil
+	}
+	if err := c.db.AddDeletedTasks(ctx, unloadedIDs); err != nil {
+		return nil, err
+	}
+	return unloaded, nil
+}
+
+// restoreDeletedTask writes a tombstoned task back to the backlog. Removing the tombstone instead is not safe because
+// the task reader may have acked the task already.
+func (c *backlogManagerImpl) restoreDeletedTask(task *persistencespb.AllocatedTaskInfo) error {
+	return executeWithRetry(context.Background(), func(_ context.Context) error {
+		_, err := c.taskWriter.appendTask(task.Data)
+		return err
+	})
+}
+
+func newBacklogTaskDeletionResult(
+	taskID int64,
+	status enumsspb.BacklogTaskDeletionStatus,
+	err error,
+) *taskqueuespb.BacklogTaskDeletionResult {
+	result := &taskqueuespb.BacklogTaskDeletionResult{
+		TaskId: taskID,
+		Status: status,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
 func (c *backlogManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	rangeID := c.db.RangeID()
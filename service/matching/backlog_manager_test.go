@@ -26,14 +26,20 @@ package matching
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestDeliverBufferTasks(t *testing.T) {
@@ -135,6 +141,97 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	require.Equal(t, int64(14), tlm.backlogMgr.taskAckManager.getReadLevel())
 }
 
+func TestDelayedTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestPhysicalTaskQueueManager(t, controller)
+	tr := tlm.backlogMgr.taskReader
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	tr.timeSource = timeSource
+	tlm.backlogMgr.taskAckManager.setAckLevel(0)
+	tlm.backlogMgr.taskAckManager.setReadLevel(0)
+
+	delayedTask := func(taskID int64, delay time.Duration) *persistencespb.AllocatedTaskInfo {
+		return &persistencespb.AllocatedTaskInfo{
+			Data:   &persistencespb.TaskInfo{VisibilityTime: timestamppb.New(now.Add(delay))},
+			TaskId: taskID,
+		}
+	}
+	require.NoError(t, tr.addTasksToBuffer(context.Background(), []*persistencespb.AllocatedTaskInfo{
+		delayedTask(11, time.Minute),
+		delayedTask(12, 0),
+		delayedTask(13, 30*time.Second),
+	}))
+	require.Len(t, tr.taskBuffer, 1)
+	require.Equal(t, int64(12), (<-tr.taskBuffer).GetTaskId())
+
+	// the ack level does not move past a delayed task when tasks behind it complete
+	require.Equal(t, int64(0), tlm.backlogMgr.taskAckManager.completeTask(12))
+
+	tr.gorogrp.Go(tr.dispatchDelayedTasks)
+	defer tr.gorogrp.Cancel()
+	nextDispatched := func() int64 {
+		var task *persistencespb.AllocatedTaskInfo
+		require.Eventually(t, func() bool {
+			select {
+			case task = <-tr.taskBuffer:
+				return true
+			default:
+				tr.signalDelayed()
+				return false
+			}
+		}, time.Second, time.Millisecond)
+		return task.GetTaskId()
+	}
+
+	timeSource.Advance(30 * time.Second)
+	require.Equal(t, int64(13), nextDispatched())
+	require.Equal(t, int64(0), tlm.backlogMgr.taskAckManager.completeTask(13))
+	require.Empty(t, tr.taskBuffer)
+
+	timeSource.Advance(30 * time.Second)
+	require.Equal(t, int64(11), nextDispatched())
+	require.Equal(t, int64(13), tlm.backlogMgr.taskAckManager.completeTask(11))
+}
+
+func TestDelayedTasks_Parked(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestPhysicalTaskQueueManager(t, controller)
+	tr := tlm.backlogMgr.taskReader
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	tr.timeSource = timeSource
+	// park every delayed task
+	tlm.backlogMgr.config.MaxBufferedDelayedTasks = func() int { return 0 }
+	tlm.Start()
+	defer tlm.Stop()
+	require.NoError(t, tlm.WaitUntilInitialized(context.Background()))
+
+	require.NoError(t, tlm.backlogMgr.SpoolTask(&persistencespb.TaskInfo{
+		WorkflowId:     "delayed",
+		VisibilityTime: timestamppb.New(now.Add(time.Minute)),
+	}))
+	require.NoError(t, tlm.backlogMgr.SpoolTask(&persistencespb.TaskInfo{WorkflowId: "ready"}))
+
+	// the parked task does not hold up the ready task behind it
+	_, out := runOneShotPoller(context.Background(), tlm)
+	require.Equal(t, "ready", (<-out).(*internalTask).event.GetData().GetWorkflowId())
+	tr.delayedLock.Lock()
+	require.Equal(t, 1, tr.delayedTasks.len())
+	require.Equal(t, 0, tr.delayedTasks.loadedLen())
+	tr.delayedLock.Unlock()
+
+	// once due, the parked task is read again from persistence and dispatched
+	timeSource.Advance(time.Minute)
+	tr.signalDelayed()
+	_, out = runOneShotPoller(context.Background(), tlm)
+	require.Equal(t, "delayed", (<-out).(*internalTask).event.GetData().GetWorkflowId())
+}
+
 func TestTaskWriterShutdown(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -154,3 +251,177 @@ func TestTaskWriterShutdown(t *testing.T) {
 	err = tlm.backlogMgr.SpoolTask(&persistencespb.TaskInfo{})
 	require.Error(t, err)
 }
+
+func TestDeleteBacklogTasks(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestPhysicalTaskQueueManager(t, controller)
+	tlm.Start()
+	defer tlm.Stop()
+	require.NoError(t, tlm.WaitUntilInitialized(context.Background()))
+	// keep the tasks in the backlog instead of loading them for dispatch
+	tr := tlm.backlogMgr.taskReader
+	tr.Stop()
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, tlm.backlogMgr.SpoolTask(&persistencespb.TaskInfo{WorkflowId: fmt.Sprintf("wf-%d", i)}))
+	}
+	tasks, _, err := tlm.ListBacklogTasks(context.Background(), 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, tasks, 4)
+	// the first task is loaded into the buffer as if the reader was running
+	require.NoError(t, tr.addTasksToBuffer(context.Background(), tasks[:1]))
+
+	var moved []*persistencespb.AllocatedTaskInfo
+	results, err := tlm.DeleteBacklogTasks(
+		context.Background(),
+		[]int64{tasks[2].GetTaskId(), tasks[0].GetTaskId(), tasks[2].GetTaskId(), tasks[3].GetTaskId() + 1000},
+		func(task *persistencespb.AllocatedTaskInfo) error {
+			moved = append(moved, task)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	requireDeletionResults(t, map[int64]enumsspb.BacklogTaskDeletionStatus{
+		tasks[0].GetTaskId():        enumsspb.BACKLOG_TASK_DELETION_STATUS_IN_FLIGHT,
+		tasks[2].GetTaskId():        enumsspb.BACKLOG_TASK_DELETION_STATUS_DELETED,
+		tasks[3].GetTaskId() + 1000: enumsspb.BACKLOG_TASK_DELETION_STATUS_NOT_FOUND,
+	}, results)
+	require.Len(t, moved, 1)
+	require.Equal(t, "wf-2", moved[0].GetData().GetWorkflowId())
+	require.True(t, tlm.backlogMgr.db.IsTaskDeleted(tasks[2].GetTaskId()))
+	require.False(t, tlm.backlogMgr.db.IsTaskDeleted(tasks[0].GetTaskId()))
+
+	remaining, _, err := tlm.ListBacklogTasks(context.Background(), 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, remaining, 3)
+	require.Equal(t, tasks[0].GetTaskId(), remaining[0].GetTaskId())
+	require.Equal(t, tasks[1].GetTaskId(), remaining[1].GetTaskId())
+	require.Equal(t, tasks[3].GetTaskId(), remaining[2].GetTaskId())
+
+	// deleting the same task again reports it as not found
+	results, err = tlm.DeleteBacklogTasks(context.Background(), []int64{tasks[2].GetTaskId()}, nil)
+	require.NoError(t, err)
+	requireDeletionResults(t, map[int64]enumsspb.BacklogTaskDeletionStatus{
+		tasks[2].GetTaskId(): enumsspb.BACKLOG_TASK_DELETION_STATUS_NOT_FOUND,
+	}, results)
+
+	// a failing callback only fails its own task, which is written back to the backlog
+	results, err = tlm.DeleteBacklogTasks(
+		context.Background(),
+		[]int64{tasks[1].GetTaskId(), tasks[3].GetTaskId()},
+		func(task *persistencespb.AllocatedTaskInfo) error {
+			if task.GetTaskId() == tasks[1].GetTaskId() {
+				return errors.New("move failed")
+			}
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	requireDeletionResults(t, map[int64]enumsspb.BacklogTaskDeletionStatus{
+		tasks[1].GetTaskId(): enumsspb.BACKLOG_TASK_DELETION_STATUS_FAILED,
+		tasks[3].GetTaskId(): enumsspb.BACKLOG_TASK_DELETION_STATUS_DELETED,
+	}, results)
+	require.Equal(t, "move failed", results[0].GetError())
+
+	remaining, _, err = tlm.ListBacklogTasks(context.Background(), 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	require.Equal(t, tasks[0].GetTaskId(), remaining[0].GetTaskId())
+	require.Equal(t, "wf-1", remaining[1].GetData().GetWorkflowId())
+	require.Greater(t, remaining[1].GetTaskId(), tasks[3].GetTaskId())
+}
+
+func TestDeleteBacklogTasks_TombstonedTaskIsNotLoaded(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestPhysicalTaskQueueManager(t, controller)
+	tlm.Start()
+	defer tlm.Stop()
+	require.NoError(t, tlm.WaitUntilInitialized(context.Background()))
+	tr := tlm.backlogMgr.taskReader
+	tr.Stop()
+
+	require.NoError(t, tlm.backlogMgr.SpoolTask(&persistencespb.TaskInfo{WorkflowId: "wf"}))
+	tasks, _, err := tlm.ListBacklogTasks(context.Background(), 10, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	results, err := tlm.DeleteBacklogTasks(context.Background(), []int64{tasks[0].GetTaskId()}, nil)
+	require.NoError(t, err)
+	requireDeletionResults(t, map[int64]enumsspb.BacklogTaskDeletionStatus{
+		tasks[0].GetTaskId(): enumsspb.BACKLOG_TASK_DELETION_STATUS_DELETED,
+	}, results)
+
+	// the reader acks the tombstoned task instead of buffering it
+	require.NoError(t, tr.addTasksToBuffer(context.Background(), tasks))
+	require.Empty(t, tr.taskBuffer)
+	require.Equal(t, tasks[0].GetTaskId(), tlm.backlogMgr.taskAckManager.getAckLevel())
+}
+
+func requireDeletionResults(
+	t *testing.T,
+	expected map[int64]enumsspb.BacklogTaskDeletionStatus,
+	results []*taskqueuespb.BacklogTaskDeletionResult,
+) {
+	t.Helper()
+	require.Len(t, results, len(expected))
+	for i, result := range results {
+		if i > 0 {
+			require.Less(t, results[i-1].GetTaskId(), result.GetTaskId())
+		}
+		require.Equal(t, expected[result.GetTaskId()], result.GetStatus(), "task %d", result.GetTaskId())
+	}
+}
+
+func TestListBacklogTasks_Pagination(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestPhysicalTaskQueueManager(t, controller)
+	tlm.Start()
+	defer tlm.Stop()
+	require.NoError(t, tlm.WaitUntilInitialized(context.Background()))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tlm.backlogMgr.SpoolTask(&persistencespb.TaskInfo{WorkflowId: fmt.Sprintf("wf-%d", i)}))
+	}
+	filter := func(_ context.Context, task *persistencespb.AllocatedTaskInfo) (bool, error) {
+		return task.GetData().GetWorkflowId() != "wf-1", nil
+	}
+	tasks, token, err := tlm.ListBacklogTasks(context.Background(), 3, nil, filter)
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	require.NotNil(t, token)
+
+	tasks, token, err = tlm.ListBacklogTasks(context.Background(), 3, token, filter)
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+	require.Nil(t, token)
+}
+
+func TestReadLevelForDeletedTasksInBatch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestPhysicalTaskQueueManager(t, controller)
+	tlm.backlogMgr.db.rangeID = int64(1)
+	tlm.backlogMgr.db.ackLevel = int64(0)
+	tlm.backlogMgr.db.deletedTaskIDs[11] = struct{}{}
+	tlm.backlogMgr.db.deletedTaskIDs[12] = struct{}{}
+	tlm.backlogMgr.taskAckManager.setAckLevel(tlm.backlogMgr.db.ackLevel)
+	tlm.backlogMgr.taskAckManager.setReadLevel(tlm.backlogMgr.db.ackLevel)
+
+	require.NoError(t, tlm.backlogMgr.taskReader.addTasksToBuffer(context.TODO(), []*persistencespb.AllocatedTaskInfo{
+		{Data: &persistencespb.TaskInfo{}, TaskId: 11},
+		{Data: &persistencespb.TaskInfo{}, TaskId: 12},
+		{Data: &persistencespb.TaskInfo{}, TaskId: 13},
+	}))
+	// deleted tasks are acked right away and never reach the buffer
+	require.Equal(t, int64(12), tlm.backlogMgr.taskAckManager.getAckLevel())
+	require.Equal(t, int64(13), tlm.backlogMgr.taskAckManager.getReadLevel())
+	require.Equal(t, int64(1), tlm.backlogMgr.BacklogCountHint())
+	require.Len(t, tlm.backlogMgr.taskReader.taskBuffer, 1)
+}
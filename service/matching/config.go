@@ -63,6 +63,20 @@ type (
 		MaxTaskQueueIdleTime                     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		NumTaskqueueWritePartitions              dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		NumTaskqueueReadPartitions               dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MaxTasksPerPoll                          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MultiTaskPollWait                        dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		WorkerRegistryTTL                        dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		WorkerRegistryMaxSize                    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MaxBufferedDelayedTasks                  dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		EnablePartitionAutoScale                 dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleInterval               dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleSustainDuration        dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScalePropagationDelay       dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleTargetAddRate          dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleTargetPollers          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleTargetBacklog          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleMinPartitions          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		PartitionAutoScaleMaxPartitions          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxOutstandingPolls             dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxOutstandingTasks             dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		ForwarderMaxRatePerSecond                dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -86,6 +100,7 @@ type (
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		MaxBacklogTaskTombstones   dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
@@ -132,6 +147,15 @@ type (
 		MaxTaskQueueIdleTime       func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
+		MaxBacklogTaskTombstones   func() int
+		// multi-task polls
+		MaxTasksPerPoll   func() int
+		MultiTaskPollWait func() time.Duration
+
+		WorkerRegistryTTL     func() time.Duration
+		WorkerRegistryMaxSize func() int
+		// Max number of delayed tasks held in memory per physical queue
+		MaxBufferedDelayedTasks func() int
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -142,6 +166,17 @@ type (
 		NumWritePartitions              func() int
 		NumReadPartitions               func() int
 
+		// partition auto scaling configuration, only used by root workflow partitions
+		EnablePartitionAutoScale           func() bool
+		PartitionAutoScaleInterval         func() time.Duration
+		PartitionAutoScaleSustainDuration  func() time.Duration
+		PartitionAutoScalePropagationDelay func() time.Duration
+		PartitionAutoScaleTargetAddRate    func() float64
+		PartitionAutoScaleTargetPollers    func() int
+		PartitionAutoScaleTargetBacklog    func() int
+		PartitionAutoScaleMinPartitions    func() int
+		PartitionAutoScaleMaxPartitions    func() int
+
 		// partition qps = AdminNamespaceToPartitionDispatchRate(namespace)
 		AdminNamespaceToPartitionDispatchRate func() float64
 		// partition qps = AdminNamespaceTaskQueueToPartitionDispatchRate(namespace, task_queue)
@@ -191,11 +226,26 @@ func NewConfig(
 		LongPollExpirationInterval:               dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:               dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:                   dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
+		MaxBacklogTaskTombstones:                 dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxBacklogTaskTombstones, 1000),
 		OutstandingTaskAppendsThreshold:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
 		MaxTaskBatchSize:                         dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
 		ThrottledLogRPS:                          dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 		NumTaskqueueWritePartitions:              dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
 		NumTaskqueueReadPartitions:               dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
+		MaxTasksPerPoll:                          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTasksPerPoll, 10),
+		MultiTaskPollWait:                        dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMultiTaskPollWait, 10*time.Millisecond),
+		WorkerRegistryTTL:                        dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingWorkerRegistryTTL, 5*time.Minute),
+		WorkerRegistryMaxSize:                    dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingWorkerRegistryMaxSize, 1000),
+		MaxBufferedDelayedTasks:                  dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxBufferedDelayedTasks, 10000),
+		EnablePartitionAutoScale:                 dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEnablePartitionAutoScale, false),
+		PartitionAutoScaleInterval:               dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleInterval, time.Minute),
+		PartitionAutoScaleSustainDuration:        dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleSustainDuration, 5*time.Minute),
+		PartitionAutoScalePropagationDelay:       dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScalePropagationDelay, time.Minute),
+		PartitionAutoScaleTargetAddRate:          dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleTargetAddRate, 200.0),
+		PartitionAutoScaleTargetPollers:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleTargetPollers, 20),
+		PartitionAutoScaleTargetBacklog:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleTargetBacklog, 10000),
+		PartitionAutoScaleMinPartitions:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleMinPartitions, 1),
+		PartitionAutoScaleMaxPartitions:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPartitionAutoScaleMaxPartitions, 16),
 		ForwarderMaxOutstandingPolls:             dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
 		ForwarderMaxOutstandingTasks:             dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:                dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
@@ -267,6 +317,24 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(ns.String(), taskQueueName, taskType)
 		},
+		MaxBacklogTaskTombstones: func() int {
+			return config.MaxBacklogTaskTombstones(ns.String(), taskQueueName, taskType)
+		},
+		MaxTasksPerPoll: func() int {
+			return config.MaxTasksPerPoll(ns.String(), taskQueueName, taskType)
+		},
+		MultiTaskPollWait: func() time.Duration {
+			return config.MultiTaskPollWait(ns.String(), taskQueueName, taskType)
+		},
+		WorkerRegistryTTL: func() time.Duration {
+			return config.WorkerRegistryTTL(ns.String(), taskQueueName, taskType)
+		},
+		WorkerRegistryMaxSize: func() int {
+			return config.WorkerRegistryMaxSize(ns.String(), taskQueueName, taskType)
+		},
+		MaxBufferedDelayedTasks: func() int {
+			return config.MaxBufferedDelayedTasks(ns.String(), taskQueueName, taskType)
+		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
 		OutstandingTaskAppendsThreshold: func() int {
@@ -281,6 +349,33 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		NumReadPartitions: func() int {
 			return max(1, config.NumTaskqueueReadPartitions(ns.String(), taskQueueName, taskType))
 		},
+		EnablePartitionAutoScale: func() bool {
+			return config.EnablePartitionAutoScale(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleInterval: func() time.Duration {
+			return config.PartitionAutoScaleInterval(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleSustainDuration: func() time.Duration {
+			return config.PartitionAutoScaleSustainDuration(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScalePropagationDelay: func() time.Duration {
+			return config.PartitionAutoScalePropagationDelay(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleTargetAddRate: func() float64 {
+			return config.PartitionAutoScaleTargetAddRate(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleTargetPollers: func() int {
+			return config.PartitionAutoScaleTargetPollers(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleTargetBacklog: func() int {
+			return config.PartitionAutoScaleTargetBacklog(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleMinPartitions: func() int {
+			return config.PartitionAutoScaleMinPartitions(ns.String(), taskQueueName, taskType)
+		},
+		PartitionAutoScaleMaxPartitions: func() int {
+			return config.PartitionAutoScaleMaxPartitions(ns.String(), taskQueueName, taskType)
+		},
 		AdminNamespaceToPartitionDispatchRate: func() float64 {
 			return config.AdminNamespaceToPartitionDispatchRate(ns.String())
 		},
@@ -52,6 +52,10 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/ApplyTaskQueueUserDataReplicationEvent": 1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdTaskQueueMapping":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ForceUnloadTaskQueue":                   1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListBacklogTasks":                       1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/DeleteBacklogTasks":                     1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/RecordWorkerHeartbeat":                  1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers":                            1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueUserData":                1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ReplicateTaskQueueUserData":             1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/PollNexusTaskQueue":                     1,
@@ -27,6 +27,7 @@ package matching
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -52,8 +53,12 @@ type (
 		queue    *PhysicalTaskQueueKey
 		rangeID  int64
 		ackLevel int64
-		store    persistence.TaskManager
-		logger   log.Logger
+		// deletedTaskIDs holds tombstones for tasks above the ack level that were deleted through the
+		// admin API. Tombstones are persisted with the queue metadata and dropped once the ack level
+		// moves past them.
+		deletedTaskIDs map[int64]struct{}
+		store          persistence.TaskManager
+		logger         log.Logger
 	}
 	taskQueueState struct {
 		rangeID  int64
@@ -77,9 +82,10 @@ func newTaskQueueDB(
 	logger log.Logger,
 ) *taskQueueDB {
 	return &taskQueueDB{
-		queue:  queue,
-		store:  store,
-		logger: logger,
+		queue:          queue,
+		deletedTaskIDs: make(map[int64]struct{}),
+		store:          store,
+		logger:         logger,
 	}
 }
 
@@ -132,6 +138,12 @@ func (db *taskQueueDB) takeOverTaskQueueLocked(
 		}
 		db.ackLevel = response.TaskQueueInfo.AckLevel
 		db.rangeID = response.RangeID + 1
+		db.deletedTaskIDs = make(map[int64]struct{}, len(response.TaskQueueInfo.DeletedTaskIds))
+		for _, taskID := range response.TaskQueueInfo.DeletedTaskIds {
+			if taskID > db.ackLevel {
+				db.deletedTaskIDs[taskID] = struct{}{}
+			}
+		}
 		return nil
 
 	case *serviceerror.NotFound:
@@ -174,6 +186,9 @@ func (db *taskQueueDB) UpdateState(
 	defer db.Unlock()
 	queueInfo := db.cachedQueueInfo()
 	queueInfo.AckLevel = ackLevel
+	queueInfo.DeletedTaskIds = slices.DeleteFunc(queueInfo.DeletedTaskIds, func(taskID int64) bool {
+		return taskID <= ackLevel
+	})
 	_, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
 		RangeID:       db.rangeID,
 		TaskQueueInfo: queueInfo,
@@ -181,32 +196,57 @@ func (db *taskQueueDB) UpdateState(
 	})
 	if err == nil {
 		db.ackLevel = ackLevel
+		for taskID := range db.deletedTaskIDs {
+			if taskID <= ackLevel {
+				delete(db.deletedTaskIDs, taskID)
+			}
+		}
 	}
 	return err
 }
 
+// AddDeletedTasks persists tombstones for the given task IDs. Tombstoned tasks are skipped by the
+// task reader and are physically removed once the ack level moves past them.
+func (db *taskQueueDB) AddDeletedTasks(
+	ctx context.Context,
+	taskIDs []int64,
+) error {
+	db.Lock()
+	defer db.Unlock()
+	queueInfo := db.cachedQueueInfo()
+	for _, taskID := range taskIDs {
+		if _, ok := db.deletedTaskIDs[taskID]; !ok && taskID > db.ackLevel {
+			queueInfo.DeletedTaskIds = append(queueInfo.DeletedTaskIds, taskID)
+		}
+	}
+	slices.Sort(queueInfo.DeletedTaskIds)
+	_, err := db.store.UpdateTaskQueue(ctx, &persistence.UpdateTaskQueueRequest{
+		RangeID:       db.rangeID,
+		TaskQueueInfo: queueInfo,
+		PrevRangeID:   db.rangeID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, taskID := range queueInfo.DeletedTaskIds {
+		db.deletedTaskIDs[taskID] = struct{}{}
+	}
+	return nil
+}
 
-func sortTasksByPriority(tasks []*persistencespb.AllocatedTaskInfo, priorityMap map[int64]int) []*persistencespb.AllocatedTaskInfo {
-    sort.Slice(tasks, func(i, j int) bool {
-        priorityI, existsI := priorityMap[tasks[i].TaskId]
-        priorityJ, existsJ := priorityMap[tasks[j].TaskId]
-
-        if !existsI && !existsJ {
-            return tasks[i].TaskId < tasks[j].TaskId
-        }
-
-        if !existsI {
-            return false
-        }
-
-        if !existsJ {
-            return true
-        }
-
-        return priorityI < priorityJ
-    })
+// IsTaskDeleted returns true if the given task was deleted through the admin API
+func (db *taskQueueDB) IsTaskDeleted(taskID int64) bool {
+	db.Lock()
+	defer db.Unlock()
+	_, ok := db.deletedTaskIDs[taskID]
+	return ok
+}
 
-    return tasks
+// DeletedTaskCount returns the number of outstanding tombstones
+func (db *taskQueueDB) DeletedTaskCount() int {
+	db.Lock()
+	defer db.Unlock()
+	return len(db.deletedTaskIDs)
 }
 
 // CreateTasks creates a batch of given tasks for this task queue
@@ -216,11 +256,6 @@ func (db *taskQueueDB) CreateTasks(
 ) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
-
-	rd := NewRouteDiscovery(tasks);
-    // Sort the tasks based on the priority map
-    priorityMap := rd.getPriorityMap()
-    sortedTasks := sortTasksByPriority(tasks, priorityMap)
 	return db.store.CreateTasks(
 		ctx,
 		&persistence.CreateTasksRequest{
@@ -228,7 +263,7 @@ func (db *taskQueueDB) CreateTasks(
 				Data:    db.cachedQueueInfo(),
 				RangeID: db.rangeID,
 			},
-			Tasks: sortedTasks,
+			Tasks: tasks,
 		})
 }
 
@@ -296,5 +331,18 @@ func (db *taskQueueDB) cachedQueueInfo() *persistencespb.TaskQueueInfo {
 		AckLevel:       db.ackLevel,
 		ExpiryTime:     db.expiryTime(),
 		LastUpdateTime: timestamp.TimeNowPtrUtc(),
+		DeletedTaskIds: db.sortedDeletedTaskIDs(),
+	}
+}
+
+func (db *taskQueueDB) sortedDeletedTaskIDs() []int64 {
+	if len(db.deletedTaskIDs) == 0 {
+		return nil
+	}
+	taskIDs := make([]int64, 0, len(db.deletedTaskIDs))
+	for taskID := range db.deletedTaskIDs {
+		taskIDs = append(taskIDs, taskID)
 	}
+	slices.Sort(taskIDs)
+	return taskIDs
 }
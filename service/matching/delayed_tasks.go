@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// delayedTask is a backlog task waiting for its visibility time. task is nil for tasks that are parked, i.e. only
+// their ID and visibility time are held in memory and the task has to be read from persistence once it is due.
+type delayedTask struct {
+	taskID         int64
+	visibilityTime time.Time
+	task           *persistencespb.AllocatedTaskInfo
+}
+
+// delayedTaskHeap orders delayed tasks by their visibility time, and by task ID for tasks with the same visibility
+// time. It implements heap.Interface, use delayedTasks for a type safe API.
+type delayedTaskHeap []*delayedTask
+
+func (h delayedTaskHeap) Len() int { return len(h) }
+
+func (h delayedTaskHeap) Less(i, j int) bool {
+	if !h[i].visibilityTime.Equal(h[j].visibilityTime) {
+		return h[i].visibilityTime.Before(h[j].visibilityTime)
+	}
+	return h[i].taskID < h[j].taskID
+}
+
+func (h delayedTaskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *delayedTaskHeap) Push(x any) { *h = append(*h, x.(*delayedTask)) }
+
+func (h *delayedTaskHeap) Pop() any {
+	old := *h
+	n := len(old)
+	t := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return t
+}
+
+// delayedTasks holds backlog tasks whose visibility time has not been reached yet. It is not thread safe.
+type delayedTasks struct {
+	heap delayedTaskHeap
+	// number of tasks held with their data
+	loaded int
+}
+
+func (d *delayedTasks) len() int {
+	return d.heap.Len()
+}
+
+// loadedLen returns the number of tasks held with their data, i.e. not parked.
+func (d *delayedTasks) loadedLen() int {
+	return d.loaded
+}
+
+// add holds the task until its visibility time. If park is true only the task ID and visibility time are kept.
+func (d *delayedTasks) add(task *persistencespb.AllocatedTaskInfo, park bool) {
+	entry := &delayedTask{
+		taskID:         task.GetTaskId(),
+		visibilityTime: taskVisibilityTime(task),
+	}
+	if !park {
+		entry.task = task
+		d.loaded++
+	}
+	heap.Push(&d.heap, entry)
+}
+
+// addParked holds a parked task until the given visibility time.
+func (d *delayedTasks) addParked(taskID int64, visibilityTime time.Time) {
+	heap.Push(&d.heap, &delayedTask{taskID: taskID, visibilityTime: visibilityTime})
+}
+
+// nextVisibilityTime returns the visibility time of the earliest task, or false if there are no tasks.
+func (d *delayedTasks) nextVisibilityTime() (time.Time, bool) {
+	if d.heap.Len() == 0 {
+		return time.Time{}, false
+	}
+	return d.heap[0].visibilityTime, true
+}
+
+// popDue removes and returns the earliest task if its visibility time is not after now.
+func (d *delayedTasks) popDue(now time.Time) *delayedTask {
+	if next, ok := d.nextVisibilityTime(); !ok || next.After(now) {
+		return nil
+	}
+	entry := heap.Pop(&d.heap).(*delayedTask)
+	if entry.task != nil {
+		d.loaded--
+	}
+	return entry
+}
+
+func taskVisibilityTime(t *persistencespb.AllocatedTaskInfo) time.Time {
+	return t.GetData().GetVisibilityTime().AsTime()
+}
+
+// isTaskDelayed returns true if the task must not be dispatched before a time later than now.
+func isTaskDelayed(t *persistencespb.TaskInfo, now time.Time) bool {
+	return t.GetVisibilityTime() != nil && t.GetVisibilityTime().AsTime().After(now)
+}
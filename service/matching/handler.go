@@ -378,6 +378,38 @@ func (h *Handler) ForceUnloadTaskQueue(
 	return h.engine.ForceUnloadTaskQueue(ctx, request)
 }
 
+func (h *Handler) ListBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.ListBacklogTasksRequest,
+) (_ *matchingservice.ListBacklogTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ListBacklogTasks(ctx, request)
+}
+
+func (h *Handler) DeleteBacklogTasks(
+	ctx context.Context,
+	request *matchingservice.DeleteBacklogTasksRequest,
+) (_ *matchingservice.DeleteBacklogTasksResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.DeleteBacklogTasks(ctx, request)
+}
+
+func (h *Handler) RecordWorkerHeartbeat(
+	ctx context.Context,
+	request *matchingservice.RecordWorkerHeartbeatRequest,
+) (_ *matchingservice.RecordWorkerHeartbeatResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.RecordWorkerHeartbeat(ctx, request)
+}
+
+func (h *Handler) ListWorkers(
+	ctx context.Context,
+	request *matchingservice.ListWorkersRequest,
+) (_ *matchingservice.ListWorkersResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ListWorkers(ctx, request)
+}
+
 func (h *Handler) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
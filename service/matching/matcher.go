@@ -431,6 +431,25 @@ func (tm *TaskMatcher) Poll(ctx context.Context, pollMetadata *pollMetadata) (*i
 	return task, err
 }
 
+// PollAdditional blocks until a non-query task is available locally or context deadline is exceeded. Unlike Poll,
+// it never forwards to the parent partition. It is used to fill a multi-task poll response after Poll returned
+// the first task, so it does not count as a separate poll in metrics.
+// Returns errNoTasks when context deadline is exceeded
+func (tm *TaskMatcher) PollAdditional(ctx context.Context) (*internalTask, error) {
+	select {
+	case <-ctx.Done():
+		return nil, errNoTasks
+	case <-tm.closeC:
+		return nil, errNoTasks
+	case task := <-tm.taskC:
+		if task.responseC != nil {
+			metrics.PollSuccessWithSyncPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+		}
+		metrics.PollSuccessPerTaskQueueCounter.With(tm.metricsHandler).Record(1)
+		return task, nil
+	}
+}
+
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns errNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, error) {
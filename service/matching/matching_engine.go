@@ -31,11 +31,14 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pborman/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -85,6 +88,8 @@ const (
 	versioningPollerSeenWindow        = 70 * time.Second
 	recordTaskStartedDefaultTimeout   = 10 * time.Second
 	recordTaskStartedSyncMatchTimeout = 1 * time.Second
+	// Number of tasks scanned per page by ListBacklogTasks when the request does not specify a page size
+	defaultListBacklogTasksPageSize = 100
 )
 
 type (
@@ -102,6 +107,7 @@ type (
 		ratePerSecond             *float64
 		workerVersionCapabilities *commonpb.WorkerVersionCapabilities
 		forwardedFrom             string
+		workerHeartbeat           *taskqueuespb.WorkerHeartbeat
 	}
 
 	namespaceUpdateLocks struct {
@@ -435,11 +441,17 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	}
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
-	var expirationTime *timestamppb.Timestamp
+	var expirationTime, visibilityTime *timestamppb.Timestamp
 	now := time.Now().UTC()
+	// the schedule to start timeout of a delayed task starts counting when it becomes visible
+	expirationBase := now
+	if addRequest.GetVisibilityTime().AsTime().After(now) {
+		visibilityTime = addRequest.GetVisibilityTime()
+		expirationBase = visibilityTime.AsTime()
+	}
 	expirationDuration := addRequest.GetScheduleToStartTimeout().AsDuration()
 	if expirationDuration != 0 {
-		expirationTime = timestamppb.New(now.Add(expirationDuration))
+		expirationTime = timestamppb.New(expirationBase.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
 		NamespaceId:      addRequest.NamespaceId,
@@ -450,6 +462,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		ExpiryTime:       expirationTime,
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
+		VisibilityTime:   visibilityTime,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -473,11 +486,17 @@ func (e *matchingEngineImpl) AddActivityTask(
 		return "", false, err
 	}
 
-	var expirationTime *timestamppb.Timestamp
+	var expirationTime, visibilityTime *timestamppb.Timestamp
 	now := time.Now().UTC()
+	// the schedule to start timeout of a delayed task starts counting when it becomes visible
+	expirationBase := now
+	if addRequest.GetVisibilityTime().AsTime().After(now) {
+		visibilityTime = addRequest.GetVisibilityTime()
+		expirationBase = visibilityTime.AsTime()
+	}
 	expirationDuration := timestamp.DurationValue(addRequest.GetScheduleToStartTimeout())
 	if expirationDuration != 0 {
-		expirationTime = timestamppb.New(now.Add(expirationDuration))
+		expirationTime = timestamppb.New(expirationBase.Add(expirationDuration))
 	}
 	taskInfo := &persistencespb.TaskInfo{
 		NamespaceId:      addRequest.NamespaceId,
@@ -488,6 +507,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		CreateTime:       timestamppb.New(now),
 		ExpiryTime:       expirationTime,
 		VersionDirective: addRequest.VersionDirective,
+		VisibilityTime:   visibilityTime,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -524,6 +544,7 @@ pollLoop:
 		pollMetadata := &pollMetadata{
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			forwardedFrom:             req.GetForwardedSource(),
+			workerHeartbeat:           req.GetWorkerHeartbeat(),
 		}
 		task, versionSetUsed, err := e.pollTask(pollerCtx, partition, pollMetadata)
 		if err != nil {
@@ -707,6 +728,7 @@ pollLoop:
 		pollMetadata := &pollMetadata{
 			workerVersionCapabilities: request.WorkerVersionCapabilities,
 			forwardedFrom:             req.GetForwardedSource(),
+			workerHeartbeat:           req.GetWorkerHeartbeat(),
 		}
 		if request.TaskQueueMetadata != nil && request.TaskQueueMetadata.MaxTasksPerSecond != nil {
 			pollMetadata.ratePerSecond = &request.TaskQueueMetadata.MaxTasksPerSecond.Value
@@ -733,35 +755,94 @@ pollLoop:
 		}
 		resp, err := e.recordActivityTaskStarted(ctx, requestClone, task)
 		if err != nil {
-			switch err.(type) {
-			case *serviceerror.NotFound: // mutable state not found, workflow not running or activity info not found
-				e.logger.Info("Activity task not found",
-					tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
-					tag.WorkflowID(task.event.Data.GetWorkflowId()),
-					tag.WorkflowRunID(task.event.Data.GetRunId()),
-					tag.WorkflowTaskQueueName(taskQueueName),
-					tag.TaskID(task.event.GetTaskId()),
-					tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
-					tag.WorkflowEventID(task.event.Data.GetScheduledEventId()),
-					tag.Error(err),
-				)
-				task.finish(nil)
-			case *serviceerrors.TaskAlreadyStarted:
-				e.logger.Debug("Duplicated activity task", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
-				task.finish(nil)
-			default:
-				task.finish(err)
-				if err.Error() == common.ErrNamespaceHandover.Error() {
-					// do not keep polling new tasks when namespace is in handover state
-					// as record start request will be rejected by history service
-					return nil, err
-				}
+			e.finishActivityTaskNotStarted(task, taskQueueName, err)
+			if err.Error() == common.ErrNamespaceHandover.Error() {
+				// do not keep polling new tasks when namespace is in handover state
+				// as record start request will be rejected by history service
+				return nil, err
 			}
-
 			continue pollLoop
 		}
 		task.finish(nil)
-		return e.createPollActivityTaskQueueResponse(task, resp, opMetrics), nil
+		response := e.createPollActivityTaskQueueResponse(task, resp, opMetrics)
+		if req.GetMaxTasks() > 1 && req.GetForwardedSource() == "" {
+			response.AdditionalTasks = e.pollAdditionalActivityTasks(ctx, partition, pollMetadata, request, int(req.GetMaxTasks())-1, opMetrics)
+		}
+		return response, nil
+	}
+}
+
+// pollAdditionalActivityTasks fills a multi-task poll response with up to maxTasks activity tasks that are
+// immediately available on the partition. Each task is recorded as started individually. Tasks that fail to start
+// are left out of the response and handled the same way as in a single-task poll.
+func (e *matchingEngineImpl) pollAdditionalActivityTasks(
+	ctx context.Context,
+	partition tqid.Partition,
+	pollMetadata *pollMetadata,
+	request *workflowservice.PollActivityTaskQueueRequest,
+	maxTasks int,
+	opMetrics metrics.Handler,
+) []*matchingservice.PollActivityTaskQueueResponse {
+	pm, err := e.getTaskQueuePartitionManager(ctx, partition, false)
+	if err != nil || pm == nil {
+		return nil
+	}
+	tasks, versionSetUsed, err := pm.PollAdditionalTasks(ctx, pollMetadata, maxTasks)
+	if err != nil || len(tasks) == 0 {
+		return nil
+	}
+	if versionSetUsed {
+		// TODO: remove this block after old versioning cleanup. [cleanup-old-wv]
+		request = common.CloneProto(request)
+		request.WorkerVersionCapabilities.BuildId = ""
+	}
+
+	responses := make([]*matchingservice.PollActivityTaskQueueResponse, len(tasks))
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		go func(i int, task *internalTask) {
+			defer wg.Done()
+			resp, err := e.recordActivityTaskStarted(ctx, request, task)
+			if err != nil {
+				e.finishActivityTaskNotStarted(task, request.TaskQueue.GetName(), err)
+				return
+			}
+			task.finish(nil)
+			responses[i] = e.createPollActivityTaskQueueResponse(task, resp, opMetrics)
+		}(i, task)
+	}
+	wg.Wait()
+
+	additionalTasks := make([]*matchingservice.PollActivityTaskQueueResponse, 0, len(responses))
+	for _, resp := range responses {
+		if resp != nil {
+			additionalTasks = append(additionalTasks, resp)
+		}
+	}
+	return additionalTasks
+}
+
+// finishActivityTaskNotStarted finishes an activity task that could not be recorded as started in history.
+func (e *matchingEngineImpl) finishActivityTaskNotStarted(task *internalTask, taskQueueName string, err error) {
+	switch err.(type) {
+	case *serviceerror.NotFound: // mutable state not found, workflow not running or activity info not found
+		e.logger.Info("Activity task not found",
+			tag.WorkflowNamespaceID(task.event.Data.GetNamespaceId()),
+			tag.WorkflowID(task.event.Data.GetWorkflowId()),
+			tag.WorkflowRunID(task.event.Data.GetRunId()),
+			tag.WorkflowTaskQueueName(taskQueueName),
+			tag.TaskID(task.event.GetTaskId()),
+			tag.TaskVisibilityTimestamp(timestamp.TimeValue(task.event.Data.GetCreateTime())),
+			tag.WorkflowEventID(task.event.Data.GetScheduledEventId()),
+			tag.Error(err),
+		)
+		task.finish(nil)
+	case *serviceerrors.TaskAlreadyStarted:
+		e.logger.Debug("Duplicated activity task", tag.WorkflowTaskQueueName(taskQueueName), tag.TaskID(task.event.GetTaskId()))
+		task.finish(nil)
+	default:
+		task.finish(err)
 	}
 }
 
@@ -898,7 +979,7 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		// collect internal info
 		physicalInfoByBuildId := make(map[string]map[enumspb.TaskQueueType]*taskqueuespb.PhysicalTaskQueueInfo)
 		for _, taskQueueType := range req.TaskQueueTypes {
-			for i := 0; i < e.config.NumTaskqueueWritePartitions(req.Namespace, req.TaskQueue.Name, taskQueueType); i++ {
+			for i := 0; i < e.numReadPartitions(userData, req.Namespace, req.TaskQueue.Name, taskQueueType); i++ {
 				partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
 					NamespaceId: request.GetNamespaceId(),
 					TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
@@ -968,7 +1049,27 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 	if err != nil {
 		return nil, err
 	}
-	return pm.LegacyDescribeTaskQueue(req.GetIncludeTaskQueueStatus()), nil
+	resp := pm.LegacyDescribeTaskQueue(req.GetIncludeTaskQueueStatus())
+	if partition.IsRoot() {
+		resp.PartitionCounts = pm.PartitionCounts()
+	}
+	return resp, nil
+}
+
+// numReadPartitions returns the number of partitions to read from for the given task queue type, taking the
+// partition counts picked by the partition auto scaler into account.
+func (e *matchingEngineImpl) numReadPartitions(
+	userData *persistencespb.TaskQueueUserData,
+	nsName string,
+	taskQueueName string,
+	taskQueueType enumspb.TaskQueueType,
+) int {
+	if e.config.EnablePartitionAutoScale(nsName, taskQueueName, taskQueueType) {
+		if counts := autoScaledPartitionCounts(userData, taskQueueType); counts != nil {
+			return int(counts.GetRead())
+		}
+	}
+	return e.config.NumTaskqueueReadPartitions(nsName, taskQueueName, taskQueueType)
 }
 
 func (e *matchingEngineImpl) DescribeTaskQueuePartition(
@@ -986,7 +1087,7 @@ func (e *matchingEngineImpl) DescribeTaskQueuePartition(
 	if err != nil {
 		return nil, err
 	}
-	return pm.Describe(buildIds, request.GetVersions().GetAllActive(), request.GetReportBacklogInfo(), request.GetReportPollers())
+	return pm.Describe(buildIds, request.GetVersions().GetAllActive(), request.GetReportBacklogInfo(), request.GetReportPollers(), request.GetReportWorkers())
 }
 
 func (e *matchingEngineImpl) getBuildIds(versions *taskqueuepb.TaskQueueVersionSelection) (map[string]bool, error) {
@@ -1499,6 +1600,281 @@ func (e *matchingEngineImpl) ForceUnloadTaskQueue(
 	return &matchingservice.ForceUnloadTaskQueueResponse{WasLoaded: wasLoaded}, nil
 }
 
+func (e *matchingEngineImpl) ListBacklogTasks(
+	ctx context.Context,
+	req *matchingservice.ListBacklogTasksRequest,
+) (*matchingservice.ListBacklogTasksResponse, error) {
+	pq, err := e.getBacklogPhysicalQueue(ctx, req.GetNamespaceId(), req.GetTaskQueue(), req.GetTaskQueueType(), req.GetBuildId())
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListBacklogTasksPageSize
+	}
+	tasks, nextPageToken, err := pq.ListBacklogTasks(ctx, pageSize, req.GetNextPageToken(), e.newBacklogTaskFilter(req.GetFilter()))
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.ListBacklogTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (e *matchingEngineImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	req *matchingservice.DeleteBacklogTasksRequest,
+) (*matchingservice.DeleteBacklogTasksResponse, error) {
+	if len(req.GetTaskIds()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("at least one task ID is required")
+	}
+	pq, err := e.getBacklogPhysicalQueue(ctx, req.GetNamespaceId(), req.GetTaskQueue(), req.GetTaskQueueType(), req.GetBuildId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetDestinationTaskQueue() == pq.QueueKey().TaskQueueFamily().Name() {
+		return nil, serviceerror.NewInvalidArgument("destination task queue must be different from the source task queue")
+	}
+	var afterDelete func(*persistencespb.AllocatedTaskInfo) error
+	if req.GetDestinationTaskQueue() != "" {
+		afterDelete = func(task *persistencespb.AllocatedTaskInfo) error {
+			return e.moveBacklogTask(ctx, req.GetTaskQueueType(), req.GetDestinationTaskQueue(), task.GetData())
+		}
+	}
+	results, err := pq.DeleteBacklogTasks(ctx, req.GetTaskIds(), afterDelete)
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.DeleteBacklogTasksResponse{Results: results}, nil
+}
+
+func (e *matchingEngineImpl) getBacklogPhysicalQueue(
+	ctx context.Context,
+	namespaceId string,
+	taskQueue string,
+	taskQueueType enumspb.TaskQueueType,
+	buildId string,
+) (physicalTaskQueueManager, error) {
+	partition, err := tqid.NormalPartitionFromRpcName(taskQueue, namespaceId, taskQueueType)
+	if err != nil {
+		return nil, err
+	}
+	pm, err := e.getTaskQueuePartitionManager(ctx, partition, true)
+	if err != nil {
+		return nil, err
+	}
+	return pm.GetPhysicalQueue(ctx, buildId)
+}
+
+// newBacklogTaskFilter returns a filter function for the given backlog filter, or nil if there is
+// nothing to filter on. Filtering by workflow type requires a mutable state lookup per distinct run.
+func (e *matchingEngineImpl) newBacklogTaskFilter(filter *taskqueuespb.BacklogTaskFilter) backlogTaskFilterFunc {
+	if filter == nil {
+		return nil
+	}
+	workflowTypes := make(map[string]string)
+	return func(ctx context.Context, task *persistencespb.AllocatedTaskInfo) (bool, error) {
+		data := task.GetData()
+		if filter.GetWorkflowId() != "" && data.GetWorkflowId() != filter.GetWorkflowId() {
+			return false, nil
+		}
+		if filter.GetRunId() != "" && data.GetRunId() != filter.GetRunId() {
+			return false, nil
+		}
+		if minAge := filter.GetMinAge().AsDuration(); minAge > 0 && time.Since(data.GetCreateTime().AsTime()) < minAge {
+			return false, nil
+		}
+		if filter.GetWorkflowType() == "" {
+			return true, nil
+		}
+		workflowType, ok := workflowTypes[data.GetRunId()]
+		if !ok {
+			resp, err := e.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+				NamespaceId: data.GetNamespaceId(),
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: data.GetWorkflowId(),
+					RunId:      data.GetRunId(),
+				},
+			})
+			var notFound *serviceerror.NotFound
+			switch {
+			case errors.As(err, &notFound):
+				// the workflow is gone, the task will be dropped when it is dispatched
+			case err != nil:
+				return false, err
+			default:
+				workflowType = resp.GetWorkflowType().GetName()
+			}
+			workflowTypes[data.GetRunId()] = workflowType
+		}
+		return workflowType == filter.GetWorkflowType(), nil
+	}
+}
+
+// moveBacklogTask adds a copy of the given backlog task to the destination task queue.
+func (e *matchingEngineImpl) moveBacklogTask(
+	ctx context.Context,
+	taskQueueType enumspb.TaskQueueType,
+	destination string,
+	task *persistencespb.TaskInfo,
+) error {
+	var scheduleToStartTimeout *durationpb.Duration
+	if task.GetExpiryTime() != nil {
+		remaining := time.Until(task.GetExpiryTime().AsTime())
+		if remaining <= 0 {
+			// expired tasks are dropped by the task reader anyway, no need to move them
+			return nil
+		}
+		// the schedule to start timeout of a delayed task starts counting at its visibility time
+		if untilVisible := time.Until(task.GetVisibilityTime().AsTime()); task.GetVisibilityTime() != nil && untilVisible > 0 {
+			remaining -= untilVisible
+		}
+		scheduleToStartTimeout = durationpb.New(remaining)
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: task.GetWorkflowId(),
+		RunId:      task.GetRunId(),
+	}
+	destinationTaskQueue := &taskqueuepb.TaskQueue{
+		Name: destination,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	var err error
+	switch taskQueueType {
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err = e.matchingRawClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            task.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destinationTaskQueue,
+			ScheduledEventId:       task.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Source:                 enumsspb.TASK_SOURCE_HISTORY,
+			Clock:                  task.GetClock(),
+			VersionDirective:       task.GetVersionDirective(),
+			VisibilityTime:         task.GetVisibilityTime(),
+		})
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err = e.matchingRawClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            task.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destinationTaskQueue,
+			ScheduledEventId:       task.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Source:                 enumsspb.TASK_SOURCE_HISTORY,
+			Clock:                  task.GetClock(),
+			VersionDirective:       task.GetVersionDirective(),
+			VisibilityTime:         task.GetVisibilityTime(),
+		})
+	default:
+		err = serviceerror.NewInvalidArgument(fmt.Sprintf("tasks of type %v can't be moved", taskQueueType))
+	}
+	return err
+}
+
+func (e *matchingEngineImpl) RecordWorkerHeartbeat(
+	ctx context.Context,
+	req *matchingservice.RecordWorkerHeartbeatRequest,
+) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
+	if req.GetHeartbeat().GetIdentity() == "" {
+		return nil, serviceerror.NewInvalidArgument("worker identity is required")
+	}
+	partition, err := tqid.NormalPartitionFromRpcName(req.GetTaskQueue(), req.GetNamespaceId(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	pm, err := e.getTaskQueuePartitionManager(ctx, partition, true)
+	if err != nil {
+		return nil, err
+	}
+	pm.RecordWorkerHeartbeat(req.GetHeartbeat())
+	return &matchingservice.RecordWorkerHeartbeatResponse{}, nil
+}
+
+// ListWorkers collects the workers known to all partitions of the workflow and activity types of a task queue. A
+// worker seen by more than one partition is reported once with its latest heartbeat.
+func (e *matchingEngineImpl) ListWorkers(
+	ctx context.Context,
+	req *matchingservice.ListWorkersRequest,
+) (*matchingservice.ListWorkersResponse, error) {
+	rootPartition, err := tqid.NormalPartitionFromRpcName(req.GetTaskQueue(), req.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !rootPartition.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("ListWorkers must be called on the root partition of the task queue")
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	userData, err := e.getUserDataClone(ctx, rootPartition)
+	if err != nil {
+		return nil, err
+	}
+
+	workers := make(map[string]*taskqueuespb.WorkerInfo)
+	for _, taskQueueType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		for i := 0; i < e.numReadPartitions(userData, nsName.String(), req.GetTaskQueue(), taskQueueType); i++ {
+			partitionResp, err := e.matchingRawClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
+				NamespaceId: req.GetNamespaceId(),
+				TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+					TaskQueue:     req.GetTaskQueue(),
+					TaskQueueType: taskQueueType,
+					PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+				},
+				Versions:      &taskqueuepb.TaskQueueVersionSelection{},
+				ReportWorkers: true,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, worker := range partitionResp.GetWorkers() {
+				identity := worker.GetHeartbeat().GetIdentity()
+				if req.GetIdentity() != "" && identity != req.GetIdentity() {
+					continue
+				}
+				workers[identity] = mergeWorkerInfo(workers[identity], worker)
+			}
+		}
+	}
+
+	resp := &matchingservice.ListWorkersResponse{}
+	for _, worker := range workers {
+		resp.Workers = append(resp.Workers, worker)
+	}
+	slices.SortFunc(resp.Workers, func(a, b *taskqueuespb.WorkerInfo) int {
+		return strings.Compare(a.GetHeartbeat().GetIdentity(), b.GetHeartbeat().GetIdentity())
+	})
+	return resp, nil
+}
+
+// mergeWorkerInfo merges the views two partitions have of the same worker. The latest heartbeat wins.
+func mergeWorkerInfo(existing, other *taskqueuespb.WorkerInfo) *taskqueuespb.WorkerInfo {
+	if existing == nil {
+		return other
+	}
+	merged := &taskqueuespb.WorkerInfo{
+		Heartbeat:         existing.GetHeartbeat(),
+		FirstSeenTime:     existing.GetFirstSeenTime(),
+		LastHeartbeatTime: existing.GetLastHeartbeatTime(),
+		TaskQueueTypes:    existing.GetTaskQueueTypes(),
+	}
+	if other.GetLastHeartbeatTime().AsTime().After(merged.GetLastHeartbeatTime().AsTime()) {
+		merged.Heartbeat = other.GetHeartbeat()
+		merged.LastHeartbeatTime = other.GetLastHeartbeatTime()
+	}
+	if other.GetFirstSeenTime().AsTime().Before(merged.GetFirstSeenTime().AsTime()) {
+		merged.FirstSeenTime = other.GetFirstSeenTime()
+	}
+	for _, taskQueueType := range other.GetTaskQueueTypes() {
+		if !slices.Contains(merged.TaskQueueTypes, taskQueueType) {
+			merged.TaskQueueTypes = append(slices.Clone(merged.TaskQueueTypes), taskQueueType)
+		}
+	}
+	return merged
+}
+
 func (e *matchingEngineImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	locks := e.getNamespaceUpdateLocks(request.GetNamespaceId())
 	locks.updateLock.Lock()
@@ -64,6 +64,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
@@ -604,6 +605,209 @@ func (s *matchingEngineSuite) TestPollActivityTaskQueues_NamespaceHandover() {
 	s.Equal(common.ErrNamespaceHandover.Error(), err.Error())
 }
 
+func (s *matchingEngineSuite) TestPollActivityTaskQueue_MultipleTasks() {
+	s.matchingEngine.config.MultiTaskPollWait = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(time.Second)
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "queue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.NewRandom().String()}
+
+	const taskCount = 5
+	for i := int64(1); i <= taskCount; i++ {
+		_, _, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            namespaceId,
+			Execution:              execution,
+			ScheduledEventId:       i,
+			TaskQueue:              taskQueue,
+			ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		})
+		s.NoError(err)
+	}
+
+	// one of the additional tasks fails to start and is left out of the response
+	s.mockHistoryClient.EXPECT().RecordActivityTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *historyservice.RecordActivityTaskStartedRequest, _ ...interface{}) (*historyservice.RecordActivityTaskStartedResponse, error) {
+			if req.ScheduledEventId == 3 {
+				return nil, serviceerror.NewNotFound("activity not found")
+			}
+			return &historyservice.RecordActivityTaskStartedResponse{
+				Attempt: 1,
+				ScheduledEvent: newActivityTaskScheduledEvent(req.ScheduledEventId, 0,
+					&commandpb.ScheduleActivityTaskCommandAttributes{
+						ActivityId:   fmt.Sprintf("activity-%d", req.ScheduledEventId),
+						TaskQueue:    taskQueue,
+						ActivityType: &commonpb.ActivityType{Name: "activity"},
+					}),
+				StartedTime: timestamp.TimeNowPtrUtc(),
+			}, nil
+		}).Times(4)
+
+	resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "identity",
+		},
+		MaxTasks: 4,
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	activityIds := []string{resp.ActivityId}
+	for _, additional := range resp.AdditionalTasks {
+		s.NotEmpty(additional.TaskToken)
+		activityIds = append(activityIds, additional.ActivityId)
+	}
+	s.ElementsMatch([]string{"activity-1", "activity-2", "activity-4"}, activityIds)
+}
+
+func (s *matchingEngineSuite) TestAddDelayedActivityTask() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(200 * time.Millisecond)
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "queue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+
+	// a waiting poller must not get the delayed task
+	pollDone := make(chan struct{})
+	go func() {
+		defer close(pollDone)
+		resp, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+			NamespaceId: namespaceId,
+			PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  "identity",
+			},
+		}, metrics.NoopMetricsHandler)
+		s.NoError(err)
+		s.Equal(emptyPollActivityTaskQueueResponse, resp)
+	}()
+	time.Sleep(20 * time.Millisecond) // let the poller block
+
+	visibilityTime := time.Now().Add(time.Hour).UTC()
+	_, syncMatch, err := s.matchingEngine.AddActivityTask(context.Background(), &matchingservice.AddActivityTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.NewRandom().String()},
+		ScheduledEventId:       1,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		VisibilityTime:         timestamppb.New(visibilityTime),
+	})
+	s.NoError(err)
+	s.False(syncMatch)
+	<-pollDone
+
+	queue := newUnversionedRootQueueKey(namespaceId, taskQueue.Name, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	s.EqualValues(1, s.taskManager.getTaskCount(queue))
+	pm, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), queue.Partition(), false)
+	s.NoError(err)
+	s.EqualValues(1, pm.(*taskQueuePartitionManagerImpl).defaultQueue.BacklogCountHint())
+}
+
+func (s *matchingEngineSuite) TestMoveBacklogTaskKeepsVisibilityTime() {
+	visibilityTime := timestamppb.New(time.Now().Add(time.Hour))
+	task := &persistencespb.TaskInfo{
+		NamespaceId:      uuid.New(),
+		WorkflowId:       "workflowID",
+		RunId:            uuid.New(),
+		ScheduledEventId: 5,
+		ExpiryTime:       timestamppb.New(visibilityTime.AsTime().Add(time.Minute)),
+		VisibilityTime:   visibilityTime,
+	}
+	s.mockMatchingClient.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddActivityTaskRequest, _ ...interface{}) (*matchingservice.AddActivityTaskResponse, error) {
+			s.Equal("destination", req.GetTaskQueue().GetName())
+			s.Equal(visibilityTime.AsTime(), req.GetVisibilityTime().AsTime())
+			// the timeout starts counting at the visibility time, so it is not stretched by the delay
+			s.InDelta(time.Minute, req.GetScheduleToStartTimeout().AsDuration(), float64(time.Second))
+			return &matchingservice.AddActivityTaskResponse{}, nil
+		})
+	s.mockMatchingClient.EXPECT().AddWorkflowTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.AddWorkflowTaskRequest, _ ...interface{}) (*matchingservice.AddWorkflowTaskResponse, error) {
+			s.Equal(visibilityTime.AsTime(), req.GetVisibilityTime().AsTime())
+			return &matchingservice.AddWorkflowTaskResponse{}, nil
+		})
+
+	s.NoError(s.matchingEngine.moveBacklogTask(context.Background(), enumspb.TASK_QUEUE_TYPE_ACTIVITY, "destination", task))
+	s.NoError(s.matchingEngine.moveBacklogTask(context.Background(), enumspb.TASK_QUEUE_TYPE_WORKFLOW, "destination", task))
+}
+
+func (s *matchingEngineSuite) TestAddDelayedWorkflowTask() {
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "queue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+
+	_, syncMatch, err := s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.NewRandom().String()},
+		ScheduledEventId:       1,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+		VisibilityTime:         timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	s.NoError(err)
+	s.False(syncMatch)
+
+	queue := newUnversionedRootQueueKey(namespaceId, taskQueue.Name, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	s.EqualValues(1, s.taskManager.getTaskCount(queue))
+}
+
+func (s *matchingEngineSuite) TestListWorkers() {
+	s.matchingEngine.config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+	s.mockMatchingClient.EXPECT().DescribeTaskQueuePartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *matchingservice.DescribeTaskQueuePartitionRequest, _ ...interface{}) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
+			return s.matchingEngine.DescribeTaskQueuePartition(ctx, req)
+		}).Times(4)
+	namespaceId := uuid.New()
+	family, err := tqid.NewTaskQueueFamily(namespaceId, "queue")
+	s.NoError(err)
+
+	recordHeartbeat := func(taskQueueType enumspb.TaskQueueType, partitionId int, heartbeat *taskqueuespb.WorkerHeartbeat) {
+		_, err := s.matchingEngine.RecordWorkerHeartbeat(context.Background(), &matchingservice.RecordWorkerHeartbeatRequest{
+			NamespaceId:   namespaceId,
+			TaskQueue:     family.TaskQueue(taskQueueType).NormalPartition(partitionId).RpcName(),
+			TaskQueueType: taskQueueType,
+			Heartbeat:     heartbeat,
+		})
+		s.NoError(err)
+	}
+	recordHeartbeat(enumspb.TASK_QUEUE_TYPE_WORKFLOW, 0, &taskqueuespb.WorkerHeartbeat{Identity: "worker-1", UsedSlots: 1})
+	// make sure the second heartbeat of worker-1 is the latest one
+	time.Sleep(time.Millisecond)
+	recordHeartbeat(enumspb.TASK_QUEUE_TYPE_ACTIVITY, 1, &taskqueuespb.WorkerHeartbeat{Identity: "worker-1", UsedSlots: 2})
+	recordHeartbeat(enumspb.TASK_QUEUE_TYPE_ACTIVITY, 0, &taskqueuespb.WorkerHeartbeat{Identity: "worker-2"})
+
+	resp, err := s.matchingEngine.ListWorkers(context.Background(), &matchingservice.ListWorkersRequest{
+		NamespaceId: namespaceId,
+		TaskQueue:   "queue",
+	})
+	s.NoError(err)
+	s.Len(resp.Workers, 2)
+	s.Equal("worker-1", resp.Workers[0].GetHeartbeat().GetIdentity())
+	s.Equal(int32(2), resp.Workers[0].GetHeartbeat().GetUsedSlots())
+	s.ElementsMatch([]enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY}, resp.Workers[0].GetTaskQueueTypes())
+	s.Equal("worker-2", resp.Workers[1].GetHeartbeat().GetIdentity())
+	s.Equal([]enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY}, resp.Workers[1].GetTaskQueueTypes())
+}
+
+func (s *matchingEngineSuite) TestPollRecordsWorkerHeartbeat() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(10 * time.Millisecond)
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "queue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+
+	_, err := s.matchingEngine.PollActivityTaskQueue(context.Background(), &matchingservice.PollActivityTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "identity",
+		},
+		WorkerHeartbeat: &taskqueuespb.WorkerHeartbeat{Identity: "identity", UsedSlots: 3},
+	}, metrics.NoopMetricsHandler)
+	s.NoError(err)
+
+	queue := newUnversionedRootQueueKey(namespaceId, taskQueue.Name, enumspb.TASK_QUEUE_TYPE_ACTIVITY)
+	pm, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), queue.Partition(), false)
+	s.NoError(err)
+	workers := pm.(*taskQueuePartitionManagerImpl).workerRegistry.list()
+	s.Len(workers, 1)
+	s.Equal("identity", workers[0].GetHeartbeat().GetIdentity())
+	s.Equal(int32(3), workers[0].GetHeartbeat().GetUsedSlots())
+}
+
 func (s *matchingEngineSuite) TestAddActivityTasks() {
 	s.AddTasksTest(enumspb.TASK_QUEUE_TYPE_ACTIVITY, false)
 }
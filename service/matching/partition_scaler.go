@@ -0,0 +1,318 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/internal/goro"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// partitionScaler periodically adjusts the number of partitions of a task queue based on the load observed
+	// across its partitions. It runs on the root workflow partition, which owns the task queue user data, and
+	// stores the chosen partition counts in the cluster-local part of the user data so that they propagate to all
+	// partitions and to the matching clients.
+	//
+	// Scaling up first raises only the read count, and raises the write count once the new read count had time to
+	// propagate, so that the new partitions have pollers before tasks are written to them. Scaling down first lowers
+	// only the write count so that the removed partitions can drain, and lowers the read count once their backlog is
+	// empty.
+	partitionScaler struct {
+		pm         *taskQueuePartitionManagerImpl
+		timeSource clock.TimeSource
+		// config for each task queue type, with the statically configured partition counts
+		configs   map[enumspb.TaskQueueType]*taskQueueConfig
+		goroGroup goro.Group
+		// only accessed by the scaler goroutine
+		pending      map[enumspb.TaskQueueType]*pendingPartitionScale
+		drainedSince map[enumspb.TaskQueueType]time.Time
+	}
+
+	// pendingPartitionScale is a partition count recommendation that has not been held long enough to be applied.
+	pendingPartitionScale struct {
+		up    bool
+		since time.Time
+		// the most conservative recommendation seen since the pending scale started
+		partitions int
+	}
+
+	// partitionLoad is the load observed on one or more partitions of a task queue type.
+	partitionLoad struct {
+		addRate float64
+		backlog int64
+		pollers int
+	}
+)
+
+var autoScaledTaskQueueTypes = []enumspb.TaskQueueType{
+	enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+}
+
+func newPartitionScaler(pm *taskQueuePartitionManagerImpl) *partitionScaler {
+	family := pm.partition.TaskQueue().Family()
+	configs := make(map[enumspb.TaskQueueType]*taskQueueConfig, len(autoScaledTaskQueueTypes))
+	for _, taskType := range autoScaledTaskQueueTypes {
+		configs[taskType] = newTaskQueueConfig(family.TaskQueue(taskType), pm.engine.config, pm.ns.Name())
+	}
+	return &partitionScaler{
+		pm:           pm,
+		timeSource:   clock.NewRealTimeSource(),
+		configs:      configs,
+		pending:      make(map[enumspb.TaskQueueType]*pendingPartitionScale),
+		drainedSince: make(map[enumspb.TaskQueueType]time.Time),
+	}
+}
+
+func (s *partitionScaler) Start() {
+	s.goroGroup.Go(s.run)
+}
+
+func (s *partitionScaler) Stop() {
+	s.goroGroup.Cancel()
+}
+
+func (s *partitionScaler) run(ctx context.Context) error {
+	ctx = s.pm.callerInfoContext(ctx)
+	if err := s.pm.userDataManager.WaitUntilInitialized(ctx); err != nil {
+		return err
+	}
+	for {
+		timer := time.NewTimer(s.configs[enumspb.TASK_QUEUE_TYPE_WORKFLOW].PartitionAutoScaleInterval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		for _, taskType := range autoScaledTaskQueueTypes {
+			if err := s.evaluate(ctx, taskType); err != nil && ctx.Err() == nil {
+				s.pm.logger.Warn("failed to evaluate task queue partition count",
+					tag.WorkflowTaskQueueType(taskType), tag.Error(err))
+			}
+		}
+	}
+}
+
+func (s *partitionScaler) evaluate(ctx context.Context, taskType enumspb.TaskQueueType) error {
+	config := s.configs[taskType]
+	if !config.EnablePartitionAutoScale() {
+		delete(s.pending, taskType)
+		delete(s.drainedSince, taskType)
+		return nil
+	}
+
+	userData, _, err := s.pm.userDataManager.GetUserData()
+	if err != nil {
+		return err
+	}
+	read, write := config.NumReadPartitions(), config.NumWritePartitions()
+	counts := autoScaledPartitionCounts(userData.GetData(), taskType)
+	if counts != nil {
+		read, write = int(counts.GetRead()), int(counts.GetWrite())
+	}
+
+	loads, err := s.collectLoad(ctx, taskType, read)
+	if err != nil {
+		return err
+	}
+	now := s.timeSource.Now()
+
+	var total partitionLoad
+	for _, load := range loads {
+		total.addRate += load.addRate
+		total.backlog += load.backlog
+		total.pollers += load.pollers
+	}
+	desired := recommendPartitionCount(total, config)
+
+	if read > write && counts != nil && desired >= read {
+		// A scale-up is in progress. Start writing to the new partitions once their read count has propagated.
+		delete(s.pending, taskType)
+		delete(s.drainedSince, taskType)
+		if now.Sub(counts.GetUpdateTime().AsTime()) < config.PartitionAutoScalePropagationDelay() {
+			return nil
+		}
+		return s.updatePartitionCounts(ctx, taskType, read, read, now)
+	}
+
+	if read > write {
+		// A scale-down is in progress. Stop reading from the removed partitions once they have been empty for
+		// long enough; the backlog count of a freshly loaded partition may be zero before its tasks are read.
+		drained := true
+		for _, load := range loads[write:] {
+			if load.backlog > 0 {
+				drained = false
+				break
+			}
+		}
+		if !drained {
+			delete(s.drainedSince, taskType)
+		} else if since, ok := s.drainedSince[taskType]; !ok {
+			s.drainedSince[taskType] = now
+		} else if now.Sub(since) >= config.PartitionAutoScaleSustainDuration() {
+			delete(s.drainedSince, taskType)
+			return s.updatePartitionCounts(ctx, taskType, write, write, now)
+		}
+	}
+
+	if desired == write {
+		delete(s.pending, taskType)
+		return nil
+	}
+
+	up := desired > write
+	pending := s.pending[taskType]
+	if pending == nil || pending.up != up {
+		s.pending[taskType] = &pendingPartitionScale{up: up, since: now, partitions: desired}
+		return nil
+	}
+	if up {
+		pending.partitions = min(pending.partitions, desired)
+	} else {
+		pending.partitions = max(pending.partitions, desired)
+	}
+	if now.Sub(pending.since) < config.PartitionAutoScaleSustainDuration() {
+		return nil
+	}
+	delete(s.pending, taskType)
+	if up {
+		// Only start reading from the new partitions, they are written to once the new read count has propagated.
+		return s.updatePartitionCounts(ctx, taskType, max(read, pending.partitions), write, now)
+	}
+	// Keep reading from all current partitions when scaling down, they are dropped once drained.
+	return s.updatePartitionCounts(ctx, taskType, max(read, pending.partitions), pending.partitions, now)
+}
+
+// collectLoad returns the load observed on each of the given number of partitions.
+func (s *partitionScaler) collectLoad(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	numPartitions int,
+) ([]partitionLoad, error) {
+	loads := make([]partitionLoad, numPartitions)
+	for i := range loads {
+		resp, err := s.pm.matchingClient.DescribeTaskQueuePartition(ctx, &matchingservice.DescribeTaskQueuePartitionRequest{
+			NamespaceId: s.pm.partition.NamespaceId().String(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     s.pm.partition.TaskQueue().Name(),
+				TaskQueueType: taskType,
+				PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(i)},
+			},
+			Versions:          &taskqueuepb.TaskQueueVersionSelection{Unversioned: true, AllActive: true},
+			ReportBacklogInfo: true,
+			ReportPollers:     true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, vInfo := range resp.GetVersionsInfoInternal() {
+			info := vInfo.GetPhysicalTaskQueueInfo()
+			loads[i].addRate += float64(info.GetTasksAddRate())
+			loads[i].backlog += info.GetBacklogCountHint()
+			loads[i].pollers += len(info.GetPollers())
+		}
+	}
+	return loads, nil
+}
+
+func (s *partitionScaler) updatePartitionCounts(
+	ctx context.Context,
+	taskType enumspb.TaskQueueType,
+	read, write int,
+	now time.Time,
+) error {
+	err := s.pm.userDataManager.UpdateUserData(ctx, UserDataUpdateOptions{}, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+		// Avoid mutation
+		ret := common.CloneProto(data)
+		if ret == nil {
+			ret = &persistencespb.TaskQueueUserData{}
+		}
+		if ret.PerType == nil {
+			ret.PerType = make(map[int32]*persistencespb.TaskQueueTypeUserData)
+		}
+		typeData := ret.PerType[int32(taskType)]
+		if typeData == nil {
+			typeData = &persistencespb.TaskQueueTypeUserData{}
+			ret.PerType[int32(taskType)] = typeData
+		}
+		typeData.PartitionCounts = &persistencespb.TaskQueuePartitionCounts{
+			Read:       int32(read),
+			Write:      int32(write),
+			UpdateTime: timestamppb.New(now),
+		}
+		// Partition counts reflect the load in this cluster only and are not replicated.
+		return ret, false, nil
+	})
+	if err != nil {
+		return err
+	}
+	s.pm.logger.Info("updated task queue partition counts",
+		tag.WorkflowTaskQueueType(taskType), tag.NewInt("read-partitions", read), tag.NewInt("write-partitions", write))
+	return nil
+}
+
+// recommendPartitionCount returns the number of partitions needed to serve the given total load within the per
+// partition targets, bounded by the configured min and max.
+func recommendPartitionCount(load partitionLoad, config *taskQueueConfig) int {
+	desired := 1
+	if target := config.PartitionAutoScaleTargetAddRate(); target > 0 {
+		desired = max(desired, int(math.Ceil(load.addRate/target)))
+	}
+	if target := config.PartitionAutoScaleTargetPollers(); target > 0 {
+		desired = max(desired, int(math.Ceil(float64(load.pollers)/float64(target))))
+	}
+	if target := config.PartitionAutoScaleTargetBacklog(); target > 0 {
+		desired = max(desired, int(math.Ceil(float64(load.backlog)/float64(target))))
+	}
+	minPartitions := max(1, config.PartitionAutoScaleMinPartitions())
+	maxPartitions := max(minPartitions, config.PartitionAutoScaleMaxPartitions())
+	return min(max(desired, minPartitions), maxPartitions)
+}
+
+// autoScaledPartitionCounts returns the partition counts picked by the partition scaler for the given task queue
+// type, or nil if there are none.
+func autoScaledPartitionCounts(
+	data *persistencespb.TaskQueueUserData,
+	taskType enumspb.TaskQueueType,
+) *persistencespb.TaskQueuePartitionCounts {
+	counts := data.GetPerType()[int32(taskType)].GetPartitionCounts()
+	if counts.GetRead() <= 0 || counts.GetWrite() <= 0 {
+		return nil
+	}
+	return counts
+}
@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/grpc"
+)
+
+func TestRecommendPartitionCount(t *testing.T) {
+	config := NewConfig(dynamicconfig.NewNoopCollection())
+	config.PartitionAutoScaleTargetAddRate = staticFloatPropertyFilteredByTaskQueueInfo(100)
+	config.PartitionAutoScaleTargetPollers = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(10)
+	config.PartitionAutoScaleTargetBacklog = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1000)
+	config.PartitionAutoScaleMinPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(2)
+	config.PartitionAutoScaleMaxPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(8)
+	f, err := tqid.NewTaskQueueFamily(namespaceId, taskQueueName)
+	require.NoError(t, err)
+	tqConfig := newTaskQueueConfig(f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW), config, namespaceName)
+
+	testCases := []struct {
+		name     string
+		load     partitionLoad
+		expected int
+	}{
+		{name: "idle", load: partitionLoad{}, expected: 2},
+		{name: "add rate", load: partitionLoad{addRate: 450}, expected: 5},
+		{name: "pollers", load: partitionLoad{addRate: 10, pollers: 61}, expected: 7},
+		{name: "backlog", load: partitionLoad{addRate: 10, backlog: 3500}, expected: 4},
+		{name: "capped", load: partitionLoad{addRate: 5000}, expected: 8},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, recommendPartitionCount(tc.load, tqConfig))
+		})
+	}
+}
+
+func TestPartitionScaler_ScaleUpAndDown(t *testing.T) {
+	controller := gomock.NewController(t)
+	ns, registry := createMockNamespaceCache(controller, namespace.Name(namespaceName))
+	config := NewConfig(dynamicconfig.NewNoopCollection())
+	config.EnablePartitionAutoScale = dynamicconfig.GetBoolPropertyFnFilteredByTaskQueueInfo(true)
+	config.NumTaskqueueReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	config.NumTaskqueueWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
+	config.PartitionAutoScaleSustainDuration = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(time.Minute)
+	config.PartitionAutoScalePropagationDelay = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(30 * time.Second)
+	config.PartitionAutoScaleTargetAddRate = staticFloatPropertyFilteredByTaskQueueInfo(100)
+
+	var lock sync.Mutex
+	loads := make(map[int32]*taskqueuespb.PhysicalTaskQueueInfo)
+	setLoad := func(partitionId int32, addRate float32, backlog int64) {
+		lock.Lock()
+		defer lock.Unlock()
+		loads[partitionId] = &taskqueuespb.PhysicalTaskQueueInfo{TasksAddRate: addRate, BacklogCountHint: backlog}
+	}
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(controller)
+	matchingClient.EXPECT().DescribeTaskQueuePartition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.DescribeTaskQueuePartitionRequest, _ ...grpc.CallOption) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
+			require.True(t, req.GetReportBacklogInfo())
+			lock.Lock()
+			defer lock.Unlock()
+			return &matchingservice.DescribeTaskQueuePartitionResponse{
+				VersionsInfoInternal: map[string]*taskqueuespb.TaskQueueVersionInfoInternal{
+					"": {PhysicalTaskQueueInfo: loads[req.GetTaskQueuePartition().GetNormalPartitionId()]},
+				},
+			}, nil
+		}).AnyTimes()
+	me := createTestMatchingEngine(controller, config, matchingClient, registry)
+
+	f, err := tqid.NewTaskQueueFamily(namespaceId, taskQueueName)
+	require.NoError(t, err)
+	partition := f.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition()
+	userDataMgr := &mockUserDataManager{data: &persistencespb.VersionedTaskQueueUserData{Data: &persistencespb.TaskQueueUserData{}}}
+	pm, err := newTaskQueuePartitionManager(me, ns, partition, newTaskQueueConfig(partition.TaskQueue(), config, ns.Name()), userDataMgr)
+	require.NoError(t, err)
+	require.NotNil(t, pm.scaler)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	pm.scaler.timeSource = timeSource
+
+	ctx := context.Background()
+	evaluate := func() {
+		require.NoError(t, pm.scaler.evaluate(ctx, enumspb.TASK_QUEUE_TYPE_WORKFLOW))
+	}
+	requireCounts := func(read, write int32) {
+		counts := pm.PartitionCounts()
+		require.Equal(t, read, counts.GetRead())
+		require.Equal(t, write, counts.GetWrite())
+	}
+
+	// scale up only after the recommendation is sustained
+	setLoad(0, 350, 0)
+	evaluate()
+	require.Nil(t, pm.PartitionCounts())
+	require.Equal(t, 1, pm.config.NumReadPartitions())
+	timeSource.Advance(time.Minute)
+	evaluate()
+	// scale up raises the read count first
+	requireCounts(4, 1)
+	require.Equal(t, 4, pm.config.NumReadPartitions())
+	require.Equal(t, 1, pm.config.NumWritePartitions())
+
+	// and the write count once the read count had time to propagate
+	evaluate()
+	requireCounts(4, 1)
+	timeSource.Advance(30 * time.Second)
+	evaluate()
+	requireCounts(4, 4)
+	require.Equal(t, 4, pm.config.NumReadPartitions())
+	require.Equal(t, 4, pm.config.NumWritePartitions())
+
+	// scale down lowers the write count first
+	for i := int32(0); i < 4; i++ {
+		setLoad(i, 10, 0)
+	}
+	setLoad(2, 10, 5)
+	evaluate()
+	timeSource.Advance(time.Minute)
+	evaluate()
+	requireCounts(4, 1)
+
+	// read count is lowered once the removed partitions are drained
+	timeSource.Advance(time.Minute)
+	evaluate()
+	requireCounts(4, 1)
+	setLoad(2, 0, 0)
+	evaluate()
+	requireCounts(4, 1)
+	timeSource.Advance(time.Minute)
+	evaluate()
+	requireCounts(1, 1)
+
+	// auto scaled counts are ignored when auto scaling is disabled
+	pm.config.EnablePartitionAutoScale = func() bool { return false }
+	require.Nil(t, pm.PartitionCounts())
+}
+
+func staticFloatPropertyFilteredByTaskQueueInfo(value float64) dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters {
+	return func(string, string, enumspb.TaskQueueType) float64 {
+		return value
+	}
+}
@@ -84,6 +84,9 @@ type (
 		// maxDispatchPerSecond is the max rate at which tasks are allowed to be dispatched
 		// from this task queue to pollers
 		PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, error)
+		// PollAdditionalTask blocks waiting for a task that is available locally, for a poller that already got
+		// a task and can accept more. Returns errNoTasks when context deadline is exceeded
+		PollAdditionalTask(ctx context.Context) (*internalTask, error)
 		// MarkAlive updates the liveness timer to keep this physicalTaskQueueManager alive.
 		MarkAlive()
 		// SpoolTask spools a task to persistence to be matched asynchronously when a poller is available.
@@ -105,6 +108,15 @@ type (
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		// Describe returns information about the physical task queue
 		Describe() *taskqueuespb.PhysicalTaskQueueInfo
+		// BacklogCountHint returns an estimate of the number of tasks in the backlog of this physical queue
+		BacklogCountHint() int64
+		// TasksAddRate returns the rate (tasks per second) at which tasks have recently been added to this
+		// physical queue, not counting tasks forwarded from child partitions
+		TasksAddRate() float64
+		// ListBacklogTasks returns a page of tasks currently in the backlog of this physical queue
+		ListBacklogTasks(ctx context.Context, pageSize int, nextPageToken []byte, filter backlogTaskFilterFunc) ([]*persistencespb.AllocatedTaskInfo, []byte, error)
+		// DeleteBacklogTasks deletes the given tasks from the backlog of this physical queue
+		DeleteBacklogTasks(ctx context.Context, taskIDs []int64, afterDelete func(*persistencespb.AllocatedTaskInfo) error) ([]*taskqueuespb.BacklogTaskDeletionResult, error)
 		UnloadFromPartitionManager()
 		String() string
 		QueueKey() *PhysicalTaskQueueKey
@@ -130,6 +142,8 @@ type (
 		pollerHistory *pollerHistory
 		currentPolls  atomic.Int64
 		taskValidator taskValidator
+		// addRate tracks the rate of tasks added to this queue, used for partition auto scaling
+		addRate *rateTracker
 	}
 )
 
@@ -166,6 +180,7 @@ func newPhysicalTaskQueueManager(
 		taggedMetricsHandler: taggedMetricsHandler,
 	}
 	pqMgr.pollerHistory = newPollerHistory()
+	pqMgr.addRate = newRateTracker(clock.NewRealTimeSource(), time.Second, 60)
 
 	pqMgr.liveness = newLiveness(
 		clock.NewRealTimeSource(),
@@ -247,6 +262,7 @@ func (c *physicalTaskQueueManagerImpl) AddTask(
 	if params.forwardedFrom == "" {
 		// request sent by history service
 		c.liveness.markAlive()
+		c.addRate.record(1)
 	}
 
 	taskInfo := params.taskInfo
@@ -255,7 +271,8 @@ func (c *physicalTaskQueueManagerImpl) AddTask(
 		return false, err
 	}
 
-	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) {
+	// delayed tasks go straight to the backlog, the task reader dispatches them once they are due
+	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) && !isTaskDelayed(taskInfo, time.Now()) {
 		syncMatch, err := c.trySyncMatch(ctx, params)
 		if syncMatch {
 			return syncMatch, err
@@ -327,6 +344,25 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 	return task, nil
 }
 
+func (c *physicalTaskQueueManagerImpl) PollAdditionalTask(ctx context.Context) (*internalTask, error) {
+	namespaceEntry, err := c.namespaceRegistry.GetNamespaceByID(c.queue.NamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	if !namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) {
+		return nil, errNoTasks
+	}
+
+	task, err := c.matcher.PollAdditional(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	task.namespace = c.partitionMgr.ns.Name()
+	task.backlogCountHint = c.backlogMgr.BacklogCountHint
+	return task, nil
+}
+
 func (c *physicalTaskQueueManagerImpl) MarkAlive() {
 	c.liveness.markAlive()
 }
@@ -420,6 +456,31 @@ func (c *physicalTaskQueueManagerImpl) Describe() *taskqueuespb.PhysicalTaskQueu
 	}
 }
 
+func (c *physicalTaskQueueManagerImpl) BacklogCountHint() int64 {
+	return c.backlogMgr.BacklogCountHint()
+}
+
+func (c *physicalTaskQueueManagerImpl) TasksAddRate() float64 {
+	return c.addRate.rate()
+}
+
+func (c *physicalTaskQueueManagerImpl) ListBacklogTasks(
+	ctx context.Context,
+	pageSize int,
+	nextPageToken []byte,
+	filter backlogTaskFilterFunc,
+) ([]*persistencespb.AllocatedTaskInfo, []byte, error) {
+	return c.backlogMgr.ListTasks(ctx, pageSize, nextPageToken, filter)
+}
+
+func (c *physicalTaskQueueManagerImpl) DeleteBacklogTasks(
+	ctx context.Context,
+	taskIDs []int64,
+	afterDelete func(*persistencespb.AllocatedTaskInfo) error,
+) ([]*taskqueuespb.BacklogTaskDeletionResult, error) {
+	return c.backlogMgr.DeleteTasks(ctx, taskIDs, afterDelete)
+}
+
 func (c *physicalTaskQueueManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.queue.TaskType() == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+)
+
+type (
+	// rateTracker counts events in fixed size time buckets and reports the average rate per second over the
+	// window covered by the buckets.
+	rateTracker struct {
+		lock       sync.Mutex
+		timeSource clock.TimeSource
+		bucketSize time.Duration
+		buckets    []int64
+		// absolute index (time since epoch divided by bucketSize) of the most recently written bucket
+		lastBucket int64
+		startTime  time.Time
+	}
+)
+
+func newRateTracker(timeSource clock.TimeSource, bucketSize time.Duration, numBuckets int) *rateTracker {
+	now := timeSource.Now()
+	return &rateTracker{
+		timeSource: timeSource,
+		bucketSize: bucketSize,
+		buckets:    make([]int64, numBuckets),
+		lastBucket: now.UnixNano() / int64(bucketSize),
+		startTime:  now,
+	}
+}
+
+func (r *rateTracker) record(count int64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.advanceLocked(r.timeSource.Now())
+	r.buckets[r.lastBucket%int64(len(r.buckets))] += count
+}
+
+// rate returns the number of recorded events per second. Until a full window has passed, the rate is computed over
+// the time since the tracker was created so that new trackers do not under-report.
+func (r *rateTracker) rate() float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.timeSource.Now()
+	r.advanceLocked(now)
+	var sum int64
+	for _, c := range r.buckets {
+		sum += c
+	}
+	window := min(time.Duration(len(r.buckets))*r.bucketSize, now.Sub(r.startTime))
+	window = max(window, r.bucketSize)
+	return float64(sum) / window.Seconds()
+}
+
+func (r *rateTracker) advanceLocked(now time.Time) {
+	current := now.UnixNano() / int64(r.bucketSize)
+	if current <= r.lastBucket {
+		return
+	}
+	numBuckets := int64(len(r.buckets))
+	for i := r.lastBucket + 1; i <= current && i <= r.lastBucket+numBuckets; i++ {
+		r.buckets[i%numBuckets] = 0
+	}
+	r.lastBucket = current
+}
@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/clock"
+)
+
+func TestRateTracker(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	tracker := newRateTracker(timeSource, time.Second, 10)
+	require.Zero(t, tracker.rate())
+
+	// before a full window has passed, the rate is computed over the elapsed time
+	tracker.record(10)
+	timeSource.Advance(time.Second)
+	tracker.record(10)
+	timeSource.Advance(time.Second)
+	require.InDelta(t, 10, tracker.rate(), 0.001)
+
+	timeSource.Advance(7 * time.Second)
+	require.InDelta(t, 20.0/9, tracker.rate(), 0.001)
+
+	// old buckets expire
+	timeSource.Advance(time.Second)
+	require.InDelta(t, 1, tracker.rate(), 0.001)
+	timeSource.Advance(time.Second)
+	require.Zero(t, tracker.rate())
+	timeSource.Advance(time.Hour)
+	require.Zero(t, tracker.rate())
+	tracker.record(5)
+	require.InDelta(t, 0.5, tracker.rate(), 0.001)
+}
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -61,6 +62,10 @@ type (
 		// maxDispatchPerSecond is the max rate at which tasks are allowed to be dispatched
 		// from this task queue to pollers
 		PollTask(ctx context.Context, pollMetadata *pollMetadata) (*internalTask, bool, error)
+		// PollAdditionalTasks returns up to maxTasks more tasks for a poller that already got one from PollTask
+		// and can accept more. Only tasks that are available locally within a short wait are returned, polls are
+		// never forwarded. The boolean reports whether a versionSet was used for the match.
+		PollAdditionalTasks(ctx context.Context, pollMetadata *pollMetadata, maxTasks int) ([]*internalTask, bool, error)
 		// ProcessSpooledTask dispatches a task to a poller. When there are no pollers to pick
 		// up the task, this method will return error. Task will not be persisted to db
 		ProcessSpooledTask(ctx context.Context, task *internalTask, sourceBuildId string) error
@@ -78,9 +83,17 @@ type (
 		HasPollerAfter(buildId string, accessTime time.Time) bool
 		// HasAnyPollerAfter checks pollers on all versioned and unversioned queues
 		HasAnyPollerAfter(accessTime time.Time) bool
+		// RecordWorkerHeartbeat stores the heartbeat of a worker in the worker registry of this partition
+		RecordWorkerHeartbeat(heartbeat *taskqueuespb.WorkerHeartbeat)
 		// LegacyDescribeTaskQueue returns information about all pollers of this partition and the status of its unversioned physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
-		Describe(buildIds map[string]bool, includeAllActive, reportBacklogInfo, reportPollers bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		Describe(buildIds map[string]bool, includeAllActive, reportBacklogInfo, reportPollers, reportWorkers bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		// GetPhysicalQueue returns the physical queue of the given build ID, or the unversioned queue if an empty
+		// string is given. The versioned queue is loaded if needed.
+		GetPhysicalQueue(ctx context.Context, buildId string) (physicalTaskQueueManager, error)
+		// PartitionCounts returns the partition counts picked by the partition auto scaler for this task queue
+		// type, or nil if auto scaling is disabled or has not picked any yet.
+		PartitionCounts() *persistencespb.TaskQueuePartitionCounts
 		String() string
 		Partition() tqid.Partition
 		LongPollExpirationInterval() time.Duration
@@ -112,6 +125,10 @@ type (
 		throttledLogger      log.ThrottledLogger
 		matchingClient       matchingservice.MatchingServiceClient
 		taggedMetricsHandler metrics.Handler // namespace/taskqueue tagged metric scope
+		// only set on the root workflow partition, which owns the partition counts of the task queue
+		scaler *partitionScaler
+		// workerRegistry stores the workers that heartbeat or polled this partition recently
+		workerRegistry *workerRegistry
 	}
 )
 
@@ -142,17 +159,45 @@ func newTaskQueuePartitionManager(
 		partition.Kind(),
 	)
 
+	// Copy the config so that overriding the partition counts below does not affect other users of tqConfig.
+	config := *tqConfig
 	pm := &taskQueuePartitionManagerImpl{
 		engine:               e,
 		partition:            partition,
 		ns:                   ns,
-		config:               tqConfig,
+		config:               &config,
 		logger:               logger,
 		throttledLogger:      throttledLogger,
 		matchingClient:       e.matchingRawClient,
 		taggedMetricsHandler: taggedMetricsHandler,
 		versionedQueues:      make(map[string]physicalTaskQueueManager),
 		userDataManager:      userDataManager,
+		workerRegistry: newWorkerRegistry(
+			partition.TaskType(),
+			config.WorkerRegistryTTL(),
+			config.WorkerRegistryMaxSize(),
+			clock.NewRealTimeSource(),
+			taggedMetricsHandler,
+		),
+	}
+
+	// When partition auto scaling is enabled, the partition counts picked by the scaler take precedence over the
+	// statically configured ones. This must be done before creating the physical queues as they capture these.
+	staticReadPartitions, staticWritePartitions := config.NumReadPartitions, config.NumWritePartitions
+	config.NumReadPartitions = func() int {
+		if counts := pm.PartitionCounts(); counts != nil {
+			return int(counts.GetRead())
+		}
+		return staticReadPartitions()
+	}
+	config.NumWritePartitions = func() int {
+		if counts := pm.PartitionCounts(); counts != nil {
+			return int(counts.GetWrite())
+		}
+		return staticWritePartitions()
+	}
+	if partition.IsRoot() && partition.Kind() == enumspb.TASK_QUEUE_KIND_NORMAL && partition.TaskType() == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+		pm.scaler = newPartitionScaler(pm)
 	}
 
 	defaultQ, err := newPhysicalTaskQueueManager(pm, UnversionedQueueKey(partition))
@@ -167,11 +212,17 @@ func (pm *taskQueuePartitionManagerImpl) Start() {
 	pm.engine.updateTaskQueuePartitionGauge(pm, 1)
 	pm.userDataManager.Start()
 	pm.defaultQueue.Start()
+	if pm.scaler != nil {
+		pm.scaler.Start()
+	}
 }
 
 // Stop does not unload the partition from matching engine. It is intended to be called by matching engine when
 // unloading the partition. For stopping and unloading a partition call unloadFromEngine instead.
 func (pm *taskQueuePartitionManagerImpl) Stop() {
+	if pm.scaler != nil {
+		pm.scaler.Stop()
+	}
 	pm.versionedQueuesLock.Lock()
 	defer pm.versionedQueuesLock.Unlock()
 	for _, vq := range pm.versionedQueues {
@@ -238,6 +289,71 @@ func (pm *taskQueuePartitionManagerImpl) PollTask(
 	ctx context.Context,
 	pollMetadata *pollMetadata,
 ) (*internalTask, bool, error) {
+	dbq, versionSetUsed, err := pm.getPhysicalQueueForPoll(ctx, pollMetadata)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if identity, ok := ctx.Value(identityKey).(string); ok && identity != "" {
+		dbq.UpdatePollerInfo(pollerIdentity(identity), pollMetadata)
+		// update timestamp when long poll ends
+		defer dbq.UpdatePollerInfo(pollerIdentity(identity), pollMetadata)
+		// forwarded polls are registered by the partition that received them
+		if pollMetadata.forwardedFrom == "" {
+			pm.recordPoll(ctx, identity, pollMetadata)
+		}
+	}
+
+	task, err := dbq.PollTask(ctx, pollMetadata)
+	return task, versionSetUsed, err
+}
+
+func (pm *taskQueuePartitionManagerImpl) PollAdditionalTasks(
+	ctx context.Context,
+	pollMetadata *pollMetadata,
+	maxTasks int,
+) ([]*internalTask, bool, error) {
+	maxTasks = min(maxTasks, pm.config.MaxTasksPerPoll()-1)
+	if maxTasks <= 0 {
+		return nil, false, nil
+	}
+	dbq, versionSetUsed, err := pm.getPhysicalQueueForPoll(ctx, pollMetadata)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pm.config.MultiTaskPollWait())
+	defer cancel()
+	var tasks []*internalTask
+	for len(tasks) < maxTasks {
+		task, err := dbq.PollAdditionalTask(ctx)
+		if err != nil {
+			// errNoTasks, return what we have
+			break
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, versionSetUsed, nil
+}
+
+func (pm *taskQueuePartitionManagerImpl) RecordWorkerHeartbeat(heartbeat *taskqueuespb.WorkerHeartbeat) {
+	pm.workerRegistry.recordHeartbeat(heartbeat)
+}
+
+func (pm *taskQueuePartitionManagerImpl) recordPoll(ctx context.Context, identity string, pollMetadata *pollMetadata) {
+	if pollMetadata.workerHeartbeat != nil {
+		pm.workerRegistry.recordHeartbeat(pollMetadata.workerHeartbeat)
+	}
+	sdkInfo := headers.GetValues(ctx, headers.ClientNameHeaderName, headers.ClientVersionHeaderName)
+	pm.workerRegistry.recordPoll(identity, sdkInfo[0], sdkInfo[1], pollMetadata.workerVersionCapabilities.GetBuildId())
+}
+
+// getPhysicalQueueForPoll returns the physical queue a poller should poll from, and a boolean that reports whether a
+// versionSet was used to pick it.
+func (pm *taskQueuePartitionManagerImpl) getPhysicalQueueForPoll(
+	ctx context.Context,
+	pollMetadata *pollMetadata,
+) (physicalTaskQueueManager, bool, error) {
 	dbq := pm.defaultQueue
 	versionSetUsed := false
 	if pollMetadata.workerVersionCapabilities.GetUseVersioning() {
@@ -282,15 +398,7 @@ func (pm *taskQueuePartitionManagerImpl) PollTask(
 			return nil, false, err
 		}
 	}
-
-	if identity, ok := ctx.Value(identityKey).(string); ok && identity != "" {
-		dbq.UpdatePollerInfo(pollerIdentity(identity), pollMetadata)
-		// update timestamp when long poll ends
-		defer dbq.UpdatePollerInfo(pollerIdentity(identity), pollMetadata)
-	}
-
-	task, err := dbq.PollTask(ctx, pollMetadata)
-	return task, versionSetUsed, err
+	return dbq, versionSetUsed, nil
 }
 
 func (pm *taskQueuePartitionManagerImpl) ProcessSpooledTask(
@@ -425,9 +533,16 @@ func (pm *taskQueuePartitionManagerImpl) LegacyDescribeTaskQueue(includeTaskQueu
 	return resp
 }
 
+func (pm *taskQueuePartitionManagerImpl) GetPhysicalQueue(ctx context.Context, buildId string) (physicalTaskQueueManager, error) {
+	if buildId == "" {
+		return pm.defaultQueue, nil
+	}
+	return pm.getVersionedQueue(ctx, "", buildId, true)
+}
+
 func (pm *taskQueuePartitionManagerImpl) Describe(
 	buildIds map[string]bool,
-	includeAllActive, reportBacklogInfo, reportPollers bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
+	includeAllActive, reportBacklogInfo, reportPollers, reportWorkers bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error) {
 	pm.versionedQueuesLock.RLock()
 	defer pm.versionedQueuesLock.RUnlock()
 
@@ -455,13 +570,21 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 			if reportPollers {
 				vInfo.PhysicalTaskQueueInfo.Pollers = physicalQueue.GetAllPollerInfo()
 			}
+			if reportBacklogInfo {
+				vInfo.PhysicalTaskQueueInfo.BacklogCountHint = physicalQueue.BacklogCountHint()
+				vInfo.PhysicalTaskQueueInfo.TasksAddRate = float32(physicalQueue.TasksAddRate())
+			}
 		}
 		versionsInfo[bid] = vInfo
 	}
 
-	return &matchingservice.DescribeTaskQueuePartitionResponse{
+	resp := &matchingservice.DescribeTaskQueuePartitionResponse{
 		VersionsInfoInternal: versionsInfo,
-	}, nil
+	}
+	if reportWorkers {
+		resp.Workers = pm.workerRegistry.list()
+	}
+	return resp, nil
 }
 
 func (pm *taskQueuePartitionManagerImpl) String() string {
@@ -476,6 +599,17 @@ func (pm *taskQueuePartitionManagerImpl) LongPollExpirationInterval() time.Durat
 	return pm.config.LongPollExpirationInterval()
 }
 
+func (pm *taskQueuePartitionManagerImpl) PartitionCounts() *persistencespb.TaskQueuePartitionCounts {
+	if pm.partition.Kind() == enumspb.TASK_QUEUE_KIND_STICKY || !pm.config.EnablePartitionAutoScale() {
+		return nil
+	}
+	userData, _, err := pm.userDataManager.GetUserData()
+	if err != nil {
+		return nil
+	}
+	return autoScaledPartitionCounts(userData.GetData(), pm.partition.TaskType())
+}
+
 func (pm *taskQueuePartitionManagerImpl) callerInfoContext(ctx context.Context) context.Context {
 	return headers.SetCallerInfo(ctx, headers.NewBackgroundCallerInfo(pm.ns.Name().String()))
 }
@@ -35,6 +35,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -54,9 +55,22 @@ type (
 		backlogMgr *backlogManagerImpl
 		gorogrp    goro.Group
 
+		// loadLock serializes registering loaded tasks in the ack manager with backlog task deletion, so that a task
+		// is either tombstoned before it is loaded or never tombstoned at all.
+		loadLock sync.Mutex
+
 		backoffTimerLock sync.Mutex
 		backoffTimer     *time.Timer
 		retrier          backoff.Retrier
+
+		// Tasks with a visibility time in the future are held in delayedTasks instead of taskBuffer until they are
+		// due. They stay outstanding in the ack manager meanwhile, so the ack level does not move past them even if
+		// tasks read after them are dispatched and completed first. Past MaxBufferedDelayedTasks, delayed tasks are
+		// parked and read again from persistence when due, so that they never hold up the tasks read after them.
+		timeSource     clock.TimeSource
+		delayedLock    sync.Mutex
+		delayedTasks   delayedTasks
+		delayedNotifyC chan struct{} // signaled when a delayed task is added or becomes due
 	}
 )
 
@@ -72,6 +86,8 @@ func newTaskReader(backlogMgr *backlogManagerImpl) *taskReader {
 			common.CreateReadTaskRetryPolicy(),
 			backoff.SystemClock,
 		),
+		timeSource:     clock.NewRealTimeSource(),
+		delayedNotifyC: make(chan struct{}, 1),
 	}
 }
 
@@ -87,6 +103,7 @@ func (tr *taskReader) Start() {
 	}
 
 	tr.gorogrp.Go(tr.dispatchBufferedTasks)
+	tr.gorogrp.Go(tr.dispatchDelayedTasks)
 	tr.gorogrp.Go(tr.getTasksPump)
 }
 
@@ -278,6 +295,16 @@ func (tr *taskReader) addTasksToBuffer(
 			tr.backlogMgr.taskAckManager.setReadLevel(t.GetTaskId())
 			continue
 		}
+		if !tr.registerTask(t) {
+			metrics.DeletedTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+			// Ack deleted tasks right away so that the ack level can move past them
+			tr.backlogMgr.completeTask(t, nil)
+			continue
+		}
+		if isTaskDelayed(t.GetData(), tr.timeSource.Now()) {
+			tr.addDelayedTask(t)
+			continue
+		}
 		if err := tr.addSingleTaskToBuffer(ctx, t); err != nil {
 			return err
 		}
@@ -285,11 +312,19 @@ func (tr *taskReader) addTasksToBuffer(
 	return nil
 }
 
+// registerTask adds a loaded task to the ack manager. It returns false if the task was deleted through the admin API,
+// in which case it must be completed without being dispatched.
+func (tr *taskReader) registerTask(task *persistencespb.AllocatedTaskInfo) bool {
+	tr.loadLock.Lock()
+	defer tr.loadLock.Unlock()
+	tr.backlogMgr.taskAckManager.addTask(task.GetTaskId())
+	return !tr.backlogMgr.db.IsTaskDeleted(task.GetTaskId())
+}
+
 func (tr *taskReader) addSingleTaskToBuffer(
 	ctx context.Context,
 	task *persistencespb.AllocatedTaskInfo,
 ) error {
-	tr.backlogMgr.taskAckManager.addTask(task.GetTaskId())
 	select {
 	case tr.taskBuffer <- task:
 		return nil
@@ -298,6 +333,95 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	}
 }
 
+// addDelayedTask holds the task until its visibility time. Once MaxBufferedDelayedTasks are held with their data, the
+// task is parked instead of blocking the reader.
+func (tr *taskReader) addDelayedTask(task *persistencespb.AllocatedTaskInfo) {
+	metrics.DelayedTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+
+	tr.delayedLock.Lock()
+	park := tr.delayedTasks.loadedLen() >= tr.backlogMgr.config.MaxBufferedDelayedTasks()
+	tr.delayedTasks.add(task, park)
+	tr.delayedLock.Unlock()
+	if park {
+		metrics.ParkedDelayedTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+	}
+	tr.signalDelayed()
+}
+
+// dispatchDelayedTasks moves delayed tasks to the dispatch buffer once their visibility time is reached.
+func (tr *taskReader) dispatchDelayedTasks(ctx context.Context) error {
+	for ctx.Err() == nil {
+		now := tr.timeSource.Now()
+		tr.delayedLock.Lock()
+		entry := tr.delayedTasks.popDue(now)
+		next, hasNext := tr.delayedTasks.nextVisibilityTime()
+		tr.delayedLock.Unlock()
+
+		if entry != nil {
+			task := entry.task
+			if task == nil {
+				var err error
+				if task, err = tr.loadParkedTask(ctx, entry.taskID); err != nil {
+					tr.delayedLock.Lock()
+					tr.delayedTasks.addParked(entry.taskID, entry.visibilityTime)
+					tr.delayedLock.Unlock()
+					tr.throttledLogger().Error("taskReader: failed to load delayed task", tag.TaskID(entry.taskID), tag.Error(err))
+					common.InterruptibleSleep(ctx, taskReaderThrottleRetryDelay)
+					continue
+				}
+				if task == nil {
+					// the task is not in persistence anymore, there is nothing to dispatch
+					tr.backlogMgr.completeTask(&persistencespb.AllocatedTaskInfo{TaskId: entry.taskID}, nil)
+					continue
+				}
+			}
+			if IsTaskExpired(task) {
+				metrics.ExpiredTasksPerTaskQueueCounter.With(tr.taggedMetricsHandler()).Record(1)
+				tr.backlogMgr.completeTask(task, nil)
+				continue
+			}
+			select {
+			case tr.taskBuffer <- task:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+
+		var timer clock.Timer
+		if hasNext {
+			timer = tr.timeSource.AfterFunc(next.Sub(now), tr.signalDelayed)
+		}
+		select {
+		case <-tr.delayedNotifyC:
+		case <-ctx.Done():
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+	return ctx.Err()
+}
+
+// loadParkedTask reads a parked delayed task from persistence. It returns nil if the task does not exist anymore.
+func (tr *taskReader) loadParkedTask(ctx context.Context, taskID int64) (*persistencespb.AllocatedTaskInfo, error) {
+	resp, err := tr.backlogMgr.db.GetTasks(ctx, taskID, taskID+1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Tasks) == 0 {
+		return nil, nil
+	}
+	return resp.Tasks[0], nil
+}
+
+func (tr *taskReader) signalDelayed() {
+	select {
+	case tr.delayedNotifyC <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
 func (tr *taskReader) persistAckLevel(ctx context.Context) error {
 	ackLevel := tr.backlogMgr.taskAckManager.getAckLevel()
 	tr.emitTaskLagMetric(ackLevel)
@@ -0,0 +1,187 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// workerRegistry keeps the latest known state of the workers of a task queue partition. Workers are added by
+	// explicit heartbeats and by polls, and are dropped when neither has been seen for the registry TTL.
+	// Polls, and heartbeats attached to them, are recorded by the partition that received the poll from the
+	// frontend, while explicit admin heartbeats always go to the root partition. Readers merge all partitions.
+	workerRegistry struct {
+		taskType       enumspb.TaskQueueType
+		maxSize        int
+		timeSource     clock.TimeSource
+		metricsHandler metrics.Handler
+
+		// lock makes read-modify-write of an entry atomic, the cache itself is thread safe
+		lock sync.Mutex
+		// worker identity -> *workerEntry
+		workers cache.Cache
+	}
+
+	workerEntry struct {
+		heartbeat     *taskqueuespb.WorkerHeartbeat
+		firstSeen     time.Time
+		lastHeartbeat time.Time
+	}
+)
+
+func newWorkerRegistry(
+	taskType enumspb.TaskQueueType,
+	ttl time.Duration,
+	maxSize int,
+	timeSource clock.TimeSource,
+	metricsHandler metrics.Handler,
+) *workerRegistry {
+	opts := &cache.Options{
+		TTL:        ttl,
+		Pin:        false,
+		TimeSource: timeSource,
+	}
+	return &workerRegistry{
+		taskType:       taskType,
+		maxSize:        maxSize,
+		timeSource:     timeSource,
+		metricsHandler: metricsHandler,
+		workers:        cache.New(maxSize, opts, metrics.NoopMetricsHandler),
+	}
+}
+
+// recordPoll registers a poll as an implicit heartbeat. Values reported by explicit heartbeats are kept, only the ones
+// known from the poll request are refreshed.
+func (r *workerRegistry) recordPoll(identity, sdkName, sdkVersion, buildId string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var heartbeat *taskqueuespb.WorkerHeartbeat
+	if prev := r.get(identity); prev != nil {
+		heartbeat = proto.Clone(prev.heartbeat).(*taskqueuespb.WorkerHeartbeat)
+	} else {
+		heartbeat = &taskqueuespb.WorkerHeartbeat{Identity: identity}
+	}
+	if sdkName != "" {
+		heartbeat.SdkName = sdkName
+	}
+	if sdkVersion != "" {
+		heartbeat.SdkVersion = sdkVersion
+	}
+	if buildId != "" {
+		heartbeat.BuildId = buildId
+	}
+	r.put(heartbeat)
+}
+
+// recordHeartbeat replaces the state of the worker with the given heartbeat. Fields that the heartbeat leaves empty
+// but are already known, e.g. from polls, are kept.
+func (r *workerRegistry) recordHeartbeat(heartbeat *taskqueuespb.WorkerHeartbeat) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	heartbeat = proto.Clone(heartbeat).(*taskqueuespb.WorkerHeartbeat)
+	if prev := r.get(heartbeat.GetIdentity()); prev != nil {
+		if heartbeat.SdkName == "" {
+			heartbeat.SdkName = prev.heartbeat.GetSdkName()
+		}
+		if heartbeat.SdkVersion == "" {
+			heartbeat.SdkVersion = prev.heartbeat.GetSdkVersion()
+		}
+		if heartbeat.BuildId == "" {
+			heartbeat.BuildId = prev.heartbeat.GetBuildId()
+		}
+		if heartbeat.HostName == "" {
+			heartbeat.HostName = prev.heartbeat.GetHostName()
+		}
+	}
+	r.put(heartbeat)
+}
+
+// list returns all workers that heartbeat or polled within the registry TTL.
+func (r *workerRegistry) list() []*taskqueuespb.WorkerInfo {
+	var result []*taskqueuespb.WorkerInfo
+
+	ite := r.workers.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next().Value().(*workerEntry)
+		result = append(result, &taskqueuespb.WorkerInfo{
+			Heartbeat:         entry.heartbeat,
+			FirstSeenTime:     timestamppb.New(entry.firstSeen),
+			LastHeartbeatTime: timestamppb.New(entry.lastHeartbeat),
+			TaskQueueTypes:    []enumspb.TaskQueueType{r.taskType},
+		})
+	}
+	return result
+}
+
+func (r *workerRegistry) get(identity string) *workerEntry {
+	if value := r.workers.Get(identity); value != nil {
+		return value.(*workerEntry)
+	}
+	return nil
+}
+
+// put must be called with the lock held. The stored heartbeat is never mutated afterward so it can be handed out by
+// list without copying.
+func (r *workerRegistry) put(heartbeat *taskqueuespb.WorkerHeartbeat) {
+	now := r.timeSource.Now()
+	if heartbeat.HostName == "" {
+		heartbeat.HostName = hostNameFromIdentity(heartbeat.GetIdentity())
+	}
+	entry := &workerEntry{
+		heartbeat:     heartbeat,
+		firstSeen:     now,
+		lastHeartbeat: now,
+	}
+	if prev := r.get(heartbeat.GetIdentity()); prev != nil {
+		entry.firstSeen = prev.firstSeen
+	} else if r.workers.Size() >= r.maxSize {
+		// the cache drops the least recently seen worker to make room for the new one
+		metrics.WorkerRegistryEvictionsPerTaskQueue.With(r.metricsHandler).Record(1)
+	}
+	r.workers.Put(heartbeat.GetIdentity(), entry)
+}
+
+// hostNameFromIdentity extracts the host from identities in the "pid@host" format used by default by the SDKs.
+func hostNameFromIdentity(identity string) string {
+	if i := strings.LastIndex(identity, "@"); i >= 0 {
+		return identity[i+1:]
+	}
+	return ""
+}
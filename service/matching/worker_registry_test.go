@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func TestWorkerRegistry(t *testing.T) {
+	start := time.Unix(1000, 0)
+	timeSource := clock.NewEventTimeSource().Update(start)
+	registry := newWorkerRegistry(enumspb.TASK_QUEUE_TYPE_ACTIVITY, time.Minute, 10, timeSource, metrics.NoopMetricsHandler)
+	require.Empty(t, registry.list())
+
+	// a poll registers the worker with what is known from the poll request
+	registry.recordPoll("123@host-a", "temporal-go", "1.26.0", "build-1")
+	workers := registry.list()
+	require.Len(t, workers, 1)
+	require.Equal(t, "host-a", workers[0].GetHeartbeat().GetHostName())
+	require.Equal(t, "build-1", workers[0].GetHeartbeat().GetBuildId())
+	require.Equal(t, []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY}, workers[0].GetTaskQueueTypes())
+
+	// an explicit heartbeat replaces the state but keeps the known values it does not report
+	timeSource.Advance(30 * time.Second)
+	registry.recordHeartbeat(&taskqueuespb.WorkerHeartbeat{
+		Identity:       "123@host-a",
+		UsedSlots:      2,
+		AvailableSlots: 8,
+		Metadata:       map[string]string{"region": "us"},
+	})
+	workers = registry.list()
+	require.Len(t, workers, 1)
+	hb := workers[0].GetHeartbeat()
+	require.Equal(t, "temporal-go", hb.GetSdkName())
+	require.Equal(t, "1.26.0", hb.GetSdkVersion())
+	require.Equal(t, "build-1", hb.GetBuildId())
+	require.Equal(t, int32(2), hb.GetUsedSlots())
+	require.Equal(t, "us", hb.GetMetadata()["region"])
+	require.Equal(t, start.UTC(), workers[0].GetFirstSeenTime().AsTime())
+	require.Equal(t, start.Add(30*time.Second).UTC(), workers[0].GetLastHeartbeatTime().AsTime())
+
+	// a later poll keeps the heartbeat values
+	registry.recordPoll("123@host-a", "", "", "build-2")
+	hb = registry.list()[0].GetHeartbeat()
+	require.Equal(t, "build-2", hb.GetBuildId())
+	require.Equal(t, int32(8), hb.GetAvailableSlots())
+
+	// workers expire after the TTL
+	timeSource.Advance(time.Minute + time.Second)
+	require.Empty(t, registry.list())
+}
+
+func TestWorkerRegistry_MaxSize(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Unix(1000, 0))
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	registry := newWorkerRegistry(enumspb.TASK_QUEUE_TYPE_ACTIVITY, time.Minute, 2, timeSource, metricsHandler)
+
+	registry.recordPoll("worker-1", "", "", "")
+	timeSource.Advance(time.Second)
+	registry.recordPoll("worker-2", "", "", "")
+	// refreshing a known worker at capacity does not evict anything
+	timeSource.Advance(time.Second)
+	registry.recordPoll("worker-1", "", "", "")
+	require.Empty(t, capture.Snapshot()[metrics.WorkerRegistryEvictionsPerTaskQueue.Name()])
+
+	// a new worker drops the least recently seen one
+	registry.recordPoll("worker-3", "", "", "")
+	var identities []string
+	for _, worker := range registry.list() {
+		identities = append(identities, worker.GetHeartbeat().GetIdentity())
+	}
+	require.ElementsMatch(t, []string{"worker-1", "worker-3"}, identities)
+	require.Len(t, capture.Snapshot()[metrics.WorkerRegistryEvictionsPerTaskQueue.Name()], 1)
+}
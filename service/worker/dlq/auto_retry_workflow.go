@@ -0,0 +1,661 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// AutoRetryWorkflowParams is the single argument to the DLQ auto-retry workflow. It is also used to carry the state
+	// of the workflow over to the next run when it continues as new, so it should be empty when starting the workflow.
+	AutoRetryWorkflowParams struct {
+		// Queues contains the progress of the workflow for each DLQ.
+		Queues []AutoRetryQueueState
+		// Tasks contains the retry state of the tasks which are currently being retried, keyed by task identity.
+		Tasks map[string]AutoRetryTaskState
+		// Namespaces contains the summary counts of each namespace, keyed by namespace name.
+		Namespaces map[string]AutoRetryNamespaceSummary
+	}
+
+	// AutoRetryQueueState is the progress of the auto-retry workflow for a single DLQ.
+	AutoRetryQueueState struct {
+		Key
+		// LastProcessedMessageID is the ID up to which all messages were either re-enqueued or retained. Messages up to
+		// this ID are never looked at again.
+		LastProcessedMessageID int64
+		// ProcessedMessageIDs contains the sorted IDs of the messages after LastProcessedMessageID which were processed
+		// while a message before them was waiting to be retried.
+		ProcessedMessageIDs []int64
+		// LastDeletedMessageID is the ID of the last message which was deleted from the DLQ by the workflow.
+		LastDeletedMessageID int64
+		// RetainedMessageIDs contains the sorted IDs of the processed messages which were left in the DLQ, either
+		// because they failed permanently or because auto-retry is disabled for their namespace. Deletion is done by
+		// range, so processed messages after the first retained message are only deleted once the retained messages
+		// before them are removed from the DLQ, e.g. by an operator. They are never retried again in the meantime.
+		RetainedMessageIDs []int64
+	}
+
+	// AutoRetryTaskState is the retry state of a single task. A task gets a new message ID every time it is written to
+	// the DLQ and a new task ID every time it is re-enqueued, so it is identified by its category, workflow execution and
+	// type instead.
+	AutoRetryTaskState struct {
+		Namespace string
+		// Attempts is the number of times the task was re-enqueued.
+		Attempts int
+		// FirstFailureTime is the time at which the task was first written to the DLQ.
+		FirstFailureTime time.Time
+		// RetryDeadline is the time after which the task is marked as permanently failed.
+		RetryDeadline time.Time
+		// PermanentlyFailed is true once the task was marked as permanently failed. The state is kept until it's
+		// pruned, so that the task isn't retried from scratch if it shows up in the DLQ again.
+		PermanentlyFailed bool
+	}
+
+	// AutoRetryNamespaceSummary contains the counts of DLQ tasks handled by the auto-retry workflow for a namespace.
+	AutoRetryNamespaceSummary struct {
+		// Retried is the number of times tasks were re-enqueued.
+		Retried int64
+		// PermanentlyFailed is the number of tasks which failed with a terminal error or exceeded the retry horizon, and
+		// were left in the DLQ.
+		PermanentlyFailed int64
+		// Skipped is the number of tasks which were left in the DLQ because auto-retry is disabled for the namespace.
+		Skipped int64
+		// Pending is the number of tasks which are still being retried. It is only set in query responses.
+		Pending int64
+	}
+
+	// AutoRetrySummaryQueryResponse is the response to the QueryTypeAutoRetrySummary query.
+	AutoRetrySummaryQueryResponse struct {
+		// Namespaces is keyed by namespace name.
+		Namespaces map[string]AutoRetryNamespaceSummary
+	}
+
+	autoRetryPolicy struct {
+		Enabled               bool
+		InitialInterval       time.Duration
+		BackoffCoefficient    float64
+		MaxInterval           time.Duration
+		RetryHorizon          time.Duration
+		RetryTerminalFailures bool
+	}
+
+	autoRetryTask struct {
+		MessageID   int64
+		Payload     *commonspb.HistoryTask
+		Identity    string
+		Namespace   string
+		FailureType enumsspb.DLQTaskFailureType
+		// FailureTime is zero if the task was written to the DLQ without a failure record.
+		FailureTime time.Time
+		Policy      autoRetryPolicy
+	}
+
+	autoRetryQueuesResponse struct {
+		Enabled      bool
+		PollInterval time.Duration
+		Keys         []Key
+	}
+
+	autoRetryReadTasksResponse struct {
+		Tasks         []autoRetryTask
+		NextPageToken []byte
+	}
+
+	autoRetryOutcome int
+
+	autoRetryDecision struct {
+		task    autoRetryTask
+		outcome autoRetryOutcome
+		state   AutoRetryTaskState
+	}
+
+	autoRetryConfig struct {
+		enabled               dynamicconfig.BoolPropertyFn
+		pollInterval          dynamicconfig.DurationPropertyFn
+		namespaceEnabled      dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		initialInterval       dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		backoffCoefficient    dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		maxInterval           dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		retryHorizon          dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		retryTerminalFailures dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	}
+)
+
+const (
+	// AutoRetryWorkflowName is the name of the DLQ auto-retry workflow.
+	AutoRetryWorkflowName = "temporal-sys-dlq-auto-retry-workflow"
+	// AutoRetryWorkflowID is the ID of the single DLQ auto-retry workflow running in each cluster.
+	AutoRetryWorkflowID = "temporal-sys-dlq-auto-retry"
+	// QueryTypeAutoRetrySummary is the query to get the per-namespace summary counts of the DLQ auto-retry workflow.
+	QueryTypeAutoRetrySummary = "dlq-auto-retry-summary-query"
+
+	autoRetryQueuesActivityName    = "dlq-auto-retry-queues-activity"
+	autoRetryReadTasksActivityName = "dlq-auto-retry-read-tasks-activity"
+
+	// autoRetryIterationsPerRun is the number of scans of all DLQs after which the workflow continues as new, to keep
+	// its history small.
+	autoRetryIterationsPerRun = 100
+	// autoRetryMinSleep prevents the workflow from busy looping when a task is due to be retried right away.
+	autoRetryMinSleep = time.Second
+	// autoRetryTaskStateRetention is how long the state of a task is kept after its retry deadline, so that a task
+	// which fails again shortly after its deadline is marked as permanently failed instead of being retried from
+	// scratch.
+	autoRetryTaskStateRetention = 24 * time.Hour
+	autoRetryStartTimeout       = 10 * time.Second
+)
+
+const (
+	autoRetryOutcomeRetry autoRetryOutcome = iota
+	autoRetryOutcomeWait
+	autoRetryOutcomePermanentlyFailed
+	autoRetryOutcomeSkipped
+)
+
+func newAutoRetryConfig(dc *dynamicconfig.Collection) autoRetryConfig {
+	return autoRetryConfig{
+		enabled: dc.GetBoolProperty(dynamicconfig.WorkerEnableDLQAutoRetry, false),
+		pollInterval: dc.GetDurationProperty(
+			dynamicconfig.WorkerDLQAutoRetryPollInterval, time.Minute,
+		),
+		namespaceEnabled: dc.GetBoolPropertyFnWithNamespaceFilter(
+			dynamicconfig.WorkerDLQAutoRetryNamespaceEnabled, true,
+		),
+		initialInterval: dc.GetDurationPropertyFilteredByNamespace(
+			dynamicconfig.WorkerDLQAutoRetryInitialInterval, time.Minute,
+		),
+		backoffCoefficient: dc.GetFloatPropertyFilteredByNamespace(
+			dynamicconfig.WorkerDLQAutoRetryBackoffCoefficient, 2.0,
+		),
+		maxInterval: dc.GetDurationPropertyFilteredByNamespace(
+			dynamicconfig.WorkerDLQAutoRetryMaxInterval, time.Hour,
+		),
+		retryHorizon: dc.GetDurationPropertyFilteredByNamespace(
+			dynamicconfig.WorkerDLQAutoRetryHorizon, 24*time.Hour,
+		),
+		retryTerminalFailures: dc.GetBoolPropertyFnWithNamespaceFilter(
+			dynamicconfig.WorkerDLQAutoRetryTerminalFailures, false,
+		),
+	}
+}
+
+// StartAutoRetryWorkflow starts the DLQ auto-retry workflow unless it is already running. The workflow completes by
+// itself once auto-retry is disabled.
+func StartAutoRetryWorkflow(ctx context.Context, client sdkclient.Client) error {
+	ctx, cancel := context.WithTimeout(ctx, autoRetryStartTimeout)
+	defer cancel()
+	_, err := client.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:                    AutoRetryWorkflowID,
+		TaskQueue:             primitives.DefaultWorkerTaskQueue,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}, AutoRetryWorkflowName, AutoRetryWorkflowParams{})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	return err
+}
+
+// autoRetryWorkflow periodically scans the history task DLQs of the current cluster and re-enqueues their tasks with
+// exponential backoff, according to the retry policy of the namespace of each task. The tasks of a namespace are
+// processed in message order, so a task may have to wait for the tasks of its namespace before it, but not for the
+// tasks of other namespaces. Tasks which aren't retried are left in the DLQ for operators to inspect.
+func (c *workerComponent) autoRetryWorkflow(ctx workflow.Context, params AutoRetryWorkflowParams) error {
+	if params.Tasks == nil {
+		params.Tasks = make(map[string]AutoRetryTaskState)
+	}
+	if params.Namespaces == nil {
+		params.Namespaces = make(map[string]AutoRetryNamespaceSummary)
+	}
+	err := workflow.SetQueryHandler(ctx, QueryTypeAutoRetrySummary, func() (AutoRetrySummaryQueryResponse, error) {
+		return params.summary(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: mergeTasksActivityTimeout,
+		TaskQueue:           primitives.DLQActivityTQ,
+		RetryPolicy:         mergeActivityRetryPolicy,
+	})
+	logger := workflow.GetLogger(ctx)
+	for i := 0; i < autoRetryIterationsPerRun; i++ {
+		var response autoRetryQueuesResponse
+		err := workflow.ExecuteActivity(ctx, autoRetryQueuesActivityName).Get(ctx, &response)
+		if err != nil {
+			return err
+		}
+		if !response.Enabled {
+			return nil
+		}
+
+		wakeUpTime := workflow.Now(ctx).Add(response.PollInterval)
+		for _, key := range response.Keys {
+			dueTime, err := c.autoRetryQueue(ctx, &params, key)
+			if err != nil {
+				// Don't let a single DLQ block the others. Its tasks will be looked at again in the next iteration.
+				logger.Warn("Failed to auto-retry DLQ tasks", "TaskCategoryID", key.TaskCategoryID, "Error", err)
+				continue
+			}
+			if !dueTime.IsZero() && dueTime.Before(wakeUpTime) {
+				wakeUpTime = dueTime
+			}
+		}
+
+		now := workflow.Now(ctx)
+		params.pruneTasks(now)
+		if err := workflow.Sleep(ctx, max(wakeUpTime.Sub(now), autoRetryMinSleep)); err != nil {
+			return err
+		}
+	}
+
+	return workflow.NewContinueAsNewError(ctx, AutoRetryWorkflowName, params)
+}
+
+// autoRetryQueue processes the new messages of a single DLQ. If some messages aren't due to be retried yet, it returns
+// the earliest time at which one of them is due. Otherwise, it returns the zero time.
+func (c *workerComponent) autoRetryQueue(
+	ctx workflow.Context,
+	params *AutoRetryWorkflowParams,
+	key Key,
+) (time.Time, error) {
+	queue := params.queue(key)
+	var (
+		nextPageToken []byte
+		dueTime       time.Time
+		// waitingNamespaces contains the namespaces which have a message waiting to be retried. Their later messages
+		// are not processed before it.
+		waitingNamespaces = make(map[string]struct{})
+		// firstWaitingMessageID is the ID of the first message waiting to be retried. It is only valid if waiting is
+		// true.
+		waiting               bool
+		firstWaitingMessageID int64
+		// retainedMessageIDs contains the retained messages which are still in the DLQ.
+		retainedMessageIDs []int64
+	)
+	for {
+		var response autoRetryReadTasksResponse
+		err := workflow.ExecuteActivity(ctx, autoRetryReadTasksActivityName, key, nextPageToken).Get(ctx, &response)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		now := workflow.Now(ctx)
+		var decisions []autoRetryDecision
+		for _, task := range response.Tasks {
+			if queue.isRetained(task.MessageID) {
+				retainedMessageIDs = append(retainedMessageIDs, task.MessageID)
+			}
+			if queue.isProcessed(task.MessageID) {
+				continue
+			}
+			if _, ok := waitingNamespaces[task.Namespace]; ok {
+				continue
+			}
+			decision, taskDueTime := params.decide(task, now)
+			if decision.outcome == autoRetryOutcomeWait {
+				waitingNamespaces[task.Namespace] = struct{}{}
+				if !waiting {
+					waiting = true
+					firstWaitingMessageID = task.MessageID
+				}
+				if dueTime.IsZero() || taskDueTime.Before(dueTime) {
+					dueTime = taskDueTime
+				}
+				continue
+			}
+			decisions = append(decisions, decision)
+		}
+
+		var historyTasks []*commonspb.HistoryTask
+		for _, decision := range decisions {
+			if decision.outcome == autoRetryOutcomeRetry {
+				historyTasks = append(historyTasks, decision.task.Payload)
+			}
+		}
+		if len(historyTasks) > 0 {
+			err = workflow.ExecuteActivity(ctx, reEnqueueTasksActivityName, MergeParams{Key: key}, historyTasks).Get(ctx, nil)
+			if err != nil {
+				return time.Time{}, err
+			}
+		}
+		for _, decision := range decisions {
+			params.apply(queue, decision)
+			if decision.outcome != autoRetryOutcomeRetry {
+				retainedMessageIDs = append(retainedMessageIDs, decision.task.MessageID)
+			}
+		}
+		if waiting {
+			// messages after the first waiting one are tracked by ID until it is processed
+			queue.advance(firstWaitingMessageID - 1)
+		} else if len(response.Tasks) > 0 {
+			queue.advance(response.Tasks[len(response.Tasks)-1].MessageID)
+		}
+
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		nextPageToken = response.NextPageToken
+	}
+
+	// The whole DLQ was read, so the retained messages which weren't seen were removed from it, e.g. by an operator.
+	queue.RetainedMessageIDs = retainedMessageIDs
+
+	maxDeletableMessageID := queue.LastProcessedMessageID
+	if len(queue.RetainedMessageIDs) > 0 {
+		maxDeletableMessageID = min(maxDeletableMessageID, queue.RetainedMessageIDs[0]-1)
+	}
+	if maxDeletableMessageID > queue.LastDeletedMessageID {
+		err := workflow.ExecuteActivity(
+			workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+				TaskQueue:           primitives.DLQActivityTQ,
+				RetryPolicy:         deleteActivityRetryPolicy,
+				StartToCloseTimeout: deleteTasksActivityTimeout,
+			}),
+			deleteTasksActivityName,
+			DeleteParams{
+				Key:          key,
+				MaxMessageID: maxDeletableMessageID,
+			},
+		).Get(ctx, nil)
+		if err != nil {
+			return time.Time{}, err
+		}
+		queue.LastDeletedMessageID = maxDeletableMessageID
+	}
+	return dueTime, nil
+}
+
+// queue returns the state of the given DLQ, adding it if this is the first time the DLQ is processed.
+func (p *AutoRetryWorkflowParams) queue(key Key) *AutoRetryQueueState {
+	for i := range p.Queues {
+		if p.Queues[i].Key == key {
+			return &p.Queues[i]
+		}
+	}
+	p.Queues = append(p.Queues, AutoRetryQueueState{
+		Key:                    key,
+		LastProcessedMessageID: persistence.FirstQueueMessageID - 1,
+		LastDeletedMessageID:   persistence.FirstQueueMessageID - 1,
+	})
+	return &p.Queues[len(p.Queues)-1]
+}
+
+// decide returns what to do with a task without modifying any state. If the outcome is autoRetryOutcomeWait, it also
+// returns the time at which the task is due to be retried.
+func (p *AutoRetryWorkflowParams) decide(task autoRetryTask, now time.Time) (autoRetryDecision, time.Time) {
+	decision := autoRetryDecision{task: task}
+	policy := task.Policy
+	if !policy.Enabled {
+		decision.outcome = autoRetryOutcomeSkipped
+		return decision, time.Time{}
+	}
+	if task.FailureType == enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL && !policy.RetryTerminalFailures {
+		decision.outcome = autoRetryOutcomePermanentlyFailed
+		return decision, time.Time{}
+	}
+
+	failureTime := task.FailureTime
+	if failureTime.IsZero() {
+		failureTime = now
+	}
+	state, ok := p.Tasks[task.Identity]
+	if !ok {
+		state = AutoRetryTaskState{
+			Namespace:        task.Namespace,
+			FirstFailureTime: failureTime,
+			RetryDeadline:    failureTime.Add(policy.RetryHorizon),
+		}
+	}
+	decision.state = state
+
+	// Tasks written to the DLQ without a failure record are retried right away.
+	dueTime := failureTime
+	if !task.FailureTime.IsZero() {
+		dueTime = dueTime.Add(policy.backoff(state.Attempts))
+	}
+	if state.PermanentlyFailed || dueTime.After(state.RetryDeadline) {
+		decision.outcome = autoRetryOutcomePermanentlyFailed
+		return decision, time.Time{}
+	}
+	if dueTime.After(now) {
+		decision.outcome = autoRetryOutcomeWait
+		return decision, dueTime
+	}
+	decision.outcome = autoRetryOutcomeRetry
+	return decision, time.Time{}
+}
+
+// apply records the outcome of a task which was processed.
+func (p *AutoRetryWorkflowParams) apply(queue *AutoRetryQueueState, decision autoRetryDecision) {
+	task := decision.task
+	summary := p.Namespaces[task.Namespace]
+	switch decision.outcome {
+	case autoRetryOutcomeRetry:
+		decision.state.Attempts++
+		p.Tasks[task.Identity] = decision.state
+		summary.Retried++
+	case autoRetryOutcomePermanentlyFailed:
+		if _, ok := p.Tasks[task.Identity]; ok {
+			decision.state.PermanentlyFailed = true
+			p.Tasks[task.Identity] = decision.state
+		}
+		summary.PermanentlyFailed++
+	case autoRetryOutcomeSkipped:
+		summary.Skipped++
+	}
+	p.Namespaces[task.Namespace] = summary
+
+	if decision.outcome != autoRetryOutcomeRetry {
+		queue.RetainedMessageIDs = insertSorted(queue.RetainedMessageIDs, task.MessageID)
+	}
+	queue.ProcessedMessageIDs = insertSorted(queue.ProcessedMessageIDs, task.MessageID)
+}
+
+// isProcessed returns true if the message was already re-enqueued or retained.
+func (q *AutoRetryQueueState) isProcessed(messageID int64) bool {
+	if messageID <= q.LastProcessedMessageID {
+		return true
+	}
+	_, found := slices.BinarySearch(q.ProcessedMessageIDs, messageID)
+	return found
+}
+
+func (q *AutoRetryQueueState) isRetained(messageID int64) bool {
+	_, found := slices.BinarySearch(q.RetainedMessageIDs, messageID)
+	return found
+}
+
+// advance records that all the messages up to the given ID were processed.
+func (q *AutoRetryQueueState) advance(messageID int64) {
+	if messageID <= q.LastProcessedMessageID {
+		return
+	}
+	q.LastProcessedMessageID = messageID
+	i, _ := slices.BinarySearch(q.ProcessedMessageIDs, messageID+1)
+	q.ProcessedMessageIDs = slices.Clone(q.ProcessedMessageIDs[i:])
+}
+
+func insertSorted(ids []int64, id int64) []int64 {
+	i, found := slices.BinarySearch(ids, id)
+	if found {
+		return ids
+	}
+	return slices.Insert(ids, i, id)
+}
+
+// pruneTasks removes the state of tasks which are past their retry deadline for long enough.
+func (p *AutoRetryWorkflowParams) pruneTasks(now time.Time) {
+	for identity, state := range p.Tasks {
+		if now.After(state.RetryDeadline.Add(autoRetryTaskStateRetention)) {
+			delete(p.Tasks, identity)
+		}
+	}
+}
+
+func (p *AutoRetryWorkflowParams) summary() AutoRetrySummaryQueryResponse {
+	namespaces := make(map[string]AutoRetryNamespaceSummary, len(p.Namespaces))
+	for ns, summary := range p.Namespaces {
+		namespaces[ns] = summary
+	}
+	for _, state := range p.Tasks {
+		if state.PermanentlyFailed {
+			continue
+		}
+		summary := namespaces[state.Namespace]
+		summary.Pending++
+		namespaces[state.Namespace] = summary
+	}
+	return AutoRetrySummaryQueryResponse{Namespaces: namespaces}
+}
+
+// backoff returns how long to wait after the last failure of a task which was already retried the given number of
+// times.
+func (p autoRetryPolicy) backoff(attempts int) time.Duration {
+	interval := float64(p.InitialInterval) * math.Pow(max(p.BackoffCoefficient, 1), float64(attempts))
+	if interval > float64(p.MaxInterval) {
+		return p.MaxInterval
+	}
+	return time.Duration(interval)
+}
+
+// autoRetryQueues returns the DLQs which the auto-retry workflow should process. Replication tasks are excluded
+// because they have their own DLQ handling, and memory timer tasks are never written to a DLQ.
+func (c *workerComponent) autoRetryQueues(context.Context) (*autoRetryQueuesResponse, error) {
+	response := &autoRetryQueuesResponse{
+		Enabled:      c.autoRetryConfig.enabled(),
+		PollInterval: c.autoRetryConfig.pollInterval(),
+	}
+	for id := range c.taskCategoryRegistry.GetCategories() {
+		if id == tasks.CategoryIDReplication || id == tasks.CategoryIDMemoryTimer {
+			continue
+		}
+		response.Keys = append(response.Keys, Key{
+			TaskCategoryID: id,
+			SourceCluster:  c.currentClusterName,
+			TargetCluster:  c.currentClusterName,
+		})
+	}
+	sort.Slice(response.Keys, func(i, j int) bool {
+		return response.Keys[i].TaskCategoryID < response.Keys[j].TaskCategoryID
+	})
+	return response, nil
+}
+
+// readAutoRetryTasks reads a page of tasks from a DLQ and resolves the namespace and retry policy of each of them.
+// Tasks which can't be deserialized, or whose namespace no longer exists, are returned with a disabled policy so that
+// they are left in the DLQ.
+func (c *workerComponent) readAutoRetryTasks(
+	ctx context.Context,
+	key Key,
+	nextPageToken []byte,
+) (*autoRetryReadTasksResponse, error) {
+	category, ok := c.taskCategoryRegistry.GetCategoryByID(key.TaskCategoryID)
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("Unknown task category: %d", key.TaskCategoryID),
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+	resp, err := c.readTasks(ctx, MergeParams{Key: key, BatchSize: DefaultMergeBatchSize}, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &autoRetryReadTasksResponse{
+		Tasks:         make([]autoRetryTask, 0, len(resp.DlqTasks)),
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, dlqTask := range resp.DlqTasks {
+		task := autoRetryTask{
+			MessageID:   dlqTask.GetMetadata().GetMessageId(),
+			Payload:     dlqTask.GetPayload(),
+			FailureType: dlqTask.GetFailure().GetType(),
+		}
+		if dlqTask.GetFailure().GetTime() != nil {
+			task.FailureTime = dlqTask.GetFailure().GetTime().AsTime()
+		}
+		historyTask, err := c.taskSerializer.DeserializeTask(category, dlqTask.GetPayload().GetBlob())
+		if err != nil {
+			response.Tasks = append(response.Tasks, task)
+			continue
+		}
+		task.Identity = fmt.Sprintf(
+			"%d/%s/%s/%s/%s",
+			key.TaskCategoryID,
+			historyTask.GetNamespaceID(),
+			historyTask.GetWorkflowID(),
+			historyTask.GetRunID(),
+			historyTask.GetType(),
+		)
+		task.Namespace = historyTask.GetNamespaceID()
+		ns, err := c.namespaceRegistry.GetNamespaceByID(namespace.ID(historyTask.GetNamespaceID()))
+		if err != nil {
+			var notFound *serviceerror.NamespaceNotFound
+			if !errors.As(err, &notFound) {
+				return nil, err
+			}
+			response.Tasks = append(response.Tasks, task)
+			continue
+		}
+		task.Namespace = ns.Name().String()
+		task.Policy = c.autoRetryPolicy(task.Namespace)
+		response.Tasks = append(response.Tasks, task)
+	}
+	return response, nil
+}
+
+func (c *workerComponent) autoRetryPolicy(ns string) autoRetryPolicy {
+	return autoRetryPolicy{
+		Enabled:               c.autoRetryConfig.namespaceEnabled(ns),
+		InitialInterval:       c.autoRetryConfig.initialInterval(ns),
+		BackoffCoefficient:    c.autoRetryConfig.backoffCoefficient(ns),
+		MaxInterval:           c.autoRetryConfig.maxInterval(ns),
+		RetryHorizon:          c.autoRetryConfig.retryHorizon(ns),
+		RetryTerminalFailures: c.autoRetryConfig.retryTerminalFailures(ns),
+	}
+}
@@ -0,0 +1,334 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dlq_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	commonspb "go.temporal.io/server/api/common/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/service/history/tasks"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.temporal.io/server/service/worker/dlq"
+)
+
+type (
+	autoRetryTestCase struct {
+		name          string
+		dynamicConfig dynamicconfig.StaticClient
+		// namespaces contains the namespace of each task initially in the DLQ. It defaults to autoRetryTestNamespace.
+		namespaces  []string
+		failureType enumsspb.DLQTaskFailureType
+		expectation func(t *testing.T, err error, dlq *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse)
+	}
+	// fakeDLQ is a single transfer task DLQ. Re-enqueued tasks fail again and are written back to the DLQ with the same
+	// failure time, so that the backoff of the auto-retry workflow is what determines when they are retried.
+	fakeDLQ struct {
+		failure        *commonspb.HistoryDLQTaskFailure
+		messages       []*commonspb.HistoryDLQTask
+		nextMessageID  int64
+		addRequests    []*adminservice.AddTasksRequest
+		deleteRequests []*historyservice.DeleteDLQTasksRequest
+	}
+)
+
+const (
+	autoRetryTestNamespace     = "test-namespace"
+	autoRetryTestSlowNamespace = "test-slow-namespace"
+)
+
+var autoRetryTestStartTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestAutoRetryWorkflow(t *testing.T) {
+	for _, tc := range []autoRetryTestCase{
+		{
+			name: "disabled",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry: false,
+			},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				require.NoError(t, err)
+				assert.Empty(t, fake.addRequests)
+				assert.Empty(t, fake.deleteRequests)
+				assert.Empty(t, summary.Namespaces)
+			},
+		},
+		{
+			name: "transient_failure_retried_until_horizon",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry:  true,
+				dynamicconfig.WorkerDLQAutoRetryHorizon: 10 * time.Minute,
+			},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				var continueAsNewErr *workflow.ContinueAsNewError
+				require.ErrorAs(t, err, &continueAsNewErr)
+				// The backoffs are 1m, 2m, 4m and 8m, and the next one, 16m, would exceed the 10m horizon.
+				assert.Len(t, fake.addRequests, 4)
+				require.NotEmpty(t, fake.deleteRequests)
+				lastDelete := fake.deleteRequests[len(fake.deleteRequests)-1]
+				assert.Equal(t, int64(3), lastDelete.GetInclusiveMaxTaskMetadata().GetMessageId(),
+					"The permanently failed message should be left in the DLQ")
+				require.Len(t, fake.messages, 1)
+				assert.Equal(t, int64(4), fake.messages[0].GetMetadata().GetMessageId())
+				assert.Equal(t, dlq.AutoRetryNamespaceSummary{
+					Retried:           4,
+					PermanentlyFailed: 1,
+				}, summary.Namespaces[autoRetryTestNamespace])
+			},
+		},
+		{
+			name: "terminal_failure_not_retried",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry: true,
+			},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				var continueAsNewErr *workflow.ContinueAsNewError
+				require.ErrorAs(t, err, &continueAsNewErr)
+				assert.Empty(t, fake.addRequests)
+				assert.Empty(t, fake.deleteRequests)
+				assert.Len(t, fake.messages, 1)
+				assert.Equal(t, dlq.AutoRetryNamespaceSummary{
+					PermanentlyFailed: 1,
+				}, summary.Namespaces[autoRetryTestNamespace])
+			},
+		},
+		{
+			name: "terminal_failure_retried_when_enabled",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry:           true,
+				dynamicconfig.WorkerDLQAutoRetryTerminalFailures: true,
+				dynamicconfig.WorkerDLQAutoRetryHorizon:          10 * time.Minute,
+			},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TERMINAL,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				var continueAsNewErr *workflow.ContinueAsNewError
+				require.ErrorAs(t, err, &continueAsNewErr)
+				assert.Len(t, fake.addRequests, 4)
+				assert.Equal(t, int64(4), summary.Namespaces[autoRetryTestNamespace].Retried)
+			},
+		},
+		{
+			name: "namespace_disabled",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry:           true,
+				dynamicconfig.WorkerDLQAutoRetryNamespaceEnabled: false,
+			},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				var continueAsNewErr *workflow.ContinueAsNewError
+				require.ErrorAs(t, err, &continueAsNewErr)
+				assert.Empty(t, fake.addRequests)
+				assert.Empty(t, fake.deleteRequests)
+				assert.Equal(t, dlq.AutoRetryNamespaceSummary{
+					Skipped: 1,
+				}, summary.Namespaces[autoRetryTestNamespace])
+			},
+		},
+		{
+			name: "pending_retry",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry: true,
+			},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				var continueAsNewErr *workflow.ContinueAsNewError
+				require.ErrorAs(t, err, &continueAsNewErr)
+				// The default policy keeps retrying for a day, which is longer than a single run of the workflow.
+				assert.NotEmpty(t, fake.addRequests)
+				assert.Equal(t, int64(1), summary.Namespaces[autoRetryTestNamespace].Pending)
+				assert.Zero(t, summary.Namespaces[autoRetryTestNamespace].PermanentlyFailed)
+			},
+		},
+		{
+			name: "waiting_task_does_not_block_other_namespaces",
+			dynamicConfig: dynamicconfig.StaticClient{
+				dynamicconfig.WorkerEnableDLQAutoRetry: true,
+				dynamicconfig.WorkerDLQAutoRetryInitialInterval: []dynamicconfig.ConstrainedValue{
+					{Constraints: dynamicconfig.Constraints{Namespace: autoRetryTestSlowNamespace}, Value: time.Hour},
+					{Value: time.Minute},
+				},
+				dynamicconfig.WorkerDLQAutoRetryHorizon: []dynamicconfig.ConstrainedValue{
+					{Constraints: dynamicconfig.Constraints{Namespace: autoRetryTestSlowNamespace}, Value: 24 * time.Hour},
+					{Value: 10 * time.Minute},
+				},
+			},
+			namespaces:  []string{autoRetryTestSlowNamespace, autoRetryTestNamespace},
+			failureType: enumsspb.DLQ_TASK_FAILURE_TYPE_TRANSIENT,
+			expectation: func(t *testing.T, err error, fake *fakeDLQ, summary dlq.AutoRetrySummaryQueryResponse) {
+				var continueAsNewErr *workflow.ContinueAsNewError
+				require.ErrorAs(t, err, &continueAsNewErr)
+				// The task of the slow namespace waits for an hour, but the task after it is retried in the meantime.
+				require.Greater(t, len(fake.addRequests), 4)
+				for i, req := range fake.addRequests[:4] {
+					assert.Equal(t, autoRetryTestNamespace+"-id", fake.namespaceID(t, req), "add request %d", i)
+				}
+				assert.Equal(t, autoRetryTestSlowNamespace+"-id", fake.namespaceID(t, fake.addRequests[4]))
+				assert.Equal(t, dlq.AutoRetryNamespaceSummary{
+					Retried:           4,
+					PermanentlyFailed: 1,
+				}, summary.Namespaces[autoRetryTestNamespace])
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			namespaces := tc.namespaces
+			if len(namespaces) == 0 {
+				namespaces = []string{autoRetryTestNamespace}
+			}
+			fake := &fakeDLQ{
+				failure: &commonspb.HistoryDLQTaskFailure{
+					Type: tc.failureType,
+					Time: timestamppb.New(autoRetryTestStartTime),
+				},
+			}
+			registry := namespace.NewMockRegistry(gomock.NewController(t))
+			for _, namespaceName := range namespaces {
+				namespaceID := namespaceName + "-id"
+				blob, err := serialization.NewTaskSerializer().SerializeTask(&tasks.WorkflowTask{
+					WorkflowKey: definition.NewWorkflowKey(namespaceID, "test-workflow-id", "test-run-id"),
+				})
+				require.NoError(t, err)
+				fake.write(&commonspb.HistoryTask{ShardId: 1, Blob: blob})
+				registry.EXPECT().GetNamespaceByID(namespace.ID(namespaceID)).Return(namespace.NewLocalNamespaceForTest(
+					&persistencespb.NamespaceInfo{Id: namespaceID, Name: namespaceName}, nil, "current-cluster",
+				), nil).AnyTimes()
+			}
+
+			var components []workercommon.WorkerComponent
+			fxtest.New(
+				t,
+				dlq.Module,
+				fx.Provide(
+					func() dlq.HistoryClient {
+						return &testHistoryClient{
+							getTasksFn:    fake.getTasks,
+							deleteTasksFn: fake.deleteTasks,
+						}
+					},
+					func() dlq.TaskClientDialer {
+						return dlq.TaskClientDialerFn(func(context.Context, string) (dlq.TaskClient, error) {
+							return dlq.AddTasksFn(fake.addTasks), nil
+						})
+					},
+					func() dlq.CurrentClusterName {
+						return "current-cluster"
+					},
+					func() *dynamicconfig.Collection {
+						return dynamicconfig.NewCollection(tc.dynamicConfig, log.NewNoopLogger())
+					},
+					func() namespace.Registry {
+						return registry
+					},
+					func() tasks.TaskCategoryRegistry {
+						return tasks.NewDefaultTaskCategoryRegistry()
+					},
+				),
+				fx.Populate(fx.Annotate(&components, fx.ParamTags(workercommon.WorkerComponentTag))),
+			)
+			require.Len(t, components, 1)
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			env.SetStartTime(autoRetryTestStartTime)
+			components[0].RegisterWorkflow(env)
+			components[0].RegisterActivities(env)
+
+			env.ExecuteWorkflow(dlq.AutoRetryWorkflowName, dlq.AutoRetryWorkflowParams{})
+			resp, err := env.QueryWorkflow(dlq.QueryTypeAutoRetrySummary)
+			require.NoError(t, err)
+			var summary dlq.AutoRetrySummaryQueryResponse
+			require.NoError(t, resp.Get(&summary))
+			tc.expectation(t, env.GetWorkflowError(), fake, summary)
+		})
+	}
+}
+
+func (f *fakeDLQ) write(task *commonspb.HistoryTask) {
+	f.messages = append(f.messages, &commonspb.HistoryDLQTask{
+		Metadata: &commonspb.HistoryDLQTaskMetadata{MessageId: f.nextMessageID},
+		Payload:  task,
+		Failure:  f.failure,
+	})
+	f.nextMessageID++
+}
+
+func (f *fakeDLQ) namespaceID(t *testing.T, req *adminservice.AddTasksRequest) string {
+	require.Len(t, req.GetTasks(), 1)
+	task, err := serialization.NewTaskSerializer().DeserializeTask(tasks.CategoryTransfer, req.GetTasks()[0].GetBlob())
+	require.NoError(t, err)
+	return task.GetNamespaceID()
+}
+
+func (f *fakeDLQ) getTasks(req *historyservice.GetDLQTasksRequest) (*historyservice.GetDLQTasksResponse, error) {
+	if req.GetDlqKey().GetTaskCategory() != int32(tasks.CategoryIDTransfer) {
+		return &historyservice.GetDLQTasksResponse{}, nil
+	}
+	return &historyservice.GetDLQTasksResponse{DlqTasks: f.messages}, nil
+}
+
+func (f *fakeDLQ) deleteTasks(req *historyservice.DeleteDLQTasksRequest) (*historyservice.DeleteDLQTasksResponse, error) {
+	f.deleteRequests = append(f.deleteRequests, req)
+	var remaining []*commonspb.HistoryDLQTask
+	for _, message := range f.messages {
+		if message.GetMetadata().GetMessageId() > req.GetInclusiveMaxTaskMetadata().GetMessageId() {
+			remaining = append(remaining, message)
+		}
+	}
+	deleted := len(f.messages) - len(remaining)
+	f.messages = remaining
+	return &historyservice.DeleteDLQTasksResponse{MessagesDeleted: int64(deleted)}, nil
+}
+
+func (f *fakeDLQ) addTasks(_ context.Context, req *adminservice.AddTasksRequest) (*adminservice.AddTasksResponse, error) {
+	f.addRequests = append(f.addRequests, req)
+	for _, task := range req.GetTasks() {
+		f.write(&commonspb.HistoryTask{ShardId: req.GetShardId(), Blob: task.GetBlob()})
+	}
+	return &adminservice.AddTasksResponse{}, nil
+}
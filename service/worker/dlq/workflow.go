@@ -23,7 +23,8 @@
 // THE SOFTWARE.
 
 // Package dlq contains the workflow for deleting and re-enqueueing DLQ tasks. Both of these operations are performed by
-// the same workflow to avoid concurrent deletion and re-enqueueing of the same task.
+// the same workflow to avoid concurrent deletion and re-enqueueing of the same task. It also contains the workflow which
+// automatically retries DLQ tasks according to per-namespace retry policies.
 package dlq
 
 import (
@@ -45,9 +46,13 @@ import (
 	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/service/history/tasks"
 	workercommon "go.temporal.io/server/service/worker/common"
 )
 
@@ -147,15 +152,22 @@ type (
 
 	workerComponentParams struct {
 		fx.In
-		HistoryClient      HistoryClient
-		CurrentClusterName CurrentClusterName
-		TaskClientDialer   TaskClientDialer
+		HistoryClient        HistoryClient
+		CurrentClusterName   CurrentClusterName
+		TaskClientDialer     TaskClientDialer
+		DynamicConfig        *dynamicconfig.Collection
+		NamespaceRegistry    namespace.Registry
+		TaskCategoryRegistry tasks.TaskCategoryRegistry
 	}
 
 	workerComponent struct {
-		historyClient      HistoryClient
-		taskClientDialer   TaskClientDialer
-		currentClusterName string
+		historyClient        HistoryClient
+		taskClientDialer     TaskClientDialer
+		currentClusterName   string
+		namespaceRegistry    namespace.Registry
+		taskCategoryRegistry tasks.TaskCategoryRegistry
+		taskSerializer       *serialization.TaskSerializer
+		autoRetryConfig      autoRetryConfig
 	}
 )
 
@@ -192,7 +204,8 @@ const (
 
 var (
 	// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph,
-	// given a [HistoryClient], a [TaskClientDialer], and a value for [CurrentClusterName].
+	// given a [HistoryClient], a [TaskClientDialer], a value for [CurrentClusterName], the dynamic config collection,
+	// the namespace registry and the task category registry.
 	Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
 
 	ErrNegativeBatchSize      = errors.New("BatchSize must be positive or 0 to use the default")
@@ -218,9 +231,13 @@ var (
 
 func newComponent(params workerComponentParams) workercommon.WorkerComponent {
 	return &workerComponent{
-		historyClient:      params.HistoryClient,
-		currentClusterName: string(params.CurrentClusterName),
-		taskClientDialer:   params.TaskClientDialer,
+		historyClient:        params.HistoryClient,
+		currentClusterName:   string(params.CurrentClusterName),
+		taskClientDialer:     params.TaskClientDialer,
+		namespaceRegistry:    params.NamespaceRegistry,
+		taskCategoryRegistry: params.TaskCategoryRegistry,
+		taskSerializer:       serialization.NewTaskSerializer(),
+		autoRetryConfig:      newAutoRetryConfig(params.DynamicConfig),
 	}
 }
 
@@ -476,6 +493,9 @@ func (c *workerComponent) RegisterWorkflow(registry sdkworker.Registry) {
 	registry.RegisterWorkflowWithOptions(c.workflow, workflow.RegisterOptions{
 		Name: WorkflowName,
 	})
+	registry.RegisterWorkflowWithOptions(c.autoRetryWorkflow, workflow.RegisterOptions{
+		Name: AutoRetryWorkflowName,
+	})
 }
 
 func (c *workerComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
@@ -493,6 +513,12 @@ func (c *workerComponent) RegisterActivities(registry sdkworker.Registry) {
 	registry.RegisterActivityWithOptions(c.reEnqueueTasks, activity.RegisterOptions{
 		Name: reEnqueueTasksActivityName,
 	})
+	registry.RegisterActivityWithOptions(c.autoRetryQueues, activity.RegisterOptions{
+		Name: autoRetryQueuesActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.readAutoRetryTasks, activity.RegisterOptions{
+		Name: autoRetryReadTasksActivityName,
+	})
 }
 
 func (c *workerComponent) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
@@ -29,6 +29,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/serviceerror"
@@ -41,6 +42,8 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/service/history/tasks"
@@ -454,6 +457,13 @@ func TestModule(t *testing.T) {
 					func() dlq.CurrentClusterName {
 						return dlq.CurrentClusterName(params.currentClusterName)
 					},
+					dynamicconfig.NewNoopCollection,
+					func() namespace.Registry {
+						return namespace.NewMockRegistry(gomock.NewController(t))
+					},
+					func() tasks.TaskCategoryRegistry {
+						return tasks.NewDefaultTaskCategoryRegistry()
+					},
 				),
 				fx.Populate(fx.Annotate(&components, fx.ParamTags(workercommon.WorkerComponentTag))),
 			)
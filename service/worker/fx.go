@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/rearchival"
 	"go.temporal.io/server/service/worker/scheduler"
 )
 
@@ -64,6 +65,7 @@ var Module = fx.Options(
 	scheduler.Module,
 	batcher.Module,
 	dlq.Module,
+	rearchival.Module,
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
 			return c
@@ -25,6 +25,7 @@
 package migration
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -54,8 +55,10 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/service/history/workflow"
 )
 
 type (
@@ -787,3 +790,166 @@ func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verify
 		}
 	}
 }
+
+type verifyExecutionConsistencyHeartbeatDetails struct {
+	NextIndex int
+	Response  verifyExecutionConsistencyResponse
+}
+
+// VerifyExecutionConsistency compares the version history, last event ID and mutable state checksum of executions
+// between the local and the remote cluster, and remediates the mismatches if requested.
+func (a *activities) VerifyExecutionConsistency(ctx context.Context, request *verifyExecutionConsistencyRequest) (verifyExecutionConsistencyResponse, error) {
+	ctx = headers.SetCallerInfo(ctx, headers.NewPreemptableCallerInfo(request.Namespace))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+
+	remoteClient, err := a.clientBean.GetRemoteAdminClient(request.RemoteCluster)
+	if err != nil {
+		return verifyExecutionConsistencyResponse{}, err
+	}
+
+	var details verifyExecutionConsistencyHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return verifyExecutionConsistencyResponse{}, err
+		}
+	}
+
+	for ; details.NextIndex < len(request.Executions); details.NextIndex++ {
+		we := request.Executions[details.NextIndex]
+		mismatch, skipped, err := a.verifySingleExecutionConsistency(ctx, request, rateLimiter, remoteClient, we)
+		if err != nil {
+			a.logger.Error("consistency-verification failed to verify workflow execution", tag.WorkflowNamespaceID(request.NamespaceID), tag.WorkflowID(we.WorkflowId), tag.WorkflowRunID(we.RunId), tag.Error(err))
+			return verifyExecutionConsistencyResponse{}, err
+		}
+
+		switch {
+		case skipped:
+			details.Response.SkippedCount++
+		case mismatch != nil:
+			a.logger.Warn("consistency-verification found inconsistent workflow execution",
+				tag.WorkflowNamespaceID(request.NamespaceID),
+				tag.WorkflowID(we.WorkflowId),
+				tag.WorkflowRunID(we.RunId),
+				tag.TargetCluster(request.RemoteCluster),
+				tag.NewAnyTag("mismatch", mismatch),
+			)
+			a.remediateExecutionMismatch(ctx, request, rateLimiter, remoteClient, mismatch)
+			details.Response.Mismatches = append(details.Response.Mismatches, *mismatch)
+		default:
+			details.Response.VerifiedCount++
+		}
+
+		activity.RecordHeartbeat(ctx, verifyExecutionConsistencyHeartbeatDetails{
+			NextIndex: details.NextIndex + 1,
+			Response:  details.Response,
+		})
+	}
+
+	return details.Response, nil
+}
+
+func (a *activities) verifySingleExecutionConsistency(
+	ctx context.Context,
+	request *verifyExecutionConsistencyRequest,
+	rateLimiter quotas.RateLimiter,
+	remoteClient adminservice.AdminServiceClient,
+	we *commonpb.WorkflowExecution,
+) (_ *ExecutionMismatch, skipped bool, _ error) {
+	if err := rateLimiter.WaitN(ctx, 1); err != nil {
+		return nil, false, err
+	}
+
+	localResp, err := a.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: request.NamespaceID,
+		Execution:   we,
+	})
+	if err != nil {
+		if isNotFoundServiceError(err) {
+			// the execution was deleted after it was listed
+			return nil, true, nil
+		}
+		return nil, false, err
+	}
+	localState := localResp.GetDatabaseMutableState()
+	if localState.GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE {
+		// zombie workflow should be a transient state, and is not replicated
+		return nil, true, nil
+	}
+
+	mismatch := &ExecutionMismatch{
+		Execution:        we,
+		LocalLastEventID: localState.GetNextEventId() - 1,
+	}
+	remoteResp, err := remoteClient.DescribeMutableState(ctx, &adminservice.DescribeMutableStateRequest{
+		Namespace: request.Namespace,
+		Execution: we,
+	})
+	if err != nil {
+		if isNotFoundServiceError(err) {
+			mismatch.NotFoundOnRemote = true
+			return mismatch, false, nil
+		}
+		return nil, false, err
+	}
+	remoteState := remoteResp.GetDatabaseMutableState()
+	mismatch.RemoteLastEventID = remoteState.GetNextEventId() - 1
+
+	localVersionHistory, err := versionhistory.GetCurrentVersionHistory(localState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, false, err
+	}
+	remoteVersionHistory, err := versionhistory.GetCurrentVersionHistory(remoteState.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		mismatch.VersionHistoryMismatch = true
+	} else {
+		mismatch.VersionHistoryMismatch = !versionhistory.IsEqualVersionHistoryItems(localVersionHistory.GetItems(), remoteVersionHistory.GetItems())
+	}
+	mismatch.LastEventIDMismatch = mismatch.LocalLastEventID != mismatch.RemoteLastEventID
+
+	localChecksum, err := workflow.GenerateReplicatedMutableStateChecksum(localState)
+	if err != nil {
+		return nil, false, err
+	}
+	remoteChecksum, err := workflow.GenerateReplicatedMutableStateChecksum(remoteState)
+	if err != nil {
+		return nil, false, err
+	}
+	mismatch.ChecksumMismatch = !bytes.Equal(localChecksum.GetValue(), remoteChecksum.GetValue())
+
+	if !mismatch.VersionHistoryMismatch && !mismatch.LastEventIDMismatch && !mismatch.ChecksumMismatch {
+		return nil, false, nil
+	}
+	return mismatch, false, nil
+}
+
+func (a *activities) remediateExecutionMismatch(
+	ctx context.Context,
+	request *verifyExecutionConsistencyRequest,
+	rateLimiter quotas.RateLimiter,
+	remoteClient adminservice.AdminServiceClient,
+	mismatch *ExecutionMismatch,
+) {
+	var err error
+	switch request.Remediation {
+	case ConsistencyRemediationResend:
+		err = a.generateWorkflowReplicationTask(
+			ctx,
+			rateLimiter,
+			definition.NewWorkflowKey(request.NamespaceID, mismatch.Execution.WorkflowId, mismatch.Execution.RunId),
+		)
+	case ConsistencyRemediationRefresh:
+		if mismatch.NotFoundOnRemote {
+			// there are no tasks to refresh
+			return
+		}
+		_, err = remoteClient.RefreshWorkflowTasks(ctx, &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: request.NamespaceID,
+			Execution:   mismatch.Execution,
+		})
+	default:
+		return
+	}
+	if err != nil {
+		mismatch.RemediationError = err.Error()
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencepb "go.temporal.io/server/api/persistence/v1"
@@ -46,6 +47,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/common/testing/protomock"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -703,3 +705,136 @@ func (s *activitiesSuite) TestGenerateReplicationTasks_Failed() {
 	// Only the generation of 1st execution suceeded.
 	s.Equal(0, lastHeartBeat)
 }
+
+func newConsistencyTestState(nextEventID int64, items ...*historyspb.VersionHistoryItem) *persistencepb.WorkflowMutableState {
+	return &persistencepb.WorkflowMutableState{
+		ExecutionInfo: &persistencepb.WorkflowExecutionInfo{
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory([]byte("branch-token"), items)),
+		},
+		ExecutionState: &persistencepb.WorkflowExecutionState{
+			State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		},
+		NextEventId: nextEventID,
+	}
+}
+
+func (s *activitiesSuite) TestVerifyExecutionConsistency() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	request := verifyExecutionConsistencyRequest{
+		Namespace:     mockedNamespace,
+		NamespaceID:   mockedNamespaceID,
+		RemoteCluster: remoteCluster,
+		Executions:    []*commonpb.WorkflowExecution{&execution1, &execution2, &execution3},
+		RPS:           10,
+	}
+
+	// execution1 is consistent, the remote branch token does not matter
+	localState := newConsistencyTestState(11, versionhistory.NewVersionHistoryItem(10, 1))
+	remoteState := newConsistencyTestState(11, versionhistory.NewVersionHistoryItem(10, 1))
+	remoteState.ExecutionInfo.VersionHistories.Histories[0].BranchToken = []byte("remote-branch-token")
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution1,
+	})).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: localState}, nil)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &execution1,
+	})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: remoteState}, nil)
+
+	// execution2 is behind on remote
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution2,
+	})).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newConsistencyTestState(21, versionhistory.NewVersionHistoryItem(20, 1)),
+	}, nil)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &execution2,
+	})).Return(&adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newConsistencyTestState(16, versionhistory.NewVersionHistoryItem(15, 1)),
+	}, nil)
+
+	// execution3 is a zombie
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution3,
+	})).Return(&zombieState, nil)
+
+	f, err := env.ExecuteActivity(s.a.VerifyExecutionConsistency, &request)
+	s.NoError(err)
+
+	var resp verifyExecutionConsistencyResponse
+	s.NoError(f.Get(&resp))
+	s.Equal(1, resp.VerifiedCount)
+	s.Equal(1, resp.SkippedCount)
+	s.Len(resp.Mismatches, 1)
+	mismatch := resp.Mismatches[0]
+	s.Equal(execution2.WorkflowId, mismatch.Execution.WorkflowId)
+	s.False(mismatch.NotFoundOnRemote)
+	s.True(mismatch.VersionHistoryMismatch)
+	s.True(mismatch.LastEventIDMismatch)
+	s.True(mismatch.ChecksumMismatch)
+	s.Equal(int64(20), mismatch.LocalLastEventID)
+	s.Equal(int64(15), mismatch.RemoteLastEventID)
+	s.Empty(mismatch.RemediationError)
+}
+
+func (s *activitiesSuite) TestVerifyExecutionConsistency_Remediation() {
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(s.a)
+
+	request := verifyExecutionConsistencyRequest{
+		Namespace:     mockedNamespace,
+		NamespaceID:   mockedNamespaceID,
+		RemoteCluster: remoteCluster,
+		Executions:    []*commonpb.WorkflowExecution{&execution1, &execution2},
+		RPS:           10,
+		Remediation:   ConsistencyRemediationRefresh,
+	}
+
+	// execution1 is missing on remote, so there is nothing to refresh
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution1,
+	})).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newConsistencyTestState(11, versionhistory.NewVersionHistoryItem(10, 1)),
+	}, nil)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &execution1,
+	})).Return(nil, serviceerror.NewNotFound(""))
+
+	// execution2 has diverged on remote and fails to refresh
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution2,
+	})).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newConsistencyTestState(11, versionhistory.NewVersionHistoryItem(10, 1)),
+	}, nil)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: &execution2,
+	})).Return(&adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: newConsistencyTestState(11, versionhistory.NewVersionHistoryItem(10, 2)),
+	}, nil)
+	s.mockRemoteAdminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), protomock.Eq(&adminservice.RefreshWorkflowTasksRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   &execution2,
+	})).Return(nil, serviceerror.NewUnavailable("refresh failed"))
+
+	f, err := env.ExecuteActivity(s.a.VerifyExecutionConsistency, &request)
+	s.NoError(err)
+
+	var resp verifyExecutionConsistencyResponse
+	s.NoError(f.Get(&resp))
+	s.Equal(0, resp.VerifiedCount)
+	s.Len(resp.Mismatches, 2)
+	s.True(resp.Mismatches[0].NotFoundOnRemote)
+	s.Empty(resp.Mismatches[0].RemediationError)
+	s.True(resp.Mismatches[1].VersionHistoryMismatch)
+	s.False(resp.Mismatches[1].LastEventIDMismatch)
+	s.Equal("refresh failed", resp.Mismatches[1].RemediationError)
+}
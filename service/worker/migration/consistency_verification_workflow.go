@@ -0,0 +1,233 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	// ConsistencyRemediation is the action taken for the executions which are not consistent between clusters.
+	ConsistencyRemediation string
+
+	ConsistencyVerificationParams struct {
+		Namespace               string `validate:"required"`
+		Query                   string // query to list workflows to verify, all workflows are verified if empty
+		RemoteCluster           string `validate:"required"`
+		ConcurrentActivityCount int
+		OverallRps              float64 // RPS for describing executions, on each cluster
+		ListWorkflowsPageSize   int     // PageSize of ListWorkflow, will paginate through results.
+		PageCountPerExecution   int     // number of pages to be processed before continue as new, max is 1000.
+		NextPageToken           []byte  // used by continue as new
+		Remediation             ConsistencyRemediation
+		// Max number of mismatches kept in the status, mismatches after that are only counted.
+		MaxReportedMismatches int
+
+		// Carry over the verification status after continue-as-new.
+		Status ConsistencyVerificationStatus
+	}
+
+	ConsistencyVerificationStatus struct {
+		VerifiedCount       int
+		SkippedCount        int
+		MismatchCount       int
+		Mismatches          []ExecutionMismatch
+		ContinuedAsNewCount int
+	}
+
+	// ExecutionMismatch describes how an execution differs between the local and the remote cluster.
+	ExecutionMismatch struct {
+		Execution              *commonpb.WorkflowExecution
+		NotFoundOnRemote       bool
+		VersionHistoryMismatch bool
+		LastEventIDMismatch    bool
+		ChecksumMismatch       bool
+		LocalLastEventID       int64
+		RemoteLastEventID      int64
+		// Set when the remediation of the mismatch failed.
+		RemediationError string
+	}
+
+	verifyExecutionConsistencyRequest struct {
+		Namespace     string
+		NamespaceID   string
+		RemoteCluster string
+		Executions    []*commonpb.WorkflowExecution
+		RPS           float64
+		Remediation   ConsistencyRemediation
+	}
+
+	verifyExecutionConsistencyResponse struct {
+		VerifiedCount int
+		SkippedCount  int
+		Mismatches    []ExecutionMismatch
+	}
+)
+
+const (
+	consistencyVerificationWorkflowName    = "consistency-verification"
+	consistencyVerificationStatusQueryType = "consistency-verification-status"
+
+	// ConsistencyRemediationNone only reports the mismatches.
+	ConsistencyRemediationNone ConsistencyRemediation = ""
+	// ConsistencyRemediationResend generates replication tasks for the last history of the mismatched executions.
+	ConsistencyRemediationResend ConsistencyRemediation = "resend"
+	// ConsistencyRemediationRefresh regenerates the tasks of the mismatched executions in the remote cluster.
+	ConsistencyRemediationRefresh ConsistencyRemediation = "refresh"
+
+	defaultMaxReportedMismatches = 1000
+)
+
+func ConsistencyVerificationWorkflow(ctx workflow.Context, params ConsistencyVerificationParams) (ConsistencyVerificationStatus, error) {
+	ctx = workflow.WithTaskQueue(ctx, primitives.MigrationActivityTQ)
+
+	if err := workflow.SetQueryHandler(ctx, consistencyVerificationStatusQueryType, func() (ConsistencyVerificationStatus, error) {
+		return params.Status, nil
+	}); err != nil {
+		return params.Status, err
+	}
+
+	if err := validateAndSetConsistencyVerificationParams(&params); err != nil {
+		return params.Status, err
+	}
+
+	metadataResp, err := getClusterMetadata(ctx, params.Namespace)
+	if err != nil {
+		return params.Status, err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	for i := 0; i < params.PageCountPerExecution; i++ {
+		var listResp listWorkflowsResponse
+		if err := workflow.ExecuteActivity(actx, a.ListWorkflows, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     params.Namespace,
+			PageSize:      int32(params.ListWorkflowsPageSize),
+			NextPageToken: params.NextPageToken,
+			Query:         params.Query,
+		}).Get(ctx, &listResp); err != nil {
+			return params.Status, err
+		}
+
+		// verify the page in concurrent batches, each with its share of the RPS
+		batchSize := (len(listResp.Executions) + params.ConcurrentActivityCount - 1) / params.ConcurrentActivityCount
+		var futures []workflow.Future
+		for start := 0; start < len(listResp.Executions); start += batchSize {
+			end := min(start+batchSize, len(listResp.Executions))
+			futures = append(futures, workflow.ExecuteActivity(actx, a.VerifyExecutionConsistency, &verifyExecutionConsistencyRequest{
+				Namespace:     params.Namespace,
+				NamespaceID:   metadataResp.NamespaceID,
+				RemoteCluster: params.RemoteCluster,
+				Executions:    listResp.Executions[start:end],
+				RPS:           params.OverallRps / float64(params.ConcurrentActivityCount),
+				Remediation:   params.Remediation,
+			}))
+		}
+		for _, future := range futures {
+			var verifyResp verifyExecutionConsistencyResponse
+			if err := future.Get(ctx, &verifyResp); err != nil {
+				return params.Status, err
+			}
+			params.Status.add(verifyResp, params.MaxReportedMismatches)
+		}
+
+		params.NextPageToken = listResp.NextPageToken
+		if params.NextPageToken == nil {
+			return params.Status, nil
+		}
+	}
+
+	params.Status.ContinuedAsNewCount++
+
+	// There are still more workflows to verify. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit
+	return params.Status, workflow.NewContinueAsNewError(ctx, ConsistencyVerificationWorkflow, params)
+}
+
+func (s *ConsistencyVerificationStatus) add(resp verifyExecutionConsistencyResponse, maxReportedMismatches int) {
+	s.VerifiedCount += resp.VerifiedCount
+	s.SkippedCount += resp.SkippedCount
+	s.MismatchCount += len(resp.Mismatches)
+	for _, mismatch := range resp.Mismatches {
+		if len(s.Mismatches) >= maxReportedMismatches {
+			break
+		}
+		s.Mismatches = append(s.Mismatches, mismatch)
+	}
+}
+
+func validateAndSetConsistencyVerificationParams(params *ConsistencyVerificationParams) error {
+	if len(params.Namespace) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Namespace is required", "InvalidArgument", nil)
+	}
+
+	if len(params.RemoteCluster) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: RemoteCluster is required", "InvalidArgument", nil)
+	}
+
+	switch params.Remediation {
+	case ConsistencyRemediationNone, ConsistencyRemediationResend, ConsistencyRemediationRefresh:
+	default:
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Remediation must be empty, resend or refresh", "InvalidArgument", nil)
+	}
+
+	if params.ConcurrentActivityCount <= 0 {
+		params.ConcurrentActivityCount = 1
+	}
+
+	if params.OverallRps <= 0 {
+		params.OverallRps = float64(params.ConcurrentActivityCount)
+	}
+
+	if params.ListWorkflowsPageSize <= 0 {
+		params.ListWorkflowsPageSize = defaultListWorkflowsPageSize
+	}
+
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+
+	if params.MaxReportedMismatches <= 0 {
+		params.MaxReportedMismatches = defaultMaxReportedMismatches
+	}
+
+	return nil
+}
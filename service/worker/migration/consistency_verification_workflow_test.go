@@ -0,0 +1,152 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestConsistencyVerificationWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	namespaceID := uuid.New()
+	var a *activities
+
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{NamespaceID: namespaceID}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{
+		Executions: []*commonpb.WorkflowExecution{
+			{WorkflowId: "wf1", RunId: "run1"},
+			{WorkflowId: "wf2", RunId: "run2"},
+			{WorkflowId: "wf3", RunId: "run3"},
+		},
+	}, nil).Once()
+
+	var batches [][]*commonpb.WorkflowExecution
+	env.OnActivity(a.VerifyExecutionConsistency, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *verifyExecutionConsistencyRequest) (verifyExecutionConsistencyResponse, error) {
+			assert.Equal(t, namespaceID, request.NamespaceID)
+			assert.Equal(t, "remote", request.RemoteCluster)
+			assert.Equal(t, 5.0, request.RPS)
+			assert.Equal(t, ConsistencyRemediationResend, request.Remediation)
+			batches = append(batches, request.Executions)
+			if request.Executions[0].WorkflowId == "wf1" {
+				return verifyExecutionConsistencyResponse{
+					VerifiedCount: 1,
+					Mismatches:    []ExecutionMismatch{{Execution: request.Executions[1], LastEventIDMismatch: true}},
+				}, nil
+			}
+			return verifyExecutionConsistencyResponse{SkippedCount: 1}, nil
+		},
+	).Twice()
+
+	env.ExecuteWorkflow(ConsistencyVerificationWorkflow, ConsistencyVerificationParams{
+		Namespace:               "test-ns",
+		RemoteCluster:           "remote",
+		ConcurrentActivityCount: 2,
+		OverallRps:              10,
+		Remediation:             ConsistencyRemediationResend,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	assert.Len(t, batches, 2)
+
+	var status ConsistencyVerificationStatus
+	require.NoError(t, env.GetWorkflowResult(&status))
+	assert.Equal(t, 1, status.VerifiedCount)
+	assert.Equal(t, 1, status.SkippedCount)
+	assert.Equal(t, 1, status.MismatchCount)
+	assert.Equal(t, "wf2", status.Mismatches[0].Execution.WorkflowId)
+}
+
+func TestConsistencyVerificationWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{NamespaceID: uuid.New()}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+			assert.Equal(t, "ExecutionStatus = 'Completed'", request.Query)
+			return &listWorkflowsResponse{
+				Executions:    []*commonpb.WorkflowExecution{{WorkflowId: "wf", RunId: "run"}},
+				NextPageToken: []byte("next-page"),
+			}, nil
+		},
+	).Twice()
+	env.OnActivity(a.VerifyExecutionConsistency, mock.Anything, mock.Anything).Return(
+		verifyExecutionConsistencyResponse{
+			Mismatches: []ExecutionMismatch{{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "run"}, NotFoundOnRemote: true}},
+		}, nil,
+	).Twice()
+
+	env.ExecuteWorkflow(ConsistencyVerificationWorkflow, ConsistencyVerificationParams{
+		Namespace:             "test-ns",
+		Query:                 "ExecutionStatus = 'Completed'",
+		RemoteCluster:         "remote",
+		PageCountPerExecution: 2,
+		MaxReportedMismatches: 1,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	assert.True(t, workflow.IsContinueAsNewError(err))
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(consistencyVerificationStatusQueryType)
+	require.NoError(t, err)
+	var status ConsistencyVerificationStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, 2, status.MismatchCount)
+	assert.Len(t, status.Mismatches, 1)
+	assert.Equal(t, 1, status.ContinuedAsNewCount)
+}
+
+func TestConsistencyVerificationWorkflow_InvalidRemediation(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(ConsistencyVerificationWorkflow, ConsistencyVerificationParams{
+		Namespace:     "test-ns",
+		RemoteCluster: "remote",
+		Remediation:   "rebuild",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "Remediation")
+}
@@ -162,7 +162,7 @@ func ForceReplicationWorkflow(ctx workflow.Context, params ForceReplicationParam
 		return err
 	}
 
-	metadataResp, err := getClusterMetadata(ctx, params)
+	metadataResp, err := getClusterMetadata(ctx, params.Namespace)
 	if err != nil {
 		return err
 	}
@@ -314,7 +314,7 @@ func validateAndSetForceReplicationParams(params *ForceReplicationParams) error
 	return nil
 }
 
-func getClusterMetadata(ctx workflow.Context, params ForceReplicationParams) (metadataResponse, error) {
+func getClusterMetadata(ctx workflow.Context, nsName string) (metadataResponse, error) {
 	var a *activities
 
 	// Get cluster metadata, we need namespace ID for history API call.
@@ -326,7 +326,7 @@ func getClusterMetadata(ctx workflow.Context, params ForceReplicationParams) (me
 
 	actx := workflow.WithLocalActivityOptions(ctx, lao)
 	var metadataResp metadataResponse
-	metadataRequest := metadataRequest{Namespace: params.Namespace}
+	metadataRequest := metadataRequest{Namespace: nsName}
 	err := workflow.ExecuteLocalActivity(actx, a.GetMetadata, metadataRequest).Get(ctx, &metadataResp)
 	return metadataResp, err
 }
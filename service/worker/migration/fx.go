@@ -75,6 +75,7 @@ func (wc *replicationWorkerComponent) RegisterWorkflow(registry sdkworker.Regist
 	registry.RegisterWorkflowWithOptions(ForceReplicationWorkflow, workflow.RegisterOptions{Name: forceReplicationWorkflowName})
 	registry.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
 	registry.RegisterWorkflow(ForceTaskQueueUserDataReplicationWorkflow)
+	registry.RegisterWorkflowWithOptions(ConsistencyVerificationWorkflow, workflow.RegisterOptions{Name: consistencyVerificationWorkflowName})
 }
 
 func (wc *replicationWorkerComponent) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
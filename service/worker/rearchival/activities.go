@@ -0,0 +1,255 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rearchival
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	carchiver "go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	activities struct {
+		historyShardCount int32
+		executionManager  persistence.ExecutionManager
+		namespaceRegistry namespace.Registry
+		frontendClient    workflowservice.WorkflowServiceClient
+		archivalMetadata  carchiver.ArchivalMetadata
+		archiverProvider  provider.ArchiverProvider
+		logger            log.Logger
+	}
+
+	rearchiveExecutionsHeartbeatDetails struct {
+		NextIndex int
+		Response  rearchiveExecutionsResponse
+	}
+
+	// executionResult is the outcome of verifying the archive of a single execution.
+	executionResult int
+)
+
+const (
+	executionVerified executionResult = iota
+	executionRearchived
+	executionSkipped
+	executionFailed
+)
+
+// ListWorkflowsToRearchive lists the closed workflows to re-archive. Activities of all worker components share the
+// default worker, so the name must not clash with other components' activities such as the migration ListWorkflows.
+func (a *activities) ListWorkflowsToRearchive(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+	ctx = headers.SetCallerInfo(ctx, headers.NewCallerInfo(request.Namespace, headers.CallerTypePreemptable, ""))
+
+	// modify query to include all namespace divisions
+	request.Query = searchattribute.QueryWithAnyNamespaceDivision(request.Query)
+
+	resp, err := a.frontendClient.ListWorkflowExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*commonpb.WorkflowExecution, len(resp.Executions))
+	for i, e := range resp.Executions {
+		executions[i] = e.Execution
+	}
+	return &listWorkflowsResponse{Executions: executions, NextPageToken: resp.NextPageToken}, nil
+}
+
+// RearchiveExecutions verifies the archived history of each execution, and re-archives it from primary storage if it
+// is missing or corrupt.
+func (a *activities) RearchiveExecutions(ctx context.Context, request *rearchiveExecutionsRequest) (rearchiveExecutionsResponse, error) {
+	ctx = headers.SetCallerInfo(ctx, headers.NewPreemptableCallerInfo(request.Namespace))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return rearchiveExecutionsResponse{}, err
+	}
+	if !a.archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() ||
+		nsEntry.HistoryArchivalState().State != enumspb.ARCHIVAL_STATE_ENABLED {
+		return rearchiveExecutionsResponse{}, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("history archival is not enabled for namespace %s", request.Namespace), "FailedPrecondition", nil,
+		)
+	}
+	uri, err := carchiver.NewURI(nsEntry.HistoryArchivalState().URI)
+	if err != nil {
+		return rearchiveExecutionsResponse{}, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("invalid history archival URI: %s", err), "FailedPrecondition", nil,
+		)
+	}
+	historyArchiver, err := a.archiverProvider.GetHistoryArchiver(uri.Scheme(), string(primitives.WorkerService))
+	if err != nil {
+		return rearchiveExecutionsResponse{}, err
+	}
+
+	var details rearchiveExecutionsHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return rearchiveExecutionsResponse{}, err
+		}
+	}
+
+	for ; details.NextIndex < len(request.Executions); details.NextIndex++ {
+		we := request.Executions[details.NextIndex]
+		if err := rateLimiter.WaitN(ctx, 1); err != nil {
+			return rearchiveExecutionsResponse{}, err
+		}
+		result, failure, err := a.rearchiveExecution(ctx, nsEntry, historyArchiver, uri, we, request.VerifyOnly)
+		if err != nil {
+			a.logger.Error("rearchival failed to verify workflow execution", tag.WorkflowNamespaceID(nsEntry.ID().String()), tag.WorkflowID(we.WorkflowId), tag.WorkflowRunID(we.RunId), tag.Error(err))
+			return rearchiveExecutionsResponse{}, err
+		}
+
+		switch result {
+		case executionVerified:
+			details.Response.VerifiedCount++
+		case executionRearchived:
+			details.Response.RearchivedCount++
+		case executionSkipped:
+			details.Response.SkippedCount++
+		case executionFailed:
+			a.logger.Warn("rearchival found workflow execution with missing or corrupt archive",
+				tag.WorkflowNamespaceID(nsEntry.ID().String()),
+				tag.WorkflowID(we.WorkflowId),
+				tag.WorkflowRunID(we.RunId),
+				tag.NewAnyTag("failure", failure),
+			)
+			details.Response.Failures = append(details.Response.Failures, *failure)
+		}
+
+		activity.RecordHeartbeat(ctx, rearchiveExecutionsHeartbeatDetails{
+			NextIndex: details.NextIndex + 1,
+			Response:  details.Response,
+		})
+	}
+
+	return details.Response, nil
+}
+
+func (a *activities) rearchiveExecution(
+	ctx context.Context,
+	nsEntry *namespace.Namespace,
+	historyArchiver carchiver.HistoryArchiver,
+	uri carchiver.URI,
+	we *commonpb.WorkflowExecution,
+	verifyOnly bool,
+) (executionResult, *ExecutionFailure, error) {
+	shardID := common.WorkflowIDToHistoryShard(nsEntry.ID().String(), we.WorkflowId, a.historyShardCount)
+	resp, err := a.executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: nsEntry.ID().String(),
+		WorkflowID:  we.WorkflowId,
+		RunID:       we.RunId,
+	})
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			// the execution was deleted after it was listed, so its history can't be archived again
+			return executionSkipped, nil, nil
+		}
+		return 0, nil, err
+	}
+	executionInfo := resp.State.GetExecutionInfo()
+	if resp.State.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return executionSkipped, nil, nil
+	}
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+	if err != nil {
+		return 0, nil, err
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	verifyRequest := &carchiver.VerifyHistoryRequest{
+		ShardID:              shardID,
+		NamespaceID:          nsEntry.ID().String(),
+		WorkflowID:           we.WorkflowId,
+		RunID:                we.RunId,
+		BranchToken:          currentVersionHistory.GetBranchToken(),
+		LastFirstEventID:     executionInfo.GetLastFirstEventId(),
+		NextEventID:          resp.State.GetNextEventId(),
+		CloseFailoverVersion: lastItem.GetVersion(),
+	}
+	verifyErr := carchiver.VerifyHistory(ctx, a.executionManager, historyArchiver, uri, verifyRequest)
+	if verifyErr == nil {
+		return executionVerified, nil, nil
+	}
+	if !isBrokenArchiveError(verifyErr) {
+		return 0, nil, verifyErr
+	}
+	failure := &ExecutionFailure{
+		Execution:         we,
+		VerificationError: verifyErr.Error(),
+	}
+	if verifyOnly {
+		return executionFailed, failure, nil
+	}
+
+	err = historyArchiver.Archive(ctx, uri, &carchiver.ArchiveHistoryRequest{
+		ShardID:              shardID,
+		NamespaceID:          nsEntry.ID().String(),
+		Namespace:            nsEntry.Name().String(),
+		WorkflowID:           we.WorkflowId,
+		RunID:                we.RunId,
+		BranchToken:          verifyRequest.BranchToken,
+		NextEventID:          verifyRequest.NextEventID,
+		CloseFailoverVersion: verifyRequest.CloseFailoverVersion,
+	}, carchiver.GetOverwriteExistingArchiveOption())
+	if err == nil {
+		err = carchiver.VerifyHistory(ctx, a.executionManager, historyArchiver, uri, verifyRequest)
+	}
+	if err != nil {
+		failure.RearchivalError = err.Error()
+		return executionFailed, failure, nil
+	}
+	return executionRearchived, nil, nil
+}
+
+func isBrokenArchiveError(err error) bool {
+	return errors.Is(err, carchiver.ErrArchivedHistoryNotFound) || errors.Is(err, carchiver.ErrArchivedHistoryMismatch)
+}
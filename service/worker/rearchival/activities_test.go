@@ -0,0 +1,230 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rearchival
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/testsuite"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	carchiver "go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestRearchiveExecutions(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		verifyOnly bool
+		expected   rearchiveExecutionsResponse
+	}{
+		{
+			name: "rearchive",
+			expected: rearchiveExecutionsResponse{
+				VerifiedCount:   1,
+				RearchivedCount: 1,
+				SkippedCount:    2,
+				Failures: []ExecutionFailure{{
+					Execution:         &commonpb.WorkflowExecution{WorkflowId: "wf-fails", RunId: "run"},
+					VerificationError: carchiver.ErrArchivedHistoryNotFound.Error(),
+					RearchivalError:   "backend unavailable",
+				}},
+			},
+		},
+		{
+			name:       "verify only",
+			verifyOnly: true,
+			expected: rearchiveExecutionsResponse{
+				VerifiedCount: 1,
+				SkippedCount:  2,
+				Failures: []ExecutionFailure{
+					{
+						Execution:         &commonpb.WorkflowExecution{WorkflowId: "wf-missing", RunId: "run"},
+						VerificationError: carchiver.ErrArchivedHistoryNotFound.Error(),
+					},
+					{
+						Execution:         &commonpb.WorkflowExecution{WorkflowId: "wf-fails", RunId: "run"},
+						VerificationError: carchiver.ErrArchivedHistoryNotFound.Error(),
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			nsEntry := namespace.NewLocalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Id: "test-ns-id", Name: "test-ns"},
+				&persistencespb.NamespaceConfig{
+					HistoryArchivalState: enumspb.ARCHIVAL_STATE_ENABLED,
+					HistoryArchivalUri:   "test:///archival",
+				},
+				"active",
+			)
+			namespaceRegistry := namespace.NewMockRegistry(ctrl)
+			namespaceRegistry.EXPECT().GetNamespace(namespace.Name("test-ns")).Return(nsEntry, nil)
+
+			historyConfig := carchiver.NewMockArchivalConfig(ctrl)
+			historyConfig.EXPECT().ClusterConfiguredForArchival().Return(true)
+			archivalMetadata := carchiver.NewMockArchivalMetadata(ctrl)
+			archivalMetadata.EXPECT().GetHistoryConfig().Return(historyConfig)
+
+			// the archive of wf-ok is complete, the archives of wf-missing and wf-fails are missing, wf-deleted was
+			// deleted from primary storage and wf-running is still running
+			archives := map[string]bool{"wf-ok": true}
+			lastEvent := &historypb.HistoryEvent{EventId: 1, Version: 5}
+			historyArchiver := carchiver.NewMockHistoryArchiver(ctrl)
+			historyArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ carchiver.URI, request *carchiver.GetHistoryRequest) (*carchiver.GetHistoryResponse, error) {
+					assert.Equal(t, int64(5), *request.CloseFailoverVersion)
+					if !archives[request.WorkflowID] {
+						return nil, serviceerror.NewNotFound("history not found")
+					}
+					return &carchiver.GetHistoryResponse{
+						HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{lastEvent}}},
+					}, nil
+				},
+			).AnyTimes()
+			historyArchiver.EXPECT().Archive(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ carchiver.URI, request *carchiver.ArchiveHistoryRequest, opts ...carchiver.ArchiveOption) error {
+					assert.False(t, tc.verifyOnly)
+					assert.True(t, carchiver.GetFeatureCatalog(opts...).OverwriteExisting)
+					assert.Equal(t, "test-ns", request.Namespace)
+					assert.Equal(t, int64(2), request.NextEventID)
+					if request.WorkflowID == "wf-fails" {
+						return errors.New("backend unavailable")
+					}
+					archives[request.WorkflowID] = true
+					return nil
+				},
+			).AnyTimes()
+			archiverProvider := provider.NewMockArchiverProvider(ctrl)
+			archiverProvider.EXPECT().GetHistoryArchiver("test", gomock.Any()).Return(historyArchiver, nil)
+
+			executionManager := persistence.NewMockExecutionManager(ctrl)
+			executionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *persistence.GetWorkflowExecutionRequest) (*persistence.GetWorkflowExecutionResponse, error) {
+					state := enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED
+					switch request.WorkflowID {
+					case "wf-deleted":
+						return nil, serviceerror.NewNotFound("workflow execution not found")
+					case "wf-running":
+						state = enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING
+					}
+					return &persistence.GetWorkflowExecutionResponse{State: &persistencespb.WorkflowMutableState{
+						ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+							LastFirstEventId: 1,
+							VersionHistories: &historyspb.VersionHistories{
+								Histories: []*historyspb.VersionHistory{{
+									BranchToken: []byte("branch-token"),
+									Items:       []*historyspb.VersionHistoryItem{{EventId: 1, Version: 5}},
+								}},
+							},
+						},
+						ExecutionState: &persistencespb.WorkflowExecutionState{State: state},
+						NextEventId:    2,
+					}}, nil
+				},
+			).Times(5)
+			executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(
+				&persistence.ReadHistoryBranchResponse{HistoryEvents: []*historypb.HistoryEvent{lastEvent}}, nil,
+			).AnyTimes()
+
+			a := &activities{
+				historyShardCount: 4,
+				executionManager:  executionManager,
+				namespaceRegistry: namespaceRegistry,
+				archivalMetadata:  archivalMetadata,
+				archiverProvider:  archiverProvider,
+				logger:            log.NewNoopLogger(),
+			}
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestActivityEnvironment()
+			env.RegisterActivity(a)
+
+			var executions []*commonpb.WorkflowExecution
+			for _, wid := range []string{"wf-ok", "wf-missing", "wf-deleted", "wf-running", "wf-fails"} {
+				executions = append(executions, &commonpb.WorkflowExecution{WorkflowId: wid, RunId: "run"})
+			}
+			val, err := env.ExecuteActivity(a.RearchiveExecutions, &rearchiveExecutionsRequest{
+				Namespace:  "test-ns",
+				Executions: executions,
+				RPS:        100,
+				VerifyOnly: tc.verifyOnly,
+			})
+			require.NoError(t, err)
+			var resp rearchiveExecutionsResponse
+			require.NoError(t, val.Get(&resp))
+			assert.Equal(t, tc.expected, resp)
+		})
+	}
+}
+
+func TestRearchiveExecutions_ArchivalDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	nsEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "test-ns-id", Name: "test-ns"},
+		&persistencespb.NamespaceConfig{HistoryArchivalState: enumspb.ARCHIVAL_STATE_DISABLED},
+		"active",
+	)
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespace(namespace.Name("test-ns")).Return(nsEntry, nil)
+	historyConfig := carchiver.NewMockArchivalConfig(ctrl)
+	historyConfig.EXPECT().ClusterConfiguredForArchival().Return(true)
+	archivalMetadata := carchiver.NewMockArchivalMetadata(ctrl)
+	archivalMetadata.EXPECT().GetHistoryConfig().Return(historyConfig)
+
+	a := &activities{
+		namespaceRegistry: namespaceRegistry,
+		archivalMetadata:  archivalMetadata,
+		logger:            log.NewNoopLogger(),
+	}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(a)
+
+	_, err := env.ExecuteActivity(a.RearchiveExecutions, &rearchiveExecutionsRequest{
+		Namespace:  "test-ns",
+		Executions: []*commonpb.WorkflowExecution{{WorkflowId: "wf", RunId: "run"}},
+		RPS:        1,
+	})
+	assert.ErrorContains(t, err, "history archival is not enabled")
+}
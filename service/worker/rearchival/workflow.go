@@ -0,0 +1,231 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package rearchival contains the workflow which verifies the archived histories of closed workflow executions, and
+// re-archives the executions whose archive is missing or corrupt while their history is still in primary storage.
+package rearchival
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	RearchivalParams struct {
+		Namespace               string
+		Query                   string // query to list workflows to verify, all closed workflows are verified if empty
+		ConcurrentActivityCount int
+		OverallRps              float64 // RPS for verifying executions
+		ListWorkflowsPageSize   int     // PageSize of ListWorkflow, will paginate through results.
+		PageCountPerExecution   int     // number of pages to be processed before continue as new, max is 1000.
+		NextPageToken           []byte  // used by continue as new
+		// VerifyOnly only reports the executions whose archive is missing or corrupt, without re-archiving them.
+		VerifyOnly bool
+		// Max number of failures kept in the status, failures after that are only counted.
+		MaxReportedFailures int
+
+		// Carry over the status after continue-as-new.
+		Status RearchivalStatus
+	}
+
+	RearchivalStatus struct {
+		// VerifiedCount is the number of executions whose archive is complete.
+		VerifiedCount int
+		// RearchivedCount is the number of executions whose archive was missing or corrupt, and was re-archived.
+		RearchivedCount int
+		// SkippedCount is the number of executions which were deleted or are not closed.
+		SkippedCount int
+		// FailedCount is the number of executions whose archive is missing or corrupt, and was not re-archived.
+		FailedCount         int
+		Failures            []ExecutionFailure
+		ContinuedAsNewCount int
+	}
+
+	// ExecutionFailure describes why the archive of an execution is missing or corrupt.
+	ExecutionFailure struct {
+		Execution         *commonpb.WorkflowExecution
+		VerificationError string
+		// Set when the re-archival of the execution failed.
+		RearchivalError string
+	}
+
+	listWorkflowsResponse struct {
+		Executions    []*commonpb.WorkflowExecution
+		NextPageToken []byte
+	}
+
+	rearchiveExecutionsRequest struct {
+		Namespace  string
+		Executions []*commonpb.WorkflowExecution
+		RPS        float64
+		VerifyOnly bool
+	}
+
+	rearchiveExecutionsResponse struct {
+		VerifiedCount   int
+		RearchivedCount int
+		SkippedCount    int
+		Failures        []ExecutionFailure
+	}
+)
+
+const (
+	// WorkflowName is the name of the re-archival workflow.
+	WorkflowName = "temporal-sys-rearchival-workflow"
+	// QueryTypeStatus is the query to get the status of the re-archival workflow.
+	QueryTypeStatus = "rearchival-status"
+
+	defaultQuery                 = "ExecutionStatus != 'Running'"
+	defaultListWorkflowsPageSize = 1000
+	defaultPageCountPerExecution = 200
+	maxPageCountPerExecution     = 1000
+	defaultMaxReportedFailures   = 1000
+)
+
+var activityRetryPolicy = &temporal.RetryPolicy{
+	InitialInterval: time.Second,
+	MaximumInterval: time.Second * 10,
+}
+
+// RearchivalWorkflow lists the closed executions of a namespace, verifies their archived history, and re-archives the
+// ones whose archive is missing or corrupt.
+func RearchivalWorkflow(ctx workflow.Context, params RearchivalParams) (RearchivalStatus, error) {
+	ctx = workflow.WithTaskQueue(ctx, primitives.RearchivalActivityTQ)
+
+	if err := workflow.SetQueryHandler(ctx, QueryTypeStatus, func() (RearchivalStatus, error) {
+		return params.Status, nil
+	}); err != nil {
+		return params.Status, err
+	}
+
+	if err := validateAndSetRearchivalParams(&params); err != nil {
+		return params.Status, err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         activityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	for i := 0; i < params.PageCountPerExecution; i++ {
+		var listResp listWorkflowsResponse
+		if err := workflow.ExecuteActivity(actx, a.ListWorkflowsToRearchive, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     params.Namespace,
+			PageSize:      int32(params.ListWorkflowsPageSize),
+			NextPageToken: params.NextPageToken,
+			Query:         params.Query,
+		}).Get(ctx, &listResp); err != nil {
+			return params.Status, err
+		}
+
+		// verify the page in concurrent batches, each with its share of the RPS
+		batchSize := (len(listResp.Executions) + params.ConcurrentActivityCount - 1) / params.ConcurrentActivityCount
+		var futures []workflow.Future
+		for start := 0; start < len(listResp.Executions); start += batchSize {
+			end := min(start+batchSize, len(listResp.Executions))
+			futures = append(futures, workflow.ExecuteActivity(actx, a.RearchiveExecutions, &rearchiveExecutionsRequest{
+				Namespace:  params.Namespace,
+				Executions: listResp.Executions[start:end],
+				RPS:        params.OverallRps / float64(params.ConcurrentActivityCount),
+				VerifyOnly: params.VerifyOnly,
+			}))
+		}
+		for _, future := range futures {
+			var resp rearchiveExecutionsResponse
+			if err := future.Get(ctx, &resp); err != nil {
+				return params.Status, err
+			}
+			params.Status.add(resp, params.MaxReportedFailures)
+		}
+
+		params.NextPageToken = listResp.NextPageToken
+		if params.NextPageToken == nil {
+			return params.Status, nil
+		}
+	}
+
+	params.Status.ContinuedAsNewCount++
+
+	// There are still more workflows to verify. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit
+	return params.Status, workflow.NewContinueAsNewError(ctx, RearchivalWorkflow, params)
+}
+
+func (s *RearchivalStatus) add(resp rearchiveExecutionsResponse, maxReportedFailures int) {
+	s.VerifiedCount += resp.VerifiedCount
+	s.RearchivedCount += resp.RearchivedCount
+	s.SkippedCount += resp.SkippedCount
+	s.FailedCount += len(resp.Failures)
+	for _, failure := range resp.Failures {
+		if len(s.Failures) >= maxReportedFailures {
+			break
+		}
+		s.Failures = append(s.Failures, failure)
+	}
+}
+
+func validateAndSetRearchivalParams(params *RearchivalParams) error {
+	if len(params.Namespace) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Namespace is required", "InvalidArgument", nil)
+	}
+
+	if len(params.Query) == 0 {
+		params.Query = defaultQuery
+	}
+
+	if params.ConcurrentActivityCount <= 0 {
+		params.ConcurrentActivityCount = 1
+	}
+
+	if params.OverallRps <= 0 {
+		params.OverallRps = float64(params.ConcurrentActivityCount)
+	}
+
+	if params.ListWorkflowsPageSize <= 0 {
+		params.ListWorkflowsPageSize = defaultListWorkflowsPageSize
+	}
+
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+
+	if params.MaxReportedFailures <= 0 {
+		params.MaxReportedFailures = defaultMaxReportedFailures
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rearchival
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestRearchivalWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.ListWorkflowsToRearchive, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+			assert.Equal(t, "test-ns", request.Namespace)
+			assert.Equal(t, defaultQuery, request.Query)
+			return &listWorkflowsResponse{
+				Executions: []*commonpb.WorkflowExecution{
+					{WorkflowId: "wf1", RunId: "run1"},
+					{WorkflowId: "wf2", RunId: "run2"},
+					{WorkflowId: "wf3", RunId: "run3"},
+				},
+			}, nil
+		},
+	).Once()
+
+	var batches [][]*commonpb.WorkflowExecution
+	env.OnActivity(a.RearchiveExecutions, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *rearchiveExecutionsRequest) (rearchiveExecutionsResponse, error) {
+			assert.Equal(t, "test-ns", request.Namespace)
+			assert.Equal(t, 5.0, request.RPS)
+			assert.False(t, request.VerifyOnly)
+			batches = append(batches, request.Executions)
+			if request.Executions[0].WorkflowId == "wf1" {
+				return rearchiveExecutionsResponse{VerifiedCount: 1, RearchivedCount: 1}, nil
+			}
+			return rearchiveExecutionsResponse{
+				Failures: []ExecutionFailure{{
+					Execution:         request.Executions[0],
+					VerificationError: "archived workflow history does not exist",
+					RearchivalError:   "backend unavailable",
+				}},
+			}, nil
+		},
+	).Twice()
+
+	env.ExecuteWorkflow(RearchivalWorkflow, RearchivalParams{
+		Namespace:               "test-ns",
+		ConcurrentActivityCount: 2,
+		OverallRps:              10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+	assert.Len(t, batches, 2)
+
+	var status RearchivalStatus
+	require.NoError(t, env.GetWorkflowResult(&status))
+	assert.Equal(t, 1, status.VerifiedCount)
+	assert.Equal(t, 1, status.RearchivedCount)
+	assert.Equal(t, 1, status.FailedCount)
+	assert.Equal(t, "wf3", status.Failures[0].Execution.WorkflowId)
+	assert.Equal(t, "backend unavailable", status.Failures[0].RearchivalError)
+}
+
+func TestRearchivalWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.ListWorkflowsToRearchive, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+			assert.Equal(t, "ExecutionStatus = 'Completed'", request.Query)
+			return &listWorkflowsResponse{
+				Executions:    []*commonpb.WorkflowExecution{{WorkflowId: "wf", RunId: "run"}},
+				NextPageToken: []byte("next-page"),
+			}, nil
+		},
+	).Twice()
+	env.OnActivity(a.RearchiveExecutions, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, request *rearchiveExecutionsRequest) (rearchiveExecutionsResponse, error) {
+			assert.True(t, request.VerifyOnly)
+			return rearchiveExecutionsResponse{
+				Failures: []ExecutionFailure{{Execution: request.Executions[0], VerificationError: "mismatch"}},
+			}, nil
+		},
+	).Twice()
+
+	env.ExecuteWorkflow(RearchivalWorkflow, RearchivalParams{
+		Namespace:             "test-ns",
+		Query:                 "ExecutionStatus = 'Completed'",
+		PageCountPerExecution: 2,
+		VerifyOnly:            true,
+		MaxReportedFailures:   1,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	assert.True(t, workflow.IsContinueAsNewError(err))
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(QueryTypeStatus)
+	require.NoError(t, err)
+	var status RearchivalStatus
+	require.NoError(t, envValue.Get(&status))
+	assert.Equal(t, 2, status.FailedCount)
+	assert.Len(t, status.Failures, 1)
+	assert.Equal(t, 1, status.ContinuedAsNewCount)
+}
+
+func TestRearchivalWorkflow_MissingNamespace(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(RearchivalWorkflow, RearchivalParams{})
+
+	require.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), "Namespace is required")
+}
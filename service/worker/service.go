@@ -30,6 +30,7 @@ import (
 
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
 
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/client"
@@ -50,6 +51,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
@@ -109,6 +111,7 @@ type (
 		BatcherRPS                            dynamicconfig.IntPropertyFnWithNamespaceFilter
 		BatcherConcurrency                    dynamicconfig.IntPropertyFnWithNamespaceFilter
 		EnableParentClosePolicyWorker         dynamicconfig.BoolPropertyFn
+		EnableDLQAutoRetry                    dynamicconfig.BoolPropertyFn
 		PerNamespaceWorkerCount               dynamicconfig.IntPropertyFnWithNamespaceFilter
 		PerNamespaceWorkerOptions             dynamicconfig.MapPropertyFnWithNamespaceFilter
 		PerNamespaceWorkerStartRate           dynamicconfig.FloatPropertyFn
@@ -293,6 +296,10 @@ func NewConfig(
 			dynamicconfig.EnableParentClosePolicyWorker,
 			true,
 		),
+		EnableDLQAutoRetry: dc.GetBoolProperty(
+			dynamicconfig.WorkerEnableDLQAutoRetry,
+			false,
+		),
 		PerNamespaceWorkerCount: dc.GetIntPropertyFilteredByNamespace(
 			dynamicconfig.WorkerPerNamespaceWorkerCount,
 			1,
@@ -369,6 +376,9 @@ func (s *Service) Start() {
 	if s.config.EnableBatcher() {
 		s.startBatcher()
 	}
+	if s.config.EnableDLQAutoRetry() {
+		s.startDLQAutoRetry()
+	}
 
 	s.workerManager.Start()
 	s.perNamespaceWorkerManager.Start(
@@ -434,6 +444,24 @@ func (s *Service) startBatcher() {
 	}
 }
 
+// startDLQAutoRetry starts the DLQ auto-retry workflow in the background. The workflow is hosted by the default worker,
+// so it only needs to be started once per cluster, and starting it again while it's running is a no-op.
+func (s *Service) startDLQAutoRetry() {
+	go func() {
+		policy := backoff.NewExponentialRetryPolicy(time.Second).
+			WithMaximumInterval(time.Minute).
+			WithExpirationInterval(10 * time.Minute)
+		err := backoff.ThrottleRetryContext(context.Background(), func(ctx context.Context) error {
+			return dlq.StartAutoRetryWorkflow(ctx, s.sdkClientFactory.GetSystemClient())
+		}, policy, func(err error) bool {
+			return true
+		})
+		if err != nil {
+			s.logger.Error("error starting DLQ auto-retry workflow", tag.Error(err))
+		}
+	}()
+}
+
 func (s *Service) initScanner() error {
 	currentCluster := s.clusterMetadata.GetCurrentClusterName()
 	adminClient, err := s.clientBean.GetRemoteAdminClient(currentCluster)
@@ -25,13 +25,22 @@
 package tdbg
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -57,6 +66,9 @@ import (
 const (
 	historyImportBlobSize = 16
 	historyImportPageSize = 256 * 1024 // 256K
+
+	exportFormatJSON  = "json"
+	exportFormatProto = "proto"
 )
 
 // AdminShowWorkflow shows history
@@ -161,8 +173,6 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 
 	client := clientFactory.AdminClient(c)
 
-	serializer := serialization.NewSerializer()
-
 	ctx, cancel := newContext(c)
 	defer cancel()
 
@@ -176,6 +186,20 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 		return fmt.Errorf("unable to deserialize History data: %s", err)
 	}
 
+	return importWorkflowHistory(ctx, client, nsName, &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      rid,
+	}, historyBatches)
+}
+
+func importWorkflowHistory(
+	ctx context.Context,
+	client adminservice.AdminServiceClient,
+	nsName string,
+	execution *commonpb.WorkflowExecution,
+	historyBatches []*historypb.History,
+) error {
+	serializer := serialization.NewSerializer()
 	versionHistory := &history.VersionHistory{}
 	for _, historyBatch := range historyBatches {
 		for _, event := range historyBatch.Events {
@@ -204,11 +228,8 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 			len(blobs) >= historyImportPageSize ||
 			(i == len(historyBatches) && len(blobs) > 0) {
 			resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-				Namespace: nsName,
-				Execution: &commonpb.WorkflowExecution{
-					WorkflowId: wid,
-					RunId:      rid,
-				},
+				Namespace:      nsName,
+				Execution:      execution,
 				HistoryBatches: blobs,
 				VersionHistory: versionHistory,
 				Token:          token,
@@ -227,11 +248,8 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	}
 	// call with empty history to commit
 	resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-		Namespace: nsName,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
+		Namespace:      nsName,
+		Execution:      execution,
 		HistoryBatches: []*commonpb.DataBlob{},
 		VersionHistory: versionHistory,
 		Token:          token,
@@ -245,6 +263,273 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminExportWorkflows exports the history branches and mutable state of the executions matching a visibility query
+// to a file, with one execution per JSON line or length-delimited proto message
+func AdminExportWorkflows(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	nsID, err := getNamespaceID(c, clientFactory, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	outputFileName, err := getRequiredOption(c, FlagOutputFilename)
+	if err != nil {
+		return err
+	}
+	format := c.String(FlagExportFormat)
+	if format != exportFormatJSON && format != exportFormatProto {
+		return fmt.Errorf("unknown export format %q, supported formats: %s, %s", format, exportFormatJSON, exportFormatProto)
+	}
+
+	client := clientFactory.AdminClient(c)
+
+	file, err := os.Create(outputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to create export file: %s", err)
+	}
+	defer func() { _ = file.Close() }()
+	writer := bufio.NewWriter(file)
+	encoder := codec.NewJSONPBEncoder()
+
+	exported := 0
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		ctx, cancel := newContext(c)
+		resp, err := client.ExportWorkflowExecutions(ctx, &adminservice.ExportWorkflowExecutionsRequest{
+			Namespace:     nsName,
+			Query:         c.String(FlagQuery),
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: token,
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to export workflow executions: %s", err)
+		}
+		for _, execution := range resp.Executions {
+			execution.Branches, err = exportWorkflowHistoryBranches(c, client, nsID, execution)
+			if err != nil {
+				return fmt.Errorf("unable to export workflow %s/%s: %s",
+					execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId(), err)
+			}
+			if format == exportFormatJSON {
+				// JSON drops the fields this tool does not know about, which the proto format keeps
+				if hasUnknownFields(execution.ProtoReflect()) {
+					return fmt.Errorf("unable to export workflow %s/%s as %s: it has fields unknown to this version of tdbg, use --%s %s",
+						execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId(),
+						exportFormatJSON, FlagExportFormat, exportFormatProto)
+				}
+				data, err := encoder.Encode(execution)
+				if err != nil {
+					return fmt.Errorf("unable to encode workflow execution: %s", err)
+				}
+				_, err = writer.Write(append(data, '\n'))
+				if err != nil {
+					return fmt.Errorf("unable to write export file: %s", err)
+				}
+			} else if _, err := protodelim.MarshalTo(writer, execution); err != nil {
+				return fmt.Errorf("unable to write export file: %s", err)
+			}
+		}
+		exported += len(resp.Executions)
+		token = resp.NextPageToken
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("unable to write export file: %s", err)
+	}
+	fmt.Printf("Exported %d workflow executions to %s\n", exported, outputFileName)
+	return nil
+}
+
+// hasUnknownFields reports whether a message, or any message nested in it, has fields unknown to its descriptor.
+func hasUnknownFields(message protoreflect.Message) bool {
+	if len(message.GetUnknown()) != 0 {
+		return true
+	}
+	found := false
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len() && !found; i++ {
+				found = hasUnknownFields(list.Get(i).Message())
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				found = hasUnknownFields(v.Message())
+				return !found
+			})
+		case !field.IsList() && !field.IsMap() && field.Message() != nil:
+			found = hasUnknownFields(value.Message())
+		}
+		return !found
+	})
+	return found
+}
+
+// exportWorkflowHistoryBranches reads the events of every history branch of an execution, one page at a time.
+func exportWorkflowHistoryBranches(
+	c *cli.Context,
+	client adminservice.AdminServiceClient,
+	nsID namespace.ID,
+	execution *adminservice.WorkflowExecutionExport,
+) ([]*adminservice.HistoryBranchExport, error) {
+	serializer := serialization.NewSerializer()
+	versionHistories := execution.GetMutableState().GetExecutionInfo().GetVersionHistories().GetHistories()
+	branches := make([]*adminservice.HistoryBranchExport, 0, len(versionHistories))
+	for _, versionHistory := range versionHistories {
+		firstItem, err := versionhistory.GetFirstVersionHistoryItem(versionHistory)
+		if err != nil {
+			return nil, err
+		}
+		lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+		if err != nil {
+			return nil, err
+		}
+
+		branch := &adminservice.HistoryBranchExport{VersionHistory: versionHistory}
+		var token []byte
+		for doContinue := true; doContinue; doContinue = len(token) != 0 {
+			ctx, cancel := newContext(c)
+			resp, err := client.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+				NamespaceId: nsID.String(),
+				Execution:   execution.GetExecution(),
+				// the API is exclusive-exclusive, and reads the branch of the version history containing the end event
+				StartEventId:      common.FirstEventID - 1,
+				StartEventVersion: firstItem.GetVersion(),
+				EndEventId:        lastItem.GetEventId() + 1,
+				EndEventVersion:   lastItem.GetVersion(),
+				MaximumPageSize:   100,
+				NextPageToken:     token,
+			})
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf("unable to recv History Branch: %s", err)
+			}
+			for _, blob := range resp.HistoryBatches {
+				events, err := serializer.DeserializeEvents(blob)
+				if err != nil {
+					return nil, fmt.Errorf("unable to deserialize Events: %s", err)
+				}
+				branch.HistoryBatches = append(branch.HistoryBatches, &historypb.History{Events: events})
+			}
+			token = resp.NextPageToken
+		}
+		branches = append(branches, branch)
+	}
+	return branches, nil
+}
+
+// AdminBulkImportWorkflows imports the executions of an export file into a namespace, which defaults to the namespace
+// they were exported from. Only the current history branch of each execution is imported, and its mutable state is
+// rebuilt from that branch, then the activities paused in the exported mutable state are paused again. The rest of
+// the exported mutable state and the other branches, e.g. the ones left behind by a reset, are not imported.
+func AdminBulkImportWorkflows(c *cli.Context, clientFactory ClientFactory) error {
+	inputFileName, err := getRequiredOption(c, FlagInputFilename)
+	if err != nil {
+		return err
+	}
+	format := c.String(FlagExportFormat)
+	if format != exportFormatJSON && format != exportFormatProto {
+		return fmt.Errorf("unknown export format %q, supported formats: %s, %s", format, exportFormatJSON, exportFormatProto)
+	}
+
+	client := clientFactory.AdminClient(c)
+
+	file, err := os.Open(inputFileName)
+	if err != nil {
+		return fmt.Errorf("unable to open export file: %s", err)
+	}
+	defer func() { _ = file.Close() }()
+	reader := bufio.NewReader(file)
+	encoder := codec.NewJSONPBEncoder()
+
+	imported := 0
+	skippedBranches := 0
+	for {
+		execution := &adminservice.WorkflowExecutionExport{}
+		if format == exportFormatJSON {
+			line, err := reader.ReadBytes('\n')
+			if err == io.EOF && len(bytes.TrimSpace(line)) == 0 {
+				break
+			} else if err != nil && err != io.EOF {
+				return fmt.Errorf("unable to read export file: %s", err)
+			}
+			if len(bytes.TrimSpace(line)) == 0 {
+				continue
+			}
+			if err := encoder.Decode(line, execution); err != nil {
+				return fmt.Errorf("unable to decode workflow execution: %s", err)
+			}
+		} else {
+			err := protodelim.UnmarshalOptions{MaxSize: -1}.UnmarshalFrom(reader, execution)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return fmt.Errorf("unable to decode workflow execution: %s", err)
+			}
+		}
+
+		nsName := execution.GetNamespace()
+		if c.IsSet(FlagNamespace) {
+			nsName = c.String(FlagNamespace)
+		}
+		currentBranchIndex := execution.GetMutableState().GetExecutionInfo().GetVersionHistories().GetCurrentVersionHistoryIndex()
+		if int(currentBranchIndex) >= len(execution.GetBranches()) {
+			return fmt.Errorf("unable to import workflow %s/%s: current history branch is not exported",
+				execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId())
+		}
+		ctx, cancel := newContext(c)
+		err := importWorkflowHistory(ctx, client, nsName, execution.GetExecution(), execution.GetBranches()[currentBranchIndex].GetHistoryBatches())
+		cancel()
+		if err == nil {
+			err = pauseImportedActivities(c, client, nsName, execution)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to import workflow %s/%s: %s",
+				execution.GetExecution().GetWorkflowId(), execution.GetExecution().GetRunId(), err)
+		}
+		imported++
+		skippedBranches += len(execution.GetBranches()) - 1
+	}
+	fmt.Printf("Imported %d workflow executions from %s\n", imported, inputFileName)
+	if skippedBranches > 0 {
+		fmt.Printf("Skipped %d history branches which are not current, only the current branch of an execution is imported\n", skippedBranches)
+	}
+	return nil
+}
+
+// pauseImportedActivities pauses the activities which are paused in the exported mutable state, since the pause is
+// not recorded in history and is lost when the mutable state is rebuilt from the imported events.
+func pauseImportedActivities(
+	c *cli.Context,
+	client adminservice.AdminServiceClient,
+	nsName string,
+	execution *adminservice.WorkflowExecutionExport,
+) error {
+	activityInfos := execution.GetMutableState().GetActivityInfos()
+	scheduledEventIDs := maps.Keys(activityInfos)
+	slices.Sort(scheduledEventIDs)
+	for _, scheduledEventID := range scheduledEventIDs {
+		activityInfo := activityInfos[scheduledEventID]
+		if !activityInfo.GetPaused() {
+			continue
+		}
+		ctx, cancel := newContext(c)
+		_, err := client.PauseActivity(ctx, &adminservice.PauseActivityRequest{
+			Namespace:  nsName,
+			Execution:  execution.GetExecution(),
+			ActivityId: activityInfo.GetActivityId(),
+		})
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to pause activity %s: %s", activityInfo.GetActivityId(), err)
+		}
+	}
+	return nil
+}
+
 // AdminDescribeWorkflow describe a new workflow execution for admin
 func AdminDescribeWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	resp, err := describeMutableState(c, clientFactory)
@@ -517,6 +802,241 @@ func AdminShardManagement(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
+// AdminDescribeShardPlacement describes the owner, placement and load of history shards
+func AdminDescribeShardPlacement(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	sid := int32(c.Int(FlagShardID))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeShardPlacement(ctx, &adminservice.DescribeShardPlacementRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe shard placement: %s", err)
+	}
+	if sid != 0 {
+		for _, shard := range resp.GetShards() {
+			if shard.GetShardId() == sid {
+				prettyPrintJSONObject(shard)
+				return nil
+			}
+		}
+		return fmt.Errorf("shard %v not found", sid)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminPinShard places a history shard on a history host
+func AdminPinShard(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+	owner, err := getRequiredOption(c, FlagHistoryAddress)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UpdateShardPlacement(ctx, &adminservice.UpdateShardPlacementRequest{
+		ShardId: int32(c.Int(FlagShardID)),
+		Owner:   owner,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pin shard: %s", err)
+	}
+	fmt.Println("Pin shard succeeded.")
+	return nil
+}
+
+// AdminUnpinShard removes the placement of a history shard
+func AdminUnpinShard(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err := adminClient.UpdateShardPlacement(ctx, &adminservice.UpdateShardPlacementRequest{
+		ShardId: int32(c.Int(FlagShardID)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unpin shard: %s", err)
+	}
+	fmt.Println("Unpin shard succeeded.")
+	return nil
+}
+
+// AdminDescribeReplicationFilters describes the replication filters of a namespace
+func AdminDescribeReplicationFilters(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetNamespaceReplicationFilters(ctx, &adminservice.GetNamespaceReplicationFiltersRequest{
+		Namespace: nsName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe replication filters: %s", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminUpdateReplicationFilters replaces the replication filters of a namespace
+func AdminUpdateReplicationFilters(c *cli.Context, clientFactory ClientFactory) error {
+	data, err := os.ReadFile(c.String(FlagInputFilename))
+	if err != nil {
+		return fmt.Errorf("unable to read replication filters file: %s", err)
+	}
+	filters := &adminservice.GetNamespaceReplicationFiltersResponse{}
+	if err := codec.NewJSONPBEncoder().Decode(data, filters); err != nil {
+		return fmt.Errorf("unable to deserialize replication filters: %s", err)
+	}
+	return updateReplicationFilters(c, clientFactory, filters.GetFilters())
+}
+
+// AdminClearReplicationFilters removes the replication filters of a namespace
+func AdminClearReplicationFilters(c *cli.Context, clientFactory ClientFactory) error {
+	return updateReplicationFilters(c, clientFactory, nil)
+}
+
+func updateReplicationFilters(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	filters []*persistencespb.ReplicationFilter,
+) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UpdateNamespaceReplicationFilters(ctx, &adminservice.UpdateNamespaceReplicationFiltersRequest{
+		Namespace: nsName,
+		Filters:   filters,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update replication filters: %s", err)
+	}
+	fmt.Println("Update replication filters succeeded.")
+	return nil
+}
+
+type (
+	shardReplicationStatusRow struct {
+		ShardID           int32
+		Cluster           string
+		AckedTaskID       int64
+		MaxTaskID         int64
+		OutstandingTasks  string
+		OldestUnackedTask time.Duration
+		DLQSize           string
+	}
+
+	replicationDivergenceRow struct {
+		WorkflowID string
+		RunID      string
+		Cluster    string
+		Divergence string
+		Local      string
+		Remote     string
+	}
+)
+
+// AdminReplicationStatus shows the replication status of each shard, and the sampled workflows diverging from the
+// remote clusters
+func AdminReplicationStatus(c *cli.Context, clientFactory ClientFactory) error {
+	request := &adminservice.GetReplicationStatusRequest{
+		RemoteClusters: c.StringSlice(FlagCluster),
+		SampleSize:     int32(c.Int(FlagSampleSize)),
+	}
+	if request.SampleSize > 0 {
+		nsName, err := getRequiredOption(c, FlagNamespace)
+		if err != nil {
+			return err
+		}
+		request.Namespace = nsName
+	}
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetReplicationStatus(ctx, request)
+	if err != nil {
+		return fmt.Errorf("unable to get replication status: %s", err)
+	}
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(resp)
+		return nil
+	}
+
+	var shardRows []interface{}
+	for _, shard := range resp.GetShards() {
+		clusters := maps.Keys(shard.GetRemoteClusters())
+		slices.Sort(clusters)
+		for _, cluster := range clusters {
+			status := shard.GetRemoteClusters()[cluster]
+			row := shardReplicationStatusRow{
+				ShardID:           shard.GetShardId(),
+				Cluster:           cluster,
+				AckedTaskID:       status.GetAckedTaskId(),
+				MaxTaskID:         shard.GetMaxReplicationTaskId(),
+				OutstandingTasks:  strconv.FormatInt(status.GetOutstandingTaskCount(), 10),
+				OldestUnackedTask: status.GetOldestUnackedTaskAge().AsDuration(),
+				DLQSize:           strconv.FormatInt(status.GetDlqSize(), 10),
+			}
+			if status.GetBacklogTruncated() {
+				// the counts are lower bounds
+				row.OutstandingTasks += "+"
+				row.DLQSize += "+"
+			}
+			shardRows = append(shardRows, row)
+		}
+	}
+	if err := printTable(shardRows, c.App.Writer); err != nil {
+		return err
+	}
+
+	if request.SampleSize <= 0 {
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "\nSampled %d workflows, %d divergences\n", resp.GetSampledExecutions(), len(resp.GetDivergences()))
+	var divergenceRows []interface{}
+	for _, divergence := range resp.GetDivergences() {
+		row := replicationDivergenceRow{
+			WorkflowID: divergence.GetExecution().GetWorkflowId(),
+			RunID:      divergence.GetExecution().GetRunId(),
+			Cluster:    divergence.GetRemoteCluster(),
+			Divergence: divergence.GetType().String(),
+			Local:      formatVersionHistory(divergence.GetLocalVersionHistory()),
+			Remote:     formatVersionHistory(divergence.GetRemoteVersionHistory()),
+		}
+		if divergence.GetError() != "" {
+			row.Divergence = "Error: " + divergence.GetError()
+		}
+		divergenceRows = append(divergenceRows, row)
+	}
+	return printTable(divergenceRows, c.App.Writer)
+}
+
+// formatVersionHistory formats the items of a version history as (event ID, version) pairs
+func formatVersionHistory(versionHistory *history.VersionHistory) string {
+	items := make([]string, 0, len(versionHistory.GetItems()))
+	for _, item := range versionHistory.GetItems() {
+		items = append(items, fmt.Sprintf("(%d, %d)", item.GetEventId(), item.GetVersion()))
+	}
+	return strings.Join(items, " ")
+}
+
 // AdminListGossipMembers outputs a list of gossip members
 func AdminListGossipMembers(c *cli.Context, clientFactory ClientFactory) error {
 	roleFlag := c.String(FlagClusterMembershipRole)
@@ -662,6 +1182,136 @@ func AdminRefreshWorkflowTasks(c *cli.Context, clientFactory ClientFactory) erro
 	return nil
 }
 
+// AdminPauseActivity stops dispatching and retrying a pending activity
+func AdminPauseActivity(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.PauseActivity(ctx, &adminservice.PauseActivityRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId: c.String(FlagActivityID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause activity: %s", err)
+	}
+	fmt.Println("Pause activity succeeded.")
+	return nil
+}
+
+// AdminUnpauseActivity resumes a paused activity
+func AdminUnpauseActivity(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.UnpauseActivity(ctx, &adminservice.UnpauseActivityRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId:    c.String(FlagActivityID),
+		ResetAttempts: c.Bool(FlagResetAttempts),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to unpause activity: %s", err)
+	}
+	fmt.Println("Unpause activity succeeded.")
+	return nil
+}
+
+// AdminSuspendWorkflow stops dispatching tasks of a workflow until it is resumed
+func AdminSuspendWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.SuspendWorkflowExecution(ctx, &adminservice.SuspendWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:   c.String(FlagReason),
+		Identity: c.String(FlagIdentity),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to suspend workflow: %s", err)
+	}
+	fmt.Println("Suspend workflow succeeded.")
+	return nil
+}
+
+// AdminResumeWorkflow resumes a suspended workflow
+func AdminResumeWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	_, err = adminClient.ResumeWorkflowExecution(ctx, &adminservice.ResumeWorkflowExecutionRequest{
+		Namespace: nsName,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: c.String(FlagIdentity),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to resume workflow: %s", err)
+	}
+	fmt.Println("Resume workflow succeeded.")
+	return nil
+}
+
 // AdminRebuildMutableState rebuild a workflow mutable state using persisted history events
 func AdminRebuildMutableState(c *cli.Context, clientFactory ClientFactory) error {
 	adminClient := clientFactory.AdminClient(c)
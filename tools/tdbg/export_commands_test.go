@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/tools/tdbg"
+	"go.temporal.io/server/tools/tdbg/tdbgtest"
+)
+
+type (
+	// exportClientFactory exports a single execution, and records what is imported and paused.
+	exportClientFactory struct {
+		t         *testing.T
+		execution *adminservice.WorkflowExecutionExport
+		events    []*historypb.HistoryEvent
+		imported  *[]*historypb.HistoryEvent
+		paused    *[]*adminservice.PauseActivityRequest
+	}
+	exportAdminClient struct {
+		adminservice.AdminServiceClient
+		exportClientFactory
+	}
+)
+
+func (f exportClientFactory) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	return diffWorkflowClient{}
+}
+
+func (f exportClientFactory) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return exportAdminClient{exportClientFactory: f}
+}
+
+func (c exportAdminClient) ExportWorkflowExecutions(
+	context.Context,
+	*adminservice.ExportWorkflowExecutionsRequest,
+	...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionsResponse, error) {
+	return &adminservice.ExportWorkflowExecutionsResponse{
+		Executions: []*adminservice.WorkflowExecutionExport{c.execution},
+	}, nil
+}
+
+func (c exportAdminClient) GetWorkflowExecutionRawHistoryV2(
+	context.Context,
+	*adminservice.GetWorkflowExecutionRawHistoryV2Request,
+	...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	blob, err := serialization.NewSerializer().SerializeEvents(c.events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(c.t, err)
+	return &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*commonpb.DataBlob{blob},
+	}, nil
+}
+
+func (c exportAdminClient) ImportWorkflowExecution(
+	_ context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	if len(request.GetHistoryBatches()) == 0 {
+		return &adminservice.ImportWorkflowExecutionResponse{}, nil
+	}
+	for _, blob := range request.GetHistoryBatches() {
+		events, err := serialization.NewSerializer().DeserializeEvents(blob)
+		require.NoError(c.t, err)
+		*c.imported = append(*c.imported, events...)
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{Token: []byte("test-token")}, nil
+}
+
+func (c exportAdminClient) PauseActivity(
+	_ context.Context,
+	request *adminservice.PauseActivityRequest,
+	_ ...grpc.CallOption,
+) (*adminservice.PauseActivityResponse, error) {
+	*c.paused = append(*c.paused, request)
+	return &adminservice.PauseActivityResponse{}, nil
+}
+
+func TestWorkflowExportAndBulkImportCommands(t *testing.T) {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "test-workflow", RunId: "test-run"}
+	newClientFactory := func(events []*historypb.HistoryEvent) exportClientFactory {
+		return exportClientFactory{
+			t: t,
+			execution: &adminservice.WorkflowExecutionExport{
+				Namespace: "test-namespace",
+				Execution: execution,
+				MutableState: &persistencespb.WorkflowMutableState{
+					ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+						VersionHistories: &historyspb.VersionHistories{
+							Histories: []*historyspb.VersionHistory{{
+								BranchToken: newTestBranchToken(t, "a"),
+								Items:       []*historyspb.VersionHistoryItem{{EventId: int64(len(events)), Version: 1}},
+							}},
+						},
+					},
+					ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+						5: {ScheduledEventId: 5, ActivityId: "paused-activity", Paused: true},
+						6: {ScheduledEventId: 6, ActivityId: "running-activity"},
+					},
+				},
+			},
+			events:   events,
+			imported: &[]*historypb.HistoryEvent{},
+			paused:   &[]*adminservice.PauseActivityRequest{},
+		}
+	}
+	newEvents := func() []*historypb.HistoryEvent {
+		return newTestHistory(
+			time.Now(),
+			enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+			enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED,
+			enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+			enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		)
+	}
+	run := func(clientFactory exportClientFactory, args ...string) error {
+		app := tdbgtest.NewCliApp(func(params *tdbg.Params) {
+			params.ClientFactory = clientFactory
+		})
+		return app.Run(append([]string{"tdbg"}, args...))
+	}
+	exportAndImport := func(t *testing.T, clientFactory exportClientFactory, format string) error {
+		fileName := filepath.Join(t.TempDir(), "export")
+		err := run(clientFactory, "workflow", "export",
+			"--"+tdbg.FlagOutputFilename, fileName, "--"+tdbg.FlagExportFormat, format)
+		if err != nil {
+			return err
+		}
+		return run(clientFactory, "workflow", "bulk-import",
+			"--"+tdbg.FlagInputFilename, fileName, "--"+tdbg.FlagExportFormat, format)
+	}
+
+	for _, format := range []string{"json", "proto"} {
+		t.Run(format, func(t *testing.T) {
+			events := newEvents()
+			clientFactory := newClientFactory(events)
+			require.NoError(t, exportAndImport(t, clientFactory, format))
+
+			require.Len(t, *clientFactory.imported, len(events))
+			for i, event := range events {
+				protorequire.ProtoEqual(t, event, (*clientFactory.imported)[i])
+			}
+			// the pause is not in history, so it is restored from the exported mutable state
+			require.Len(t, *clientFactory.paused, 1)
+			pause := (*clientFactory.paused)[0]
+			assert.Equal(t, "test-namespace", pause.GetNamespace())
+			protorequire.ProtoEqual(t, execution, pause.GetExecution())
+			assert.Equal(t, "paused-activity", pause.GetActivityId())
+		})
+	}
+
+	// an event written by a newer server, with a field this version does not know about
+	unknownField := protowire.AppendVarint(protowire.AppendTag(nil, 1000, protowire.VarintType), 1)
+	newEventsWithUnknownField := func() []*historypb.HistoryEvent {
+		events := newEvents()
+		events[4].ProtoReflect().SetUnknown(unknownField)
+		return events
+	}
+
+	t.Run("json with unknown fields", func(t *testing.T) {
+		clientFactory := newClientFactory(newEventsWithUnknownField())
+		err := exportAndImport(t, clientFactory, "json")
+		assert.ErrorContains(t, err, "unknown to this version of tdbg")
+		assert.Empty(t, *clientFactory.imported)
+	})
+
+	t.Run("proto with unknown fields", func(t *testing.T) {
+		clientFactory := newClientFactory(newEventsWithUnknownField())
+		require.NoError(t, exportAndImport(t, clientFactory, "proto"))
+		require.Len(t, *clientFactory.imported, 6)
+		assert.Equal(t, unknownField, []byte((*clientFactory.imported)[4].ProtoReflect().GetUnknown()))
+		require.Len(t, *clientFactory.paused, 1)
+	})
+}
@@ -36,6 +36,8 @@ var (
 	FlagWorkflowIDAlias            = []string{"wid"}
 	FlagRunID                      = "run-id"
 	FlagRunIDAlias                 = []string{"rid"}
+	FlagActivityID                 = "activity-id"
+	FlagResetAttempts              = "reset-attempts"
 	FlagNumberOfShards             = "number-of-shards"
 	FlagMinEventID                 = "min-event-id"
 	FlagMaxEventID                 = "max-event-id"
@@ -49,6 +51,7 @@ var (
 	FlagFrom                       = "from"
 	FlagPrintFullyDetail           = "print-full"
 	FlagPrintJSON                  = "print-json"
+	FlagSampleSize                 = "sample-size"
 	FlagHeartbeatedWithin          = "heartbeated-within"
 	FlagInputFilename              = "input-filename"
 	FlagOutputFilename             = "output-filename"
@@ -86,4 +89,15 @@ var (
 	FlagBase64File                 = "base64-file"
 	FlagTaskCategoryID             = "task-category-id"
 	FlagEncoding                   = "encoding"
+	FlagPartitionID                = "partition-id"
+	FlagBuildID                    = "build-id"
+	FlagWorkflowType               = "workflow-type"
+	FlagMinAge                     = "min-age"
+	FlagDestinationTaskQueue       = "destination-task-queue"
+	FlagIdentity                   = "identity"
+	FlagQuery                      = "query"
+	FlagExportFormat               = "format"
+	FlagOutputFormat               = "output-format"
+	FlagOtherRunID                 = "other-run-id"
+	FlagOtherAddress               = "other-address"
 )
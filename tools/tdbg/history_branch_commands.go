@@ -0,0 +1,294 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	branchesFormatDOT     = "dot"
+	branchesFormatMermaid = "mermaid"
+)
+
+type (
+	// historyBranchTree is the tree of history branches of a workflow execution, as recorded in its version histories.
+	historyBranchTree struct {
+		treeID string
+		// branches are ordered so that a branch always comes after the branch it was forked from.
+		branches []*historyBranchNode
+	}
+
+	// historyBranchNode is a single branch of a history tree.
+	historyBranchNode struct {
+		branchID string
+		// beginEventID is inclusive and endEventID is exclusive, like in a history branch range.
+		beginEventID int64
+		endEventID   int64
+		// parent is the branch this branch was forked from, if any. The branch shares all events of its parent before
+		// beginEventID.
+		parent      *historyBranchNode
+		versions    []string
+		resetPoints []string
+		current     bool
+	}
+)
+
+// AdminShowWorkflowBranches renders the history branches of a workflow execution as a graph
+func AdminShowWorkflowBranches(c *cli.Context, clientFactory ClientFactory) error {
+	format := c.String(FlagOutputFormat)
+	if format != branchesFormatDOT && format != branchesFormatMermaid {
+		return fmt.Errorf(
+			"invalid %s: %q, must be %q or %q", FlagOutputFormat, format, branchesFormatDOT, branchesFormatMermaid,
+		)
+	}
+
+	resp, err := describeMutableState(c, clientFactory)
+	if err != nil {
+		return err
+	}
+	mutableState := resp.GetDatabaseMutableState()
+	tree, err := newHistoryBranchTree(
+		mutableState.GetExecutionInfo().GetVersionHistories(),
+		mutableState.GetExecutionInfo().GetAutoResetPoints(),
+		mutableState.GetExecutionState().GetRunId(),
+	)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if format == branchesFormatMermaid {
+		tree.writeMermaid(&buf)
+	} else {
+		tree.writeDOT(&buf)
+	}
+
+	if outputFileName := c.String(FlagOutputFilename); outputFileName != "" {
+		if err := os.WriteFile(outputFileName, buf.Bytes(), 0666); err != nil {
+			return fmt.Errorf("unable to write output file: %s", err)
+		}
+		return nil
+	}
+	_, err = c.App.Writer.Write(buf.Bytes())
+	return err
+}
+
+// newHistoryBranchTree builds the branch tree from the branch tokens of the version histories. The ancestors of each
+// branch token are the ranges of the branches it was forked from, so the tree is rebuilt by linking each range to the
+// one before it.
+func newHistoryBranchTree(
+	versionHistories *historyspb.VersionHistories,
+	resetPoints *workflowpb.ResetPoints,
+	runID string,
+) (*historyBranchTree, error) {
+	if len(versionHistories.GetHistories()) == 0 {
+		return nil, errors.New("workflow execution has no version histories")
+	}
+	currentIndex := int(versionHistories.GetCurrentVersionHistoryIndex())
+	if currentIndex < 0 || currentIndex >= len(versionHistories.GetHistories()) {
+		return nil, fmt.Errorf("invalid current version history index: %d", currentIndex)
+	}
+
+	branchUtil := &persistence.HistoryBranchUtilImpl{}
+	tree := &historyBranchTree{}
+	branchesByID := make(map[string]*historyBranchNode)
+	getBranch := func(branchID string, beginEventID int64, endEventID int64, parent *historyBranchNode) *historyBranchNode {
+		branch, ok := branchesByID[branchID]
+		if !ok {
+			branch = &historyBranchNode{
+				branchID:     branchID,
+				beginEventID: beginEventID,
+				endEventID:   endEventID,
+				parent:       parent,
+			}
+			branchesByID[branchID] = branch
+			tree.branches = append(tree.branches, branch)
+		}
+		branch.beginEventID = min(branch.beginEventID, beginEventID)
+		branch.endEventID = max(branch.endEventID, endEventID)
+		return branch
+	}
+
+	// paths contains the branches of each version history, in event ID order.
+	paths := make([][]*historyBranchNode, len(versionHistories.GetHistories()))
+	for i, versionHistory := range versionHistories.GetHistories() {
+		branchInfo, err := branchUtil.ParseHistoryBranchInfo(versionHistory.GetBranchToken())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse branch token of version history %d: %s", i, err)
+		}
+		tree.treeID = branchInfo.GetTreeId()
+
+		var parent *historyBranchNode
+		beginEventID := common.FirstEventID
+		for _, ancestor := range branchInfo.GetAncestors() {
+			parent = getBranch(ancestor.GetBranchId(), ancestor.GetBeginNodeId(), ancestor.GetEndNodeId(), parent)
+			paths[i] = append(paths[i], parent)
+			beginEventID = ancestor.GetEndNodeId()
+		}
+		var endEventID int64
+		if items := versionHistory.GetItems(); len(items) > 0 {
+			endEventID = items[len(items)-1].GetEventId() + 1
+		}
+		branch := getBranch(branchInfo.GetBranchId(), beginEventID, max(beginEventID, endEventID), parent)
+		paths[i] = append(paths[i], branch)
+		if i == currentIndex {
+			branch.current = true
+		}
+	}
+
+	for _, branch := range tree.branches {
+		for i, path := range paths {
+			if slices.Contains(path, branch) {
+				branch.versions = formatBranchVersions(versionHistories.GetHistories()[i], branch)
+				break
+			}
+		}
+	}
+
+	// Reset points refer to events of the current run, so they are annotated on the branches of the current version
+	// history.
+	currentPath := paths[currentIndex]
+	for _, point := range resetPoints.GetPoints() {
+		if point.GetRunId() != runID {
+			continue
+		}
+		eventID := point.GetFirstWorkflowTaskCompletedId()
+		for _, branch := range currentPath {
+			if eventID >= branch.beginEventID && eventID < branch.endEventID {
+				branch.resetPoints = append(branch.resetPoints, formatResetPoint(point))
+				break
+			}
+		}
+	}
+
+	return tree, nil
+}
+
+// formatBranchVersions returns the version of each range of events of the branch, according to the version history.
+func formatBranchVersions(versionHistory *historyspb.VersionHistory, branch *historyBranchNode) []string {
+	var versions []string
+	firstEventID := common.FirstEventID
+	for _, item := range versionHistory.GetItems() {
+		begin := max(firstEventID, branch.beginEventID)
+		end := min(item.GetEventId(), branch.endEventID-1)
+		if begin <= end {
+			versions = append(versions, fmt.Sprintf("version %d: events %d-%d", item.GetVersion(), begin, end))
+		}
+		firstEventID = item.GetEventId() + 1
+	}
+	return versions
+}
+
+func formatResetPoint(point *workflowpb.ResetPointInfo) string {
+	description := fmt.Sprintf("reset point: event %d", point.GetFirstWorkflowTaskCompletedId())
+	if point.GetBuildId() != "" {
+		description += ", build id " + point.GetBuildId()
+	} else if point.GetBinaryChecksum() != "" {
+		description += ", binary checksum " + point.GetBinaryChecksum()
+	}
+	if !point.GetResettable() {
+		description += ", not resettable"
+	}
+	return description
+}
+
+func (b *historyBranchNode) labelLines() []string {
+	lines := []string{
+		"branch " + b.branchID,
+		fmt.Sprintf("events %d-%d", b.beginEventID, b.endEventID-1),
+	}
+	lines = append(lines, b.versions...)
+	lines = append(lines, b.resetPoints...)
+	if b.current {
+		lines = append(lines, "(current)")
+	}
+	return lines
+}
+
+func (t *historyBranchTree) writeDOT(w io.Writer) {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace
+	fmt.Fprintf(w, "digraph \"history-tree-%s\" {\n", escape(t.treeID))
+	fmt.Fprintln(w, "  node [shape=box];")
+	for _, branch := range t.branches {
+		lines := branch.labelLines()
+		for i, line := range lines {
+			lines[i] = escape(line)
+		}
+		style := ""
+		if branch.current {
+			style = ", style=bold"
+		}
+		fmt.Fprintf(w, "  \"%s\" [label=\"%s\"%s];\n", escape(branch.branchID), strings.Join(lines, `\n`), style)
+	}
+	for _, branch := range t.branches {
+		if branch.parent != nil {
+			fmt.Fprintf(
+				w, "  \"%s\" -> \"%s\" [label=\"fork at event %d\"];\n",
+				escape(branch.parent.branchID), escape(branch.branchID), branch.beginEventID,
+			)
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
+
+func (t *historyBranchTree) writeMermaid(w io.Writer) {
+	escape := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace
+	nodeIDs := make(map[*historyBranchNode]string, len(t.branches))
+	fmt.Fprintln(w, "graph TD")
+	for i, branch := range t.branches {
+		nodeIDs[branch] = fmt.Sprintf("b%d", i)
+		lines := branch.labelLines()
+		for i, line := range lines {
+			lines[i] = escape(line)
+		}
+		fmt.Fprintf(w, "  %s[\"%s\"]\n", nodeIDs[branch], strings.Join(lines, "<br/>"))
+	}
+	for _, branch := range t.branches {
+		if branch.parent != nil {
+			fmt.Fprintf(
+				w, "  %s -->|\"fork at event %d\"| %s\n", nodeIDs[branch.parent], branch.beginEventID, nodeIDs[branch],
+			)
+		}
+	}
+	for _, branch := range t.branches {
+		if branch.current {
+			fmt.Fprintf(w, "  style %s stroke-width:3px\n", nodeIDs[branch])
+		}
+	}
+}
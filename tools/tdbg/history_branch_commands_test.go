@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/tools/tdbg"
+	"go.temporal.io/server/tools/tdbg/tdbgtest"
+)
+
+type (
+	branchesClientFactory struct {
+		mutableState *persistencespb.WorkflowMutableState
+	}
+	branchesAdminClient struct {
+		adminservice.AdminServiceClient
+		mutableState *persistencespb.WorkflowMutableState
+	}
+)
+
+func (f branchesClientFactory) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("not implemented")
+}
+
+func (f branchesClientFactory) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return branchesAdminClient{mutableState: f.mutableState}
+}
+
+func (c branchesAdminClient) DescribeMutableState(
+	context.Context,
+	*adminservice.DescribeMutableStateRequest,
+	...grpc.CallOption,
+) (*adminservice.DescribeMutableStateResponse, error) {
+	return &adminservice.DescribeMutableStateResponse{DatabaseMutableState: c.mutableState}, nil
+}
+
+func newTestBranchToken(t *testing.T, branchID string, ancestors ...*persistencespb.HistoryBranchRange) []byte {
+	token, err := persistence.NewHistoryBranch("test-tree", &branchID, ancestors)
+	require.NoError(t, err)
+	return token
+}
+
+func TestWorkflowBranchesCommand(t *testing.T) {
+	// Branch "b" was forked from branch "a" at event 8, e.g. by a conflict resolution, and is now the current branch.
+	mutableState := &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			VersionHistories: &historyspb.VersionHistories{
+				CurrentVersionHistoryIndex: 1,
+				Histories: []*historyspb.VersionHistory{
+					{
+						BranchToken: newTestBranchToken(t, "a"),
+						Items: []*historyspb.VersionHistoryItem{
+							{EventId: 5, Version: 1},
+							{EventId: 10, Version: 2},
+						},
+					},
+					{
+						BranchToken: newTestBranchToken(t, "b", &persistencespb.HistoryBranchRange{
+							BranchId:    "a",
+							BeginNodeId: 1,
+							EndNodeId:   8,
+						}),
+						Items: []*historyspb.VersionHistoryItem{
+							{EventId: 5, Version: 1},
+							{EventId: 7, Version: 2},
+							{EventId: 12, Version: 3},
+						},
+					},
+				},
+			},
+			AutoResetPoints: &workflowpb.ResetPoints{
+				Points: []*workflowpb.ResetPointInfo{
+					{RunId: "test-run", FirstWorkflowTaskCompletedId: 4, BuildId: "build-1", Resettable: true},
+					{RunId: "other-run", FirstWorkflowTaskCompletedId: 3, BuildId: "build-0", Resettable: true},
+				},
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "test-run"},
+	}
+
+	runBranches := func(t *testing.T, format string) (string, error) {
+		app := tdbgtest.NewCliApp(func(params *tdbg.Params) {
+			params.ClientFactory = branchesClientFactory{mutableState: mutableState}
+		})
+		var buf bytes.Buffer
+		app.Writer = &buf
+		args := []string{"tdbg", "workflow", "branches", "--" + tdbg.FlagWorkflowID, "test-workflow"}
+		args = appendArg(args, tdbg.FlagOutputFormat, format)
+		err := app.Run(args)
+		return buf.String(), err
+	}
+
+	t.Run("dot", func(t *testing.T) {
+		output, err := runBranches(t, "")
+		require.NoError(t, err)
+		assert.Equal(t, `digraph "history-tree-test-tree" {
+  node [shape=box];
+  "a" [label="branch a\nevents 1-10\nversion 1: events 1-5\nversion 2: events 6-10\nreset point: event 4, build id build-1"];
+  "b" [label="branch b\nevents 8-12\nversion 3: events 8-12\n(current)", style=bold];
+  "a" -> "b" [label="fork at event 8"];
+}
+`, output)
+	})
+
+	t.Run("mermaid", func(t *testing.T) {
+		output, err := runBranches(t, "mermaid")
+		require.NoError(t, err)
+		assert.Equal(t, `graph TD
+  b0["branch a<br/>events 1-10<br/>version 1: events 1-5<br/>version 2: events 6-10<br/>reset point: event 4, build id build-1"]
+  b1["branch b<br/>events 8-12<br/>version 3: events 8-12<br/>(current)"]
+  b0 -->|"fork at event 8"| b1
+  style b1 stroke-width:3px
+`, output)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		_, err := runBranches(t, "svg")
+		assert.ErrorContains(t, err, tdbg.FlagOutputFormat)
+	})
+}
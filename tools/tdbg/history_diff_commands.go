@@ -0,0 +1,251 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/temporalio/tctl-kit/pkg/color"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	historyEventDiffRow struct {
+		EventID    int64
+		Left       string
+		Right      string
+		Difference string
+	}
+
+	historyEventDiff struct {
+		row   historyEventDiffRow
+		left  *historypb.HistoryEvent
+		right *historypb.HistoryEvent
+	}
+)
+
+// AdminDiffWorkflow compares the history of a workflow execution event by event with either the history of another
+// run, or the history of the same execution in another cluster
+func AdminDiffWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return err
+	}
+	otherRunID := c.String(FlagOtherRunID)
+	otherAddress := c.String(FlagOtherAddress)
+	if otherRunID == "" && otherAddress == "" {
+		return fmt.Errorf("at least one of %s and %s is required", FlagOtherRunID, FlagOtherAddress)
+	}
+	if otherRunID == "" {
+		otherRunID = rid
+	}
+
+	nsID, err := getNamespaceID(c, clientFactory, namespace.Name(nsName))
+	if err != nil {
+		return err
+	}
+	client := clientFactory.AdminClient(c)
+	otherClient := client
+	if otherAddress != "" {
+		otherClient, err = otherClusterAdminClient(c, clientFactory, otherAddress)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	left, err := getWorkflowHistoryEvents(ctx, client, nsID, wid, rid)
+	if err != nil {
+		return err
+	}
+	right, err := getWorkflowHistoryEvents(ctx, otherClient, nsID, wid, otherRunID)
+	if err != nil {
+		return err
+	}
+
+	diffs := diffHistoryEvents(left, right)
+	rows := make([]interface{}, 0, len(diffs))
+	for _, diff := range diffs {
+		rows = append(rows, diff.row)
+	}
+	if err := printTable(rows, c.App.Writer); err != nil {
+		return err
+	}
+	if c.Bool(FlagPrintFullyDetail) {
+		for _, diff := range diffs {
+			fmt.Fprintln(c.App.Writer, color.Green(c, "Event %d:", diff.row.EventID))
+			if diff.left != nil {
+				prettyPrintJSONObject(diff.left)
+			}
+			if diff.right != nil {
+				prettyPrintJSONObject(diff.right)
+			}
+		}
+	}
+	fmt.Fprintf(
+		c.App.Writer, "Compared %d and %d events, %d differences\n", len(left), len(right), len(diffs),
+	)
+	return nil
+}
+
+// otherClusterAdminClient builds an admin client for the frontend of another cluster. It goes through the same client
+// factory, with the address flag temporarily pointing to the other frontend, so that the TLS flags apply to both
+// clusters.
+func otherClusterAdminClient(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	otherAddress string,
+) (adminservice.AdminServiceClient, error) {
+	address := c.String(FlagAddress)
+	if err := setGlobalFlag(c, FlagAddress, otherAddress); err != nil {
+		return nil, err
+	}
+	client := clientFactory.AdminClient(c)
+	if err := setGlobalFlag(c, FlagAddress, address); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// setGlobalFlag sets a flag which is defined by the app rather than by the command, since cli.Context.Set only looks
+// up the flags of its own command.
+func setGlobalFlag(c *cli.Context, name string, value string) error {
+	var err error
+	for _, ctx := range c.Lineage() {
+		if err = ctx.Set(name, value); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("unable to set %s: %s", name, err)
+}
+
+func getWorkflowHistoryEvents(
+	ctx context.Context,
+	client adminservice.AdminServiceClient,
+	nsID namespace.ID,
+	wid string,
+	rid string,
+) ([]*historypb.HistoryEvent, error) {
+	serializer := serialization.NewSerializer()
+	var events []*historypb.HistoryEvent
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		resp, err := client.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId: nsID.String(),
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      rid,
+			},
+			EndEventId:      math.MaxInt64,
+			MaximumPageSize: 100,
+			NextPageToken:   token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get history of run %s: %s", rid, err)
+		}
+		for _, blob := range resp.GetHistoryBatches() {
+			batch, err := serializer.DeserializeEvents(blob)
+			if err != nil {
+				return nil, fmt.Errorf("unable to deserialize Events: %s", err)
+			}
+			events = append(events, batch...)
+		}
+		token = resp.NextPageToken
+	}
+	return events, nil
+}
+
+// diffHistoryEvents compares two histories event by event, and returns the events which differ. Event times and task
+// IDs are ignored because they're expected to differ between runs and between clusters.
+func diffHistoryEvents(left []*historypb.HistoryEvent, right []*historypb.HistoryEvent) []historyEventDiff {
+	var diffs []historyEventDiff
+	for i := 0; i < max(len(left), len(right)); i++ {
+		var diff historyEventDiff
+		if i < len(left) {
+			diff.left = left[i]
+			diff.row.EventID = left[i].GetEventId()
+			diff.row.Left = formatHistoryEvent(left[i])
+		}
+		if i < len(right) {
+			diff.right = right[i]
+			diff.row.EventID = right[i].GetEventId()
+			diff.row.Right = formatHistoryEvent(right[i])
+		}
+
+		diff.row.Difference = compareHistoryEvents(diff.left, diff.right)
+		if diff.row.Difference != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
+
+// compareHistoryEvents returns which part of the events differ, or an empty string if they are the same.
+func compareHistoryEvents(left *historypb.HistoryEvent, right *historypb.HistoryEvent) string {
+	switch {
+	case left == nil:
+		return "missing on the left"
+	case right == nil:
+		return "missing on the right"
+	case left.GetEventId() != right.GetEventId():
+		return "event ID"
+	case left.GetEventType() != right.GetEventType():
+		return "event type"
+	case left.GetVersion() != right.GetVersion():
+		return "version"
+	}
+
+	left = proto.Clone(left).(*historypb.HistoryEvent)
+	right = proto.Clone(right).(*historypb.HistoryEvent)
+	left.EventTime, right.EventTime = nil, nil
+	left.TaskId, right.TaskId = 0, 0
+	if !proto.Equal(left, right) {
+		return "attributes"
+	}
+	return ""
+}
+
+func formatHistoryEvent(event *historypb.HistoryEvent) string {
+	return fmt.Sprintf("%s (version %d)", event.GetEventType(), event.GetVersion())
+}
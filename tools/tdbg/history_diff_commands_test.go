@@ -0,0 +1,196 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tdbg_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/tools/tdbg"
+	"go.temporal.io/server/tools/tdbg/tdbgtest"
+)
+
+type (
+	// diffClientFactory serves the histories of runs by frontend address and run ID.
+	diffClientFactory struct {
+		t         *testing.T
+		histories map[string]map[string][]*historypb.HistoryEvent
+	}
+	diffAdminClient struct {
+		adminservice.AdminServiceClient
+		diffClientFactory
+		address string
+	}
+	diffWorkflowClient struct {
+		workflowservice.WorkflowServiceClient
+	}
+)
+
+func (f diffClientFactory) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	return diffWorkflowClient{}
+}
+
+func (f diffClientFactory) AdminClient(c *cli.Context) adminservice.AdminServiceClient {
+	return diffAdminClient{diffClientFactory: f, address: c.String(tdbg.FlagAddress)}
+}
+
+func (c diffWorkflowClient) DescribeNamespace(
+	context.Context,
+	*workflowservice.DescribeNamespaceRequest,
+	...grpc.CallOption,
+) (*workflowservice.DescribeNamespaceResponse, error) {
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Id: "test-namespace-id"},
+	}, nil
+}
+
+func (c diffAdminClient) GetWorkflowExecutionRawHistoryV2(
+	_ context.Context,
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+	_ ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	events, ok := c.histories[c.address][request.GetExecution().GetRunId()]
+	if !ok {
+		return nil, serviceerror.NewNotFound("workflow execution not found")
+	}
+	blob, err := serialization.NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(c.t, err)
+	return &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: []*commonpb.DataBlob{blob},
+	}, nil
+}
+
+func newTestHistory(now time.Time, eventTypes ...enumspb.EventType) []*historypb.HistoryEvent {
+	events := make([]*historypb.HistoryEvent, len(eventTypes))
+	for i, eventType := range eventTypes {
+		events[i] = &historypb.HistoryEvent{
+			EventId:   int64(i + 1),
+			EventTime: timestamppb.New(now.Add(time.Duration(i) * time.Second)),
+			EventType: eventType,
+			Version:   1,
+			TaskId:    int64(i + 100),
+		}
+	}
+	return events
+}
+
+func TestWorkflowDiffCommand(t *testing.T) {
+	now := time.Now()
+	history := newTestHistory(
+		now,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED,
+	)
+	// Event times and task IDs are expected to differ between clusters, so they must not be reported.
+	sameHistory := newTestHistory(
+		now.Add(time.Minute),
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED,
+	)
+	for _, event := range sameHistory {
+		event.TaskId += 1000
+	}
+	otherHistory := newTestHistory(
+		now,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT,
+		enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+	)
+	otherHistory[1].Version = 2
+
+	clientFactory := diffClientFactory{
+		t: t,
+		histories: map[string]map[string][]*historypb.HistoryEvent{
+			"": {
+				"test-run":  history,
+				"other-run": otherHistory,
+			},
+			"other-cluster:7233": {
+				"test-run": sameHistory,
+			},
+		},
+	}
+	runDiff := func(t *testing.T, args ...string) (string, error) {
+		app := tdbgtest.NewCliApp(func(params *tdbg.Params) {
+			params.ClientFactory = clientFactory
+		})
+		var buf bytes.Buffer
+		app.Writer = &buf
+		err := app.Run(append([]string{
+			"tdbg", "workflow", "diff",
+			"--" + tdbg.FlagWorkflowID, "test-workflow",
+			"--" + tdbg.FlagRunID, "test-run",
+		}, args...))
+		return buf.String(), err
+	}
+
+	t.Run("other run", func(t *testing.T) {
+		output, err := runDiff(t, "--"+tdbg.FlagOtherRunID, "other-run")
+		require.NoError(t, err)
+		assert.Contains(t, output, "version")
+		assert.Contains(t, output, "event type")
+		assert.Contains(t, output, "missing on the left")
+		assert.Contains(t, output, "Compared 3 and 4 events, 3 differences")
+	})
+
+	t.Run("other cluster", func(t *testing.T) {
+		output, err := runDiff(t, "--"+tdbg.FlagOtherAddress, "other-cluster:7233")
+		require.NoError(t, err)
+		assert.Contains(t, output, "Compared 3 and 3 events, 0 differences")
+	})
+
+	t.Run("run not found in other cluster", func(t *testing.T) {
+		_, err := runDiff(
+			t,
+			"--"+tdbg.FlagOtherAddress, "other-cluster:7233",
+			"--"+tdbg.FlagOtherRunID, "other-run",
+		)
+		assert.ErrorContains(t, err, "other-run")
+	})
+
+	t.Run("nothing to compare with", func(t *testing.T) {
+		_, err := runDiff(t)
+		assert.ErrorContains(t, err, tdbg.FlagOtherRunID)
+	})
+}
@@ -26,10 +26,15 @@ package tdbg
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -105,3 +110,192 @@ func AdminListTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error
 	}
 	return nil
 }
+
+// AdminListBacklogTasks displays tasks in the backlog of a task queue partition
+func AdminListBacklogTasks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := getTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+	pageSize := defaultPageSize
+	if c.IsSet(FlagPageSize) {
+		pageSize = c.Int(FlagPageSize)
+	}
+
+	client := clientFactory.AdminClient(c)
+
+	req := &adminservice.ListBacklogTasksRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+		PartitionId:   int32(c.Int(FlagPartitionID)),
+		BuildId:       c.String(FlagBuildID),
+		Filter: &taskqueuespb.BacklogTaskFilter{
+			WorkflowId:   c.String(FlagWorkflowID),
+			RunId:        c.String(FlagRunID),
+			WorkflowType: c.String(FlagWorkflowType),
+		},
+		PageSize: int32(pageSize),
+	}
+	if c.IsSet(FlagMinAge) {
+		req.Filter.MinAge = durationpb.New(c.Duration(FlagMinAge))
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
+		req.NextPageToken = paginationToken
+		response, err := client.ListBacklogTasks(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []interface{}
+		for _, task := range response.Tasks {
+			items = append(items, task)
+		}
+		return items, response.NextPageToken, nil
+	}
+
+	if err := paginate(c, paginationFunc, pageSize); err != nil {
+		return fmt.Errorf("unable to list backlog tasks: %v", err)
+	}
+	return nil
+}
+
+// AdminDeleteBacklogTasks deletes tasks from the backlog of a task queue partition, optionally
+// moving them to another task queue
+func AdminDeleteBacklogTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := getTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+	taskIDs := c.Int64Slice(FlagTaskID)
+	if len(taskIDs) == 0 {
+		return fmt.Errorf("option %s is required", FlagTaskID)
+	}
+	destination := c.String(FlagDestinationTaskQueue)
+
+	action := "Delete"
+	if destination != "" {
+		action = fmt.Sprintf("Move to task queue %q", destination)
+	}
+	ids := make([]string, len(taskIDs))
+	for i, id := range taskIDs {
+		ids[i] = fmt.Sprint(id)
+	}
+	prompter.Prompt(fmt.Sprintf("Namespace: %s TaskQueue: %s Partition: %d TaskIDs: %s\n%s above tasks?",
+		namespace, tqName, c.Int(FlagPartitionID), strings.Join(ids, ","), action))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.DeleteBacklogTasks(ctx, &adminservice.DeleteBacklogTasksRequest{
+		Namespace:            namespace,
+		TaskQueue:            tqName,
+		TaskQueueType:        tqType,
+		PartitionId:          int32(c.Int(FlagPartitionID)),
+		BuildId:              c.String(FlagBuildID),
+		TaskIds:              taskIDs,
+		DestinationTaskQueue: destination,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete backlog tasks: %v", err)
+	}
+	prettyPrintJSONObject(resp)
+	var failed []string
+	for _, result := range resp.GetResults() {
+		if result.GetStatus() == enumsspb.BACKLOG_TASK_DELETION_STATUS_FAILED {
+			failed = append(failed, fmt.Sprint(result.GetTaskId()))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to delete tasks %s", strings.Join(failed, ","))
+	}
+	return nil
+}
+
+// AdminListWorkers lists the workers of a task queue
+func AdminListWorkers(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.ListWorkers(ctx, &adminservice.ListWorkersRequest{
+		Namespace: namespace,
+		TaskQueue: tqName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list workers: %v", err)
+	}
+	prettyPrintJSONObject(resp)
+	return nil
+}
+
+// AdminDescribeWorker describes a single worker of a task queue
+func AdminDescribeWorker(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	identity, err := getRequiredOption(c, FlagIdentity)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := client.DescribeWorker(ctx, &adminservice.DescribeWorkerRequest{
+		Namespace: namespace,
+		TaskQueue: tqName,
+		Identity:  identity,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe worker: %v", err)
+	}
+	prettyPrintJSONObject(resp.Worker)
+	return nil
+}
+
+func getTaskQueueType(c *cli.Context) (enumspb.TaskQueueType, error) {
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return tqType, fmt.Errorf("missing Task Queue type")
+	}
+	return tqType, nil
+}
@@ -58,6 +58,18 @@ func getCommands(
 			Usage:       "Run admin operation on specific shard",
 			Subcommands: newAdminShardManagementCommands(clientFactory, taskCategoryRegistry),
 		},
+		{
+			Name:        "namespace",
+			Aliases:     []string{"ns"},
+			Usage:       "Run admin operation on namespace",
+			Subcommands: newAdminNamespaceCommands(clientFactory),
+		},
+		{
+			Name:        "replication",
+			Aliases:     []string{"r"},
+			Usage:       "Run admin operation on replication",
+			Subcommands: newAdminReplicationCommands(clientFactory),
+		},
 		{
 			Name:        "history-host",
 			Aliases:     []string{"h"},
@@ -68,7 +80,7 @@ func getCommands(
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
 			Usage:       "Run admin operation on taskQueue",
-			Subcommands: newAdminTaskQueueCommands(clientFactory),
+			Subcommands: newAdminTaskQueueCommands(clientFactory, prompterFactory),
 		},
 		{
 			Name:        "membership",
@@ -120,6 +132,54 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminImportWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "export",
+			Usage: "export all history branches and the mutable state of the workflows matching a visibility query to a file",
+			Description: "The json format fails on workflows with fields unknown to this version of tdbg, since JSON " +
+				"would drop them; the proto format keeps them.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagQuery,
+					Usage: "Visibility query of the workflows to export, all workflows by default",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "output file",
+				},
+				&cli.StringFlag{
+					Name:  FlagExportFormat,
+					Usage: "Format of the output file: json (one workflow per line) or proto (length-delimited messages)",
+					Value: exportFormatJSON,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of workflows to export per request",
+					Value: 10,
+				}},
+			Action: func(c *cli.Context) error {
+				return AdminExportWorkflows(c, clientFactory)
+			},
+		},
+		{
+			Name:  "bulk-import",
+			Usage: "import the current history branch of the workflows of an export file to database, into their original namespace unless --namespace is set",
+			Description: "The mutable state of each workflow is rebuilt from its current history branch, then the activities " +
+				"paused in the exported mutable state are paused again. The rest of the exported mutable state, e.g. " +
+				"activity attempts, and the history branches which are not current are not imported.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "input file",
+				},
+				&cli.StringFlag{
+					Name:  FlagExportFormat,
+					Usage: "Format of the input file: json or proto",
+					Value: exportFormatJSON,
+				}},
+			Action: func(c *cli.Context) error {
+				return AdminBulkImportWorkflows(c, clientFactory)
+			},
+		},
 		{
 			Name:  "show",
 			Usage: "show workflow history from database",
@@ -179,6 +239,65 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminDescribeWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "branches",
+			Usage: "Render the history branches of a workflow execution as a tree, with event ID ranges, versions and reset points",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFormat,
+					Usage: "Format of the output: dot (Graphviz) or mermaid",
+					Value: branchesFormatDOT,
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "output file, stdout by default",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminShowWorkflowBranches(c, clientFactory)
+			},
+		},
+		{
+			Name:  "diff",
+			Usage: "Compare the history of a workflow execution event by event with another run, or with the same run in another cluster",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagOtherRunID,
+					Usage: "Run ID to compare with, the same run ID by default",
+				},
+				&cli.StringFlag{
+					Name:  FlagOtherAddress,
+					Usage: "Frontend address of the other cluster to compare with, the same cluster by default",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintFullyDetail,
+					Usage: "Print the differing events in full",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDiffWorkflow(c, clientFactory)
+			},
+		},
 		{
 			Name:    "refresh-tasks",
 			Aliases: []string{"rt"},
@@ -239,6 +358,167 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				return AdminDeleteWorkflow(c, clientFactory, prompterFactory(c))
 			},
 		},
+		{
+			Name:  "pause-activity",
+			Usage: "Stop dispatching and retrying a pending activity until it is unpaused",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:     FlagActivityID,
+					Usage:    "Activity ID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPauseActivity(c, clientFactory)
+			},
+		},
+		{
+			Name:  "unpause-activity",
+			Usage: "Resume a paused activity",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:     FlagActivityID,
+					Usage:    "Activity ID",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Restart the retry policy of the activity from the first attempt",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUnpauseActivity(c, clientFactory)
+			},
+		},
+		{
+			Name:  "suspend",
+			Usage: "Stop dispatching workflow tasks, user timers and activities of a workflow until it is resumed",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for suspending the workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSuspendWorkflow(c, clientFactory)
+			},
+		},
+		{
+			Name:  "resume",
+			Usage: "Resume a suspended workflow",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminResumeWorkflow(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminNamespaceCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "describe-replication-filters",
+			Usage: "Describe the filters excluding workflows of a namespace from replication",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeReplicationFilters(c, clientFactory)
+			},
+		},
+		{
+			Name:  "update-replication-filters",
+			Usage: "Replace the filters excluding workflows of a namespace from replication with the filters of a file, in the format describe-replication-filters prints",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagInputFilename,
+					Usage:    "input file",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateReplicationFilters(c, clientFactory)
+			},
+		},
+		{
+			Name:  "clear-replication-filters",
+			Usage: "Remove the filters excluding workflows of a namespace from replication",
+			Action: func(c *cli.Context) error {
+				return AdminClearReplicationFilters(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminReplicationCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "status",
+			Usage: "Show the replication ack level, outstanding tasks, oldest unacked task age and DLQ size of each shard for remote clusters",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  FlagCluster,
+					Usage: "Remote cluster to show, all remote clusters are shown if not set",
+				},
+				&cli.IntFlag{
+					Name:  FlagSampleSize,
+					Usage: "Number of running workflows of the namespace to compare with the remote clusters to detect divergence, no workflows are compared if not set",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminReplicationStatus(c, clientFactory)
+			},
+		},
 	}
 }
 
@@ -331,6 +611,52 @@ func newAdminShardManagementCommands(clientFactory ClientFactory, taskCategoryRe
 				return AdminShardManagement(c, clientFactory)
 			},
 		},
+		{
+			Name:  "describe-placement",
+			Usage: "Describe the owner, placement and load of history shards",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId, all shards are described if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeShardPlacement(c, clientFactory)
+			},
+		},
+		{
+			Name:  "pin",
+			Usage: "Pin a shard to a history host, which is honored in the load-aware shard placement mode",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "ShardId",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagHistoryAddress,
+					Usage:    "Identity of the history host (IP:PORT)",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPinShard(c, clientFactory)
+			},
+		},
+		{
+			Name:  "unpin",
+			Usage: "Remove the placement of a shard, moving it back to the host membership assigns it to",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "ShardId",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUnpinShard(c, clientFactory)
+			},
+		},
 		{
 			Name:    "remove-task",
 			Aliases: []string{"rmtk"},
@@ -464,7 +790,7 @@ func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
 	}
 }
 
-func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminTaskQueueCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "list-tasks",
@@ -506,6 +832,133 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminListTaskQueueTasks(c, clientFactory)
 			},
 		},
+		{
+			Name:  "list-backlog",
+			Usage: "List tasks in the backlog of a task queue partition",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Number of backlog tasks scanned per page; a page may contain fewer tasks when filters are set",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitionID,
+					Usage: "Task Queue partition ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Build ID of the versioned queue, default is the unversioned queue",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Only list tasks of this workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Only list tasks of this run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagWorkflowType,
+					Usage: "Only list tasks of this workflow type (requires a mutable state lookup per run)",
+				},
+				&cli.DurationFlag{
+					Name:  FlagMinAge,
+					Usage: "Only list tasks created at least this long ago",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListBacklogTasks(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete-tasks",
+			Usage: "Delete tasks from the backlog of a task queue partition, optionally moving them to another task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitionID,
+					Usage: "Task Queue partition ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagBuildID,
+					Usage: "Build ID of the versioned queue, default is the unversioned queue",
+				},
+				&cli.Int64SliceFlag{
+					Name:     FlagTaskID,
+					Usage:    "ID of the task to delete, can be repeated",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagDestinationTaskQueue,
+					Usage: "Re-enqueue the tasks to this task queue before deleting them",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteBacklogTasks(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "list-workers",
+			Usage: "List workers that heartbeat or polled any partition of a task queue recently",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListWorkers(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-worker",
+			Usage: "Describe a worker of a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagIdentity,
+					Usage:    "Worker identity",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeWorker(c, clientFactory)
+			},
+		},
 	}
 }
 